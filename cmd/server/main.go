@@ -2,10 +2,14 @@ package main
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"goblog/ent"
@@ -13,10 +17,16 @@ import (
 	"goblog/internal/domain"
 	"goblog/internal/handler"
 	"goblog/internal/middleware"
+	"goblog/internal/pkg/cache"
+	"goblog/internal/pkg/jwtkeys"
 	"goblog/internal/pkg/logger"
+	"goblog/internal/pkg/oidc"
+	"goblog/internal/pkg/ratelimit"
+	"goblog/internal/pkg/sse"
 	"goblog/internal/repository"
 	"goblog/internal/service"
 
+	entsql "entgo.io/ent/dialect/sql"
 	"github.com/labstack/echo/v4"
 	echomiddleware "github.com/labstack/echo/v4/middleware"
 	_ "github.com/lib/pq"
@@ -32,6 +42,9 @@ func main() {
 		case "--migrate-only":
 			runMigrationOnly()
 			return
+		case "--backfill-text-stats":
+			runBackfillTextStats(len(os.Args) > 2 && os.Args[2] == "--all")
+			return
 		case "--version":
 			fmt.Println("goblog version 1.0.0")
 			return
@@ -43,42 +56,141 @@ func main() {
 
 	// 加载配置
 	cfg := config.Load()
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("invalid configuration: %v", err)
+	}
 
-	// 创建Ent客户端
-	client, err := ent.Open(cfg.Database.Driver, cfg.Database.DSN)
+	// 加载令牌签名密钥
+	jwtKeys, err := newJWTKeySet(cfg)
+	if err != nil {
+		log.Fatalf("failed loading jwt keys: %v", err)
+	}
+
+	// 创建数据库连接，Ent客户端和共享限流存储共用同一个连接池
+	db, err := sql.Open(cfg.Database.Driver, cfg.Database.DSN)
 	if err != nil {
 		log.Fatalf("failed opening connection to database: %v", err)
 	}
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(cfg.Database.Driver, db)))
 	defer client.Close()
 
 	// 运行自动迁移
 	if err := client.Schema.Create(context.Background()); err != nil {
 		log.Fatalf("failed creating schema resources: %v", err)
 	}
+	if _, err := repository.BackfillArticleStatus(context.Background(), client); err != nil {
+		log.Fatalf("failed backfilling article status: %v", err)
+	}
+
+	// 初始化限流存储
+	rateLimitStore, err := newRateLimitStore(cfg, db)
+	if err != nil {
+		log.Fatalf("failed creating rate limit store: %v", err)
+	}
 
 	// 初始化仓储层
 	articleRepo := repository.NewArticleRepository(client)
+	articleReviewRepo := repository.NewArticleReviewRepository(client)
+	articleLockRepo := repository.NewArticleLockRepository(client)
 	categoryRepo := repository.NewCategoryRepository(client)
 	tagRepo := repository.NewTagRepository(client)
+	commentRepo := repository.NewCommentRepository(client)
+	spamTokenRepo := repository.NewSpamTokenRepository(client)
+	loginAttemptRepo := repository.NewLoginAttemptRepository(client)
+	loginThrottleRepo := repository.NewLoginThrottleRepository(client)
+	refreshTokenRepo := repository.NewRefreshTokenRepository(client)
+	tokenDenylistRepo := repository.NewTokenDenylistRepository(client)
+	twoFactorRepo := repository.NewTwoFactorRepository(client)
+	userRepo := repository.NewUserRepository(client)
+	apiKeyRepo := repository.NewAPIKeyRepository(client)
+	auditRepo := repository.NewAuditRepository(client)
+	webhookRepo := repository.NewWebhookRepository(client)
+	webhookDeliveryRepo := repository.NewWebhookDeliveryRepository(client)
+	outboxRepo := repository.NewOutboxRepository(client)
+	transactor := repository.NewTransactor(client)
+
+	// 文章、分类、标签的读穿缓存，写操作提交后失效相关条目
+	repoCache, err := newRepositoryCache(cfg, db)
+	if err != nil {
+		log.Fatalf("failed creating repository cache: %v", err)
+	}
+	if repoCache != nil {
+		articleRepo = repository.NewCachedArticleRepository(articleRepo, repoCache)
+		categoryRepo = repository.NewCachedCategoryRepository(categoryRepo, repoCache)
+		tagRepo = repository.NewCachedTagRepository(tagRepo, repoCache)
+		commentRepo = repository.NewCachedCommentRepository(commentRepo, repoCache)
+	}
+
+	// 领域事件：写操作在同一事务中写入发件箱，由后台任务分发给订阅者；实时事件流在事务提交后直接推送
+	streamBroker := sse.NewBroker(cfg.Stream.BufferSize)
+	streamPublisher, err := newStreamPublisher(cfg, db, streamBroker)
+	if err != nil {
+		log.Fatalf("failed creating stream publisher: %v", err)
+	}
+	eventBus := domain.NewEventBus()
+	eventPublisher := service.NewStreamPublisher(service.NewOutboxPublisher(outboxRepo, eventBus), repository.NewStreamNotifier(streamPublisher))
 
 	// 初始化服务层
-	authService := service.NewAuthService(cfg)
-	articleService := service.NewArticleService(articleRepo, categoryRepo, tagRepo)
-	categoryService := service.NewCategoryService(categoryRepo)
-	tagService := service.NewTagService(tagRepo)
+	twoFactorService := service.NewTwoFactorService(twoFactorRepo, cfg.TwoFactor)
+	authService := service.NewAuthService(cfg, jwtKeys, loginAttemptRepo, loginThrottleRepo, refreshTokenRepo, tokenDenylistRepo, twoFactorService, userRepo)
+	apiKeyService := service.NewAPIKeyService(apiKeyRepo, userRepo, cfg)
+	auditService := service.NewAuditService(auditRepo)
+	webhookService := service.NewWebhookService(webhookRepo, webhookDeliveryRepo, &http.Client{Timeout: cfg.Webhook.Timeout}, cfg.Webhook)
+	articleService := service.NewArticleService(articleRepo, categoryRepo, tagRepo, articleReviewRepo, transactor, eventPublisher)
+	articleLockService := service.NewArticleLockService(articleLockRepo, articleRepo, cfg.Article)
+	categoryService := service.NewCategoryService(categoryRepo, transactor, eventPublisher)
+	tagService := service.NewTagService(tagRepo, transactor, eventPublisher)
+	spamClassifier := service.NewSpamClassifier(spamTokenRepo, cfg.Comment)
+	commentService := service.NewCommentService(commentRepo, articleRepo, spamClassifier, transactor, eventPublisher, cfg.Comment)
+
+	// 事件订阅者
+	service.SubscribeAudit(eventBus, auditService)
+	service.SubscribeWebhooks(eventBus, webhookService)
 
 	// 初始化中间件
-	authMiddleware := middleware.NewAuthMiddleware(authService)
+	authMiddleware := middleware.NewAuthMiddleware(authService, apiKeyService)
+	rateLimitMiddleware := middleware.NewRateLimitMiddleware(rateLimitStore)
 
 	// 初始化处理器
 	articleHandler := handler.NewArticleHandler(articleService)
+	articleLockHandler := handler.NewArticleLockHandler(articleLockService)
 	categoryHandler := handler.NewCategoryHandler(categoryService)
 	tagHandler := handler.NewTagHandler(tagService)
+	commentHandler := handler.NewCommentHandler(commentService)
+	authHandler := handler.NewAuthHandler(authService, twoFactorService)
+	apiKeyHandler := handler.NewAPIKeyHandler(apiKeyService)
+	auditHandler := handler.NewAuditHandler(auditService)
+	webhookHandler := handler.NewWebhookHandler(webhookService)
+	streamHandler := handler.NewStreamHandler(streamBroker, cfg.Stream.Heartbeat)
+	cacheHandler := handler.NewCacheHandler(repoCache)
+
+	// 收到退出信号后停止后台任务并关闭服务器
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// 启动后台任务
+	workerCtx, stopWorkers := context.WithCancel(ctx)
+	defer stopWorkers()
+	go service.RunWorker(workerCtx, "outbox", cfg.Outbox.PollInterval, service.NewOutboxDispatcher(outboxRepo, eventBus, cfg.Outbox).ProcessDue)
+	go service.RunWorker(workerCtx, "webhook", cfg.Webhook.PollInterval, webhookService.ProcessDue)
+	if listener, ok := streamPublisher.(*sse.PostgresPublisher); ok {
+		go func() {
+			if err := listener.Listen(workerCtx); err != nil {
+				logger.Error("监听实时事件失败", "error", err)
+			}
+		}()
+	}
 
-	// 创建Echo实例
+	// 创建Echo实例，客户端IP用于限流、登录锁定和审计日志，只信任配置的代理转发的地址
 	e := echo.New()
+	ipExtractor, err := middleware.IPExtractor(cfg.Server.TrustedProxies)
+	if err != nil {
+		log.Fatalf("配置客户端IP获取方式失败: %v", err)
+	}
+	e.IPExtractor = ipExtractor
 
 	// 全局中间件
+	e.Use(echomiddleware.RequestID())
 	e.Use(echomiddleware.Logger())
 	e.Use(echomiddleware.Recover())
 	e.Use(echomiddleware.CORS())
@@ -87,86 +199,245 @@ func main() {
 	api := e.Group("/api")
 
 	// 公开路由（读操作）
-	setupPublicRoutes(api, articleHandler, categoryHandler, tagHandler)
+	setupPublicRoutes(api, cfg, rateLimitMiddleware, articleHandler, categoryHandler, tagHandler, commentHandler)
 
 	// 需要认证的路由（写操作）
-	setupAuthRoutes(api, authMiddleware, articleHandler, categoryHandler, tagHandler)
+	setupAuthRoutes(api, cfg, authMiddleware, rateLimitMiddleware, articleHandler, articleLockHandler, categoryHandler, tagHandler, commentHandler, authHandler, apiKeyHandler, auditHandler, webhookHandler, streamHandler, cacheHandler)
 
 	// 认证路由
-	setupAuthEndpoints(e, authService)
+	setupAuthEndpoints(e, cfg, authMiddleware, rateLimitMiddleware, authHandler)
+	e.GET("/.well-known/jwks.json", handler.NewJWKSHandler(jwtKeys).Keys, rateLimitMiddleware.Limit("public", cfg.RateLimit.PublicRead))
+
+	// 单点登录
+	if cfg.OIDC.Enabled {
+		provider := oidc.NewProvider(oidc.Config{
+			Issuer:       cfg.OIDC.Issuer,
+			ClientID:     cfg.OIDC.ClientID,
+			ClientSecret: cfg.OIDC.ClientSecret,
+			RedirectURL:  cfg.OIDC.RedirectURL,
+			Scopes:       cfg.OIDC.Scopes,
+		}, &http.Client{Timeout: 10 * time.Second})
+		oidcService := service.NewOIDCService(provider, jwtKeys, userRepo, authService, cfg)
+		setupOIDCEndpoints(e, cfg, rateLimitMiddleware, handler.NewOIDCHandler(oidcService))
+	}
 
 	// 健康检查端点
 	setupHealthCheck(e)
 
 	// 启动服务器
 	logger.Info("博客服务器启动", "port", cfg.Server.Port)
-	log.Fatal(e.Start(cfg.Server.Port))
+	go func() {
+		if err := e.Start(cfg.Server.Port); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("failed starting server: %v", err)
+		}
+	}()
+
+	<-ctx.Done()
+	logger.Info("博客服务器关闭中")
+
+	// 先结束事件流长连接，否则服务器会一直等待其退出
+	streamBroker.Close()
+	stopWorkers()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := e.Shutdown(shutdownCtx); err != nil {
+		logger.Error("服务器关闭失败", "error", err)
+	}
 }
 
+// shutdownTimeout 关闭服务器时等待进行中请求完成的最长时间
+const shutdownTimeout = 10 * time.Second
+
 // setupPublicRoutes 设置公开路由
-func setupPublicRoutes(api *echo.Group, articleHandler *handler.ArticleHandler, categoryHandler *handler.CategoryHandler, tagHandler *handler.TagHandler) {
+func setupPublicRoutes(api *echo.Group, cfg *config.Config, rateLimitMiddleware *middleware.RateLimitMiddleware, articleHandler *handler.ArticleHandler, categoryHandler *handler.CategoryHandler, tagHandler *handler.TagHandler, commentHandler *handler.CommentHandler) {
+	publicGroup := api.Group("", rateLimitMiddleware.Limit("public", cfg.RateLimit.PublicRead), middleware.CacheControl(cfg.HTTPCache.Public))
+
 	// 文章路由
-	api.GET("/articles", articleHandler.List)
-	api.GET("/articles/:id", articleHandler.GetByID)
-	api.GET("/articles/category/:categoryId", articleHandler.ListByCategory)
-	api.GET("/articles/tag/:tagId", articleHandler.ListByTag)
+	publicGroup.GET("/articles", articleHandler.List)
+	publicGroup.GET("/articles/:id", articleHandler.GetByID)
+	publicGroup.GET("/articles/category/:categoryId", articleHandler.ListByCategory)
+	publicGroup.GET("/articles/tag/:tagId", articleHandler.ListByTag)
 
 	// 分类路由
-	api.GET("/categories", categoryHandler.List)
-	api.GET("/categories/:id", categoryHandler.GetByID)
+	publicGroup.GET("/categories", categoryHandler.List)
+	publicGroup.GET("/categories/tree", categoryHandler.Tree)
+	publicGroup.GET("/categories/:id", categoryHandler.GetByID)
 
 	// 标签路由
-	api.GET("/tags", tagHandler.List)
-	api.GET("/tags/:id", tagHandler.GetByID)
+	publicGroup.GET("/tags", tagHandler.List)
+	publicGroup.GET("/tags/cloud", tagHandler.Cloud)
+	publicGroup.GET("/tags/:id", tagHandler.GetByID)
+
+	// 评论路由
+	publicGroup.GET("/articles/:id/comments", commentHandler.ListByArticle)
+	api.POST("/articles/:id/comments", commentHandler.Create, rateLimitMiddleware.Limit("comment", cfg.RateLimit.Comment))
 }
 
 // setupAuthRoutes 设置需要认证的路由
-func setupAuthRoutes(api *echo.Group, authMiddleware *middleware.AuthMiddleware, articleHandler *handler.ArticleHandler, categoryHandler *handler.CategoryHandler, tagHandler *handler.TagHandler) {
-	authGroup := api.Group("", authMiddleware.RequireAuth())
+func setupAuthRoutes(api *echo.Group, cfg *config.Config, authMiddleware *middleware.AuthMiddleware, rateLimitMiddleware *middleware.RateLimitMiddleware, articleHandler *handler.ArticleHandler, articleLockHandler *handler.ArticleLockHandler, categoryHandler *handler.CategoryHandler, tagHandler *handler.TagHandler, commentHandler *handler.CommentHandler, authHandler *handler.AuthHandler, apiKeyHandler *handler.APIKeyHandler, auditHandler *handler.AuditHandler, webhookHandler *handler.WebhookHandler, streamHandler *handler.StreamHandler, cacheHandler *handler.CacheHandler) {
+	// 实时事件流包含未发布文章，仅编辑和管理员可订阅；长连接单独限制建立连接的频率，不占用写接口的配额
+	api.GET("/events/stream", streamHandler.Stream, authMiddleware.RequireAuth(), rateLimitMiddleware.Limit("stream", cfg.RateLimit.Stream),
+		authMiddleware.RequireScope(domain.ScopeEventsRead), authMiddleware.RequireRole(domain.RoleEditor, domain.RoleAdmin))
+
+	authGroup := api.Group("", authMiddleware.RequireAuth(), rateLimitMiddleware.Limit("write", cfg.RateLimit.Write), middleware.CacheControl(cfg.HTTPCache.Private))
+
+	// API密钥只能访问其权限范围内的路由，账号安全相关操作只能使用登录会话
+	articlesWrite := authMiddleware.RequireScope(domain.ScopeArticlesWrite)
+	categoriesWrite := authMiddleware.RequireScope(domain.ScopeCategoriesWrite)
+	tagsWrite := authMiddleware.RequireScope(domain.ScopeTagsWrite)
+	commentsModerate := authMiddleware.RequireScope(domain.ScopeCommentsModerate)
+	backupRead := authMiddleware.RequireScope(domain.ScopeBackupRead)
+	sessionOnly := authMiddleware.RequireSession()
+	adminOnly := authMiddleware.RequireRole(domain.RoleAdmin)
 
 	// 文章管理
-	authGroup.POST("/articles", articleHandler.Create)
-	authGroup.PUT("/articles/:id", articleHandler.Update)
-	authGroup.DELETE("/articles/:id", articleHandler.Delete)
-
-	// 文章备份
-	authGroup.GET("/articles/backup", articleHandler.Backup)
-
-	// 分类管理
-	authGroup.POST("/categories", categoryHandler.Create)
-	authGroup.PUT("/categories/:id", categoryHandler.Update)
-	authGroup.DELETE("/categories/:id", categoryHandler.Delete)
-
-	// 标签管理
-	authGroup.POST("/tags", tagHandler.Create)
-	authGroup.PUT("/tags/:id", tagHandler.Update)
-	authGroup.DELETE("/tags/:id", tagHandler.Delete)
+	authGroup.POST("/articles", articleHandler.Create, articlesWrite)
+	authGroup.PUT("/articles/:id", articleHandler.Update, articlesWrite)
+	authGroup.DELETE("/articles/:id", articleHandler.Delete, articlesWrite)
+
+	// 编辑流程，各状态流转允许的角色由服务层校验
+	authGroup.POST("/articles/:id/status", articleHandler.Transition, articlesWrite)
+	authGroup.GET("/articles/:id/reviews", articleHandler.ListReviews, articlesWrite)
+	authGroup.POST("/articles/:id/reviews", articleHandler.AddReviewNote, articlesWrite)
+
+	// 编辑租约，提示其他编辑者文章正在编辑
+	authGroup.GET("/articles/:id/lock", articleLockHandler.Get, articlesWrite)
+	authGroup.POST("/articles/:id/lock", articleLockHandler.Acquire, articlesWrite)
+	authGroup.DELETE("/articles/:id/lock", articleLockHandler.Release, articlesWrite)
+
+	// 批量修改标签，过滤参数与文章列表相同，仅编辑和管理员可操作
+	authGroup.POST("/articles/retag", articleHandler.Retag, articlesWrite, tagsWrite)
+
+	// 文章备份，包含未发布文章，仅编辑和管理员可操作
+	authGroup.GET("/articles/backup", articleHandler.Backup, backupRead)
+
+	// 分类管理，仅编辑和管理员可操作
+	authGroup.POST("/categories", categoryHandler.Create, categoriesWrite)
+	authGroup.PUT("/categories/:id", categoryHandler.Update, categoriesWrite)
+	authGroup.DELETE("/categories/:id", categoryHandler.Delete, categoriesWrite)
+
+	// 标签管理，仅编辑和管理员可操作
+	authGroup.POST("/tags", tagHandler.Create, tagsWrite)
+	authGroup.PUT("/tags/:id", tagHandler.Update, tagsWrite)
+	authGroup.DELETE("/tags/:id", tagHandler.Delete, tagsWrite)
+	authGroup.POST("/tags/:id/merge", tagHandler.Merge, tagsWrite)
+
+	// 评论审核，仅编辑和管理员可操作
+	authGroup.GET("/comments", commentHandler.List, commentsModerate)
+	authGroup.PUT("/comments/:id/approve", commentHandler.Approve, commentsModerate)
+	authGroup.PUT("/comments/:id/spam", commentHandler.MarkSpam, commentsModerate)
+	authGroup.DELETE("/comments/:id", commentHandler.Delete, commentsModerate)
+
+	// 登录审计和锁定管理，只允许管理员查看和解锁
+	authGroup.GET("/auth/login-attempts", authHandler.ListLoginAttempts, sessionOnly, adminOnly)
+	authGroup.GET("/auth/lockouts", authHandler.ListLockouts, sessionOnly, adminOnly)
+	authGroup.DELETE("/auth/lockouts", authHandler.ClearLockout, sessionOnly, adminOnly)
+
+	// 内容变更审计，只允许管理员查询
+	authGroup.GET("/audit", auditHandler.List, sessionOnly, adminOnly)
+
+	// 两步验证管理
+	authGroup.POST("/auth/2fa/setup", authHandler.SetupTwoFactor, sessionOnly)
+	authGroup.POST("/auth/2fa/enable", authHandler.EnableTwoFactor, sessionOnly)
+	authGroup.POST("/auth/2fa/disable", authHandler.DisableTwoFactor, sessionOnly)
+	authGroup.POST("/auth/2fa/recovery-codes", authHandler.RegenerateRecoveryCodes, sessionOnly)
+
+	// 个人API密钥
+	authGroup.POST("/auth/api-keys", apiKeyHandler.Create, sessionOnly)
+	authGroup.GET("/auth/api-keys", apiKeyHandler.List, sessionOnly)
+	authGroup.DELETE("/auth/api-keys/:id", apiKeyHandler.Revoke, sessionOnly)
+
+	// Webhook订阅，回调地址和签名密钥只允许管理员管理
+	authGroup.POST("/webhooks", webhookHandler.Create, sessionOnly, adminOnly)
+	authGroup.GET("/webhooks", webhookHandler.List, sessionOnly, adminOnly)
+	authGroup.GET("/webhooks/:id", webhookHandler.GetByID, sessionOnly, adminOnly)
+	authGroup.PUT("/webhooks/:id", webhookHandler.Update, sessionOnly, adminOnly)
+	authGroup.DELETE("/webhooks/:id", webhookHandler.Delete, sessionOnly, adminOnly)
+	authGroup.GET("/webhooks/:id/deliveries", webhookHandler.ListDeliveries, sessionOnly, adminOnly)
+	authGroup.POST("/webhooks/:id/deliveries/:deliveryId/replay", webhookHandler.Replay, sessionOnly, adminOnly)
+
+	// 仓储缓存统计
+	authGroup.GET("/cache/stats", cacheHandler.Stats, sessionOnly, adminOnly)
+}
+
+// newJWTKeySet 配置了JWT_KEYS时使用非对称密钥签名，否则使用JWT_SECRET
+func newJWTKeySet(cfg *config.Config) (*jwtkeys.KeySet, error) {
+	if len(cfg.JWT.Keys) == 0 {
+		return jwtkeys.NewHMAC(cfg.JWT.Secret), nil
+	}
+	return jwtkeys.LoadFiles(cfg.JWT.Keys, cfg.JWT.ActiveKeyID)
+}
+
+// setupOIDCEndpoints 设置单点登录端点
+func setupOIDCEndpoints(e *echo.Echo, cfg *config.Config, rateLimitMiddleware *middleware.RateLimitMiddleware, oidcHandler *handler.OIDCHandler) {
+	oidcGroup := e.Group("/auth/oidc", rateLimitMiddleware.Limit("login", cfg.RateLimit.Login))
+	oidcGroup.GET("/login", oidcHandler.Login)
+	oidcGroup.GET("/callback", oidcHandler.Callback)
 }
 
 // setupAuthEndpoints 设置认证端点
-func setupAuthEndpoints(e *echo.Echo, authService domain.AuthService) {
-	// 直接处理登录，避免循环依赖
-	e.POST("/auth/login", func(c echo.Context) error {
-		var req struct {
-			Username string `json:"username"`
-			Password string `json:"password"`
-		}
-		if err := c.Bind(&req); err != nil {
-			return c.JSON(400, map[string]string{"error": "无效的请求参数"})
-		}
+func setupAuthEndpoints(e *echo.Echo, cfg *config.Config, authMiddleware *middleware.AuthMiddleware, rateLimitMiddleware *middleware.RateLimitMiddleware, authHandler *handler.AuthHandler) {
+	e.POST("/auth/login", authHandler.Login, rateLimitMiddleware.Limit("login", cfg.RateLimit.Login))
+	e.POST("/auth/login/2fa", authHandler.LoginTwoFactor, rateLimitMiddleware.Limit("login", cfg.RateLimit.Login))
+	e.POST("/auth/refresh", authHandler.Refresh, rateLimitMiddleware.Limit("refresh", cfg.RateLimit.Login))
+	e.POST("/auth/logout", authHandler.Logout)
+
+	// 强制开启两步验证的用户使用登录返回的挑战令牌完成绑定
+	setupGroup := e.Group("/auth/2fa", authMiddleware.RequireChallenge(), rateLimitMiddleware.Limit("login", cfg.RateLimit.Login))
+	setupGroup.POST("/setup", authHandler.SetupTwoFactor)
+	setupGroup.POST("/enable", authHandler.EnableTwoFactor)
+}
 
-		loginReq := &domain.LoginRequest{
-			Username: req.Username,
-			Password: req.Password,
-		}
+// newRateLimitStore 根据配置创建限流计数存储
+func newRateLimitStore(cfg *config.Config, db *sql.DB) (ratelimit.Store, error) {
+	switch cfg.RateLimit.Store {
+	case "postgres":
+		return ratelimit.NewPostgresStore(context.Background(), db)
+	case "memory", "":
+		return ratelimit.NewMemoryStore(), nil
+	default:
+		return nil, fmt.Errorf("unknown rate limit store: %s", cfg.RateLimit.Store)
+	}
+}
+
+// newStreamPublisher 根据配置创建实时事件流发布器
+func newStreamPublisher(cfg *config.Config, db *sql.DB, broker *sse.Broker) (sse.Publisher, error) {
+	switch cfg.Stream.Store {
+	case "postgres":
+		return sse.NewPostgresPublisher(db, cfg.Database.DSN, broker)
+	case "memory", "":
+		return sse.NewLocalPublisher(broker), nil
+	default:
+		return nil, fmt.Errorf("unknown stream store: %s", cfg.Stream.Store)
+	}
+}
 
-		resp, err := authService.Login(c.Request().Context(), loginReq)
+// newRepositoryCache 根据配置创建仓储缓存，未启用时返回nil
+func newRepositoryCache(cfg *config.Config, db *sql.DB) (*cache.Cache, error) {
+	if !cfg.Cache.Enabled {
+		return nil, nil
+	}
+
+	opts := cache.Options{TTL: cfg.Cache.TTL, LocalTTL: cfg.Cache.LocalTTL}
+	switch cfg.Cache.Store {
+	case "redis":
+		shared, err := cache.NewRedis(context.Background(), cfg.Cache.RedisURL)
 		if err != nil {
-			return c.JSON(401, map[string]string{"error": "用户名或密码错误"})
+			return nil, err
 		}
-
-		return c.JSON(200, resp)
-	})
+		return cache.New(cache.NewMemory(cfg.Cache.Size), shared, opts), nil
+	case "postgres":
+		shared, err := cache.NewPostgres(context.Background(), db)
+		if err != nil {
+			return nil, err
+		}
+		return cache.New(cache.NewMemory(cfg.Cache.Size), shared, opts), nil
+	case "memory", "":
+		return cache.New(cache.NewMemory(cfg.Cache.Size), nil, opts), nil
+	default:
+		return nil, fmt.Errorf("unknown cache store: %s", cfg.Cache.Store)
+	}
 }
 
 // setupHealthCheck 设置健康检查端点
@@ -202,10 +473,35 @@ func runMigrationOnly() {
 	if err := client.Schema.Create(context.Background()); err != nil {
 		log.Fatalf("failed creating schema resources: %v", err)
 	}
+	if _, err := repository.BackfillArticleStatus(context.Background(), client); err != nil {
+		log.Fatalf("failed backfilling article status: %v", err)
+	}
 
 	log.Println("数据库迁移完成")
 }
 
+// runBackfillTextStats 为已有文章计算字数、阅读时间和生成的摘要，all为true时重新计算全部文章
+func runBackfillTextStats(all bool) {
+	logger.Init()
+	cfg := config.Load()
+
+	client, err := ent.Open(cfg.Database.Driver, cfg.Database.DSN)
+	if err != nil {
+		log.Fatalf("failed opening connection to database: %v", err)
+	}
+	defer client.Close()
+
+	if err := client.Schema.Create(context.Background()); err != nil {
+		log.Fatalf("failed creating schema resources: %v", err)
+	}
+
+	updated, err := repository.BackfillArticleTextStats(context.Background(), client, all)
+	if err != nil {
+		log.Fatalf("failed backfilling article text stats: %v", err)
+	}
+	log.Printf("已更新%d篇文章的字数统计", updated)
+}
+
 // healthCheck 执行健康检查
 func healthCheck() {
 	// 获取服务端口，默认为8080
@@ -5,6 +5,7 @@ package ent
 import (
 	"fmt"
 	"goblog/ent/article"
+	"goblog/ent/articlelock"
 	"goblog/ent/category"
 	"strings"
 	"time"
@@ -28,8 +29,22 @@ type Article struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// 更新时间
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
-	// 是否发布
+	// 编辑流程状态
+	Status article.Status `json:"status,omitempty"`
+	// 乐观锁版本号，每次修改加一
+	Version int `json:"version,omitempty"`
+	// 是否发布，与status保持同步以兼容旧的查询
 	Published bool `json:"published,omitempty"`
+	// 首次发布时间
+	PublishedAt *time.Time `json:"published_at,omitempty"`
+	// 作者用户名
+	Author string `json:"author,omitempty"`
+	// 正文字数，中日文按字计数，其余按词计数
+	WordCount int `json:"word_count,omitempty"`
+	// 预计阅读分钟数
+	ReadingMinutes int `json:"reading_minutes,omitempty"`
+	// 从正文生成的摘要，文章没有填写摘要时使用
+	Excerpt string `json:"excerpt,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ArticleQuery when eager-loading is set.
 	Edges             ArticleEdges `json:"edges"`
@@ -43,9 +58,15 @@ type ArticleEdges struct {
 	Category *Category `json:"category,omitempty"`
 	// Tags holds the value of the tags edge.
 	Tags []*Tag `json:"tags,omitempty"`
+	// Comments holds the value of the comments edge.
+	Comments []*Comment `json:"comments,omitempty"`
+	// Reviews holds the value of the reviews edge.
+	Reviews []*ArticleReview `json:"reviews,omitempty"`
+	// Lock holds the value of the lock edge.
+	Lock *ArticleLock `json:"lock,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [5]bool
 }
 
 // CategoryOrErr returns the Category value or an error if the edge
@@ -68,6 +89,35 @@ func (e ArticleEdges) TagsOrErr() ([]*Tag, error) {
 	return nil, &NotLoadedError{edge: "tags"}
 }
 
+// CommentsOrErr returns the Comments value or an error if the edge
+// was not loaded in eager-loading.
+func (e ArticleEdges) CommentsOrErr() ([]*Comment, error) {
+	if e.loadedTypes[2] {
+		return e.Comments, nil
+	}
+	return nil, &NotLoadedError{edge: "comments"}
+}
+
+// ReviewsOrErr returns the Reviews value or an error if the edge
+// was not loaded in eager-loading.
+func (e ArticleEdges) ReviewsOrErr() ([]*ArticleReview, error) {
+	if e.loadedTypes[3] {
+		return e.Reviews, nil
+	}
+	return nil, &NotLoadedError{edge: "reviews"}
+}
+
+// LockOrErr returns the Lock value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ArticleEdges) LockOrErr() (*ArticleLock, error) {
+	if e.Lock != nil {
+		return e.Lock, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: articlelock.Label}
+	}
+	return nil, &NotLoadedError{edge: "lock"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Article) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -75,11 +125,11 @@ func (*Article) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case article.FieldPublished:
 			values[i] = new(sql.NullBool)
-		case article.FieldID:
+		case article.FieldID, article.FieldVersion, article.FieldWordCount, article.FieldReadingMinutes:
 			values[i] = new(sql.NullInt64)
-		case article.FieldTitle, article.FieldContent, article.FieldSummary:
+		case article.FieldTitle, article.FieldContent, article.FieldSummary, article.FieldStatus, article.FieldAuthor, article.FieldExcerpt:
 			values[i] = new(sql.NullString)
-		case article.FieldCreatedAt, article.FieldUpdatedAt:
+		case article.FieldCreatedAt, article.FieldUpdatedAt, article.FieldPublishedAt:
 			values[i] = new(sql.NullTime)
 		case article.ForeignKeys[0]: // category_articles
 			values[i] = new(sql.NullInt64)
@@ -134,12 +184,55 @@ func (a *Article) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				a.UpdatedAt = value.Time
 			}
+		case article.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				a.Status = article.Status(value.String)
+			}
+		case article.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				a.Version = int(value.Int64)
+			}
 		case article.FieldPublished:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field published", values[i])
 			} else if value.Valid {
 				a.Published = value.Bool
 			}
+		case article.FieldPublishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field published_at", values[i])
+			} else if value.Valid {
+				a.PublishedAt = new(time.Time)
+				*a.PublishedAt = value.Time
+			}
+		case article.FieldAuthor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author", values[i])
+			} else if value.Valid {
+				a.Author = value.String
+			}
+		case article.FieldWordCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field word_count", values[i])
+			} else if value.Valid {
+				a.WordCount = int(value.Int64)
+			}
+		case article.FieldReadingMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reading_minutes", values[i])
+			} else if value.Valid {
+				a.ReadingMinutes = int(value.Int64)
+			}
+		case article.FieldExcerpt:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field excerpt", values[i])
+			} else if value.Valid {
+				a.Excerpt = value.String
+			}
 		case article.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field category_articles", value)
@@ -170,6 +263,21 @@ func (a *Article) QueryTags() *TagQuery {
 	return NewArticleClient(a.config).QueryTags(a)
 }
 
+// QueryComments queries the "comments" edge of the Article entity.
+func (a *Article) QueryComments() *CommentQuery {
+	return NewArticleClient(a.config).QueryComments(a)
+}
+
+// QueryReviews queries the "reviews" edge of the Article entity.
+func (a *Article) QueryReviews() *ArticleReviewQuery {
+	return NewArticleClient(a.config).QueryReviews(a)
+}
+
+// QueryLock queries the "lock" edge of the Article entity.
+func (a *Article) QueryLock() *ArticleLockQuery {
+	return NewArticleClient(a.config).QueryLock(a)
+}
+
 // Update returns a builder for updating this Article.
 // Note that you need to call Article.Unwrap() before calling this method if this Article
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -208,8 +316,31 @@ func (a *Article) String() string {
 	builder.WriteString("updated_at=")
 	builder.WriteString(a.UpdatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", a.Status))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", a.Version))
+	builder.WriteString(", ")
 	builder.WriteString("published=")
 	builder.WriteString(fmt.Sprintf("%v", a.Published))
+	builder.WriteString(", ")
+	if v := a.PublishedAt; v != nil {
+		builder.WriteString("published_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("author=")
+	builder.WriteString(a.Author)
+	builder.WriteString(", ")
+	builder.WriteString("word_count=")
+	builder.WriteString(fmt.Sprintf("%v", a.WordCount))
+	builder.WriteString(", ")
+	builder.WriteString("reading_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", a.ReadingMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("excerpt=")
+	builder.WriteString(a.Excerpt)
 	builder.WriteByte(')')
 	return builder.String()
 }
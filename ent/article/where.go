@@ -80,11 +80,41 @@ func UpdatedAt(v time.Time) predicate.Article {
 	return predicate.Article(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldVersion, v))
+}
+
 // Published applies equality check predicate on the "published" field. It's identical to PublishedEQ.
 func Published(v bool) predicate.Article {
 	return predicate.Article(sql.FieldEQ(FieldPublished, v))
 }
 
+// PublishedAt applies equality check predicate on the "published_at" field. It's identical to PublishedAtEQ.
+func PublishedAt(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldPublishedAt, v))
+}
+
+// Author applies equality check predicate on the "author" field. It's identical to AuthorEQ.
+func Author(v string) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldAuthor, v))
+}
+
+// WordCount applies equality check predicate on the "word_count" field. It's identical to WordCountEQ.
+func WordCount(v int) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldWordCount, v))
+}
+
+// ReadingMinutes applies equality check predicate on the "reading_minutes" field. It's identical to ReadingMinutesEQ.
+func ReadingMinutes(v int) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldReadingMinutes, v))
+}
+
+// Excerpt applies equality check predicate on the "excerpt" field. It's identical to ExcerptEQ.
+func Excerpt(v string) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldExcerpt, v))
+}
+
 // TitleEQ applies the EQ predicate on the "title" field.
 func TitleEQ(v string) predicate.Article {
 	return predicate.Article(sql.FieldEQ(FieldTitle, v))
@@ -370,6 +400,66 @@ func UpdatedAtLTE(v time.Time) predicate.Article {
 	return predicate.Article(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Article {
+	return predicate.Article(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Article {
+	return predicate.Article(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Article {
+	return predicate.Article(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.Article {
+	return predicate.Article(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.Article {
+	return predicate.Article(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.Article {
+	return predicate.Article(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.Article {
+	return predicate.Article(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.Article {
+	return predicate.Article(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.Article {
+	return predicate.Article(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.Article {
+	return predicate.Article(sql.FieldLTE(FieldVersion, v))
+}
+
 // PublishedEQ applies the EQ predicate on the "published" field.
 func PublishedEQ(v bool) predicate.Article {
 	return predicate.Article(sql.FieldEQ(FieldPublished, v))
@@ -380,6 +470,286 @@ func PublishedNEQ(v bool) predicate.Article {
 	return predicate.Article(sql.FieldNEQ(FieldPublished, v))
 }
 
+// PublishedAtEQ applies the EQ predicate on the "published_at" field.
+func PublishedAtEQ(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldPublishedAt, v))
+}
+
+// PublishedAtNEQ applies the NEQ predicate on the "published_at" field.
+func PublishedAtNEQ(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldNEQ(FieldPublishedAt, v))
+}
+
+// PublishedAtIn applies the In predicate on the "published_at" field.
+func PublishedAtIn(vs ...time.Time) predicate.Article {
+	return predicate.Article(sql.FieldIn(FieldPublishedAt, vs...))
+}
+
+// PublishedAtNotIn applies the NotIn predicate on the "published_at" field.
+func PublishedAtNotIn(vs ...time.Time) predicate.Article {
+	return predicate.Article(sql.FieldNotIn(FieldPublishedAt, vs...))
+}
+
+// PublishedAtGT applies the GT predicate on the "published_at" field.
+func PublishedAtGT(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldGT(FieldPublishedAt, v))
+}
+
+// PublishedAtGTE applies the GTE predicate on the "published_at" field.
+func PublishedAtGTE(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldGTE(FieldPublishedAt, v))
+}
+
+// PublishedAtLT applies the LT predicate on the "published_at" field.
+func PublishedAtLT(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldLT(FieldPublishedAt, v))
+}
+
+// PublishedAtLTE applies the LTE predicate on the "published_at" field.
+func PublishedAtLTE(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldLTE(FieldPublishedAt, v))
+}
+
+// PublishedAtIsNil applies the IsNil predicate on the "published_at" field.
+func PublishedAtIsNil() predicate.Article {
+	return predicate.Article(sql.FieldIsNull(FieldPublishedAt))
+}
+
+// PublishedAtNotNil applies the NotNil predicate on the "published_at" field.
+func PublishedAtNotNil() predicate.Article {
+	return predicate.Article(sql.FieldNotNull(FieldPublishedAt))
+}
+
+// AuthorEQ applies the EQ predicate on the "author" field.
+func AuthorEQ(v string) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldAuthor, v))
+}
+
+// AuthorNEQ applies the NEQ predicate on the "author" field.
+func AuthorNEQ(v string) predicate.Article {
+	return predicate.Article(sql.FieldNEQ(FieldAuthor, v))
+}
+
+// AuthorIn applies the In predicate on the "author" field.
+func AuthorIn(vs ...string) predicate.Article {
+	return predicate.Article(sql.FieldIn(FieldAuthor, vs...))
+}
+
+// AuthorNotIn applies the NotIn predicate on the "author" field.
+func AuthorNotIn(vs ...string) predicate.Article {
+	return predicate.Article(sql.FieldNotIn(FieldAuthor, vs...))
+}
+
+// AuthorGT applies the GT predicate on the "author" field.
+func AuthorGT(v string) predicate.Article {
+	return predicate.Article(sql.FieldGT(FieldAuthor, v))
+}
+
+// AuthorGTE applies the GTE predicate on the "author" field.
+func AuthorGTE(v string) predicate.Article {
+	return predicate.Article(sql.FieldGTE(FieldAuthor, v))
+}
+
+// AuthorLT applies the LT predicate on the "author" field.
+func AuthorLT(v string) predicate.Article {
+	return predicate.Article(sql.FieldLT(FieldAuthor, v))
+}
+
+// AuthorLTE applies the LTE predicate on the "author" field.
+func AuthorLTE(v string) predicate.Article {
+	return predicate.Article(sql.FieldLTE(FieldAuthor, v))
+}
+
+// AuthorContains applies the Contains predicate on the "author" field.
+func AuthorContains(v string) predicate.Article {
+	return predicate.Article(sql.FieldContains(FieldAuthor, v))
+}
+
+// AuthorHasPrefix applies the HasPrefix predicate on the "author" field.
+func AuthorHasPrefix(v string) predicate.Article {
+	return predicate.Article(sql.FieldHasPrefix(FieldAuthor, v))
+}
+
+// AuthorHasSuffix applies the HasSuffix predicate on the "author" field.
+func AuthorHasSuffix(v string) predicate.Article {
+	return predicate.Article(sql.FieldHasSuffix(FieldAuthor, v))
+}
+
+// AuthorIsNil applies the IsNil predicate on the "author" field.
+func AuthorIsNil() predicate.Article {
+	return predicate.Article(sql.FieldIsNull(FieldAuthor))
+}
+
+// AuthorNotNil applies the NotNil predicate on the "author" field.
+func AuthorNotNil() predicate.Article {
+	return predicate.Article(sql.FieldNotNull(FieldAuthor))
+}
+
+// AuthorEqualFold applies the EqualFold predicate on the "author" field.
+func AuthorEqualFold(v string) predicate.Article {
+	return predicate.Article(sql.FieldEqualFold(FieldAuthor, v))
+}
+
+// AuthorContainsFold applies the ContainsFold predicate on the "author" field.
+func AuthorContainsFold(v string) predicate.Article {
+	return predicate.Article(sql.FieldContainsFold(FieldAuthor, v))
+}
+
+// WordCountEQ applies the EQ predicate on the "word_count" field.
+func WordCountEQ(v int) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldWordCount, v))
+}
+
+// WordCountNEQ applies the NEQ predicate on the "word_count" field.
+func WordCountNEQ(v int) predicate.Article {
+	return predicate.Article(sql.FieldNEQ(FieldWordCount, v))
+}
+
+// WordCountIn applies the In predicate on the "word_count" field.
+func WordCountIn(vs ...int) predicate.Article {
+	return predicate.Article(sql.FieldIn(FieldWordCount, vs...))
+}
+
+// WordCountNotIn applies the NotIn predicate on the "word_count" field.
+func WordCountNotIn(vs ...int) predicate.Article {
+	return predicate.Article(sql.FieldNotIn(FieldWordCount, vs...))
+}
+
+// WordCountGT applies the GT predicate on the "word_count" field.
+func WordCountGT(v int) predicate.Article {
+	return predicate.Article(sql.FieldGT(FieldWordCount, v))
+}
+
+// WordCountGTE applies the GTE predicate on the "word_count" field.
+func WordCountGTE(v int) predicate.Article {
+	return predicate.Article(sql.FieldGTE(FieldWordCount, v))
+}
+
+// WordCountLT applies the LT predicate on the "word_count" field.
+func WordCountLT(v int) predicate.Article {
+	return predicate.Article(sql.FieldLT(FieldWordCount, v))
+}
+
+// WordCountLTE applies the LTE predicate on the "word_count" field.
+func WordCountLTE(v int) predicate.Article {
+	return predicate.Article(sql.FieldLTE(FieldWordCount, v))
+}
+
+// ReadingMinutesEQ applies the EQ predicate on the "reading_minutes" field.
+func ReadingMinutesEQ(v int) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldReadingMinutes, v))
+}
+
+// ReadingMinutesNEQ applies the NEQ predicate on the "reading_minutes" field.
+func ReadingMinutesNEQ(v int) predicate.Article {
+	return predicate.Article(sql.FieldNEQ(FieldReadingMinutes, v))
+}
+
+// ReadingMinutesIn applies the In predicate on the "reading_minutes" field.
+func ReadingMinutesIn(vs ...int) predicate.Article {
+	return predicate.Article(sql.FieldIn(FieldReadingMinutes, vs...))
+}
+
+// ReadingMinutesNotIn applies the NotIn predicate on the "reading_minutes" field.
+func ReadingMinutesNotIn(vs ...int) predicate.Article {
+	return predicate.Article(sql.FieldNotIn(FieldReadingMinutes, vs...))
+}
+
+// ReadingMinutesGT applies the GT predicate on the "reading_minutes" field.
+func ReadingMinutesGT(v int) predicate.Article {
+	return predicate.Article(sql.FieldGT(FieldReadingMinutes, v))
+}
+
+// ReadingMinutesGTE applies the GTE predicate on the "reading_minutes" field.
+func ReadingMinutesGTE(v int) predicate.Article {
+	return predicate.Article(sql.FieldGTE(FieldReadingMinutes, v))
+}
+
+// ReadingMinutesLT applies the LT predicate on the "reading_minutes" field.
+func ReadingMinutesLT(v int) predicate.Article {
+	return predicate.Article(sql.FieldLT(FieldReadingMinutes, v))
+}
+
+// ReadingMinutesLTE applies the LTE predicate on the "reading_minutes" field.
+func ReadingMinutesLTE(v int) predicate.Article {
+	return predicate.Article(sql.FieldLTE(FieldReadingMinutes, v))
+}
+
+// ExcerptEQ applies the EQ predicate on the "excerpt" field.
+func ExcerptEQ(v string) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldExcerpt, v))
+}
+
+// ExcerptNEQ applies the NEQ predicate on the "excerpt" field.
+func ExcerptNEQ(v string) predicate.Article {
+	return predicate.Article(sql.FieldNEQ(FieldExcerpt, v))
+}
+
+// ExcerptIn applies the In predicate on the "excerpt" field.
+func ExcerptIn(vs ...string) predicate.Article {
+	return predicate.Article(sql.FieldIn(FieldExcerpt, vs...))
+}
+
+// ExcerptNotIn applies the NotIn predicate on the "excerpt" field.
+func ExcerptNotIn(vs ...string) predicate.Article {
+	return predicate.Article(sql.FieldNotIn(FieldExcerpt, vs...))
+}
+
+// ExcerptGT applies the GT predicate on the "excerpt" field.
+func ExcerptGT(v string) predicate.Article {
+	return predicate.Article(sql.FieldGT(FieldExcerpt, v))
+}
+
+// ExcerptGTE applies the GTE predicate on the "excerpt" field.
+func ExcerptGTE(v string) predicate.Article {
+	return predicate.Article(sql.FieldGTE(FieldExcerpt, v))
+}
+
+// ExcerptLT applies the LT predicate on the "excerpt" field.
+func ExcerptLT(v string) predicate.Article {
+	return predicate.Article(sql.FieldLT(FieldExcerpt, v))
+}
+
+// ExcerptLTE applies the LTE predicate on the "excerpt" field.
+func ExcerptLTE(v string) predicate.Article {
+	return predicate.Article(sql.FieldLTE(FieldExcerpt, v))
+}
+
+// ExcerptContains applies the Contains predicate on the "excerpt" field.
+func ExcerptContains(v string) predicate.Article {
+	return predicate.Article(sql.FieldContains(FieldExcerpt, v))
+}
+
+// ExcerptHasPrefix applies the HasPrefix predicate on the "excerpt" field.
+func ExcerptHasPrefix(v string) predicate.Article {
+	return predicate.Article(sql.FieldHasPrefix(FieldExcerpt, v))
+}
+
+// ExcerptHasSuffix applies the HasSuffix predicate on the "excerpt" field.
+func ExcerptHasSuffix(v string) predicate.Article {
+	return predicate.Article(sql.FieldHasSuffix(FieldExcerpt, v))
+}
+
+// ExcerptIsNil applies the IsNil predicate on the "excerpt" field.
+func ExcerptIsNil() predicate.Article {
+	return predicate.Article(sql.FieldIsNull(FieldExcerpt))
+}
+
+// ExcerptNotNil applies the NotNil predicate on the "excerpt" field.
+func ExcerptNotNil() predicate.Article {
+	return predicate.Article(sql.FieldNotNull(FieldExcerpt))
+}
+
+// ExcerptEqualFold applies the EqualFold predicate on the "excerpt" field.
+func ExcerptEqualFold(v string) predicate.Article {
+	return predicate.Article(sql.FieldEqualFold(FieldExcerpt, v))
+}
+
+// ExcerptContainsFold applies the ContainsFold predicate on the "excerpt" field.
+func ExcerptContainsFold(v string) predicate.Article {
+	return predicate.Article(sql.FieldContainsFold(FieldExcerpt, v))
+}
+
 // HasCategory applies the HasEdge predicate on the "category" edge.
 func HasCategory() predicate.Article {
 	return predicate.Article(func(s *sql.Selector) {
@@ -426,6 +796,75 @@ func HasTagsWith(preds ...predicate.Tag) predicate.Article {
 	})
 }
 
+// HasComments applies the HasEdge predicate on the "comments" edge.
+func HasComments() predicate.Article {
+	return predicate.Article(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CommentsTable, CommentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCommentsWith applies the HasEdge predicate on the "comments" edge with a given conditions (other predicates).
+func HasCommentsWith(preds ...predicate.Comment) predicate.Article {
+	return predicate.Article(func(s *sql.Selector) {
+		step := newCommentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReviews applies the HasEdge predicate on the "reviews" edge.
+func HasReviews() predicate.Article {
+	return predicate.Article(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReviewsTable, ReviewsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReviewsWith applies the HasEdge predicate on the "reviews" edge with a given conditions (other predicates).
+func HasReviewsWith(preds ...predicate.ArticleReview) predicate.Article {
+	return predicate.Article(func(s *sql.Selector) {
+		step := newReviewsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLock applies the HasEdge predicate on the "lock" edge.
+func HasLock() predicate.Article {
+	return predicate.Article(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, LockTable, LockColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLockWith applies the HasEdge predicate on the "lock" edge with a given conditions (other predicates).
+func HasLockWith(preds ...predicate.ArticleLock) predicate.Article {
+	return predicate.Article(func(s *sql.Selector) {
+		step := newLockStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Article) predicate.Article {
 	return predicate.Article(sql.AndPredicates(predicates...))
@@ -7,10 +7,14 @@ import (
 	"errors"
 	"fmt"
 	"goblog/ent/article"
+	"goblog/ent/articlelock"
+	"goblog/ent/articlereview"
 	"goblog/ent/category"
+	"goblog/ent/comment"
 	"goblog/ent/tag"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -20,6 +24,7 @@ type ArticleCreate struct {
 	config
 	mutation *ArticleMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetTitle sets the "title" field.
@@ -76,6 +81,34 @@ func (ac *ArticleCreate) SetNillableUpdatedAt(t *time.Time) *ArticleCreate {
 	return ac
 }
 
+// SetStatus sets the "status" field.
+func (ac *ArticleCreate) SetStatus(a article.Status) *ArticleCreate {
+	ac.mutation.SetStatus(a)
+	return ac
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ac *ArticleCreate) SetNillableStatus(a *article.Status) *ArticleCreate {
+	if a != nil {
+		ac.SetStatus(*a)
+	}
+	return ac
+}
+
+// SetVersion sets the "version" field.
+func (ac *ArticleCreate) SetVersion(i int) *ArticleCreate {
+	ac.mutation.SetVersion(i)
+	return ac
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (ac *ArticleCreate) SetNillableVersion(i *int) *ArticleCreate {
+	if i != nil {
+		ac.SetVersion(*i)
+	}
+	return ac
+}
+
 // SetPublished sets the "published" field.
 func (ac *ArticleCreate) SetPublished(b bool) *ArticleCreate {
 	ac.mutation.SetPublished(b)
@@ -90,6 +123,76 @@ func (ac *ArticleCreate) SetNillablePublished(b *bool) *ArticleCreate {
 	return ac
 }
 
+// SetPublishedAt sets the "published_at" field.
+func (ac *ArticleCreate) SetPublishedAt(t time.Time) *ArticleCreate {
+	ac.mutation.SetPublishedAt(t)
+	return ac
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (ac *ArticleCreate) SetNillablePublishedAt(t *time.Time) *ArticleCreate {
+	if t != nil {
+		ac.SetPublishedAt(*t)
+	}
+	return ac
+}
+
+// SetAuthor sets the "author" field.
+func (ac *ArticleCreate) SetAuthor(s string) *ArticleCreate {
+	ac.mutation.SetAuthor(s)
+	return ac
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (ac *ArticleCreate) SetNillableAuthor(s *string) *ArticleCreate {
+	if s != nil {
+		ac.SetAuthor(*s)
+	}
+	return ac
+}
+
+// SetWordCount sets the "word_count" field.
+func (ac *ArticleCreate) SetWordCount(i int) *ArticleCreate {
+	ac.mutation.SetWordCount(i)
+	return ac
+}
+
+// SetNillableWordCount sets the "word_count" field if the given value is not nil.
+func (ac *ArticleCreate) SetNillableWordCount(i *int) *ArticleCreate {
+	if i != nil {
+		ac.SetWordCount(*i)
+	}
+	return ac
+}
+
+// SetReadingMinutes sets the "reading_minutes" field.
+func (ac *ArticleCreate) SetReadingMinutes(i int) *ArticleCreate {
+	ac.mutation.SetReadingMinutes(i)
+	return ac
+}
+
+// SetNillableReadingMinutes sets the "reading_minutes" field if the given value is not nil.
+func (ac *ArticleCreate) SetNillableReadingMinutes(i *int) *ArticleCreate {
+	if i != nil {
+		ac.SetReadingMinutes(*i)
+	}
+	return ac
+}
+
+// SetExcerpt sets the "excerpt" field.
+func (ac *ArticleCreate) SetExcerpt(s string) *ArticleCreate {
+	ac.mutation.SetExcerpt(s)
+	return ac
+}
+
+// SetNillableExcerpt sets the "excerpt" field if the given value is not nil.
+func (ac *ArticleCreate) SetNillableExcerpt(s *string) *ArticleCreate {
+	if s != nil {
+		ac.SetExcerpt(*s)
+	}
+	return ac
+}
+
 // SetCategoryID sets the "category" edge to the Category entity by ID.
 func (ac *ArticleCreate) SetCategoryID(id int) *ArticleCreate {
 	ac.mutation.SetCategoryID(id)
@@ -124,6 +227,55 @@ func (ac *ArticleCreate) AddTags(t ...*Tag) *ArticleCreate {
 	return ac.AddTagIDs(ids...)
 }
 
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (ac *ArticleCreate) AddCommentIDs(ids ...int) *ArticleCreate {
+	ac.mutation.AddCommentIDs(ids...)
+	return ac
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (ac *ArticleCreate) AddComments(c ...*Comment) *ArticleCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ac.AddCommentIDs(ids...)
+}
+
+// AddReviewIDs adds the "reviews" edge to the ArticleReview entity by IDs.
+func (ac *ArticleCreate) AddReviewIDs(ids ...int) *ArticleCreate {
+	ac.mutation.AddReviewIDs(ids...)
+	return ac
+}
+
+// AddReviews adds the "reviews" edges to the ArticleReview entity.
+func (ac *ArticleCreate) AddReviews(a ...*ArticleReview) *ArticleCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ac.AddReviewIDs(ids...)
+}
+
+// SetLockID sets the "lock" edge to the ArticleLock entity by ID.
+func (ac *ArticleCreate) SetLockID(id int) *ArticleCreate {
+	ac.mutation.SetLockID(id)
+	return ac
+}
+
+// SetNillableLockID sets the "lock" edge to the ArticleLock entity by ID if the given value is not nil.
+func (ac *ArticleCreate) SetNillableLockID(id *int) *ArticleCreate {
+	if id != nil {
+		ac = ac.SetLockID(*id)
+	}
+	return ac
+}
+
+// SetLock sets the "lock" edge to the ArticleLock entity.
+func (ac *ArticleCreate) SetLock(a *ArticleLock) *ArticleCreate {
+	return ac.SetLockID(a.ID)
+}
+
 // Mutation returns the ArticleMutation object of the builder.
 func (ac *ArticleCreate) Mutation() *ArticleMutation {
 	return ac.mutation
@@ -167,10 +319,26 @@ func (ac *ArticleCreate) defaults() {
 		v := article.DefaultUpdatedAt()
 		ac.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := ac.mutation.Status(); !ok {
+		v := article.DefaultStatus
+		ac.mutation.SetStatus(v)
+	}
+	if _, ok := ac.mutation.Version(); !ok {
+		v := article.DefaultVersion
+		ac.mutation.SetVersion(v)
+	}
 	if _, ok := ac.mutation.Published(); !ok {
 		v := article.DefaultPublished
 		ac.mutation.SetPublished(v)
 	}
+	if _, ok := ac.mutation.WordCount(); !ok {
+		v := article.DefaultWordCount
+		ac.mutation.SetWordCount(v)
+	}
+	if _, ok := ac.mutation.ReadingMinutes(); !ok {
+		v := article.DefaultReadingMinutes
+		ac.mutation.SetReadingMinutes(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -197,9 +365,41 @@ func (ac *ArticleCreate) check() error {
 	if _, ok := ac.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Article.updated_at"`)}
 	}
+	if _, ok := ac.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Article.status"`)}
+	}
+	if v, ok := ac.mutation.Status(); ok {
+		if err := article.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Article.status": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Article.version"`)}
+	}
+	if v, ok := ac.mutation.Version(); ok {
+		if err := article.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Article.version": %w`, err)}
+		}
+	}
 	if _, ok := ac.mutation.Published(); !ok {
 		return &ValidationError{Name: "published", err: errors.New(`ent: missing required field "Article.published"`)}
 	}
+	if _, ok := ac.mutation.WordCount(); !ok {
+		return &ValidationError{Name: "word_count", err: errors.New(`ent: missing required field "Article.word_count"`)}
+	}
+	if v, ok := ac.mutation.WordCount(); ok {
+		if err := article.WordCountValidator(v); err != nil {
+			return &ValidationError{Name: "word_count", err: fmt.Errorf(`ent: validator failed for field "Article.word_count": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.ReadingMinutes(); !ok {
+		return &ValidationError{Name: "reading_minutes", err: errors.New(`ent: missing required field "Article.reading_minutes"`)}
+	}
+	if v, ok := ac.mutation.ReadingMinutes(); ok {
+		if err := article.ReadingMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "reading_minutes", err: fmt.Errorf(`ent: validator failed for field "Article.reading_minutes": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -226,6 +426,7 @@ func (ac *ArticleCreate) createSpec() (*Article, *sqlgraph.CreateSpec) {
 		_node = &Article{config: ac.config}
 		_spec = sqlgraph.NewCreateSpec(article.Table, sqlgraph.NewFieldSpec(article.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = ac.conflict
 	if value, ok := ac.mutation.Title(); ok {
 		_spec.SetField(article.FieldTitle, field.TypeString, value)
 		_node.Title = value
@@ -246,10 +447,38 @@ func (ac *ArticleCreate) createSpec() (*Article, *sqlgraph.CreateSpec) {
 		_spec.SetField(article.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := ac.mutation.Status(); ok {
+		_spec.SetField(article.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := ac.mutation.Version(); ok {
+		_spec.SetField(article.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
 	if value, ok := ac.mutation.Published(); ok {
 		_spec.SetField(article.FieldPublished, field.TypeBool, value)
 		_node.Published = value
 	}
+	if value, ok := ac.mutation.PublishedAt(); ok {
+		_spec.SetField(article.FieldPublishedAt, field.TypeTime, value)
+		_node.PublishedAt = &value
+	}
+	if value, ok := ac.mutation.Author(); ok {
+		_spec.SetField(article.FieldAuthor, field.TypeString, value)
+		_node.Author = value
+	}
+	if value, ok := ac.mutation.WordCount(); ok {
+		_spec.SetField(article.FieldWordCount, field.TypeInt, value)
+		_node.WordCount = value
+	}
+	if value, ok := ac.mutation.ReadingMinutes(); ok {
+		_spec.SetField(article.FieldReadingMinutes, field.TypeInt, value)
+		_node.ReadingMinutes = value
+	}
+	if value, ok := ac.mutation.Excerpt(); ok {
+		_spec.SetField(article.FieldExcerpt, field.TypeString, value)
+		_node.Excerpt = value
+	}
 	if nodes := ac.mutation.CategoryIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -283,14 +512,580 @@ func (ac *ArticleCreate) createSpec() (*Article, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ac.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.CommentsTable,
+			Columns: []string{article.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.ReviewsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.ReviewsTable,
+			Columns: []string{article.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articlereview.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.LockIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   article.LockTable,
+			Columns: []string{article.LockColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articlelock.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Article.Create().
+//		SetTitle(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ArticleUpsert) {
+//			SetTitle(v+v).
+//		}).
+//		Exec(ctx)
+func (ac *ArticleCreate) OnConflict(opts ...sql.ConflictOption) *ArticleUpsertOne {
+	ac.conflict = opts
+	return &ArticleUpsertOne{
+		create: ac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Article.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ac *ArticleCreate) OnConflictColumns(columns ...string) *ArticleUpsertOne {
+	ac.conflict = append(ac.conflict, sql.ConflictColumns(columns...))
+	return &ArticleUpsertOne{
+		create: ac,
+	}
+}
+
+type (
+	// ArticleUpsertOne is the builder for "upsert"-ing
+	//  one Article node.
+	ArticleUpsertOne struct {
+		create *ArticleCreate
+	}
+
+	// ArticleUpsert is the "OnConflict" setter.
+	ArticleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTitle sets the "title" field.
+func (u *ArticleUpsert) SetTitle(v string) *ArticleUpsert {
+	u.Set(article.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ArticleUpsert) UpdateTitle() *ArticleUpsert {
+	u.SetExcluded(article.FieldTitle)
+	return u
+}
+
+// SetContent sets the "content" field.
+func (u *ArticleUpsert) SetContent(v string) *ArticleUpsert {
+	u.Set(article.FieldContent, v)
+	return u
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *ArticleUpsert) UpdateContent() *ArticleUpsert {
+	u.SetExcluded(article.FieldContent)
+	return u
+}
+
+// SetSummary sets the "summary" field.
+func (u *ArticleUpsert) SetSummary(v string) *ArticleUpsert {
+	u.Set(article.FieldSummary, v)
+	return u
+}
+
+// UpdateSummary sets the "summary" field to the value that was provided on create.
+func (u *ArticleUpsert) UpdateSummary() *ArticleUpsert {
+	u.SetExcluded(article.FieldSummary)
+	return u
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (u *ArticleUpsert) ClearSummary() *ArticleUpsert {
+	u.SetNull(article.FieldSummary)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ArticleUpsert) SetCreatedAt(v time.Time) *ArticleUpsert {
+	u.Set(article.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ArticleUpsert) UpdateCreatedAt() *ArticleUpsert {
+	u.SetExcluded(article.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ArticleUpsert) SetUpdatedAt(v time.Time) *ArticleUpsert {
+	u.Set(article.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ArticleUpsert) UpdateUpdatedAt() *ArticleUpsert {
+	u.SetExcluded(article.FieldUpdatedAt)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ArticleUpsert) SetStatus(v article.Status) *ArticleUpsert {
+	u.Set(article.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ArticleUpsert) UpdateStatus() *ArticleUpsert {
+	u.SetExcluded(article.FieldStatus)
+	return u
+}
+
+// SetVersion sets the "version" field.
+func (u *ArticleUpsert) SetVersion(v int) *ArticleUpsert {
+	u.Set(article.FieldVersion, v)
+	return u
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *ArticleUpsert) UpdateVersion() *ArticleUpsert {
+	u.SetExcluded(article.FieldVersion)
+	return u
+}
+
+// AddVersion adds v to the "version" field.
+func (u *ArticleUpsert) AddVersion(v int) *ArticleUpsert {
+	u.Add(article.FieldVersion, v)
+	return u
+}
+
+// SetPublished sets the "published" field.
+func (u *ArticleUpsert) SetPublished(v bool) *ArticleUpsert {
+	u.Set(article.FieldPublished, v)
+	return u
+}
+
+// UpdatePublished sets the "published" field to the value that was provided on create.
+func (u *ArticleUpsert) UpdatePublished() *ArticleUpsert {
+	u.SetExcluded(article.FieldPublished)
+	return u
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (u *ArticleUpsert) SetPublishedAt(v time.Time) *ArticleUpsert {
+	u.Set(article.FieldPublishedAt, v)
+	return u
+}
+
+// UpdatePublishedAt sets the "published_at" field to the value that was provided on create.
+func (u *ArticleUpsert) UpdatePublishedAt() *ArticleUpsert {
+	u.SetExcluded(article.FieldPublishedAt)
+	return u
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (u *ArticleUpsert) ClearPublishedAt() *ArticleUpsert {
+	u.SetNull(article.FieldPublishedAt)
+	return u
+}
+
+// SetWordCount sets the "word_count" field.
+func (u *ArticleUpsert) SetWordCount(v int) *ArticleUpsert {
+	u.Set(article.FieldWordCount, v)
+	return u
+}
+
+// UpdateWordCount sets the "word_count" field to the value that was provided on create.
+func (u *ArticleUpsert) UpdateWordCount() *ArticleUpsert {
+	u.SetExcluded(article.FieldWordCount)
+	return u
+}
+
+// AddWordCount adds v to the "word_count" field.
+func (u *ArticleUpsert) AddWordCount(v int) *ArticleUpsert {
+	u.Add(article.FieldWordCount, v)
+	return u
+}
+
+// SetReadingMinutes sets the "reading_minutes" field.
+func (u *ArticleUpsert) SetReadingMinutes(v int) *ArticleUpsert {
+	u.Set(article.FieldReadingMinutes, v)
+	return u
+}
+
+// UpdateReadingMinutes sets the "reading_minutes" field to the value that was provided on create.
+func (u *ArticleUpsert) UpdateReadingMinutes() *ArticleUpsert {
+	u.SetExcluded(article.FieldReadingMinutes)
+	return u
+}
+
+// AddReadingMinutes adds v to the "reading_minutes" field.
+func (u *ArticleUpsert) AddReadingMinutes(v int) *ArticleUpsert {
+	u.Add(article.FieldReadingMinutes, v)
+	return u
+}
+
+// SetExcerpt sets the "excerpt" field.
+func (u *ArticleUpsert) SetExcerpt(v string) *ArticleUpsert {
+	u.Set(article.FieldExcerpt, v)
+	return u
+}
+
+// UpdateExcerpt sets the "excerpt" field to the value that was provided on create.
+func (u *ArticleUpsert) UpdateExcerpt() *ArticleUpsert {
+	u.SetExcluded(article.FieldExcerpt)
+	return u
+}
+
+// ClearExcerpt clears the value of the "excerpt" field.
+func (u *ArticleUpsert) ClearExcerpt() *ArticleUpsert {
+	u.SetNull(article.FieldExcerpt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Article.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ArticleUpsertOne) UpdateNewValues() *ArticleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.Author(); exists {
+			s.SetIgnore(article.FieldAuthor)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Article.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ArticleUpsertOne) Ignore() *ArticleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ArticleUpsertOne) DoNothing() *ArticleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ArticleCreate.OnConflict
+// documentation for more info.
+func (u *ArticleUpsertOne) Update(set func(*ArticleUpsert)) *ArticleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ArticleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *ArticleUpsertOne) SetTitle(v string) *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ArticleUpsertOne) UpdateTitle() *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *ArticleUpsertOne) SetContent(v string) *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *ArticleUpsertOne) UpdateContent() *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// SetSummary sets the "summary" field.
+func (u *ArticleUpsertOne) SetSummary(v string) *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetSummary(v)
+	})
+}
+
+// UpdateSummary sets the "summary" field to the value that was provided on create.
+func (u *ArticleUpsertOne) UpdateSummary() *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdateSummary()
+	})
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (u *ArticleUpsertOne) ClearSummary() *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.ClearSummary()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ArticleUpsertOne) SetCreatedAt(v time.Time) *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ArticleUpsertOne) UpdateCreatedAt() *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ArticleUpsertOne) SetUpdatedAt(v time.Time) *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ArticleUpsertOne) UpdateUpdatedAt() *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ArticleUpsertOne) SetStatus(v article.Status) *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ArticleUpsertOne) UpdateStatus() *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *ArticleUpsertOne) SetVersion(v int) *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *ArticleUpsertOne) AddVersion(v int) *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *ArticleUpsertOne) UpdateVersion() *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetPublished sets the "published" field.
+func (u *ArticleUpsertOne) SetPublished(v bool) *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetPublished(v)
+	})
+}
+
+// UpdatePublished sets the "published" field to the value that was provided on create.
+func (u *ArticleUpsertOne) UpdatePublished() *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdatePublished()
+	})
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (u *ArticleUpsertOne) SetPublishedAt(v time.Time) *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetPublishedAt(v)
+	})
+}
+
+// UpdatePublishedAt sets the "published_at" field to the value that was provided on create.
+func (u *ArticleUpsertOne) UpdatePublishedAt() *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdatePublishedAt()
+	})
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (u *ArticleUpsertOne) ClearPublishedAt() *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.ClearPublishedAt()
+	})
+}
+
+// SetWordCount sets the "word_count" field.
+func (u *ArticleUpsertOne) SetWordCount(v int) *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetWordCount(v)
+	})
+}
+
+// AddWordCount adds v to the "word_count" field.
+func (u *ArticleUpsertOne) AddWordCount(v int) *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.AddWordCount(v)
+	})
+}
+
+// UpdateWordCount sets the "word_count" field to the value that was provided on create.
+func (u *ArticleUpsertOne) UpdateWordCount() *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdateWordCount()
+	})
+}
+
+// SetReadingMinutes sets the "reading_minutes" field.
+func (u *ArticleUpsertOne) SetReadingMinutes(v int) *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetReadingMinutes(v)
+	})
+}
+
+// AddReadingMinutes adds v to the "reading_minutes" field.
+func (u *ArticleUpsertOne) AddReadingMinutes(v int) *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.AddReadingMinutes(v)
+	})
+}
+
+// UpdateReadingMinutes sets the "reading_minutes" field to the value that was provided on create.
+func (u *ArticleUpsertOne) UpdateReadingMinutes() *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdateReadingMinutes()
+	})
+}
+
+// SetExcerpt sets the "excerpt" field.
+func (u *ArticleUpsertOne) SetExcerpt(v string) *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetExcerpt(v)
+	})
+}
+
+// UpdateExcerpt sets the "excerpt" field to the value that was provided on create.
+func (u *ArticleUpsertOne) UpdateExcerpt() *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdateExcerpt()
+	})
+}
+
+// ClearExcerpt clears the value of the "excerpt" field.
+func (u *ArticleUpsertOne) ClearExcerpt() *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.ClearExcerpt()
+	})
+}
+
+// Exec executes the query.
+func (u *ArticleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ArticleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ArticleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ArticleUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ArticleUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // ArticleCreateBulk is the builder for creating many Article entities in bulk.
 type ArticleCreateBulk struct {
 	config
 	err      error
 	builders []*ArticleCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Article entities in the database.
@@ -320,6 +1115,7 @@ func (acb *ArticleCreateBulk) Save(ctx context.Context) ([]*Article, error) {
 					_, err = mutators[i+1].Mutate(root, acb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = acb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, acb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -373,3 +1169,324 @@ func (acb *ArticleCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Article.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ArticleUpsert) {
+//			SetTitle(v+v).
+//		}).
+//		Exec(ctx)
+func (acb *ArticleCreateBulk) OnConflict(opts ...sql.ConflictOption) *ArticleUpsertBulk {
+	acb.conflict = opts
+	return &ArticleUpsertBulk{
+		create: acb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Article.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (acb *ArticleCreateBulk) OnConflictColumns(columns ...string) *ArticleUpsertBulk {
+	acb.conflict = append(acb.conflict, sql.ConflictColumns(columns...))
+	return &ArticleUpsertBulk{
+		create: acb,
+	}
+}
+
+// ArticleUpsertBulk is the builder for "upsert"-ing
+// a bulk of Article nodes.
+type ArticleUpsertBulk struct {
+	create *ArticleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Article.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ArticleUpsertBulk) UpdateNewValues() *ArticleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.Author(); exists {
+				s.SetIgnore(article.FieldAuthor)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Article.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ArticleUpsertBulk) Ignore() *ArticleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ArticleUpsertBulk) DoNothing() *ArticleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ArticleCreateBulk.OnConflict
+// documentation for more info.
+func (u *ArticleUpsertBulk) Update(set func(*ArticleUpsert)) *ArticleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ArticleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *ArticleUpsertBulk) SetTitle(v string) *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ArticleUpsertBulk) UpdateTitle() *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *ArticleUpsertBulk) SetContent(v string) *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *ArticleUpsertBulk) UpdateContent() *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// SetSummary sets the "summary" field.
+func (u *ArticleUpsertBulk) SetSummary(v string) *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetSummary(v)
+	})
+}
+
+// UpdateSummary sets the "summary" field to the value that was provided on create.
+func (u *ArticleUpsertBulk) UpdateSummary() *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdateSummary()
+	})
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (u *ArticleUpsertBulk) ClearSummary() *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.ClearSummary()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ArticleUpsertBulk) SetCreatedAt(v time.Time) *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ArticleUpsertBulk) UpdateCreatedAt() *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ArticleUpsertBulk) SetUpdatedAt(v time.Time) *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ArticleUpsertBulk) UpdateUpdatedAt() *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ArticleUpsertBulk) SetStatus(v article.Status) *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ArticleUpsertBulk) UpdateStatus() *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *ArticleUpsertBulk) SetVersion(v int) *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *ArticleUpsertBulk) AddVersion(v int) *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *ArticleUpsertBulk) UpdateVersion() *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetPublished sets the "published" field.
+func (u *ArticleUpsertBulk) SetPublished(v bool) *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetPublished(v)
+	})
+}
+
+// UpdatePublished sets the "published" field to the value that was provided on create.
+func (u *ArticleUpsertBulk) UpdatePublished() *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdatePublished()
+	})
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (u *ArticleUpsertBulk) SetPublishedAt(v time.Time) *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetPublishedAt(v)
+	})
+}
+
+// UpdatePublishedAt sets the "published_at" field to the value that was provided on create.
+func (u *ArticleUpsertBulk) UpdatePublishedAt() *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdatePublishedAt()
+	})
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (u *ArticleUpsertBulk) ClearPublishedAt() *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.ClearPublishedAt()
+	})
+}
+
+// SetWordCount sets the "word_count" field.
+func (u *ArticleUpsertBulk) SetWordCount(v int) *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetWordCount(v)
+	})
+}
+
+// AddWordCount adds v to the "word_count" field.
+func (u *ArticleUpsertBulk) AddWordCount(v int) *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.AddWordCount(v)
+	})
+}
+
+// UpdateWordCount sets the "word_count" field to the value that was provided on create.
+func (u *ArticleUpsertBulk) UpdateWordCount() *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdateWordCount()
+	})
+}
+
+// SetReadingMinutes sets the "reading_minutes" field.
+func (u *ArticleUpsertBulk) SetReadingMinutes(v int) *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetReadingMinutes(v)
+	})
+}
+
+// AddReadingMinutes adds v to the "reading_minutes" field.
+func (u *ArticleUpsertBulk) AddReadingMinutes(v int) *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.AddReadingMinutes(v)
+	})
+}
+
+// UpdateReadingMinutes sets the "reading_minutes" field to the value that was provided on create.
+func (u *ArticleUpsertBulk) UpdateReadingMinutes() *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdateReadingMinutes()
+	})
+}
+
+// SetExcerpt sets the "excerpt" field.
+func (u *ArticleUpsertBulk) SetExcerpt(v string) *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetExcerpt(v)
+	})
+}
+
+// UpdateExcerpt sets the "excerpt" field to the value that was provided on create.
+func (u *ArticleUpsertBulk) UpdateExcerpt() *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdateExcerpt()
+	})
+}
+
+// ClearExcerpt clears the value of the "excerpt" field.
+func (u *ArticleUpsertBulk) ClearExcerpt() *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.ClearExcerpt()
+	})
+}
+
+// Exec executes the query.
+func (u *ArticleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ArticleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ArticleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ArticleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -7,12 +7,16 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"goblog/ent/article"
+	"goblog/ent/articlelock"
+	"goblog/ent/articlereview"
 	"goblog/ent/category"
+	"goblog/ent/comment"
 	"goblog/ent/predicate"
 	"goblog/ent/tag"
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -27,7 +31,11 @@ type ArticleQuery struct {
 	predicates   []predicate.Article
 	withCategory *CategoryQuery
 	withTags     *TagQuery
+	withComments *CommentQuery
+	withReviews  *ArticleReviewQuery
+	withLock     *ArticleLockQuery
 	withFKs      bool
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -108,6 +116,72 @@ func (aq *ArticleQuery) QueryTags() *TagQuery {
 	return query
 }
 
+// QueryComments chains the current query on the "comments" edge.
+func (aq *ArticleQuery) QueryComments() *CommentQuery {
+	query := (&CommentClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(article.Table, article.FieldID, selector),
+			sqlgraph.To(comment.Table, comment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, article.CommentsTable, article.CommentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReviews chains the current query on the "reviews" edge.
+func (aq *ArticleQuery) QueryReviews() *ArticleReviewQuery {
+	query := (&ArticleReviewClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(article.Table, article.FieldID, selector),
+			sqlgraph.To(articlereview.Table, articlereview.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, article.ReviewsTable, article.ReviewsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLock chains the current query on the "lock" edge.
+func (aq *ArticleQuery) QueryLock() *ArticleLockQuery {
+	query := (&ArticleLockClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(article.Table, article.FieldID, selector),
+			sqlgraph.To(articlelock.Table, articlelock.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, article.LockTable, article.LockColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Article entity from the query.
 // Returns a *NotFoundError when no Article was found.
 func (aq *ArticleQuery) First(ctx context.Context) (*Article, error) {
@@ -302,6 +376,9 @@ func (aq *ArticleQuery) Clone() *ArticleQuery {
 		predicates:   append([]predicate.Article{}, aq.predicates...),
 		withCategory: aq.withCategory.Clone(),
 		withTags:     aq.withTags.Clone(),
+		withComments: aq.withComments.Clone(),
+		withReviews:  aq.withReviews.Clone(),
+		withLock:     aq.withLock.Clone(),
 		// clone intermediate query.
 		sql:  aq.sql.Clone(),
 		path: aq.path,
@@ -330,6 +407,39 @@ func (aq *ArticleQuery) WithTags(opts ...func(*TagQuery)) *ArticleQuery {
 	return aq
 }
 
+// WithComments tells the query-builder to eager-load the nodes that are connected to
+// the "comments" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *ArticleQuery) WithComments(opts ...func(*CommentQuery)) *ArticleQuery {
+	query := (&CommentClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withComments = query
+	return aq
+}
+
+// WithReviews tells the query-builder to eager-load the nodes that are connected to
+// the "reviews" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *ArticleQuery) WithReviews(opts ...func(*ArticleReviewQuery)) *ArticleQuery {
+	query := (&ArticleReviewClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withReviews = query
+	return aq
+}
+
+// WithLock tells the query-builder to eager-load the nodes that are connected to
+// the "lock" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *ArticleQuery) WithLock(opts ...func(*ArticleLockQuery)) *ArticleQuery {
+	query := (&ArticleLockClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withLock = query
+	return aq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -409,9 +519,12 @@ func (aq *ArticleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Arti
 		nodes       = []*Article{}
 		withFKs     = aq.withFKs
 		_spec       = aq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [5]bool{
 			aq.withCategory != nil,
 			aq.withTags != nil,
+			aq.withComments != nil,
+			aq.withReviews != nil,
+			aq.withLock != nil,
 		}
 	)
 	if aq.withCategory != nil {
@@ -429,6 +542,9 @@ func (aq *ArticleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Arti
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(aq.modifiers) > 0 {
+		_spec.Modifiers = aq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -451,6 +567,26 @@ func (aq *ArticleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Arti
 			return nil, err
 		}
 	}
+	if query := aq.withComments; query != nil {
+		if err := aq.loadComments(ctx, query, nodes,
+			func(n *Article) { n.Edges.Comments = []*Comment{} },
+			func(n *Article, e *Comment) { n.Edges.Comments = append(n.Edges.Comments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := aq.withReviews; query != nil {
+		if err := aq.loadReviews(ctx, query, nodes,
+			func(n *Article) { n.Edges.Reviews = []*ArticleReview{} },
+			func(n *Article, e *ArticleReview) { n.Edges.Reviews = append(n.Edges.Reviews, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := aq.withLock; query != nil {
+		if err := aq.loadLock(ctx, query, nodes, nil,
+			func(n *Article, e *ArticleLock) { n.Edges.Lock = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -547,9 +683,101 @@ func (aq *ArticleQuery) loadTags(ctx context.Context, query *TagQuery, nodes []*
 	}
 	return nil
 }
+func (aq *ArticleQuery) loadComments(ctx context.Context, query *CommentQuery, nodes []*Article, init func(*Article), assign func(*Article, *Comment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Article)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Comment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(article.CommentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.article_comments
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "article_comments" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "article_comments" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (aq *ArticleQuery) loadReviews(ctx context.Context, query *ArticleReviewQuery, nodes []*Article, init func(*Article), assign func(*Article, *ArticleReview)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Article)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ArticleReview(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(article.ReviewsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.article_reviews
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "article_reviews" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "article_reviews" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (aq *ArticleQuery) loadLock(ctx context.Context, query *ArticleLockQuery, nodes []*Article, init func(*Article), assign func(*Article, *ArticleLock)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Article)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(articlelock.FieldArticleID)
+	}
+	query.Where(predicate.ArticleLock(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(article.LockColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ArticleID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "article_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (aq *ArticleQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := aq.querySpec()
+	if len(aq.modifiers) > 0 {
+		_spec.Modifiers = aq.modifiers
+	}
 	_spec.Node.Columns = aq.ctx.Fields
 	if len(aq.ctx.Fields) > 0 {
 		_spec.Unique = aq.ctx.Unique != nil && *aq.ctx.Unique
@@ -612,6 +840,9 @@ func (aq *ArticleQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if aq.ctx.Unique != nil && *aq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range aq.modifiers {
+		m(selector)
+	}
 	for _, p := range aq.predicates {
 		p(selector)
 	}
@@ -629,6 +860,32 @@ func (aq *ArticleQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (aq *ArticleQuery) ForUpdate(opts ...sql.LockOption) *ArticleQuery {
+	if aq.driver.Dialect() == dialect.Postgres {
+		aq.Unique(false)
+	}
+	aq.modifiers = append(aq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return aq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (aq *ArticleQuery) ForShare(opts ...sql.LockOption) *ArticleQuery {
+	if aq.driver.Dialect() == dialect.Postgres {
+		aq.Unique(false)
+	}
+	aq.modifiers = append(aq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return aq
+}
+
 // ArticleGroupBy is the group-by builder for Article entities.
 type ArticleGroupBy struct {
 	selector
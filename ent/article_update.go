@@ -7,7 +7,10 @@ import (
 	"errors"
 	"fmt"
 	"goblog/ent/article"
+	"goblog/ent/articlelock"
+	"goblog/ent/articlereview"
 	"goblog/ent/category"
+	"goblog/ent/comment"
 	"goblog/ent/predicate"
 	"goblog/ent/tag"
 	"time"
@@ -98,6 +101,41 @@ func (au *ArticleUpdate) SetUpdatedAt(t time.Time) *ArticleUpdate {
 	return au
 }
 
+// SetStatus sets the "status" field.
+func (au *ArticleUpdate) SetStatus(a article.Status) *ArticleUpdate {
+	au.mutation.SetStatus(a)
+	return au
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (au *ArticleUpdate) SetNillableStatus(a *article.Status) *ArticleUpdate {
+	if a != nil {
+		au.SetStatus(*a)
+	}
+	return au
+}
+
+// SetVersion sets the "version" field.
+func (au *ArticleUpdate) SetVersion(i int) *ArticleUpdate {
+	au.mutation.ResetVersion()
+	au.mutation.SetVersion(i)
+	return au
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (au *ArticleUpdate) SetNillableVersion(i *int) *ArticleUpdate {
+	if i != nil {
+		au.SetVersion(*i)
+	}
+	return au
+}
+
+// AddVersion adds i to the "version" field.
+func (au *ArticleUpdate) AddVersion(i int) *ArticleUpdate {
+	au.mutation.AddVersion(i)
+	return au
+}
+
 // SetPublished sets the "published" field.
 func (au *ArticleUpdate) SetPublished(b bool) *ArticleUpdate {
 	au.mutation.SetPublished(b)
@@ -112,6 +150,88 @@ func (au *ArticleUpdate) SetNillablePublished(b *bool) *ArticleUpdate {
 	return au
 }
 
+// SetPublishedAt sets the "published_at" field.
+func (au *ArticleUpdate) SetPublishedAt(t time.Time) *ArticleUpdate {
+	au.mutation.SetPublishedAt(t)
+	return au
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (au *ArticleUpdate) SetNillablePublishedAt(t *time.Time) *ArticleUpdate {
+	if t != nil {
+		au.SetPublishedAt(*t)
+	}
+	return au
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (au *ArticleUpdate) ClearPublishedAt() *ArticleUpdate {
+	au.mutation.ClearPublishedAt()
+	return au
+}
+
+// SetWordCount sets the "word_count" field.
+func (au *ArticleUpdate) SetWordCount(i int) *ArticleUpdate {
+	au.mutation.ResetWordCount()
+	au.mutation.SetWordCount(i)
+	return au
+}
+
+// SetNillableWordCount sets the "word_count" field if the given value is not nil.
+func (au *ArticleUpdate) SetNillableWordCount(i *int) *ArticleUpdate {
+	if i != nil {
+		au.SetWordCount(*i)
+	}
+	return au
+}
+
+// AddWordCount adds i to the "word_count" field.
+func (au *ArticleUpdate) AddWordCount(i int) *ArticleUpdate {
+	au.mutation.AddWordCount(i)
+	return au
+}
+
+// SetReadingMinutes sets the "reading_minutes" field.
+func (au *ArticleUpdate) SetReadingMinutes(i int) *ArticleUpdate {
+	au.mutation.ResetReadingMinutes()
+	au.mutation.SetReadingMinutes(i)
+	return au
+}
+
+// SetNillableReadingMinutes sets the "reading_minutes" field if the given value is not nil.
+func (au *ArticleUpdate) SetNillableReadingMinutes(i *int) *ArticleUpdate {
+	if i != nil {
+		au.SetReadingMinutes(*i)
+	}
+	return au
+}
+
+// AddReadingMinutes adds i to the "reading_minutes" field.
+func (au *ArticleUpdate) AddReadingMinutes(i int) *ArticleUpdate {
+	au.mutation.AddReadingMinutes(i)
+	return au
+}
+
+// SetExcerpt sets the "excerpt" field.
+func (au *ArticleUpdate) SetExcerpt(s string) *ArticleUpdate {
+	au.mutation.SetExcerpt(s)
+	return au
+}
+
+// SetNillableExcerpt sets the "excerpt" field if the given value is not nil.
+func (au *ArticleUpdate) SetNillableExcerpt(s *string) *ArticleUpdate {
+	if s != nil {
+		au.SetExcerpt(*s)
+	}
+	return au
+}
+
+// ClearExcerpt clears the value of the "excerpt" field.
+func (au *ArticleUpdate) ClearExcerpt() *ArticleUpdate {
+	au.mutation.ClearExcerpt()
+	return au
+}
+
 // SetCategoryID sets the "category" edge to the Category entity by ID.
 func (au *ArticleUpdate) SetCategoryID(id int) *ArticleUpdate {
 	au.mutation.SetCategoryID(id)
@@ -146,6 +266,55 @@ func (au *ArticleUpdate) AddTags(t ...*Tag) *ArticleUpdate {
 	return au.AddTagIDs(ids...)
 }
 
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (au *ArticleUpdate) AddCommentIDs(ids ...int) *ArticleUpdate {
+	au.mutation.AddCommentIDs(ids...)
+	return au
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (au *ArticleUpdate) AddComments(c ...*Comment) *ArticleUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return au.AddCommentIDs(ids...)
+}
+
+// AddReviewIDs adds the "reviews" edge to the ArticleReview entity by IDs.
+func (au *ArticleUpdate) AddReviewIDs(ids ...int) *ArticleUpdate {
+	au.mutation.AddReviewIDs(ids...)
+	return au
+}
+
+// AddReviews adds the "reviews" edges to the ArticleReview entity.
+func (au *ArticleUpdate) AddReviews(a ...*ArticleReview) *ArticleUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return au.AddReviewIDs(ids...)
+}
+
+// SetLockID sets the "lock" edge to the ArticleLock entity by ID.
+func (au *ArticleUpdate) SetLockID(id int) *ArticleUpdate {
+	au.mutation.SetLockID(id)
+	return au
+}
+
+// SetNillableLockID sets the "lock" edge to the ArticleLock entity by ID if the given value is not nil.
+func (au *ArticleUpdate) SetNillableLockID(id *int) *ArticleUpdate {
+	if id != nil {
+		au = au.SetLockID(*id)
+	}
+	return au
+}
+
+// SetLock sets the "lock" edge to the ArticleLock entity.
+func (au *ArticleUpdate) SetLock(a *ArticleLock) *ArticleUpdate {
+	return au.SetLockID(a.ID)
+}
+
 // Mutation returns the ArticleMutation object of the builder.
 func (au *ArticleUpdate) Mutation() *ArticleMutation {
 	return au.mutation
@@ -178,6 +347,54 @@ func (au *ArticleUpdate) RemoveTags(t ...*Tag) *ArticleUpdate {
 	return au.RemoveTagIDs(ids...)
 }
 
+// ClearComments clears all "comments" edges to the Comment entity.
+func (au *ArticleUpdate) ClearComments() *ArticleUpdate {
+	au.mutation.ClearComments()
+	return au
+}
+
+// RemoveCommentIDs removes the "comments" edge to Comment entities by IDs.
+func (au *ArticleUpdate) RemoveCommentIDs(ids ...int) *ArticleUpdate {
+	au.mutation.RemoveCommentIDs(ids...)
+	return au
+}
+
+// RemoveComments removes "comments" edges to Comment entities.
+func (au *ArticleUpdate) RemoveComments(c ...*Comment) *ArticleUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return au.RemoveCommentIDs(ids...)
+}
+
+// ClearReviews clears all "reviews" edges to the ArticleReview entity.
+func (au *ArticleUpdate) ClearReviews() *ArticleUpdate {
+	au.mutation.ClearReviews()
+	return au
+}
+
+// RemoveReviewIDs removes the "reviews" edge to ArticleReview entities by IDs.
+func (au *ArticleUpdate) RemoveReviewIDs(ids ...int) *ArticleUpdate {
+	au.mutation.RemoveReviewIDs(ids...)
+	return au
+}
+
+// RemoveReviews removes "reviews" edges to ArticleReview entities.
+func (au *ArticleUpdate) RemoveReviews(a ...*ArticleReview) *ArticleUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return au.RemoveReviewIDs(ids...)
+}
+
+// ClearLock clears the "lock" edge to the ArticleLock entity.
+func (au *ArticleUpdate) ClearLock() *ArticleUpdate {
+	au.mutation.ClearLock()
+	return au
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (au *ArticleUpdate) Save(ctx context.Context) (int, error) {
 	au.defaults()
@@ -226,6 +443,26 @@ func (au *ArticleUpdate) check() error {
 			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "Article.content": %w`, err)}
 		}
 	}
+	if v, ok := au.mutation.Status(); ok {
+		if err := article.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Article.status": %w`, err)}
+		}
+	}
+	if v, ok := au.mutation.Version(); ok {
+		if err := article.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Article.version": %w`, err)}
+		}
+	}
+	if v, ok := au.mutation.WordCount(); ok {
+		if err := article.WordCountValidator(v); err != nil {
+			return &ValidationError{Name: "word_count", err: fmt.Errorf(`ent: validator failed for field "Article.word_count": %w`, err)}
+		}
+	}
+	if v, ok := au.mutation.ReadingMinutes(); ok {
+		if err := article.ReadingMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "reading_minutes", err: fmt.Errorf(`ent: validator failed for field "Article.reading_minutes": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -259,9 +496,45 @@ func (au *ArticleUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := au.mutation.UpdatedAt(); ok {
 		_spec.SetField(article.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := au.mutation.Status(); ok {
+		_spec.SetField(article.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := au.mutation.Version(); ok {
+		_spec.SetField(article.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedVersion(); ok {
+		_spec.AddField(article.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := au.mutation.Published(); ok {
 		_spec.SetField(article.FieldPublished, field.TypeBool, value)
 	}
+	if value, ok := au.mutation.PublishedAt(); ok {
+		_spec.SetField(article.FieldPublishedAt, field.TypeTime, value)
+	}
+	if au.mutation.PublishedAtCleared() {
+		_spec.ClearField(article.FieldPublishedAt, field.TypeTime)
+	}
+	if au.mutation.AuthorCleared() {
+		_spec.ClearField(article.FieldAuthor, field.TypeString)
+	}
+	if value, ok := au.mutation.WordCount(); ok {
+		_spec.SetField(article.FieldWordCount, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedWordCount(); ok {
+		_spec.AddField(article.FieldWordCount, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.ReadingMinutes(); ok {
+		_spec.SetField(article.FieldReadingMinutes, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedReadingMinutes(); ok {
+		_spec.AddField(article.FieldReadingMinutes, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.Excerpt(); ok {
+		_spec.SetField(article.FieldExcerpt, field.TypeString, value)
+	}
+	if au.mutation.ExcerptCleared() {
+		_spec.ClearField(article.FieldExcerpt, field.TypeString)
+	}
 	if au.mutation.CategoryCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -336,6 +609,125 @@ func (au *ArticleUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if au.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.CommentsTable,
+			Columns: []string{article.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !au.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.CommentsTable,
+			Columns: []string{article.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.CommentsTable,
+			Columns: []string{article.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.ReviewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.ReviewsTable,
+			Columns: []string{article.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articlereview.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedReviewsIDs(); len(nodes) > 0 && !au.mutation.ReviewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.ReviewsTable,
+			Columns: []string{article.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articlereview.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.ReviewsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.ReviewsTable,
+			Columns: []string{article.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articlereview.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.LockCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   article.LockTable,
+			Columns: []string{article.LockColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articlelock.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.LockIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   article.LockTable,
+			Columns: []string{article.LockColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articlelock.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{article.Label}
@@ -424,6 +816,41 @@ func (auo *ArticleUpdateOne) SetUpdatedAt(t time.Time) *ArticleUpdateOne {
 	return auo
 }
 
+// SetStatus sets the "status" field.
+func (auo *ArticleUpdateOne) SetStatus(a article.Status) *ArticleUpdateOne {
+	auo.mutation.SetStatus(a)
+	return auo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (auo *ArticleUpdateOne) SetNillableStatus(a *article.Status) *ArticleUpdateOne {
+	if a != nil {
+		auo.SetStatus(*a)
+	}
+	return auo
+}
+
+// SetVersion sets the "version" field.
+func (auo *ArticleUpdateOne) SetVersion(i int) *ArticleUpdateOne {
+	auo.mutation.ResetVersion()
+	auo.mutation.SetVersion(i)
+	return auo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (auo *ArticleUpdateOne) SetNillableVersion(i *int) *ArticleUpdateOne {
+	if i != nil {
+		auo.SetVersion(*i)
+	}
+	return auo
+}
+
+// AddVersion adds i to the "version" field.
+func (auo *ArticleUpdateOne) AddVersion(i int) *ArticleUpdateOne {
+	auo.mutation.AddVersion(i)
+	return auo
+}
+
 // SetPublished sets the "published" field.
 func (auo *ArticleUpdateOne) SetPublished(b bool) *ArticleUpdateOne {
 	auo.mutation.SetPublished(b)
@@ -438,6 +865,88 @@ func (auo *ArticleUpdateOne) SetNillablePublished(b *bool) *ArticleUpdateOne {
 	return auo
 }
 
+// SetPublishedAt sets the "published_at" field.
+func (auo *ArticleUpdateOne) SetPublishedAt(t time.Time) *ArticleUpdateOne {
+	auo.mutation.SetPublishedAt(t)
+	return auo
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (auo *ArticleUpdateOne) SetNillablePublishedAt(t *time.Time) *ArticleUpdateOne {
+	if t != nil {
+		auo.SetPublishedAt(*t)
+	}
+	return auo
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (auo *ArticleUpdateOne) ClearPublishedAt() *ArticleUpdateOne {
+	auo.mutation.ClearPublishedAt()
+	return auo
+}
+
+// SetWordCount sets the "word_count" field.
+func (auo *ArticleUpdateOne) SetWordCount(i int) *ArticleUpdateOne {
+	auo.mutation.ResetWordCount()
+	auo.mutation.SetWordCount(i)
+	return auo
+}
+
+// SetNillableWordCount sets the "word_count" field if the given value is not nil.
+func (auo *ArticleUpdateOne) SetNillableWordCount(i *int) *ArticleUpdateOne {
+	if i != nil {
+		auo.SetWordCount(*i)
+	}
+	return auo
+}
+
+// AddWordCount adds i to the "word_count" field.
+func (auo *ArticleUpdateOne) AddWordCount(i int) *ArticleUpdateOne {
+	auo.mutation.AddWordCount(i)
+	return auo
+}
+
+// SetReadingMinutes sets the "reading_minutes" field.
+func (auo *ArticleUpdateOne) SetReadingMinutes(i int) *ArticleUpdateOne {
+	auo.mutation.ResetReadingMinutes()
+	auo.mutation.SetReadingMinutes(i)
+	return auo
+}
+
+// SetNillableReadingMinutes sets the "reading_minutes" field if the given value is not nil.
+func (auo *ArticleUpdateOne) SetNillableReadingMinutes(i *int) *ArticleUpdateOne {
+	if i != nil {
+		auo.SetReadingMinutes(*i)
+	}
+	return auo
+}
+
+// AddReadingMinutes adds i to the "reading_minutes" field.
+func (auo *ArticleUpdateOne) AddReadingMinutes(i int) *ArticleUpdateOne {
+	auo.mutation.AddReadingMinutes(i)
+	return auo
+}
+
+// SetExcerpt sets the "excerpt" field.
+func (auo *ArticleUpdateOne) SetExcerpt(s string) *ArticleUpdateOne {
+	auo.mutation.SetExcerpt(s)
+	return auo
+}
+
+// SetNillableExcerpt sets the "excerpt" field if the given value is not nil.
+func (auo *ArticleUpdateOne) SetNillableExcerpt(s *string) *ArticleUpdateOne {
+	if s != nil {
+		auo.SetExcerpt(*s)
+	}
+	return auo
+}
+
+// ClearExcerpt clears the value of the "excerpt" field.
+func (auo *ArticleUpdateOne) ClearExcerpt() *ArticleUpdateOne {
+	auo.mutation.ClearExcerpt()
+	return auo
+}
+
 // SetCategoryID sets the "category" edge to the Category entity by ID.
 func (auo *ArticleUpdateOne) SetCategoryID(id int) *ArticleUpdateOne {
 	auo.mutation.SetCategoryID(id)
@@ -472,6 +981,55 @@ func (auo *ArticleUpdateOne) AddTags(t ...*Tag) *ArticleUpdateOne {
 	return auo.AddTagIDs(ids...)
 }
 
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (auo *ArticleUpdateOne) AddCommentIDs(ids ...int) *ArticleUpdateOne {
+	auo.mutation.AddCommentIDs(ids...)
+	return auo
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (auo *ArticleUpdateOne) AddComments(c ...*Comment) *ArticleUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return auo.AddCommentIDs(ids...)
+}
+
+// AddReviewIDs adds the "reviews" edge to the ArticleReview entity by IDs.
+func (auo *ArticleUpdateOne) AddReviewIDs(ids ...int) *ArticleUpdateOne {
+	auo.mutation.AddReviewIDs(ids...)
+	return auo
+}
+
+// AddReviews adds the "reviews" edges to the ArticleReview entity.
+func (auo *ArticleUpdateOne) AddReviews(a ...*ArticleReview) *ArticleUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return auo.AddReviewIDs(ids...)
+}
+
+// SetLockID sets the "lock" edge to the ArticleLock entity by ID.
+func (auo *ArticleUpdateOne) SetLockID(id int) *ArticleUpdateOne {
+	auo.mutation.SetLockID(id)
+	return auo
+}
+
+// SetNillableLockID sets the "lock" edge to the ArticleLock entity by ID if the given value is not nil.
+func (auo *ArticleUpdateOne) SetNillableLockID(id *int) *ArticleUpdateOne {
+	if id != nil {
+		auo = auo.SetLockID(*id)
+	}
+	return auo
+}
+
+// SetLock sets the "lock" edge to the ArticleLock entity.
+func (auo *ArticleUpdateOne) SetLock(a *ArticleLock) *ArticleUpdateOne {
+	return auo.SetLockID(a.ID)
+}
+
 // Mutation returns the ArticleMutation object of the builder.
 func (auo *ArticleUpdateOne) Mutation() *ArticleMutation {
 	return auo.mutation
@@ -504,6 +1062,54 @@ func (auo *ArticleUpdateOne) RemoveTags(t ...*Tag) *ArticleUpdateOne {
 	return auo.RemoveTagIDs(ids...)
 }
 
+// ClearComments clears all "comments" edges to the Comment entity.
+func (auo *ArticleUpdateOne) ClearComments() *ArticleUpdateOne {
+	auo.mutation.ClearComments()
+	return auo
+}
+
+// RemoveCommentIDs removes the "comments" edge to Comment entities by IDs.
+func (auo *ArticleUpdateOne) RemoveCommentIDs(ids ...int) *ArticleUpdateOne {
+	auo.mutation.RemoveCommentIDs(ids...)
+	return auo
+}
+
+// RemoveComments removes "comments" edges to Comment entities.
+func (auo *ArticleUpdateOne) RemoveComments(c ...*Comment) *ArticleUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return auo.RemoveCommentIDs(ids...)
+}
+
+// ClearReviews clears all "reviews" edges to the ArticleReview entity.
+func (auo *ArticleUpdateOne) ClearReviews() *ArticleUpdateOne {
+	auo.mutation.ClearReviews()
+	return auo
+}
+
+// RemoveReviewIDs removes the "reviews" edge to ArticleReview entities by IDs.
+func (auo *ArticleUpdateOne) RemoveReviewIDs(ids ...int) *ArticleUpdateOne {
+	auo.mutation.RemoveReviewIDs(ids...)
+	return auo
+}
+
+// RemoveReviews removes "reviews" edges to ArticleReview entities.
+func (auo *ArticleUpdateOne) RemoveReviews(a ...*ArticleReview) *ArticleUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return auo.RemoveReviewIDs(ids...)
+}
+
+// ClearLock clears the "lock" edge to the ArticleLock entity.
+func (auo *ArticleUpdateOne) ClearLock() *ArticleUpdateOne {
+	auo.mutation.ClearLock()
+	return auo
+}
+
 // Where appends a list predicates to the ArticleUpdate builder.
 func (auo *ArticleUpdateOne) Where(ps ...predicate.Article) *ArticleUpdateOne {
 	auo.mutation.Where(ps...)
@@ -565,6 +1171,26 @@ func (auo *ArticleUpdateOne) check() error {
 			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "Article.content": %w`, err)}
 		}
 	}
+	if v, ok := auo.mutation.Status(); ok {
+		if err := article.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Article.status": %w`, err)}
+		}
+	}
+	if v, ok := auo.mutation.Version(); ok {
+		if err := article.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Article.version": %w`, err)}
+		}
+	}
+	if v, ok := auo.mutation.WordCount(); ok {
+		if err := article.WordCountValidator(v); err != nil {
+			return &ValidationError{Name: "word_count", err: fmt.Errorf(`ent: validator failed for field "Article.word_count": %w`, err)}
+		}
+	}
+	if v, ok := auo.mutation.ReadingMinutes(); ok {
+		if err := article.ReadingMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "reading_minutes", err: fmt.Errorf(`ent: validator failed for field "Article.reading_minutes": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -615,9 +1241,45 @@ func (auo *ArticleUpdateOne) sqlSave(ctx context.Context) (_node *Article, err e
 	if value, ok := auo.mutation.UpdatedAt(); ok {
 		_spec.SetField(article.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := auo.mutation.Status(); ok {
+		_spec.SetField(article.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := auo.mutation.Version(); ok {
+		_spec.SetField(article.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedVersion(); ok {
+		_spec.AddField(article.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := auo.mutation.Published(); ok {
 		_spec.SetField(article.FieldPublished, field.TypeBool, value)
 	}
+	if value, ok := auo.mutation.PublishedAt(); ok {
+		_spec.SetField(article.FieldPublishedAt, field.TypeTime, value)
+	}
+	if auo.mutation.PublishedAtCleared() {
+		_spec.ClearField(article.FieldPublishedAt, field.TypeTime)
+	}
+	if auo.mutation.AuthorCleared() {
+		_spec.ClearField(article.FieldAuthor, field.TypeString)
+	}
+	if value, ok := auo.mutation.WordCount(); ok {
+		_spec.SetField(article.FieldWordCount, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedWordCount(); ok {
+		_spec.AddField(article.FieldWordCount, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.ReadingMinutes(); ok {
+		_spec.SetField(article.FieldReadingMinutes, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedReadingMinutes(); ok {
+		_spec.AddField(article.FieldReadingMinutes, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.Excerpt(); ok {
+		_spec.SetField(article.FieldExcerpt, field.TypeString, value)
+	}
+	if auo.mutation.ExcerptCleared() {
+		_spec.ClearField(article.FieldExcerpt, field.TypeString)
+	}
 	if auo.mutation.CategoryCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -692,6 +1354,125 @@ func (auo *ArticleUpdateOne) sqlSave(ctx context.Context) (_node *Article, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if auo.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.CommentsTable,
+			Columns: []string{article.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !auo.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.CommentsTable,
+			Columns: []string{article.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.CommentsTable,
+			Columns: []string{article.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.ReviewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.ReviewsTable,
+			Columns: []string{article.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articlereview.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedReviewsIDs(); len(nodes) > 0 && !auo.mutation.ReviewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.ReviewsTable,
+			Columns: []string{article.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articlereview.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.ReviewsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.ReviewsTable,
+			Columns: []string{article.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articlereview.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.LockCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   article.LockTable,
+			Columns: []string{article.LockColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articlelock.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.LockIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   article.LockTable,
+			Columns: []string{article.LockColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articlelock.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Article{config: auo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,168 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"goblog/ent/article"
+	"goblog/ent/articlelock"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ArticleLock is the model entity for the ArticleLock schema.
+type ArticleLock struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 文章ID，每篇文章最多一个编辑租约
+	ArticleID int `json:"article_id,omitempty"`
+	// 持有者用户名
+	Holder string `json:"holder,omitempty"`
+	// 持有者获得租约的时间，续期不变
+	AcquiredAt time.Time `json:"acquired_at,omitempty"`
+	// 过期时间，过期后其他人可以直接获取
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ArticleLockQuery when eager-loading is set.
+	Edges        ArticleLockEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ArticleLockEdges holds the relations/edges for other nodes in the graph.
+type ArticleLockEdges struct {
+	// Article holds the value of the article edge.
+	Article *Article `json:"article,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ArticleOrErr returns the Article value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ArticleLockEdges) ArticleOrErr() (*Article, error) {
+	if e.Article != nil {
+		return e.Article, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: article.Label}
+	}
+	return nil, &NotLoadedError{edge: "article"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ArticleLock) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case articlelock.FieldID, articlelock.FieldArticleID:
+			values[i] = new(sql.NullInt64)
+		case articlelock.FieldHolder:
+			values[i] = new(sql.NullString)
+		case articlelock.FieldAcquiredAt, articlelock.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ArticleLock fields.
+func (al *ArticleLock) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case articlelock.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			al.ID = int(value.Int64)
+		case articlelock.FieldArticleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field article_id", values[i])
+			} else if value.Valid {
+				al.ArticleID = int(value.Int64)
+			}
+		case articlelock.FieldHolder:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field holder", values[i])
+			} else if value.Valid {
+				al.Holder = value.String
+			}
+		case articlelock.FieldAcquiredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field acquired_at", values[i])
+			} else if value.Valid {
+				al.AcquiredAt = value.Time
+			}
+		case articlelock.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				al.ExpiresAt = value.Time
+			}
+		default:
+			al.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ArticleLock.
+// This includes values selected through modifiers, order, etc.
+func (al *ArticleLock) Value(name string) (ent.Value, error) {
+	return al.selectValues.Get(name)
+}
+
+// QueryArticle queries the "article" edge of the ArticleLock entity.
+func (al *ArticleLock) QueryArticle() *ArticleQuery {
+	return NewArticleLockClient(al.config).QueryArticle(al)
+}
+
+// Update returns a builder for updating this ArticleLock.
+// Note that you need to call ArticleLock.Unwrap() before calling this method if this ArticleLock
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (al *ArticleLock) Update() *ArticleLockUpdateOne {
+	return NewArticleLockClient(al.config).UpdateOne(al)
+}
+
+// Unwrap unwraps the ArticleLock entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (al *ArticleLock) Unwrap() *ArticleLock {
+	_tx, ok := al.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ArticleLock is not a transactional entity")
+	}
+	al.config.driver = _tx.drv
+	return al
+}
+
+// String implements the fmt.Stringer.
+func (al *ArticleLock) String() string {
+	var builder strings.Builder
+	builder.WriteString("ArticleLock(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", al.ID))
+	builder.WriteString("article_id=")
+	builder.WriteString(fmt.Sprintf("%v", al.ArticleID))
+	builder.WriteString(", ")
+	builder.WriteString("holder=")
+	builder.WriteString(al.Holder)
+	builder.WriteString(", ")
+	builder.WriteString("acquired_at=")
+	builder.WriteString(al.AcquiredAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(al.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ArticleLocks is a parsable slice of ArticleLock.
+type ArticleLocks []*ArticleLock
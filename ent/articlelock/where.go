@@ -0,0 +1,279 @@
+// Code generated by ent, DO NOT EDIT.
+
+package articlelock
+
+import (
+	"goblog/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldLTE(FieldID, id))
+}
+
+// ArticleID applies equality check predicate on the "article_id" field. It's identical to ArticleIDEQ.
+func ArticleID(v int) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldEQ(FieldArticleID, v))
+}
+
+// Holder applies equality check predicate on the "holder" field. It's identical to HolderEQ.
+func Holder(v string) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldEQ(FieldHolder, v))
+}
+
+// AcquiredAt applies equality check predicate on the "acquired_at" field. It's identical to AcquiredAtEQ.
+func AcquiredAt(v time.Time) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldEQ(FieldAcquiredAt, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ArticleIDEQ applies the EQ predicate on the "article_id" field.
+func ArticleIDEQ(v int) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldEQ(FieldArticleID, v))
+}
+
+// ArticleIDNEQ applies the NEQ predicate on the "article_id" field.
+func ArticleIDNEQ(v int) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldNEQ(FieldArticleID, v))
+}
+
+// ArticleIDIn applies the In predicate on the "article_id" field.
+func ArticleIDIn(vs ...int) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldIn(FieldArticleID, vs...))
+}
+
+// ArticleIDNotIn applies the NotIn predicate on the "article_id" field.
+func ArticleIDNotIn(vs ...int) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldNotIn(FieldArticleID, vs...))
+}
+
+// HolderEQ applies the EQ predicate on the "holder" field.
+func HolderEQ(v string) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldEQ(FieldHolder, v))
+}
+
+// HolderNEQ applies the NEQ predicate on the "holder" field.
+func HolderNEQ(v string) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldNEQ(FieldHolder, v))
+}
+
+// HolderIn applies the In predicate on the "holder" field.
+func HolderIn(vs ...string) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldIn(FieldHolder, vs...))
+}
+
+// HolderNotIn applies the NotIn predicate on the "holder" field.
+func HolderNotIn(vs ...string) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldNotIn(FieldHolder, vs...))
+}
+
+// HolderGT applies the GT predicate on the "holder" field.
+func HolderGT(v string) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldGT(FieldHolder, v))
+}
+
+// HolderGTE applies the GTE predicate on the "holder" field.
+func HolderGTE(v string) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldGTE(FieldHolder, v))
+}
+
+// HolderLT applies the LT predicate on the "holder" field.
+func HolderLT(v string) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldLT(FieldHolder, v))
+}
+
+// HolderLTE applies the LTE predicate on the "holder" field.
+func HolderLTE(v string) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldLTE(FieldHolder, v))
+}
+
+// HolderContains applies the Contains predicate on the "holder" field.
+func HolderContains(v string) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldContains(FieldHolder, v))
+}
+
+// HolderHasPrefix applies the HasPrefix predicate on the "holder" field.
+func HolderHasPrefix(v string) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldHasPrefix(FieldHolder, v))
+}
+
+// HolderHasSuffix applies the HasSuffix predicate on the "holder" field.
+func HolderHasSuffix(v string) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldHasSuffix(FieldHolder, v))
+}
+
+// HolderEqualFold applies the EqualFold predicate on the "holder" field.
+func HolderEqualFold(v string) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldEqualFold(FieldHolder, v))
+}
+
+// HolderContainsFold applies the ContainsFold predicate on the "holder" field.
+func HolderContainsFold(v string) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldContainsFold(FieldHolder, v))
+}
+
+// AcquiredAtEQ applies the EQ predicate on the "acquired_at" field.
+func AcquiredAtEQ(v time.Time) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldEQ(FieldAcquiredAt, v))
+}
+
+// AcquiredAtNEQ applies the NEQ predicate on the "acquired_at" field.
+func AcquiredAtNEQ(v time.Time) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldNEQ(FieldAcquiredAt, v))
+}
+
+// AcquiredAtIn applies the In predicate on the "acquired_at" field.
+func AcquiredAtIn(vs ...time.Time) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldIn(FieldAcquiredAt, vs...))
+}
+
+// AcquiredAtNotIn applies the NotIn predicate on the "acquired_at" field.
+func AcquiredAtNotIn(vs ...time.Time) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldNotIn(FieldAcquiredAt, vs...))
+}
+
+// AcquiredAtGT applies the GT predicate on the "acquired_at" field.
+func AcquiredAtGT(v time.Time) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldGT(FieldAcquiredAt, v))
+}
+
+// AcquiredAtGTE applies the GTE predicate on the "acquired_at" field.
+func AcquiredAtGTE(v time.Time) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldGTE(FieldAcquiredAt, v))
+}
+
+// AcquiredAtLT applies the LT predicate on the "acquired_at" field.
+func AcquiredAtLT(v time.Time) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldLT(FieldAcquiredAt, v))
+}
+
+// AcquiredAtLTE applies the LTE predicate on the "acquired_at" field.
+func AcquiredAtLTE(v time.Time) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldLTE(FieldAcquiredAt, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// HasArticle applies the HasEdge predicate on the "article" edge.
+func HasArticle() predicate.ArticleLock {
+	return predicate.ArticleLock(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, ArticleTable, ArticleColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasArticleWith applies the HasEdge predicate on the "article" edge with a given conditions (other predicates).
+func HasArticleWith(preds ...predicate.Article) predicate.ArticleLock {
+	return predicate.ArticleLock(func(s *sql.Selector) {
+		step := newArticleStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ArticleLock) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ArticleLock) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ArticleLock) predicate.ArticleLock {
+	return predicate.ArticleLock(sql.NotPredicates(p))
+}
@@ -0,0 +1,631 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"goblog/ent/article"
+	"goblog/ent/articlelock"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ArticleLockCreate is the builder for creating a ArticleLock entity.
+type ArticleLockCreate struct {
+	config
+	mutation *ArticleLockMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetArticleID sets the "article_id" field.
+func (alc *ArticleLockCreate) SetArticleID(i int) *ArticleLockCreate {
+	alc.mutation.SetArticleID(i)
+	return alc
+}
+
+// SetHolder sets the "holder" field.
+func (alc *ArticleLockCreate) SetHolder(s string) *ArticleLockCreate {
+	alc.mutation.SetHolder(s)
+	return alc
+}
+
+// SetAcquiredAt sets the "acquired_at" field.
+func (alc *ArticleLockCreate) SetAcquiredAt(t time.Time) *ArticleLockCreate {
+	alc.mutation.SetAcquiredAt(t)
+	return alc
+}
+
+// SetNillableAcquiredAt sets the "acquired_at" field if the given value is not nil.
+func (alc *ArticleLockCreate) SetNillableAcquiredAt(t *time.Time) *ArticleLockCreate {
+	if t != nil {
+		alc.SetAcquiredAt(*t)
+	}
+	return alc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (alc *ArticleLockCreate) SetExpiresAt(t time.Time) *ArticleLockCreate {
+	alc.mutation.SetExpiresAt(t)
+	return alc
+}
+
+// SetArticle sets the "article" edge to the Article entity.
+func (alc *ArticleLockCreate) SetArticle(a *Article) *ArticleLockCreate {
+	return alc.SetArticleID(a.ID)
+}
+
+// Mutation returns the ArticleLockMutation object of the builder.
+func (alc *ArticleLockCreate) Mutation() *ArticleLockMutation {
+	return alc.mutation
+}
+
+// Save creates the ArticleLock in the database.
+func (alc *ArticleLockCreate) Save(ctx context.Context) (*ArticleLock, error) {
+	alc.defaults()
+	return withHooks(ctx, alc.sqlSave, alc.mutation, alc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (alc *ArticleLockCreate) SaveX(ctx context.Context) *ArticleLock {
+	v, err := alc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (alc *ArticleLockCreate) Exec(ctx context.Context) error {
+	_, err := alc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alc *ArticleLockCreate) ExecX(ctx context.Context) {
+	if err := alc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (alc *ArticleLockCreate) defaults() {
+	if _, ok := alc.mutation.AcquiredAt(); !ok {
+		v := articlelock.DefaultAcquiredAt()
+		alc.mutation.SetAcquiredAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (alc *ArticleLockCreate) check() error {
+	if _, ok := alc.mutation.ArticleID(); !ok {
+		return &ValidationError{Name: "article_id", err: errors.New(`ent: missing required field "ArticleLock.article_id"`)}
+	}
+	if _, ok := alc.mutation.Holder(); !ok {
+		return &ValidationError{Name: "holder", err: errors.New(`ent: missing required field "ArticleLock.holder"`)}
+	}
+	if v, ok := alc.mutation.Holder(); ok {
+		if err := articlelock.HolderValidator(v); err != nil {
+			return &ValidationError{Name: "holder", err: fmt.Errorf(`ent: validator failed for field "ArticleLock.holder": %w`, err)}
+		}
+	}
+	if _, ok := alc.mutation.AcquiredAt(); !ok {
+		return &ValidationError{Name: "acquired_at", err: errors.New(`ent: missing required field "ArticleLock.acquired_at"`)}
+	}
+	if _, ok := alc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "ArticleLock.expires_at"`)}
+	}
+	if len(alc.mutation.ArticleIDs()) == 0 {
+		return &ValidationError{Name: "article", err: errors.New(`ent: missing required edge "ArticleLock.article"`)}
+	}
+	return nil
+}
+
+func (alc *ArticleLockCreate) sqlSave(ctx context.Context) (*ArticleLock, error) {
+	if err := alc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := alc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, alc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	alc.mutation.id = &_node.ID
+	alc.mutation.done = true
+	return _node, nil
+}
+
+func (alc *ArticleLockCreate) createSpec() (*ArticleLock, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ArticleLock{config: alc.config}
+		_spec = sqlgraph.NewCreateSpec(articlelock.Table, sqlgraph.NewFieldSpec(articlelock.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = alc.conflict
+	if value, ok := alc.mutation.Holder(); ok {
+		_spec.SetField(articlelock.FieldHolder, field.TypeString, value)
+		_node.Holder = value
+	}
+	if value, ok := alc.mutation.AcquiredAt(); ok {
+		_spec.SetField(articlelock.FieldAcquiredAt, field.TypeTime, value)
+		_node.AcquiredAt = value
+	}
+	if value, ok := alc.mutation.ExpiresAt(); ok {
+		_spec.SetField(articlelock.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if nodes := alc.mutation.ArticleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   articlelock.ArticleTable,
+			Columns: []string{articlelock.ArticleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ArticleID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ArticleLock.Create().
+//		SetArticleID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ArticleLockUpsert) {
+//			SetArticleID(v+v).
+//		}).
+//		Exec(ctx)
+func (alc *ArticleLockCreate) OnConflict(opts ...sql.ConflictOption) *ArticleLockUpsertOne {
+	alc.conflict = opts
+	return &ArticleLockUpsertOne{
+		create: alc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ArticleLock.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (alc *ArticleLockCreate) OnConflictColumns(columns ...string) *ArticleLockUpsertOne {
+	alc.conflict = append(alc.conflict, sql.ConflictColumns(columns...))
+	return &ArticleLockUpsertOne{
+		create: alc,
+	}
+}
+
+type (
+	// ArticleLockUpsertOne is the builder for "upsert"-ing
+	//  one ArticleLock node.
+	ArticleLockUpsertOne struct {
+		create *ArticleLockCreate
+	}
+
+	// ArticleLockUpsert is the "OnConflict" setter.
+	ArticleLockUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetHolder sets the "holder" field.
+func (u *ArticleLockUpsert) SetHolder(v string) *ArticleLockUpsert {
+	u.Set(articlelock.FieldHolder, v)
+	return u
+}
+
+// UpdateHolder sets the "holder" field to the value that was provided on create.
+func (u *ArticleLockUpsert) UpdateHolder() *ArticleLockUpsert {
+	u.SetExcluded(articlelock.FieldHolder)
+	return u
+}
+
+// SetAcquiredAt sets the "acquired_at" field.
+func (u *ArticleLockUpsert) SetAcquiredAt(v time.Time) *ArticleLockUpsert {
+	u.Set(articlelock.FieldAcquiredAt, v)
+	return u
+}
+
+// UpdateAcquiredAt sets the "acquired_at" field to the value that was provided on create.
+func (u *ArticleLockUpsert) UpdateAcquiredAt() *ArticleLockUpsert {
+	u.SetExcluded(articlelock.FieldAcquiredAt)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ArticleLockUpsert) SetExpiresAt(v time.Time) *ArticleLockUpsert {
+	u.Set(articlelock.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ArticleLockUpsert) UpdateExpiresAt() *ArticleLockUpsert {
+	u.SetExcluded(articlelock.FieldExpiresAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ArticleLock.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ArticleLockUpsertOne) UpdateNewValues() *ArticleLockUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ArticleID(); exists {
+			s.SetIgnore(articlelock.FieldArticleID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ArticleLock.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ArticleLockUpsertOne) Ignore() *ArticleLockUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ArticleLockUpsertOne) DoNothing() *ArticleLockUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ArticleLockCreate.OnConflict
+// documentation for more info.
+func (u *ArticleLockUpsertOne) Update(set func(*ArticleLockUpsert)) *ArticleLockUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ArticleLockUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetHolder sets the "holder" field.
+func (u *ArticleLockUpsertOne) SetHolder(v string) *ArticleLockUpsertOne {
+	return u.Update(func(s *ArticleLockUpsert) {
+		s.SetHolder(v)
+	})
+}
+
+// UpdateHolder sets the "holder" field to the value that was provided on create.
+func (u *ArticleLockUpsertOne) UpdateHolder() *ArticleLockUpsertOne {
+	return u.Update(func(s *ArticleLockUpsert) {
+		s.UpdateHolder()
+	})
+}
+
+// SetAcquiredAt sets the "acquired_at" field.
+func (u *ArticleLockUpsertOne) SetAcquiredAt(v time.Time) *ArticleLockUpsertOne {
+	return u.Update(func(s *ArticleLockUpsert) {
+		s.SetAcquiredAt(v)
+	})
+}
+
+// UpdateAcquiredAt sets the "acquired_at" field to the value that was provided on create.
+func (u *ArticleLockUpsertOne) UpdateAcquiredAt() *ArticleLockUpsertOne {
+	return u.Update(func(s *ArticleLockUpsert) {
+		s.UpdateAcquiredAt()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ArticleLockUpsertOne) SetExpiresAt(v time.Time) *ArticleLockUpsertOne {
+	return u.Update(func(s *ArticleLockUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ArticleLockUpsertOne) UpdateExpiresAt() *ArticleLockUpsertOne {
+	return u.Update(func(s *ArticleLockUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ArticleLockUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ArticleLockCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ArticleLockUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ArticleLockUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ArticleLockUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ArticleLockCreateBulk is the builder for creating many ArticleLock entities in bulk.
+type ArticleLockCreateBulk struct {
+	config
+	err      error
+	builders []*ArticleLockCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ArticleLock entities in the database.
+func (alcb *ArticleLockCreateBulk) Save(ctx context.Context) ([]*ArticleLock, error) {
+	if alcb.err != nil {
+		return nil, alcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(alcb.builders))
+	nodes := make([]*ArticleLock, len(alcb.builders))
+	mutators := make([]Mutator, len(alcb.builders))
+	for i := range alcb.builders {
+		func(i int, root context.Context) {
+			builder := alcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ArticleLockMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, alcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = alcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, alcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, alcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (alcb *ArticleLockCreateBulk) SaveX(ctx context.Context) []*ArticleLock {
+	v, err := alcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (alcb *ArticleLockCreateBulk) Exec(ctx context.Context) error {
+	_, err := alcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alcb *ArticleLockCreateBulk) ExecX(ctx context.Context) {
+	if err := alcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ArticleLock.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ArticleLockUpsert) {
+//			SetArticleID(v+v).
+//		}).
+//		Exec(ctx)
+func (alcb *ArticleLockCreateBulk) OnConflict(opts ...sql.ConflictOption) *ArticleLockUpsertBulk {
+	alcb.conflict = opts
+	return &ArticleLockUpsertBulk{
+		create: alcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ArticleLock.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (alcb *ArticleLockCreateBulk) OnConflictColumns(columns ...string) *ArticleLockUpsertBulk {
+	alcb.conflict = append(alcb.conflict, sql.ConflictColumns(columns...))
+	return &ArticleLockUpsertBulk{
+		create: alcb,
+	}
+}
+
+// ArticleLockUpsertBulk is the builder for "upsert"-ing
+// a bulk of ArticleLock nodes.
+type ArticleLockUpsertBulk struct {
+	create *ArticleLockCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ArticleLock.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ArticleLockUpsertBulk) UpdateNewValues() *ArticleLockUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ArticleID(); exists {
+				s.SetIgnore(articlelock.FieldArticleID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ArticleLock.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ArticleLockUpsertBulk) Ignore() *ArticleLockUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ArticleLockUpsertBulk) DoNothing() *ArticleLockUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ArticleLockCreateBulk.OnConflict
+// documentation for more info.
+func (u *ArticleLockUpsertBulk) Update(set func(*ArticleLockUpsert)) *ArticleLockUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ArticleLockUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetHolder sets the "holder" field.
+func (u *ArticleLockUpsertBulk) SetHolder(v string) *ArticleLockUpsertBulk {
+	return u.Update(func(s *ArticleLockUpsert) {
+		s.SetHolder(v)
+	})
+}
+
+// UpdateHolder sets the "holder" field to the value that was provided on create.
+func (u *ArticleLockUpsertBulk) UpdateHolder() *ArticleLockUpsertBulk {
+	return u.Update(func(s *ArticleLockUpsert) {
+		s.UpdateHolder()
+	})
+}
+
+// SetAcquiredAt sets the "acquired_at" field.
+func (u *ArticleLockUpsertBulk) SetAcquiredAt(v time.Time) *ArticleLockUpsertBulk {
+	return u.Update(func(s *ArticleLockUpsert) {
+		s.SetAcquiredAt(v)
+	})
+}
+
+// UpdateAcquiredAt sets the "acquired_at" field to the value that was provided on create.
+func (u *ArticleLockUpsertBulk) UpdateAcquiredAt() *ArticleLockUpsertBulk {
+	return u.Update(func(s *ArticleLockUpsert) {
+		s.UpdateAcquiredAt()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ArticleLockUpsertBulk) SetExpiresAt(v time.Time) *ArticleLockUpsertBulk {
+	return u.Update(func(s *ArticleLockUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ArticleLockUpsertBulk) UpdateExpiresAt() *ArticleLockUpsertBulk {
+	return u.Update(func(s *ArticleLockUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ArticleLockUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ArticleLockCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ArticleLockCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ArticleLockUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
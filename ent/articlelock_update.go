@@ -0,0 +1,310 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"goblog/ent/articlelock"
+	"goblog/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ArticleLockUpdate is the builder for updating ArticleLock entities.
+type ArticleLockUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ArticleLockMutation
+}
+
+// Where appends a list predicates to the ArticleLockUpdate builder.
+func (alu *ArticleLockUpdate) Where(ps ...predicate.ArticleLock) *ArticleLockUpdate {
+	alu.mutation.Where(ps...)
+	return alu
+}
+
+// SetHolder sets the "holder" field.
+func (alu *ArticleLockUpdate) SetHolder(s string) *ArticleLockUpdate {
+	alu.mutation.SetHolder(s)
+	return alu
+}
+
+// SetNillableHolder sets the "holder" field if the given value is not nil.
+func (alu *ArticleLockUpdate) SetNillableHolder(s *string) *ArticleLockUpdate {
+	if s != nil {
+		alu.SetHolder(*s)
+	}
+	return alu
+}
+
+// SetAcquiredAt sets the "acquired_at" field.
+func (alu *ArticleLockUpdate) SetAcquiredAt(t time.Time) *ArticleLockUpdate {
+	alu.mutation.SetAcquiredAt(t)
+	return alu
+}
+
+// SetNillableAcquiredAt sets the "acquired_at" field if the given value is not nil.
+func (alu *ArticleLockUpdate) SetNillableAcquiredAt(t *time.Time) *ArticleLockUpdate {
+	if t != nil {
+		alu.SetAcquiredAt(*t)
+	}
+	return alu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (alu *ArticleLockUpdate) SetExpiresAt(t time.Time) *ArticleLockUpdate {
+	alu.mutation.SetExpiresAt(t)
+	return alu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (alu *ArticleLockUpdate) SetNillableExpiresAt(t *time.Time) *ArticleLockUpdate {
+	if t != nil {
+		alu.SetExpiresAt(*t)
+	}
+	return alu
+}
+
+// Mutation returns the ArticleLockMutation object of the builder.
+func (alu *ArticleLockUpdate) Mutation() *ArticleLockMutation {
+	return alu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (alu *ArticleLockUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, alu.sqlSave, alu.mutation, alu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (alu *ArticleLockUpdate) SaveX(ctx context.Context) int {
+	affected, err := alu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (alu *ArticleLockUpdate) Exec(ctx context.Context) error {
+	_, err := alu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alu *ArticleLockUpdate) ExecX(ctx context.Context) {
+	if err := alu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (alu *ArticleLockUpdate) check() error {
+	if v, ok := alu.mutation.Holder(); ok {
+		if err := articlelock.HolderValidator(v); err != nil {
+			return &ValidationError{Name: "holder", err: fmt.Errorf(`ent: validator failed for field "ArticleLock.holder": %w`, err)}
+		}
+	}
+	if alu.mutation.ArticleCleared() && len(alu.mutation.ArticleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ArticleLock.article"`)
+	}
+	return nil
+}
+
+func (alu *ArticleLockUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := alu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(articlelock.Table, articlelock.Columns, sqlgraph.NewFieldSpec(articlelock.FieldID, field.TypeInt))
+	if ps := alu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := alu.mutation.Holder(); ok {
+		_spec.SetField(articlelock.FieldHolder, field.TypeString, value)
+	}
+	if value, ok := alu.mutation.AcquiredAt(); ok {
+		_spec.SetField(articlelock.FieldAcquiredAt, field.TypeTime, value)
+	}
+	if value, ok := alu.mutation.ExpiresAt(); ok {
+		_spec.SetField(articlelock.FieldExpiresAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, alu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{articlelock.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	alu.mutation.done = true
+	return n, nil
+}
+
+// ArticleLockUpdateOne is the builder for updating a single ArticleLock entity.
+type ArticleLockUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ArticleLockMutation
+}
+
+// SetHolder sets the "holder" field.
+func (aluo *ArticleLockUpdateOne) SetHolder(s string) *ArticleLockUpdateOne {
+	aluo.mutation.SetHolder(s)
+	return aluo
+}
+
+// SetNillableHolder sets the "holder" field if the given value is not nil.
+func (aluo *ArticleLockUpdateOne) SetNillableHolder(s *string) *ArticleLockUpdateOne {
+	if s != nil {
+		aluo.SetHolder(*s)
+	}
+	return aluo
+}
+
+// SetAcquiredAt sets the "acquired_at" field.
+func (aluo *ArticleLockUpdateOne) SetAcquiredAt(t time.Time) *ArticleLockUpdateOne {
+	aluo.mutation.SetAcquiredAt(t)
+	return aluo
+}
+
+// SetNillableAcquiredAt sets the "acquired_at" field if the given value is not nil.
+func (aluo *ArticleLockUpdateOne) SetNillableAcquiredAt(t *time.Time) *ArticleLockUpdateOne {
+	if t != nil {
+		aluo.SetAcquiredAt(*t)
+	}
+	return aluo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (aluo *ArticleLockUpdateOne) SetExpiresAt(t time.Time) *ArticleLockUpdateOne {
+	aluo.mutation.SetExpiresAt(t)
+	return aluo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (aluo *ArticleLockUpdateOne) SetNillableExpiresAt(t *time.Time) *ArticleLockUpdateOne {
+	if t != nil {
+		aluo.SetExpiresAt(*t)
+	}
+	return aluo
+}
+
+// Mutation returns the ArticleLockMutation object of the builder.
+func (aluo *ArticleLockUpdateOne) Mutation() *ArticleLockMutation {
+	return aluo.mutation
+}
+
+// Where appends a list predicates to the ArticleLockUpdate builder.
+func (aluo *ArticleLockUpdateOne) Where(ps ...predicate.ArticleLock) *ArticleLockUpdateOne {
+	aluo.mutation.Where(ps...)
+	return aluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aluo *ArticleLockUpdateOne) Select(field string, fields ...string) *ArticleLockUpdateOne {
+	aluo.fields = append([]string{field}, fields...)
+	return aluo
+}
+
+// Save executes the query and returns the updated ArticleLock entity.
+func (aluo *ArticleLockUpdateOne) Save(ctx context.Context) (*ArticleLock, error) {
+	return withHooks(ctx, aluo.sqlSave, aluo.mutation, aluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aluo *ArticleLockUpdateOne) SaveX(ctx context.Context) *ArticleLock {
+	node, err := aluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aluo *ArticleLockUpdateOne) Exec(ctx context.Context) error {
+	_, err := aluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aluo *ArticleLockUpdateOne) ExecX(ctx context.Context) {
+	if err := aluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aluo *ArticleLockUpdateOne) check() error {
+	if v, ok := aluo.mutation.Holder(); ok {
+		if err := articlelock.HolderValidator(v); err != nil {
+			return &ValidationError{Name: "holder", err: fmt.Errorf(`ent: validator failed for field "ArticleLock.holder": %w`, err)}
+		}
+	}
+	if aluo.mutation.ArticleCleared() && len(aluo.mutation.ArticleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ArticleLock.article"`)
+	}
+	return nil
+}
+
+func (aluo *ArticleLockUpdateOne) sqlSave(ctx context.Context) (_node *ArticleLock, err error) {
+	if err := aluo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(articlelock.Table, articlelock.Columns, sqlgraph.NewFieldSpec(articlelock.FieldID, field.TypeInt))
+	id, ok := aluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ArticleLock.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, articlelock.FieldID)
+		for _, f := range fields {
+			if !articlelock.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != articlelock.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aluo.mutation.Holder(); ok {
+		_spec.SetField(articlelock.FieldHolder, field.TypeString, value)
+	}
+	if value, ok := aluo.mutation.AcquiredAt(); ok {
+		_spec.SetField(articlelock.FieldAcquiredAt, field.TypeTime, value)
+	}
+	if value, ok := aluo.mutation.ExpiresAt(); ok {
+		_spec.SetField(articlelock.FieldExpiresAt, field.TypeTime, value)
+	}
+	_node = &ArticleLock{config: aluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{articlelock.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aluo.mutation.done = true
+	return _node, nil
+}
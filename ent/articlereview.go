@@ -0,0 +1,189 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"goblog/ent/article"
+	"goblog/ent/articlereview"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ArticleReview is the model entity for the ArticleReview schema.
+type ArticleReview struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 操作者用户名
+	Reviewer string `json:"reviewer,omitempty"`
+	// 流转前状态
+	FromStatus string `json:"from_status,omitempty"`
+	// 流转后状态，仅添加备注时与流转前相同
+	ToStatus string `json:"to_status,omitempty"`
+	// 审阅备注
+	Note string `json:"note,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ArticleReviewQuery when eager-loading is set.
+	Edges           ArticleReviewEdges `json:"edges"`
+	article_reviews *int
+	selectValues    sql.SelectValues
+}
+
+// ArticleReviewEdges holds the relations/edges for other nodes in the graph.
+type ArticleReviewEdges struct {
+	// Article holds the value of the article edge.
+	Article *Article `json:"article,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ArticleOrErr returns the Article value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ArticleReviewEdges) ArticleOrErr() (*Article, error) {
+	if e.Article != nil {
+		return e.Article, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: article.Label}
+	}
+	return nil, &NotLoadedError{edge: "article"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ArticleReview) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case articlereview.FieldID:
+			values[i] = new(sql.NullInt64)
+		case articlereview.FieldReviewer, articlereview.FieldFromStatus, articlereview.FieldToStatus, articlereview.FieldNote:
+			values[i] = new(sql.NullString)
+		case articlereview.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case articlereview.ForeignKeys[0]: // article_reviews
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ArticleReview fields.
+func (ar *ArticleReview) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case articlereview.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ar.ID = int(value.Int64)
+		case articlereview.FieldReviewer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewer", values[i])
+			} else if value.Valid {
+				ar.Reviewer = value.String
+			}
+		case articlereview.FieldFromStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_status", values[i])
+			} else if value.Valid {
+				ar.FromStatus = value.String
+			}
+		case articlereview.FieldToStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_status", values[i])
+			} else if value.Valid {
+				ar.ToStatus = value.String
+			}
+		case articlereview.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				ar.Note = value.String
+			}
+		case articlereview.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ar.CreatedAt = value.Time
+			}
+		case articlereview.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field article_reviews", value)
+			} else if value.Valid {
+				ar.article_reviews = new(int)
+				*ar.article_reviews = int(value.Int64)
+			}
+		default:
+			ar.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ArticleReview.
+// This includes values selected through modifiers, order, etc.
+func (ar *ArticleReview) Value(name string) (ent.Value, error) {
+	return ar.selectValues.Get(name)
+}
+
+// QueryArticle queries the "article" edge of the ArticleReview entity.
+func (ar *ArticleReview) QueryArticle() *ArticleQuery {
+	return NewArticleReviewClient(ar.config).QueryArticle(ar)
+}
+
+// Update returns a builder for updating this ArticleReview.
+// Note that you need to call ArticleReview.Unwrap() before calling this method if this ArticleReview
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ar *ArticleReview) Update() *ArticleReviewUpdateOne {
+	return NewArticleReviewClient(ar.config).UpdateOne(ar)
+}
+
+// Unwrap unwraps the ArticleReview entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ar *ArticleReview) Unwrap() *ArticleReview {
+	_tx, ok := ar.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ArticleReview is not a transactional entity")
+	}
+	ar.config.driver = _tx.drv
+	return ar
+}
+
+// String implements the fmt.Stringer.
+func (ar *ArticleReview) String() string {
+	var builder strings.Builder
+	builder.WriteString("ArticleReview(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ar.ID))
+	builder.WriteString("reviewer=")
+	builder.WriteString(ar.Reviewer)
+	builder.WriteString(", ")
+	builder.WriteString("from_status=")
+	builder.WriteString(ar.FromStatus)
+	builder.WriteString(", ")
+	builder.WriteString("to_status=")
+	builder.WriteString(ar.ToStatus)
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(ar.Note)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ar.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ArticleReviews is a parsable slice of ArticleReview.
+type ArticleReviews []*ArticleReview
@@ -0,0 +1,558 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"goblog/ent/article"
+	"goblog/ent/articlereview"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ArticleReviewCreate is the builder for creating a ArticleReview entity.
+type ArticleReviewCreate struct {
+	config
+	mutation *ArticleReviewMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetReviewer sets the "reviewer" field.
+func (arc *ArticleReviewCreate) SetReviewer(s string) *ArticleReviewCreate {
+	arc.mutation.SetReviewer(s)
+	return arc
+}
+
+// SetFromStatus sets the "from_status" field.
+func (arc *ArticleReviewCreate) SetFromStatus(s string) *ArticleReviewCreate {
+	arc.mutation.SetFromStatus(s)
+	return arc
+}
+
+// SetToStatus sets the "to_status" field.
+func (arc *ArticleReviewCreate) SetToStatus(s string) *ArticleReviewCreate {
+	arc.mutation.SetToStatus(s)
+	return arc
+}
+
+// SetNote sets the "note" field.
+func (arc *ArticleReviewCreate) SetNote(s string) *ArticleReviewCreate {
+	arc.mutation.SetNote(s)
+	return arc
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (arc *ArticleReviewCreate) SetNillableNote(s *string) *ArticleReviewCreate {
+	if s != nil {
+		arc.SetNote(*s)
+	}
+	return arc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (arc *ArticleReviewCreate) SetCreatedAt(t time.Time) *ArticleReviewCreate {
+	arc.mutation.SetCreatedAt(t)
+	return arc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (arc *ArticleReviewCreate) SetNillableCreatedAt(t *time.Time) *ArticleReviewCreate {
+	if t != nil {
+		arc.SetCreatedAt(*t)
+	}
+	return arc
+}
+
+// SetArticleID sets the "article" edge to the Article entity by ID.
+func (arc *ArticleReviewCreate) SetArticleID(id int) *ArticleReviewCreate {
+	arc.mutation.SetArticleID(id)
+	return arc
+}
+
+// SetArticle sets the "article" edge to the Article entity.
+func (arc *ArticleReviewCreate) SetArticle(a *Article) *ArticleReviewCreate {
+	return arc.SetArticleID(a.ID)
+}
+
+// Mutation returns the ArticleReviewMutation object of the builder.
+func (arc *ArticleReviewCreate) Mutation() *ArticleReviewMutation {
+	return arc.mutation
+}
+
+// Save creates the ArticleReview in the database.
+func (arc *ArticleReviewCreate) Save(ctx context.Context) (*ArticleReview, error) {
+	arc.defaults()
+	return withHooks(ctx, arc.sqlSave, arc.mutation, arc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (arc *ArticleReviewCreate) SaveX(ctx context.Context) *ArticleReview {
+	v, err := arc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (arc *ArticleReviewCreate) Exec(ctx context.Context) error {
+	_, err := arc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (arc *ArticleReviewCreate) ExecX(ctx context.Context) {
+	if err := arc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (arc *ArticleReviewCreate) defaults() {
+	if _, ok := arc.mutation.CreatedAt(); !ok {
+		v := articlereview.DefaultCreatedAt()
+		arc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (arc *ArticleReviewCreate) check() error {
+	if _, ok := arc.mutation.Reviewer(); !ok {
+		return &ValidationError{Name: "reviewer", err: errors.New(`ent: missing required field "ArticleReview.reviewer"`)}
+	}
+	if _, ok := arc.mutation.FromStatus(); !ok {
+		return &ValidationError{Name: "from_status", err: errors.New(`ent: missing required field "ArticleReview.from_status"`)}
+	}
+	if _, ok := arc.mutation.ToStatus(); !ok {
+		return &ValidationError{Name: "to_status", err: errors.New(`ent: missing required field "ArticleReview.to_status"`)}
+	}
+	if _, ok := arc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ArticleReview.created_at"`)}
+	}
+	if len(arc.mutation.ArticleIDs()) == 0 {
+		return &ValidationError{Name: "article", err: errors.New(`ent: missing required edge "ArticleReview.article"`)}
+	}
+	return nil
+}
+
+func (arc *ArticleReviewCreate) sqlSave(ctx context.Context) (*ArticleReview, error) {
+	if err := arc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := arc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, arc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	arc.mutation.id = &_node.ID
+	arc.mutation.done = true
+	return _node, nil
+}
+
+func (arc *ArticleReviewCreate) createSpec() (*ArticleReview, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ArticleReview{config: arc.config}
+		_spec = sqlgraph.NewCreateSpec(articlereview.Table, sqlgraph.NewFieldSpec(articlereview.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = arc.conflict
+	if value, ok := arc.mutation.Reviewer(); ok {
+		_spec.SetField(articlereview.FieldReviewer, field.TypeString, value)
+		_node.Reviewer = value
+	}
+	if value, ok := arc.mutation.FromStatus(); ok {
+		_spec.SetField(articlereview.FieldFromStatus, field.TypeString, value)
+		_node.FromStatus = value
+	}
+	if value, ok := arc.mutation.ToStatus(); ok {
+		_spec.SetField(articlereview.FieldToStatus, field.TypeString, value)
+		_node.ToStatus = value
+	}
+	if value, ok := arc.mutation.Note(); ok {
+		_spec.SetField(articlereview.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := arc.mutation.CreatedAt(); ok {
+		_spec.SetField(articlereview.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := arc.mutation.ArticleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   articlereview.ArticleTable,
+			Columns: []string{articlereview.ArticleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.article_reviews = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ArticleReview.Create().
+//		SetReviewer(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ArticleReviewUpsert) {
+//			SetReviewer(v+v).
+//		}).
+//		Exec(ctx)
+func (arc *ArticleReviewCreate) OnConflict(opts ...sql.ConflictOption) *ArticleReviewUpsertOne {
+	arc.conflict = opts
+	return &ArticleReviewUpsertOne{
+		create: arc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ArticleReview.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (arc *ArticleReviewCreate) OnConflictColumns(columns ...string) *ArticleReviewUpsertOne {
+	arc.conflict = append(arc.conflict, sql.ConflictColumns(columns...))
+	return &ArticleReviewUpsertOne{
+		create: arc,
+	}
+}
+
+type (
+	// ArticleReviewUpsertOne is the builder for "upsert"-ing
+	//  one ArticleReview node.
+	ArticleReviewUpsertOne struct {
+		create *ArticleReviewCreate
+	}
+
+	// ArticleReviewUpsert is the "OnConflict" setter.
+	ArticleReviewUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ArticleReview.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ArticleReviewUpsertOne) UpdateNewValues() *ArticleReviewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.Reviewer(); exists {
+			s.SetIgnore(articlereview.FieldReviewer)
+		}
+		if _, exists := u.create.mutation.FromStatus(); exists {
+			s.SetIgnore(articlereview.FieldFromStatus)
+		}
+		if _, exists := u.create.mutation.ToStatus(); exists {
+			s.SetIgnore(articlereview.FieldToStatus)
+		}
+		if _, exists := u.create.mutation.Note(); exists {
+			s.SetIgnore(articlereview.FieldNote)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(articlereview.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ArticleReview.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ArticleReviewUpsertOne) Ignore() *ArticleReviewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ArticleReviewUpsertOne) DoNothing() *ArticleReviewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ArticleReviewCreate.OnConflict
+// documentation for more info.
+func (u *ArticleReviewUpsertOne) Update(set func(*ArticleReviewUpsert)) *ArticleReviewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ArticleReviewUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ArticleReviewUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ArticleReviewCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ArticleReviewUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ArticleReviewUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ArticleReviewUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ArticleReviewCreateBulk is the builder for creating many ArticleReview entities in bulk.
+type ArticleReviewCreateBulk struct {
+	config
+	err      error
+	builders []*ArticleReviewCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ArticleReview entities in the database.
+func (arcb *ArticleReviewCreateBulk) Save(ctx context.Context) ([]*ArticleReview, error) {
+	if arcb.err != nil {
+		return nil, arcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(arcb.builders))
+	nodes := make([]*ArticleReview, len(arcb.builders))
+	mutators := make([]Mutator, len(arcb.builders))
+	for i := range arcb.builders {
+		func(i int, root context.Context) {
+			builder := arcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ArticleReviewMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, arcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = arcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, arcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, arcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (arcb *ArticleReviewCreateBulk) SaveX(ctx context.Context) []*ArticleReview {
+	v, err := arcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (arcb *ArticleReviewCreateBulk) Exec(ctx context.Context) error {
+	_, err := arcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (arcb *ArticleReviewCreateBulk) ExecX(ctx context.Context) {
+	if err := arcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ArticleReview.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ArticleReviewUpsert) {
+//			SetReviewer(v+v).
+//		}).
+//		Exec(ctx)
+func (arcb *ArticleReviewCreateBulk) OnConflict(opts ...sql.ConflictOption) *ArticleReviewUpsertBulk {
+	arcb.conflict = opts
+	return &ArticleReviewUpsertBulk{
+		create: arcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ArticleReview.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (arcb *ArticleReviewCreateBulk) OnConflictColumns(columns ...string) *ArticleReviewUpsertBulk {
+	arcb.conflict = append(arcb.conflict, sql.ConflictColumns(columns...))
+	return &ArticleReviewUpsertBulk{
+		create: arcb,
+	}
+}
+
+// ArticleReviewUpsertBulk is the builder for "upsert"-ing
+// a bulk of ArticleReview nodes.
+type ArticleReviewUpsertBulk struct {
+	create *ArticleReviewCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ArticleReview.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ArticleReviewUpsertBulk) UpdateNewValues() *ArticleReviewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.Reviewer(); exists {
+				s.SetIgnore(articlereview.FieldReviewer)
+			}
+			if _, exists := b.mutation.FromStatus(); exists {
+				s.SetIgnore(articlereview.FieldFromStatus)
+			}
+			if _, exists := b.mutation.ToStatus(); exists {
+				s.SetIgnore(articlereview.FieldToStatus)
+			}
+			if _, exists := b.mutation.Note(); exists {
+				s.SetIgnore(articlereview.FieldNote)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(articlereview.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ArticleReview.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ArticleReviewUpsertBulk) Ignore() *ArticleReviewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ArticleReviewUpsertBulk) DoNothing() *ArticleReviewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ArticleReviewCreateBulk.OnConflict
+// documentation for more info.
+func (u *ArticleReviewUpsertBulk) Update(set func(*ArticleReviewUpsert)) *ArticleReviewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ArticleReviewUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ArticleReviewUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ArticleReviewCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ArticleReviewCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ArticleReviewUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,651 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"goblog/ent/article"
+	"goblog/ent/articlereview"
+	"goblog/ent/predicate"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ArticleReviewQuery is the builder for querying ArticleReview entities.
+type ArticleReviewQuery struct {
+	config
+	ctx         *QueryContext
+	order       []articlereview.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.ArticleReview
+	withArticle *ArticleQuery
+	withFKs     bool
+	modifiers   []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ArticleReviewQuery builder.
+func (arq *ArticleReviewQuery) Where(ps ...predicate.ArticleReview) *ArticleReviewQuery {
+	arq.predicates = append(arq.predicates, ps...)
+	return arq
+}
+
+// Limit the number of records to be returned by this query.
+func (arq *ArticleReviewQuery) Limit(limit int) *ArticleReviewQuery {
+	arq.ctx.Limit = &limit
+	return arq
+}
+
+// Offset to start from.
+func (arq *ArticleReviewQuery) Offset(offset int) *ArticleReviewQuery {
+	arq.ctx.Offset = &offset
+	return arq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (arq *ArticleReviewQuery) Unique(unique bool) *ArticleReviewQuery {
+	arq.ctx.Unique = &unique
+	return arq
+}
+
+// Order specifies how the records should be ordered.
+func (arq *ArticleReviewQuery) Order(o ...articlereview.OrderOption) *ArticleReviewQuery {
+	arq.order = append(arq.order, o...)
+	return arq
+}
+
+// QueryArticle chains the current query on the "article" edge.
+func (arq *ArticleReviewQuery) QueryArticle() *ArticleQuery {
+	query := (&ArticleClient{config: arq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := arq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := arq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(articlereview.Table, articlereview.FieldID, selector),
+			sqlgraph.To(article.Table, article.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, articlereview.ArticleTable, articlereview.ArticleColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(arq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ArticleReview entity from the query.
+// Returns a *NotFoundError when no ArticleReview was found.
+func (arq *ArticleReviewQuery) First(ctx context.Context) (*ArticleReview, error) {
+	nodes, err := arq.Limit(1).All(setContextOp(ctx, arq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{articlereview.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (arq *ArticleReviewQuery) FirstX(ctx context.Context) *ArticleReview {
+	node, err := arq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ArticleReview ID from the query.
+// Returns a *NotFoundError when no ArticleReview ID was found.
+func (arq *ArticleReviewQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = arq.Limit(1).IDs(setContextOp(ctx, arq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{articlereview.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (arq *ArticleReviewQuery) FirstIDX(ctx context.Context) int {
+	id, err := arq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ArticleReview entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ArticleReview entity is found.
+// Returns a *NotFoundError when no ArticleReview entities are found.
+func (arq *ArticleReviewQuery) Only(ctx context.Context) (*ArticleReview, error) {
+	nodes, err := arq.Limit(2).All(setContextOp(ctx, arq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{articlereview.Label}
+	default:
+		return nil, &NotSingularError{articlereview.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (arq *ArticleReviewQuery) OnlyX(ctx context.Context) *ArticleReview {
+	node, err := arq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ArticleReview ID in the query.
+// Returns a *NotSingularError when more than one ArticleReview ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (arq *ArticleReviewQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = arq.Limit(2).IDs(setContextOp(ctx, arq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{articlereview.Label}
+	default:
+		err = &NotSingularError{articlereview.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (arq *ArticleReviewQuery) OnlyIDX(ctx context.Context) int {
+	id, err := arq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ArticleReviews.
+func (arq *ArticleReviewQuery) All(ctx context.Context) ([]*ArticleReview, error) {
+	ctx = setContextOp(ctx, arq.ctx, ent.OpQueryAll)
+	if err := arq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ArticleReview, *ArticleReviewQuery]()
+	return withInterceptors[[]*ArticleReview](ctx, arq, qr, arq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (arq *ArticleReviewQuery) AllX(ctx context.Context) []*ArticleReview {
+	nodes, err := arq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ArticleReview IDs.
+func (arq *ArticleReviewQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if arq.ctx.Unique == nil && arq.path != nil {
+		arq.Unique(true)
+	}
+	ctx = setContextOp(ctx, arq.ctx, ent.OpQueryIDs)
+	if err = arq.Select(articlereview.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (arq *ArticleReviewQuery) IDsX(ctx context.Context) []int {
+	ids, err := arq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (arq *ArticleReviewQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, arq.ctx, ent.OpQueryCount)
+	if err := arq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, arq, querierCount[*ArticleReviewQuery](), arq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (arq *ArticleReviewQuery) CountX(ctx context.Context) int {
+	count, err := arq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (arq *ArticleReviewQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, arq.ctx, ent.OpQueryExist)
+	switch _, err := arq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (arq *ArticleReviewQuery) ExistX(ctx context.Context) bool {
+	exist, err := arq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ArticleReviewQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (arq *ArticleReviewQuery) Clone() *ArticleReviewQuery {
+	if arq == nil {
+		return nil
+	}
+	return &ArticleReviewQuery{
+		config:      arq.config,
+		ctx:         arq.ctx.Clone(),
+		order:       append([]articlereview.OrderOption{}, arq.order...),
+		inters:      append([]Interceptor{}, arq.inters...),
+		predicates:  append([]predicate.ArticleReview{}, arq.predicates...),
+		withArticle: arq.withArticle.Clone(),
+		// clone intermediate query.
+		sql:  arq.sql.Clone(),
+		path: arq.path,
+	}
+}
+
+// WithArticle tells the query-builder to eager-load the nodes that are connected to
+// the "article" edge. The optional arguments are used to configure the query builder of the edge.
+func (arq *ArticleReviewQuery) WithArticle(opts ...func(*ArticleQuery)) *ArticleReviewQuery {
+	query := (&ArticleClient{config: arq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	arq.withArticle = query
+	return arq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Reviewer string `json:"reviewer,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ArticleReview.Query().
+//		GroupBy(articlereview.FieldReviewer).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (arq *ArticleReviewQuery) GroupBy(field string, fields ...string) *ArticleReviewGroupBy {
+	arq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ArticleReviewGroupBy{build: arq}
+	grbuild.flds = &arq.ctx.Fields
+	grbuild.label = articlereview.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Reviewer string `json:"reviewer,omitempty"`
+//	}
+//
+//	client.ArticleReview.Query().
+//		Select(articlereview.FieldReviewer).
+//		Scan(ctx, &v)
+func (arq *ArticleReviewQuery) Select(fields ...string) *ArticleReviewSelect {
+	arq.ctx.Fields = append(arq.ctx.Fields, fields...)
+	sbuild := &ArticleReviewSelect{ArticleReviewQuery: arq}
+	sbuild.label = articlereview.Label
+	sbuild.flds, sbuild.scan = &arq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ArticleReviewSelect configured with the given aggregations.
+func (arq *ArticleReviewQuery) Aggregate(fns ...AggregateFunc) *ArticleReviewSelect {
+	return arq.Select().Aggregate(fns...)
+}
+
+func (arq *ArticleReviewQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range arq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, arq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range arq.ctx.Fields {
+		if !articlereview.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if arq.path != nil {
+		prev, err := arq.path(ctx)
+		if err != nil {
+			return err
+		}
+		arq.sql = prev
+	}
+	return nil
+}
+
+func (arq *ArticleReviewQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ArticleReview, error) {
+	var (
+		nodes       = []*ArticleReview{}
+		withFKs     = arq.withFKs
+		_spec       = arq.querySpec()
+		loadedTypes = [1]bool{
+			arq.withArticle != nil,
+		}
+	)
+	if arq.withArticle != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, articlereview.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ArticleReview).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ArticleReview{config: arq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(arq.modifiers) > 0 {
+		_spec.Modifiers = arq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, arq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := arq.withArticle; query != nil {
+		if err := arq.loadArticle(ctx, query, nodes, nil,
+			func(n *ArticleReview, e *Article) { n.Edges.Article = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (arq *ArticleReviewQuery) loadArticle(ctx context.Context, query *ArticleQuery, nodes []*ArticleReview, init func(*ArticleReview), assign func(*ArticleReview, *Article)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ArticleReview)
+	for i := range nodes {
+		if nodes[i].article_reviews == nil {
+			continue
+		}
+		fk := *nodes[i].article_reviews
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(article.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "article_reviews" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (arq *ArticleReviewQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := arq.querySpec()
+	if len(arq.modifiers) > 0 {
+		_spec.Modifiers = arq.modifiers
+	}
+	_spec.Node.Columns = arq.ctx.Fields
+	if len(arq.ctx.Fields) > 0 {
+		_spec.Unique = arq.ctx.Unique != nil && *arq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, arq.driver, _spec)
+}
+
+func (arq *ArticleReviewQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(articlereview.Table, articlereview.Columns, sqlgraph.NewFieldSpec(articlereview.FieldID, field.TypeInt))
+	_spec.From = arq.sql
+	if unique := arq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if arq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := arq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, articlereview.FieldID)
+		for i := range fields {
+			if fields[i] != articlereview.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := arq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := arq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := arq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := arq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (arq *ArticleReviewQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(arq.driver.Dialect())
+	t1 := builder.Table(articlereview.Table)
+	columns := arq.ctx.Fields
+	if len(columns) == 0 {
+		columns = articlereview.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if arq.sql != nil {
+		selector = arq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if arq.ctx.Unique != nil && *arq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range arq.modifiers {
+		m(selector)
+	}
+	for _, p := range arq.predicates {
+		p(selector)
+	}
+	for _, p := range arq.order {
+		p(selector)
+	}
+	if offset := arq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := arq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (arq *ArticleReviewQuery) ForUpdate(opts ...sql.LockOption) *ArticleReviewQuery {
+	if arq.driver.Dialect() == dialect.Postgres {
+		arq.Unique(false)
+	}
+	arq.modifiers = append(arq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return arq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (arq *ArticleReviewQuery) ForShare(opts ...sql.LockOption) *ArticleReviewQuery {
+	if arq.driver.Dialect() == dialect.Postgres {
+		arq.Unique(false)
+	}
+	arq.modifiers = append(arq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return arq
+}
+
+// ArticleReviewGroupBy is the group-by builder for ArticleReview entities.
+type ArticleReviewGroupBy struct {
+	selector
+	build *ArticleReviewQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (argb *ArticleReviewGroupBy) Aggregate(fns ...AggregateFunc) *ArticleReviewGroupBy {
+	argb.fns = append(argb.fns, fns...)
+	return argb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (argb *ArticleReviewGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, argb.build.ctx, ent.OpQueryGroupBy)
+	if err := argb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ArticleReviewQuery, *ArticleReviewGroupBy](ctx, argb.build, argb, argb.build.inters, v)
+}
+
+func (argb *ArticleReviewGroupBy) sqlScan(ctx context.Context, root *ArticleReviewQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(argb.fns))
+	for _, fn := range argb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*argb.flds)+len(argb.fns))
+		for _, f := range *argb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*argb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := argb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ArticleReviewSelect is the builder for selecting fields of ArticleReview entities.
+type ArticleReviewSelect struct {
+	*ArticleReviewQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ars *ArticleReviewSelect) Aggregate(fns ...AggregateFunc) *ArticleReviewSelect {
+	ars.fns = append(ars.fns, fns...)
+	return ars
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ars *ArticleReviewSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ars.ctx, ent.OpQuerySelect)
+	if err := ars.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ArticleReviewQuery, *ArticleReviewSelect](ctx, ars.ArticleReviewQuery, ars, ars.inters, v)
+}
+
+func (ars *ArticleReviewSelect) sqlScan(ctx context.Context, root *ArticleReviewQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ars.fns))
+	for _, fn := range ars.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ars.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ars.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,296 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"goblog/ent/article"
+	"goblog/ent/articlereview"
+	"goblog/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ArticleReviewUpdate is the builder for updating ArticleReview entities.
+type ArticleReviewUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ArticleReviewMutation
+}
+
+// Where appends a list predicates to the ArticleReviewUpdate builder.
+func (aru *ArticleReviewUpdate) Where(ps ...predicate.ArticleReview) *ArticleReviewUpdate {
+	aru.mutation.Where(ps...)
+	return aru
+}
+
+// SetArticleID sets the "article" edge to the Article entity by ID.
+func (aru *ArticleReviewUpdate) SetArticleID(id int) *ArticleReviewUpdate {
+	aru.mutation.SetArticleID(id)
+	return aru
+}
+
+// SetArticle sets the "article" edge to the Article entity.
+func (aru *ArticleReviewUpdate) SetArticle(a *Article) *ArticleReviewUpdate {
+	return aru.SetArticleID(a.ID)
+}
+
+// Mutation returns the ArticleReviewMutation object of the builder.
+func (aru *ArticleReviewUpdate) Mutation() *ArticleReviewMutation {
+	return aru.mutation
+}
+
+// ClearArticle clears the "article" edge to the Article entity.
+func (aru *ArticleReviewUpdate) ClearArticle() *ArticleReviewUpdate {
+	aru.mutation.ClearArticle()
+	return aru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (aru *ArticleReviewUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, aru.sqlSave, aru.mutation, aru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aru *ArticleReviewUpdate) SaveX(ctx context.Context) int {
+	affected, err := aru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (aru *ArticleReviewUpdate) Exec(ctx context.Context) error {
+	_, err := aru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aru *ArticleReviewUpdate) ExecX(ctx context.Context) {
+	if err := aru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aru *ArticleReviewUpdate) check() error {
+	if aru.mutation.ArticleCleared() && len(aru.mutation.ArticleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ArticleReview.article"`)
+	}
+	return nil
+}
+
+func (aru *ArticleReviewUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := aru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(articlereview.Table, articlereview.Columns, sqlgraph.NewFieldSpec(articlereview.FieldID, field.TypeInt))
+	if ps := aru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if aru.mutation.NoteCleared() {
+		_spec.ClearField(articlereview.FieldNote, field.TypeString)
+	}
+	if aru.mutation.ArticleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   articlereview.ArticleTable,
+			Columns: []string{articlereview.ArticleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aru.mutation.ArticleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   articlereview.ArticleTable,
+			Columns: []string{articlereview.ArticleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, aru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{articlereview.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	aru.mutation.done = true
+	return n, nil
+}
+
+// ArticleReviewUpdateOne is the builder for updating a single ArticleReview entity.
+type ArticleReviewUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ArticleReviewMutation
+}
+
+// SetArticleID sets the "article" edge to the Article entity by ID.
+func (aruo *ArticleReviewUpdateOne) SetArticleID(id int) *ArticleReviewUpdateOne {
+	aruo.mutation.SetArticleID(id)
+	return aruo
+}
+
+// SetArticle sets the "article" edge to the Article entity.
+func (aruo *ArticleReviewUpdateOne) SetArticle(a *Article) *ArticleReviewUpdateOne {
+	return aruo.SetArticleID(a.ID)
+}
+
+// Mutation returns the ArticleReviewMutation object of the builder.
+func (aruo *ArticleReviewUpdateOne) Mutation() *ArticleReviewMutation {
+	return aruo.mutation
+}
+
+// ClearArticle clears the "article" edge to the Article entity.
+func (aruo *ArticleReviewUpdateOne) ClearArticle() *ArticleReviewUpdateOne {
+	aruo.mutation.ClearArticle()
+	return aruo
+}
+
+// Where appends a list predicates to the ArticleReviewUpdate builder.
+func (aruo *ArticleReviewUpdateOne) Where(ps ...predicate.ArticleReview) *ArticleReviewUpdateOne {
+	aruo.mutation.Where(ps...)
+	return aruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aruo *ArticleReviewUpdateOne) Select(field string, fields ...string) *ArticleReviewUpdateOne {
+	aruo.fields = append([]string{field}, fields...)
+	return aruo
+}
+
+// Save executes the query and returns the updated ArticleReview entity.
+func (aruo *ArticleReviewUpdateOne) Save(ctx context.Context) (*ArticleReview, error) {
+	return withHooks(ctx, aruo.sqlSave, aruo.mutation, aruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aruo *ArticleReviewUpdateOne) SaveX(ctx context.Context) *ArticleReview {
+	node, err := aruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aruo *ArticleReviewUpdateOne) Exec(ctx context.Context) error {
+	_, err := aruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aruo *ArticleReviewUpdateOne) ExecX(ctx context.Context) {
+	if err := aruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aruo *ArticleReviewUpdateOne) check() error {
+	if aruo.mutation.ArticleCleared() && len(aruo.mutation.ArticleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ArticleReview.article"`)
+	}
+	return nil
+}
+
+func (aruo *ArticleReviewUpdateOne) sqlSave(ctx context.Context) (_node *ArticleReview, err error) {
+	if err := aruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(articlereview.Table, articlereview.Columns, sqlgraph.NewFieldSpec(articlereview.FieldID, field.TypeInt))
+	id, ok := aruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ArticleReview.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, articlereview.FieldID)
+		for _, f := range fields {
+			if !articlereview.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != articlereview.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if aruo.mutation.NoteCleared() {
+		_spec.ClearField(articlereview.FieldNote, field.TypeString)
+	}
+	if aruo.mutation.ArticleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   articlereview.ArticleTable,
+			Columns: []string{articlereview.ArticleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aruo.mutation.ArticleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   articlereview.ArticleTable,
+			Columns: []string{articlereview.ArticleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ArticleReview{config: aruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{articlereview.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aruo.mutation.done = true
+	return _node, nil
+}
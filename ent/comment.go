@@ -0,0 +1,264 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"goblog/ent/article"
+	"goblog/ent/comment"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Comment is the model entity for the Comment schema.
+type Comment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 评论者昵称
+	AuthorName string `json:"author_name,omitempty"`
+	// 评论者邮箱
+	AuthorEmail string `json:"author_email,omitempty"`
+	// 评论内容
+	Content string `json:"content,omitempty"`
+	// 审核状态
+	Status comment.Status `json:"status,omitempty"`
+	// 是否已经过人工审核
+	Moderated bool `json:"moderated,omitempty"`
+	// 垃圾评论评分(0-1)
+	SpamScore float64 `json:"spam_score,omitempty"`
+	// 垃圾评论评分依据
+	SpamReasons []string `json:"spam_reasons,omitempty"`
+	// 评论者IP
+	IP string `json:"ip,omitempty"`
+	// 评论者User-Agent
+	UserAgent string `json:"user_agent,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CommentQuery when eager-loading is set.
+	Edges            CommentEdges `json:"edges"`
+	article_comments *int
+	selectValues     sql.SelectValues
+}
+
+// CommentEdges holds the relations/edges for other nodes in the graph.
+type CommentEdges struct {
+	// Article holds the value of the article edge.
+	Article *Article `json:"article,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ArticleOrErr returns the Article value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CommentEdges) ArticleOrErr() (*Article, error) {
+	if e.Article != nil {
+		return e.Article, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: article.Label}
+	}
+	return nil, &NotLoadedError{edge: "article"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Comment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case comment.FieldSpamReasons:
+			values[i] = new([]byte)
+		case comment.FieldModerated:
+			values[i] = new(sql.NullBool)
+		case comment.FieldSpamScore:
+			values[i] = new(sql.NullFloat64)
+		case comment.FieldID:
+			values[i] = new(sql.NullInt64)
+		case comment.FieldAuthorName, comment.FieldAuthorEmail, comment.FieldContent, comment.FieldStatus, comment.FieldIP, comment.FieldUserAgent:
+			values[i] = new(sql.NullString)
+		case comment.FieldCreatedAt, comment.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case comment.ForeignKeys[0]: // article_comments
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Comment fields.
+func (c *Comment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case comment.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			c.ID = int(value.Int64)
+		case comment.FieldAuthorName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author_name", values[i])
+			} else if value.Valid {
+				c.AuthorName = value.String
+			}
+		case comment.FieldAuthorEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author_email", values[i])
+			} else if value.Valid {
+				c.AuthorEmail = value.String
+			}
+		case comment.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				c.Content = value.String
+			}
+		case comment.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				c.Status = comment.Status(value.String)
+			}
+		case comment.FieldModerated:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field moderated", values[i])
+			} else if value.Valid {
+				c.Moderated = value.Bool
+			}
+		case comment.FieldSpamScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field spam_score", values[i])
+			} else if value.Valid {
+				c.SpamScore = value.Float64
+			}
+		case comment.FieldSpamReasons:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field spam_reasons", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &c.SpamReasons); err != nil {
+					return fmt.Errorf("unmarshal field spam_reasons: %w", err)
+				}
+			}
+		case comment.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				c.IP = value.String
+			}
+		case comment.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				c.UserAgent = value.String
+			}
+		case comment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				c.CreatedAt = value.Time
+			}
+		case comment.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				c.UpdatedAt = value.Time
+			}
+		case comment.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field article_comments", value)
+			} else if value.Valid {
+				c.article_comments = new(int)
+				*c.article_comments = int(value.Int64)
+			}
+		default:
+			c.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Comment.
+// This includes values selected through modifiers, order, etc.
+func (c *Comment) Value(name string) (ent.Value, error) {
+	return c.selectValues.Get(name)
+}
+
+// QueryArticle queries the "article" edge of the Comment entity.
+func (c *Comment) QueryArticle() *ArticleQuery {
+	return NewCommentClient(c.config).QueryArticle(c)
+}
+
+// Update returns a builder for updating this Comment.
+// Note that you need to call Comment.Unwrap() before calling this method if this Comment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Comment) Update() *CommentUpdateOne {
+	return NewCommentClient(c.config).UpdateOne(c)
+}
+
+// Unwrap unwraps the Comment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (c *Comment) Unwrap() *Comment {
+	_tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Comment is not a transactional entity")
+	}
+	c.config.driver = _tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Comment) String() string {
+	var builder strings.Builder
+	builder.WriteString("Comment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	builder.WriteString("author_name=")
+	builder.WriteString(c.AuthorName)
+	builder.WriteString(", ")
+	builder.WriteString("author_email=")
+	builder.WriteString(c.AuthorEmail)
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(c.Content)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", c.Status))
+	builder.WriteString(", ")
+	builder.WriteString("moderated=")
+	builder.WriteString(fmt.Sprintf("%v", c.Moderated))
+	builder.WriteString(", ")
+	builder.WriteString("spam_score=")
+	builder.WriteString(fmt.Sprintf("%v", c.SpamScore))
+	builder.WriteString(", ")
+	builder.WriteString("spam_reasons=")
+	builder.WriteString(fmt.Sprintf("%v", c.SpamReasons))
+	builder.WriteString(", ")
+	builder.WriteString("ip=")
+	builder.WriteString(c.IP)
+	builder.WriteString(", ")
+	builder.WriteString("user_agent=")
+	builder.WriteString(c.UserAgent)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(c.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Comments is a parsable slice of Comment.
+type Comments []*Comment
@@ -0,0 +1,1210 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"goblog/ent/article"
+	"goblog/ent/comment"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CommentCreate is the builder for creating a Comment entity.
+type CommentCreate struct {
+	config
+	mutation *CommentMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetAuthorName sets the "author_name" field.
+func (cc *CommentCreate) SetAuthorName(s string) *CommentCreate {
+	cc.mutation.SetAuthorName(s)
+	return cc
+}
+
+// SetAuthorEmail sets the "author_email" field.
+func (cc *CommentCreate) SetAuthorEmail(s string) *CommentCreate {
+	cc.mutation.SetAuthorEmail(s)
+	return cc
+}
+
+// SetNillableAuthorEmail sets the "author_email" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableAuthorEmail(s *string) *CommentCreate {
+	if s != nil {
+		cc.SetAuthorEmail(*s)
+	}
+	return cc
+}
+
+// SetContent sets the "content" field.
+func (cc *CommentCreate) SetContent(s string) *CommentCreate {
+	cc.mutation.SetContent(s)
+	return cc
+}
+
+// SetStatus sets the "status" field.
+func (cc *CommentCreate) SetStatus(c comment.Status) *CommentCreate {
+	cc.mutation.SetStatus(c)
+	return cc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableStatus(c *comment.Status) *CommentCreate {
+	if c != nil {
+		cc.SetStatus(*c)
+	}
+	return cc
+}
+
+// SetModerated sets the "moderated" field.
+func (cc *CommentCreate) SetModerated(b bool) *CommentCreate {
+	cc.mutation.SetModerated(b)
+	return cc
+}
+
+// SetNillableModerated sets the "moderated" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableModerated(b *bool) *CommentCreate {
+	if b != nil {
+		cc.SetModerated(*b)
+	}
+	return cc
+}
+
+// SetSpamScore sets the "spam_score" field.
+func (cc *CommentCreate) SetSpamScore(f float64) *CommentCreate {
+	cc.mutation.SetSpamScore(f)
+	return cc
+}
+
+// SetNillableSpamScore sets the "spam_score" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableSpamScore(f *float64) *CommentCreate {
+	if f != nil {
+		cc.SetSpamScore(*f)
+	}
+	return cc
+}
+
+// SetSpamReasons sets the "spam_reasons" field.
+func (cc *CommentCreate) SetSpamReasons(s []string) *CommentCreate {
+	cc.mutation.SetSpamReasons(s)
+	return cc
+}
+
+// SetIP sets the "ip" field.
+func (cc *CommentCreate) SetIP(s string) *CommentCreate {
+	cc.mutation.SetIP(s)
+	return cc
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableIP(s *string) *CommentCreate {
+	if s != nil {
+		cc.SetIP(*s)
+	}
+	return cc
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (cc *CommentCreate) SetUserAgent(s string) *CommentCreate {
+	cc.mutation.SetUserAgent(s)
+	return cc
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableUserAgent(s *string) *CommentCreate {
+	if s != nil {
+		cc.SetUserAgent(*s)
+	}
+	return cc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cc *CommentCreate) SetCreatedAt(t time.Time) *CommentCreate {
+	cc.mutation.SetCreatedAt(t)
+	return cc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableCreatedAt(t *time.Time) *CommentCreate {
+	if t != nil {
+		cc.SetCreatedAt(*t)
+	}
+	return cc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cc *CommentCreate) SetUpdatedAt(t time.Time) *CommentCreate {
+	cc.mutation.SetUpdatedAt(t)
+	return cc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableUpdatedAt(t *time.Time) *CommentCreate {
+	if t != nil {
+		cc.SetUpdatedAt(*t)
+	}
+	return cc
+}
+
+// SetArticleID sets the "article" edge to the Article entity by ID.
+func (cc *CommentCreate) SetArticleID(id int) *CommentCreate {
+	cc.mutation.SetArticleID(id)
+	return cc
+}
+
+// SetArticle sets the "article" edge to the Article entity.
+func (cc *CommentCreate) SetArticle(a *Article) *CommentCreate {
+	return cc.SetArticleID(a.ID)
+}
+
+// Mutation returns the CommentMutation object of the builder.
+func (cc *CommentCreate) Mutation() *CommentMutation {
+	return cc.mutation
+}
+
+// Save creates the Comment in the database.
+func (cc *CommentCreate) Save(ctx context.Context) (*Comment, error) {
+	cc.defaults()
+	return withHooks(ctx, cc.sqlSave, cc.mutation, cc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cc *CommentCreate) SaveX(ctx context.Context) *Comment {
+	v, err := cc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cc *CommentCreate) Exec(ctx context.Context) error {
+	_, err := cc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cc *CommentCreate) ExecX(ctx context.Context) {
+	if err := cc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cc *CommentCreate) defaults() {
+	if _, ok := cc.mutation.Status(); !ok {
+		v := comment.DefaultStatus
+		cc.mutation.SetStatus(v)
+	}
+	if _, ok := cc.mutation.Moderated(); !ok {
+		v := comment.DefaultModerated
+		cc.mutation.SetModerated(v)
+	}
+	if _, ok := cc.mutation.SpamScore(); !ok {
+		v := comment.DefaultSpamScore
+		cc.mutation.SetSpamScore(v)
+	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		v := comment.DefaultCreatedAt()
+		cc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := cc.mutation.UpdatedAt(); !ok {
+		v := comment.DefaultUpdatedAt()
+		cc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cc *CommentCreate) check() error {
+	if _, ok := cc.mutation.AuthorName(); !ok {
+		return &ValidationError{Name: "author_name", err: errors.New(`ent: missing required field "Comment.author_name"`)}
+	}
+	if v, ok := cc.mutation.AuthorName(); ok {
+		if err := comment.AuthorNameValidator(v); err != nil {
+			return &ValidationError{Name: "author_name", err: fmt.Errorf(`ent: validator failed for field "Comment.author_name": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "Comment.content"`)}
+	}
+	if v, ok := cc.mutation.Content(); ok {
+		if err := comment.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "Comment.content": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Comment.status"`)}
+	}
+	if v, ok := cc.mutation.Status(); ok {
+		if err := comment.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Comment.status": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.Moderated(); !ok {
+		return &ValidationError{Name: "moderated", err: errors.New(`ent: missing required field "Comment.moderated"`)}
+	}
+	if _, ok := cc.mutation.SpamScore(); !ok {
+		return &ValidationError{Name: "spam_score", err: errors.New(`ent: missing required field "Comment.spam_score"`)}
+	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Comment.created_at"`)}
+	}
+	if _, ok := cc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Comment.updated_at"`)}
+	}
+	if len(cc.mutation.ArticleIDs()) == 0 {
+		return &ValidationError{Name: "article", err: errors.New(`ent: missing required edge "Comment.article"`)}
+	}
+	return nil
+}
+
+func (cc *CommentCreate) sqlSave(ctx context.Context) (*Comment, error) {
+	if err := cc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	cc.mutation.id = &_node.ID
+	cc.mutation.done = true
+	return _node, nil
+}
+
+func (cc *CommentCreate) createSpec() (*Comment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Comment{config: cc.config}
+		_spec = sqlgraph.NewCreateSpec(comment.Table, sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = cc.conflict
+	if value, ok := cc.mutation.AuthorName(); ok {
+		_spec.SetField(comment.FieldAuthorName, field.TypeString, value)
+		_node.AuthorName = value
+	}
+	if value, ok := cc.mutation.AuthorEmail(); ok {
+		_spec.SetField(comment.FieldAuthorEmail, field.TypeString, value)
+		_node.AuthorEmail = value
+	}
+	if value, ok := cc.mutation.Content(); ok {
+		_spec.SetField(comment.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := cc.mutation.Status(); ok {
+		_spec.SetField(comment.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := cc.mutation.Moderated(); ok {
+		_spec.SetField(comment.FieldModerated, field.TypeBool, value)
+		_node.Moderated = value
+	}
+	if value, ok := cc.mutation.SpamScore(); ok {
+		_spec.SetField(comment.FieldSpamScore, field.TypeFloat64, value)
+		_node.SpamScore = value
+	}
+	if value, ok := cc.mutation.SpamReasons(); ok {
+		_spec.SetField(comment.FieldSpamReasons, field.TypeJSON, value)
+		_node.SpamReasons = value
+	}
+	if value, ok := cc.mutation.IP(); ok {
+		_spec.SetField(comment.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := cc.mutation.UserAgent(); ok {
+		_spec.SetField(comment.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := cc.mutation.CreatedAt(); ok {
+		_spec.SetField(comment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := cc.mutation.UpdatedAt(); ok {
+		_spec.SetField(comment.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := cc.mutation.ArticleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.ArticleTable,
+			Columns: []string{comment.ArticleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.article_comments = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Comment.Create().
+//		SetAuthorName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CommentUpsert) {
+//			SetAuthorName(v+v).
+//		}).
+//		Exec(ctx)
+func (cc *CommentCreate) OnConflict(opts ...sql.ConflictOption) *CommentUpsertOne {
+	cc.conflict = opts
+	return &CommentUpsertOne{
+		create: cc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Comment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cc *CommentCreate) OnConflictColumns(columns ...string) *CommentUpsertOne {
+	cc.conflict = append(cc.conflict, sql.ConflictColumns(columns...))
+	return &CommentUpsertOne{
+		create: cc,
+	}
+}
+
+type (
+	// CommentUpsertOne is the builder for "upsert"-ing
+	//  one Comment node.
+	CommentUpsertOne struct {
+		create *CommentCreate
+	}
+
+	// CommentUpsert is the "OnConflict" setter.
+	CommentUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetAuthorName sets the "author_name" field.
+func (u *CommentUpsert) SetAuthorName(v string) *CommentUpsert {
+	u.Set(comment.FieldAuthorName, v)
+	return u
+}
+
+// UpdateAuthorName sets the "author_name" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateAuthorName() *CommentUpsert {
+	u.SetExcluded(comment.FieldAuthorName)
+	return u
+}
+
+// SetAuthorEmail sets the "author_email" field.
+func (u *CommentUpsert) SetAuthorEmail(v string) *CommentUpsert {
+	u.Set(comment.FieldAuthorEmail, v)
+	return u
+}
+
+// UpdateAuthorEmail sets the "author_email" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateAuthorEmail() *CommentUpsert {
+	u.SetExcluded(comment.FieldAuthorEmail)
+	return u
+}
+
+// ClearAuthorEmail clears the value of the "author_email" field.
+func (u *CommentUpsert) ClearAuthorEmail() *CommentUpsert {
+	u.SetNull(comment.FieldAuthorEmail)
+	return u
+}
+
+// SetContent sets the "content" field.
+func (u *CommentUpsert) SetContent(v string) *CommentUpsert {
+	u.Set(comment.FieldContent, v)
+	return u
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateContent() *CommentUpsert {
+	u.SetExcluded(comment.FieldContent)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *CommentUpsert) SetStatus(v comment.Status) *CommentUpsert {
+	u.Set(comment.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateStatus() *CommentUpsert {
+	u.SetExcluded(comment.FieldStatus)
+	return u
+}
+
+// SetModerated sets the "moderated" field.
+func (u *CommentUpsert) SetModerated(v bool) *CommentUpsert {
+	u.Set(comment.FieldModerated, v)
+	return u
+}
+
+// UpdateModerated sets the "moderated" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateModerated() *CommentUpsert {
+	u.SetExcluded(comment.FieldModerated)
+	return u
+}
+
+// SetSpamScore sets the "spam_score" field.
+func (u *CommentUpsert) SetSpamScore(v float64) *CommentUpsert {
+	u.Set(comment.FieldSpamScore, v)
+	return u
+}
+
+// UpdateSpamScore sets the "spam_score" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateSpamScore() *CommentUpsert {
+	u.SetExcluded(comment.FieldSpamScore)
+	return u
+}
+
+// AddSpamScore adds v to the "spam_score" field.
+func (u *CommentUpsert) AddSpamScore(v float64) *CommentUpsert {
+	u.Add(comment.FieldSpamScore, v)
+	return u
+}
+
+// SetSpamReasons sets the "spam_reasons" field.
+func (u *CommentUpsert) SetSpamReasons(v []string) *CommentUpsert {
+	u.Set(comment.FieldSpamReasons, v)
+	return u
+}
+
+// UpdateSpamReasons sets the "spam_reasons" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateSpamReasons() *CommentUpsert {
+	u.SetExcluded(comment.FieldSpamReasons)
+	return u
+}
+
+// ClearSpamReasons clears the value of the "spam_reasons" field.
+func (u *CommentUpsert) ClearSpamReasons() *CommentUpsert {
+	u.SetNull(comment.FieldSpamReasons)
+	return u
+}
+
+// SetIP sets the "ip" field.
+func (u *CommentUpsert) SetIP(v string) *CommentUpsert {
+	u.Set(comment.FieldIP, v)
+	return u
+}
+
+// UpdateIP sets the "ip" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateIP() *CommentUpsert {
+	u.SetExcluded(comment.FieldIP)
+	return u
+}
+
+// ClearIP clears the value of the "ip" field.
+func (u *CommentUpsert) ClearIP() *CommentUpsert {
+	u.SetNull(comment.FieldIP)
+	return u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (u *CommentUpsert) SetUserAgent(v string) *CommentUpsert {
+	u.Set(comment.FieldUserAgent, v)
+	return u
+}
+
+// UpdateUserAgent sets the "user_agent" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateUserAgent() *CommentUpsert {
+	u.SetExcluded(comment.FieldUserAgent)
+	return u
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (u *CommentUpsert) ClearUserAgent() *CommentUpsert {
+	u.SetNull(comment.FieldUserAgent)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *CommentUpsert) SetCreatedAt(v time.Time) *CommentUpsert {
+	u.Set(comment.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateCreatedAt() *CommentUpsert {
+	u.SetExcluded(comment.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CommentUpsert) SetUpdatedAt(v time.Time) *CommentUpsert {
+	u.Set(comment.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateUpdatedAt() *CommentUpsert {
+	u.SetExcluded(comment.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Comment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *CommentUpsertOne) UpdateNewValues() *CommentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Comment.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CommentUpsertOne) Ignore() *CommentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CommentUpsertOne) DoNothing() *CommentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CommentCreate.OnConflict
+// documentation for more info.
+func (u *CommentUpsertOne) Update(set func(*CommentUpsert)) *CommentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CommentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAuthorName sets the "author_name" field.
+func (u *CommentUpsertOne) SetAuthorName(v string) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetAuthorName(v)
+	})
+}
+
+// UpdateAuthorName sets the "author_name" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateAuthorName() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateAuthorName()
+	})
+}
+
+// SetAuthorEmail sets the "author_email" field.
+func (u *CommentUpsertOne) SetAuthorEmail(v string) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetAuthorEmail(v)
+	})
+}
+
+// UpdateAuthorEmail sets the "author_email" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateAuthorEmail() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateAuthorEmail()
+	})
+}
+
+// ClearAuthorEmail clears the value of the "author_email" field.
+func (u *CommentUpsertOne) ClearAuthorEmail() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearAuthorEmail()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *CommentUpsertOne) SetContent(v string) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateContent() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *CommentUpsertOne) SetStatus(v comment.Status) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateStatus() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetModerated sets the "moderated" field.
+func (u *CommentUpsertOne) SetModerated(v bool) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetModerated(v)
+	})
+}
+
+// UpdateModerated sets the "moderated" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateModerated() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateModerated()
+	})
+}
+
+// SetSpamScore sets the "spam_score" field.
+func (u *CommentUpsertOne) SetSpamScore(v float64) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetSpamScore(v)
+	})
+}
+
+// AddSpamScore adds v to the "spam_score" field.
+func (u *CommentUpsertOne) AddSpamScore(v float64) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.AddSpamScore(v)
+	})
+}
+
+// UpdateSpamScore sets the "spam_score" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateSpamScore() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateSpamScore()
+	})
+}
+
+// SetSpamReasons sets the "spam_reasons" field.
+func (u *CommentUpsertOne) SetSpamReasons(v []string) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetSpamReasons(v)
+	})
+}
+
+// UpdateSpamReasons sets the "spam_reasons" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateSpamReasons() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateSpamReasons()
+	})
+}
+
+// ClearSpamReasons clears the value of the "spam_reasons" field.
+func (u *CommentUpsertOne) ClearSpamReasons() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearSpamReasons()
+	})
+}
+
+// SetIP sets the "ip" field.
+func (u *CommentUpsertOne) SetIP(v string) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetIP(v)
+	})
+}
+
+// UpdateIP sets the "ip" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateIP() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateIP()
+	})
+}
+
+// ClearIP clears the value of the "ip" field.
+func (u *CommentUpsertOne) ClearIP() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearIP()
+	})
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (u *CommentUpsertOne) SetUserAgent(v string) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetUserAgent(v)
+	})
+}
+
+// UpdateUserAgent sets the "user_agent" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateUserAgent() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateUserAgent()
+	})
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (u *CommentUpsertOne) ClearUserAgent() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearUserAgent()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *CommentUpsertOne) SetCreatedAt(v time.Time) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateCreatedAt() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CommentUpsertOne) SetUpdatedAt(v time.Time) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateUpdatedAt() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CommentUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CommentCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CommentUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CommentUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CommentUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CommentCreateBulk is the builder for creating many Comment entities in bulk.
+type CommentCreateBulk struct {
+	config
+	err      error
+	builders []*CommentCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Comment entities in the database.
+func (ccb *CommentCreateBulk) Save(ctx context.Context) ([]*Comment, error) {
+	if ccb.err != nil {
+		return nil, ccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
+	nodes := make([]*Comment, len(ccb.builders))
+	mutators := make([]Mutator, len(ccb.builders))
+	for i := range ccb.builders {
+		func(i int, root context.Context) {
+			builder := ccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CommentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccb *CommentCreateBulk) SaveX(ctx context.Context) []*Comment {
+	v, err := ccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccb *CommentCreateBulk) Exec(ctx context.Context) error {
+	_, err := ccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccb *CommentCreateBulk) ExecX(ctx context.Context) {
+	if err := ccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Comment.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CommentUpsert) {
+//			SetAuthorName(v+v).
+//		}).
+//		Exec(ctx)
+func (ccb *CommentCreateBulk) OnConflict(opts ...sql.ConflictOption) *CommentUpsertBulk {
+	ccb.conflict = opts
+	return &CommentUpsertBulk{
+		create: ccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Comment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ccb *CommentCreateBulk) OnConflictColumns(columns ...string) *CommentUpsertBulk {
+	ccb.conflict = append(ccb.conflict, sql.ConflictColumns(columns...))
+	return &CommentUpsertBulk{
+		create: ccb,
+	}
+}
+
+// CommentUpsertBulk is the builder for "upsert"-ing
+// a bulk of Comment nodes.
+type CommentUpsertBulk struct {
+	create *CommentCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Comment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *CommentUpsertBulk) UpdateNewValues() *CommentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Comment.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CommentUpsertBulk) Ignore() *CommentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CommentUpsertBulk) DoNothing() *CommentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CommentCreateBulk.OnConflict
+// documentation for more info.
+func (u *CommentUpsertBulk) Update(set func(*CommentUpsert)) *CommentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CommentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAuthorName sets the "author_name" field.
+func (u *CommentUpsertBulk) SetAuthorName(v string) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetAuthorName(v)
+	})
+}
+
+// UpdateAuthorName sets the "author_name" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateAuthorName() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateAuthorName()
+	})
+}
+
+// SetAuthorEmail sets the "author_email" field.
+func (u *CommentUpsertBulk) SetAuthorEmail(v string) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetAuthorEmail(v)
+	})
+}
+
+// UpdateAuthorEmail sets the "author_email" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateAuthorEmail() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateAuthorEmail()
+	})
+}
+
+// ClearAuthorEmail clears the value of the "author_email" field.
+func (u *CommentUpsertBulk) ClearAuthorEmail() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearAuthorEmail()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *CommentUpsertBulk) SetContent(v string) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateContent() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *CommentUpsertBulk) SetStatus(v comment.Status) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateStatus() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetModerated sets the "moderated" field.
+func (u *CommentUpsertBulk) SetModerated(v bool) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetModerated(v)
+	})
+}
+
+// UpdateModerated sets the "moderated" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateModerated() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateModerated()
+	})
+}
+
+// SetSpamScore sets the "spam_score" field.
+func (u *CommentUpsertBulk) SetSpamScore(v float64) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetSpamScore(v)
+	})
+}
+
+// AddSpamScore adds v to the "spam_score" field.
+func (u *CommentUpsertBulk) AddSpamScore(v float64) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.AddSpamScore(v)
+	})
+}
+
+// UpdateSpamScore sets the "spam_score" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateSpamScore() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateSpamScore()
+	})
+}
+
+// SetSpamReasons sets the "spam_reasons" field.
+func (u *CommentUpsertBulk) SetSpamReasons(v []string) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetSpamReasons(v)
+	})
+}
+
+// UpdateSpamReasons sets the "spam_reasons" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateSpamReasons() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateSpamReasons()
+	})
+}
+
+// ClearSpamReasons clears the value of the "spam_reasons" field.
+func (u *CommentUpsertBulk) ClearSpamReasons() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearSpamReasons()
+	})
+}
+
+// SetIP sets the "ip" field.
+func (u *CommentUpsertBulk) SetIP(v string) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetIP(v)
+	})
+}
+
+// UpdateIP sets the "ip" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateIP() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateIP()
+	})
+}
+
+// ClearIP clears the value of the "ip" field.
+func (u *CommentUpsertBulk) ClearIP() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearIP()
+	})
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (u *CommentUpsertBulk) SetUserAgent(v string) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetUserAgent(v)
+	})
+}
+
+// UpdateUserAgent sets the "user_agent" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateUserAgent() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateUserAgent()
+	})
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (u *CommentUpsertBulk) ClearUserAgent() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearUserAgent()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *CommentUpsertBulk) SetCreatedAt(v time.Time) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateCreatedAt() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CommentUpsertBulk) SetUpdatedAt(v time.Time) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateUpdatedAt() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CommentUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CommentCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CommentCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CommentUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,796 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"goblog/ent/article"
+	"goblog/ent/comment"
+	"goblog/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// CommentUpdate is the builder for updating Comment entities.
+type CommentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CommentMutation
+}
+
+// Where appends a list predicates to the CommentUpdate builder.
+func (cu *CommentUpdate) Where(ps ...predicate.Comment) *CommentUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetAuthorName sets the "author_name" field.
+func (cu *CommentUpdate) SetAuthorName(s string) *CommentUpdate {
+	cu.mutation.SetAuthorName(s)
+	return cu
+}
+
+// SetNillableAuthorName sets the "author_name" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableAuthorName(s *string) *CommentUpdate {
+	if s != nil {
+		cu.SetAuthorName(*s)
+	}
+	return cu
+}
+
+// SetAuthorEmail sets the "author_email" field.
+func (cu *CommentUpdate) SetAuthorEmail(s string) *CommentUpdate {
+	cu.mutation.SetAuthorEmail(s)
+	return cu
+}
+
+// SetNillableAuthorEmail sets the "author_email" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableAuthorEmail(s *string) *CommentUpdate {
+	if s != nil {
+		cu.SetAuthorEmail(*s)
+	}
+	return cu
+}
+
+// ClearAuthorEmail clears the value of the "author_email" field.
+func (cu *CommentUpdate) ClearAuthorEmail() *CommentUpdate {
+	cu.mutation.ClearAuthorEmail()
+	return cu
+}
+
+// SetContent sets the "content" field.
+func (cu *CommentUpdate) SetContent(s string) *CommentUpdate {
+	cu.mutation.SetContent(s)
+	return cu
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableContent(s *string) *CommentUpdate {
+	if s != nil {
+		cu.SetContent(*s)
+	}
+	return cu
+}
+
+// SetStatus sets the "status" field.
+func (cu *CommentUpdate) SetStatus(c comment.Status) *CommentUpdate {
+	cu.mutation.SetStatus(c)
+	return cu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableStatus(c *comment.Status) *CommentUpdate {
+	if c != nil {
+		cu.SetStatus(*c)
+	}
+	return cu
+}
+
+// SetModerated sets the "moderated" field.
+func (cu *CommentUpdate) SetModerated(b bool) *CommentUpdate {
+	cu.mutation.SetModerated(b)
+	return cu
+}
+
+// SetNillableModerated sets the "moderated" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableModerated(b *bool) *CommentUpdate {
+	if b != nil {
+		cu.SetModerated(*b)
+	}
+	return cu
+}
+
+// SetSpamScore sets the "spam_score" field.
+func (cu *CommentUpdate) SetSpamScore(f float64) *CommentUpdate {
+	cu.mutation.ResetSpamScore()
+	cu.mutation.SetSpamScore(f)
+	return cu
+}
+
+// SetNillableSpamScore sets the "spam_score" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableSpamScore(f *float64) *CommentUpdate {
+	if f != nil {
+		cu.SetSpamScore(*f)
+	}
+	return cu
+}
+
+// AddSpamScore adds f to the "spam_score" field.
+func (cu *CommentUpdate) AddSpamScore(f float64) *CommentUpdate {
+	cu.mutation.AddSpamScore(f)
+	return cu
+}
+
+// SetSpamReasons sets the "spam_reasons" field.
+func (cu *CommentUpdate) SetSpamReasons(s []string) *CommentUpdate {
+	cu.mutation.SetSpamReasons(s)
+	return cu
+}
+
+// AppendSpamReasons appends s to the "spam_reasons" field.
+func (cu *CommentUpdate) AppendSpamReasons(s []string) *CommentUpdate {
+	cu.mutation.AppendSpamReasons(s)
+	return cu
+}
+
+// ClearSpamReasons clears the value of the "spam_reasons" field.
+func (cu *CommentUpdate) ClearSpamReasons() *CommentUpdate {
+	cu.mutation.ClearSpamReasons()
+	return cu
+}
+
+// SetIP sets the "ip" field.
+func (cu *CommentUpdate) SetIP(s string) *CommentUpdate {
+	cu.mutation.SetIP(s)
+	return cu
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableIP(s *string) *CommentUpdate {
+	if s != nil {
+		cu.SetIP(*s)
+	}
+	return cu
+}
+
+// ClearIP clears the value of the "ip" field.
+func (cu *CommentUpdate) ClearIP() *CommentUpdate {
+	cu.mutation.ClearIP()
+	return cu
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (cu *CommentUpdate) SetUserAgent(s string) *CommentUpdate {
+	cu.mutation.SetUserAgent(s)
+	return cu
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableUserAgent(s *string) *CommentUpdate {
+	if s != nil {
+		cu.SetUserAgent(*s)
+	}
+	return cu
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (cu *CommentUpdate) ClearUserAgent() *CommentUpdate {
+	cu.mutation.ClearUserAgent()
+	return cu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cu *CommentUpdate) SetCreatedAt(t time.Time) *CommentUpdate {
+	cu.mutation.SetCreatedAt(t)
+	return cu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableCreatedAt(t *time.Time) *CommentUpdate {
+	if t != nil {
+		cu.SetCreatedAt(*t)
+	}
+	return cu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cu *CommentUpdate) SetUpdatedAt(t time.Time) *CommentUpdate {
+	cu.mutation.SetUpdatedAt(t)
+	return cu
+}
+
+// SetArticleID sets the "article" edge to the Article entity by ID.
+func (cu *CommentUpdate) SetArticleID(id int) *CommentUpdate {
+	cu.mutation.SetArticleID(id)
+	return cu
+}
+
+// SetArticle sets the "article" edge to the Article entity.
+func (cu *CommentUpdate) SetArticle(a *Article) *CommentUpdate {
+	return cu.SetArticleID(a.ID)
+}
+
+// Mutation returns the CommentMutation object of the builder.
+func (cu *CommentUpdate) Mutation() *CommentMutation {
+	return cu.mutation
+}
+
+// ClearArticle clears the "article" edge to the Article entity.
+func (cu *CommentUpdate) ClearArticle() *CommentUpdate {
+	cu.mutation.ClearArticle()
+	return cu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *CommentUpdate) Save(ctx context.Context) (int, error) {
+	cu.defaults()
+	return withHooks(ctx, cu.sqlSave, cu.mutation, cu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *CommentUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *CommentUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *CommentUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cu *CommentUpdate) defaults() {
+	if _, ok := cu.mutation.UpdatedAt(); !ok {
+		v := comment.UpdateDefaultUpdatedAt()
+		cu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cu *CommentUpdate) check() error {
+	if v, ok := cu.mutation.AuthorName(); ok {
+		if err := comment.AuthorNameValidator(v); err != nil {
+			return &ValidationError{Name: "author_name", err: fmt.Errorf(`ent: validator failed for field "Comment.author_name": %w`, err)}
+		}
+	}
+	if v, ok := cu.mutation.Content(); ok {
+		if err := comment.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "Comment.content": %w`, err)}
+		}
+	}
+	if v, ok := cu.mutation.Status(); ok {
+		if err := comment.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Comment.status": %w`, err)}
+		}
+	}
+	if cu.mutation.ArticleCleared() && len(cu.mutation.ArticleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Comment.article"`)
+	}
+	return nil
+}
+
+func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(comment.Table, comment.Columns, sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt))
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.AuthorName(); ok {
+		_spec.SetField(comment.FieldAuthorName, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.AuthorEmail(); ok {
+		_spec.SetField(comment.FieldAuthorEmail, field.TypeString, value)
+	}
+	if cu.mutation.AuthorEmailCleared() {
+		_spec.ClearField(comment.FieldAuthorEmail, field.TypeString)
+	}
+	if value, ok := cu.mutation.Content(); ok {
+		_spec.SetField(comment.FieldContent, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.Status(); ok {
+		_spec.SetField(comment.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := cu.mutation.Moderated(); ok {
+		_spec.SetField(comment.FieldModerated, field.TypeBool, value)
+	}
+	if value, ok := cu.mutation.SpamScore(); ok {
+		_spec.SetField(comment.FieldSpamScore, field.TypeFloat64, value)
+	}
+	if value, ok := cu.mutation.AddedSpamScore(); ok {
+		_spec.AddField(comment.FieldSpamScore, field.TypeFloat64, value)
+	}
+	if value, ok := cu.mutation.SpamReasons(); ok {
+		_spec.SetField(comment.FieldSpamReasons, field.TypeJSON, value)
+	}
+	if value, ok := cu.mutation.AppendedSpamReasons(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, comment.FieldSpamReasons, value)
+		})
+	}
+	if cu.mutation.SpamReasonsCleared() {
+		_spec.ClearField(comment.FieldSpamReasons, field.TypeJSON)
+	}
+	if value, ok := cu.mutation.IP(); ok {
+		_spec.SetField(comment.FieldIP, field.TypeString, value)
+	}
+	if cu.mutation.IPCleared() {
+		_spec.ClearField(comment.FieldIP, field.TypeString)
+	}
+	if value, ok := cu.mutation.UserAgent(); ok {
+		_spec.SetField(comment.FieldUserAgent, field.TypeString, value)
+	}
+	if cu.mutation.UserAgentCleared() {
+		_spec.ClearField(comment.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := cu.mutation.CreatedAt(); ok {
+		_spec.SetField(comment.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := cu.mutation.UpdatedAt(); ok {
+		_spec.SetField(comment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if cu.mutation.ArticleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.ArticleTable,
+			Columns: []string{comment.ArticleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.ArticleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.ArticleTable,
+			Columns: []string{comment.ArticleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{comment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cu.mutation.done = true
+	return n, nil
+}
+
+// CommentUpdateOne is the builder for updating a single Comment entity.
+type CommentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CommentMutation
+}
+
+// SetAuthorName sets the "author_name" field.
+func (cuo *CommentUpdateOne) SetAuthorName(s string) *CommentUpdateOne {
+	cuo.mutation.SetAuthorName(s)
+	return cuo
+}
+
+// SetNillableAuthorName sets the "author_name" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableAuthorName(s *string) *CommentUpdateOne {
+	if s != nil {
+		cuo.SetAuthorName(*s)
+	}
+	return cuo
+}
+
+// SetAuthorEmail sets the "author_email" field.
+func (cuo *CommentUpdateOne) SetAuthorEmail(s string) *CommentUpdateOne {
+	cuo.mutation.SetAuthorEmail(s)
+	return cuo
+}
+
+// SetNillableAuthorEmail sets the "author_email" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableAuthorEmail(s *string) *CommentUpdateOne {
+	if s != nil {
+		cuo.SetAuthorEmail(*s)
+	}
+	return cuo
+}
+
+// ClearAuthorEmail clears the value of the "author_email" field.
+func (cuo *CommentUpdateOne) ClearAuthorEmail() *CommentUpdateOne {
+	cuo.mutation.ClearAuthorEmail()
+	return cuo
+}
+
+// SetContent sets the "content" field.
+func (cuo *CommentUpdateOne) SetContent(s string) *CommentUpdateOne {
+	cuo.mutation.SetContent(s)
+	return cuo
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableContent(s *string) *CommentUpdateOne {
+	if s != nil {
+		cuo.SetContent(*s)
+	}
+	return cuo
+}
+
+// SetStatus sets the "status" field.
+func (cuo *CommentUpdateOne) SetStatus(c comment.Status) *CommentUpdateOne {
+	cuo.mutation.SetStatus(c)
+	return cuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableStatus(c *comment.Status) *CommentUpdateOne {
+	if c != nil {
+		cuo.SetStatus(*c)
+	}
+	return cuo
+}
+
+// SetModerated sets the "moderated" field.
+func (cuo *CommentUpdateOne) SetModerated(b bool) *CommentUpdateOne {
+	cuo.mutation.SetModerated(b)
+	return cuo
+}
+
+// SetNillableModerated sets the "moderated" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableModerated(b *bool) *CommentUpdateOne {
+	if b != nil {
+		cuo.SetModerated(*b)
+	}
+	return cuo
+}
+
+// SetSpamScore sets the "spam_score" field.
+func (cuo *CommentUpdateOne) SetSpamScore(f float64) *CommentUpdateOne {
+	cuo.mutation.ResetSpamScore()
+	cuo.mutation.SetSpamScore(f)
+	return cuo
+}
+
+// SetNillableSpamScore sets the "spam_score" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableSpamScore(f *float64) *CommentUpdateOne {
+	if f != nil {
+		cuo.SetSpamScore(*f)
+	}
+	return cuo
+}
+
+// AddSpamScore adds f to the "spam_score" field.
+func (cuo *CommentUpdateOne) AddSpamScore(f float64) *CommentUpdateOne {
+	cuo.mutation.AddSpamScore(f)
+	return cuo
+}
+
+// SetSpamReasons sets the "spam_reasons" field.
+func (cuo *CommentUpdateOne) SetSpamReasons(s []string) *CommentUpdateOne {
+	cuo.mutation.SetSpamReasons(s)
+	return cuo
+}
+
+// AppendSpamReasons appends s to the "spam_reasons" field.
+func (cuo *CommentUpdateOne) AppendSpamReasons(s []string) *CommentUpdateOne {
+	cuo.mutation.AppendSpamReasons(s)
+	return cuo
+}
+
+// ClearSpamReasons clears the value of the "spam_reasons" field.
+func (cuo *CommentUpdateOne) ClearSpamReasons() *CommentUpdateOne {
+	cuo.mutation.ClearSpamReasons()
+	return cuo
+}
+
+// SetIP sets the "ip" field.
+func (cuo *CommentUpdateOne) SetIP(s string) *CommentUpdateOne {
+	cuo.mutation.SetIP(s)
+	return cuo
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableIP(s *string) *CommentUpdateOne {
+	if s != nil {
+		cuo.SetIP(*s)
+	}
+	return cuo
+}
+
+// ClearIP clears the value of the "ip" field.
+func (cuo *CommentUpdateOne) ClearIP() *CommentUpdateOne {
+	cuo.mutation.ClearIP()
+	return cuo
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (cuo *CommentUpdateOne) SetUserAgent(s string) *CommentUpdateOne {
+	cuo.mutation.SetUserAgent(s)
+	return cuo
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableUserAgent(s *string) *CommentUpdateOne {
+	if s != nil {
+		cuo.SetUserAgent(*s)
+	}
+	return cuo
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (cuo *CommentUpdateOne) ClearUserAgent() *CommentUpdateOne {
+	cuo.mutation.ClearUserAgent()
+	return cuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cuo *CommentUpdateOne) SetCreatedAt(t time.Time) *CommentUpdateOne {
+	cuo.mutation.SetCreatedAt(t)
+	return cuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableCreatedAt(t *time.Time) *CommentUpdateOne {
+	if t != nil {
+		cuo.SetCreatedAt(*t)
+	}
+	return cuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cuo *CommentUpdateOne) SetUpdatedAt(t time.Time) *CommentUpdateOne {
+	cuo.mutation.SetUpdatedAt(t)
+	return cuo
+}
+
+// SetArticleID sets the "article" edge to the Article entity by ID.
+func (cuo *CommentUpdateOne) SetArticleID(id int) *CommentUpdateOne {
+	cuo.mutation.SetArticleID(id)
+	return cuo
+}
+
+// SetArticle sets the "article" edge to the Article entity.
+func (cuo *CommentUpdateOne) SetArticle(a *Article) *CommentUpdateOne {
+	return cuo.SetArticleID(a.ID)
+}
+
+// Mutation returns the CommentMutation object of the builder.
+func (cuo *CommentUpdateOne) Mutation() *CommentMutation {
+	return cuo.mutation
+}
+
+// ClearArticle clears the "article" edge to the Article entity.
+func (cuo *CommentUpdateOne) ClearArticle() *CommentUpdateOne {
+	cuo.mutation.ClearArticle()
+	return cuo
+}
+
+// Where appends a list predicates to the CommentUpdate builder.
+func (cuo *CommentUpdateOne) Where(ps ...predicate.Comment) *CommentUpdateOne {
+	cuo.mutation.Where(ps...)
+	return cuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *CommentUpdateOne) Select(field string, fields ...string) *CommentUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Comment entity.
+func (cuo *CommentUpdateOne) Save(ctx context.Context) (*Comment, error) {
+	cuo.defaults()
+	return withHooks(ctx, cuo.sqlSave, cuo.mutation, cuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *CommentUpdateOne) SaveX(ctx context.Context) *Comment {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *CommentUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *CommentUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cuo *CommentUpdateOne) defaults() {
+	if _, ok := cuo.mutation.UpdatedAt(); !ok {
+		v := comment.UpdateDefaultUpdatedAt()
+		cuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cuo *CommentUpdateOne) check() error {
+	if v, ok := cuo.mutation.AuthorName(); ok {
+		if err := comment.AuthorNameValidator(v); err != nil {
+			return &ValidationError{Name: "author_name", err: fmt.Errorf(`ent: validator failed for field "Comment.author_name": %w`, err)}
+		}
+	}
+	if v, ok := cuo.mutation.Content(); ok {
+		if err := comment.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "Comment.content": %w`, err)}
+		}
+	}
+	if v, ok := cuo.mutation.Status(); ok {
+		if err := comment.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Comment.status": %w`, err)}
+		}
+	}
+	if cuo.mutation.ArticleCleared() && len(cuo.mutation.ArticleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Comment.article"`)
+	}
+	return nil
+}
+
+func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err error) {
+	if err := cuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(comment.Table, comment.Columns, sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt))
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Comment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, comment.FieldID)
+		for _, f := range fields {
+			if !comment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != comment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.AuthorName(); ok {
+		_spec.SetField(comment.FieldAuthorName, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.AuthorEmail(); ok {
+		_spec.SetField(comment.FieldAuthorEmail, field.TypeString, value)
+	}
+	if cuo.mutation.AuthorEmailCleared() {
+		_spec.ClearField(comment.FieldAuthorEmail, field.TypeString)
+	}
+	if value, ok := cuo.mutation.Content(); ok {
+		_spec.SetField(comment.FieldContent, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.Status(); ok {
+		_spec.SetField(comment.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := cuo.mutation.Moderated(); ok {
+		_spec.SetField(comment.FieldModerated, field.TypeBool, value)
+	}
+	if value, ok := cuo.mutation.SpamScore(); ok {
+		_spec.SetField(comment.FieldSpamScore, field.TypeFloat64, value)
+	}
+	if value, ok := cuo.mutation.AddedSpamScore(); ok {
+		_spec.AddField(comment.FieldSpamScore, field.TypeFloat64, value)
+	}
+	if value, ok := cuo.mutation.SpamReasons(); ok {
+		_spec.SetField(comment.FieldSpamReasons, field.TypeJSON, value)
+	}
+	if value, ok := cuo.mutation.AppendedSpamReasons(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, comment.FieldSpamReasons, value)
+		})
+	}
+	if cuo.mutation.SpamReasonsCleared() {
+		_spec.ClearField(comment.FieldSpamReasons, field.TypeJSON)
+	}
+	if value, ok := cuo.mutation.IP(); ok {
+		_spec.SetField(comment.FieldIP, field.TypeString, value)
+	}
+	if cuo.mutation.IPCleared() {
+		_spec.ClearField(comment.FieldIP, field.TypeString)
+	}
+	if value, ok := cuo.mutation.UserAgent(); ok {
+		_spec.SetField(comment.FieldUserAgent, field.TypeString, value)
+	}
+	if cuo.mutation.UserAgentCleared() {
+		_spec.ClearField(comment.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := cuo.mutation.CreatedAt(); ok {
+		_spec.SetField(comment.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := cuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(comment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if cuo.mutation.ArticleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.ArticleTable,
+			Columns: []string{comment.ArticleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.ArticleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.ArticleTable,
+			Columns: []string{comment.ArticleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Comment{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{comment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cuo.mutation.done = true
+	return _node, nil
+}
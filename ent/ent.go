@@ -6,9 +6,26 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"goblog/ent/apikey"
 	"goblog/ent/article"
+	"goblog/ent/articlelock"
+	"goblog/ent/articlereview"
+	"goblog/ent/auditlog"
 	"goblog/ent/category"
+	"goblog/ent/comment"
+	"goblog/ent/loginattempt"
+	"goblog/ent/loginthrottle"
+	"goblog/ent/outboxevent"
+	"goblog/ent/recoverycode"
+	"goblog/ent/refreshtoken"
+	"goblog/ent/revokedtoken"
+	"goblog/ent/spamtoken"
 	"goblog/ent/tag"
+	"goblog/ent/tagalias"
+	"goblog/ent/twofactor"
+	"goblog/ent/user"
+	"goblog/ent/webhook"
+	"goblog/ent/webhookdelivery"
 	"reflect"
 	"sync"
 
@@ -75,9 +92,26 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			article.Table:  article.ValidColumn,
-			category.Table: category.ValidColumn,
-			tag.Table:      tag.ValidColumn,
+			apikey.Table:          apikey.ValidColumn,
+			article.Table:         article.ValidColumn,
+			articlelock.Table:     articlelock.ValidColumn,
+			articlereview.Table:   articlereview.ValidColumn,
+			auditlog.Table:        auditlog.ValidColumn,
+			category.Table:        category.ValidColumn,
+			comment.Table:         comment.ValidColumn,
+			loginattempt.Table:    loginattempt.ValidColumn,
+			loginthrottle.Table:   loginthrottle.ValidColumn,
+			outboxevent.Table:     outboxevent.ValidColumn,
+			recoverycode.Table:    recoverycode.ValidColumn,
+			refreshtoken.Table:    refreshtoken.ValidColumn,
+			revokedtoken.Table:    revokedtoken.ValidColumn,
+			spamtoken.Table:       spamtoken.ValidColumn,
+			tag.Table:             tag.ValidColumn,
+			tagalias.Table:        tagalias.ValidColumn,
+			twofactor.Table:       twofactor.ValidColumn,
+			user.Table:            user.ValidColumn,
+			webhook.Table:         webhook.ValidColumn,
+			webhookdelivery.Table: webhookdelivery.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
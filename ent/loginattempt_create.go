@@ -0,0 +1,785 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"goblog/ent/loginattempt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LoginAttemptCreate is the builder for creating a LoginAttempt entity.
+type LoginAttemptCreate struct {
+	config
+	mutation *LoginAttemptMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUsername sets the "username" field.
+func (lac *LoginAttemptCreate) SetUsername(s string) *LoginAttemptCreate {
+	lac.mutation.SetUsername(s)
+	return lac
+}
+
+// SetIP sets the "ip" field.
+func (lac *LoginAttemptCreate) SetIP(s string) *LoginAttemptCreate {
+	lac.mutation.SetIP(s)
+	return lac
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (lac *LoginAttemptCreate) SetNillableIP(s *string) *LoginAttemptCreate {
+	if s != nil {
+		lac.SetIP(*s)
+	}
+	return lac
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (lac *LoginAttemptCreate) SetUserAgent(s string) *LoginAttemptCreate {
+	lac.mutation.SetUserAgent(s)
+	return lac
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (lac *LoginAttemptCreate) SetNillableUserAgent(s *string) *LoginAttemptCreate {
+	if s != nil {
+		lac.SetUserAgent(*s)
+	}
+	return lac
+}
+
+// SetSuccess sets the "success" field.
+func (lac *LoginAttemptCreate) SetSuccess(b bool) *LoginAttemptCreate {
+	lac.mutation.SetSuccess(b)
+	return lac
+}
+
+// SetReason sets the "reason" field.
+func (lac *LoginAttemptCreate) SetReason(s string) *LoginAttemptCreate {
+	lac.mutation.SetReason(s)
+	return lac
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (lac *LoginAttemptCreate) SetNillableReason(s *string) *LoginAttemptCreate {
+	if s != nil {
+		lac.SetReason(*s)
+	}
+	return lac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (lac *LoginAttemptCreate) SetCreatedAt(t time.Time) *LoginAttemptCreate {
+	lac.mutation.SetCreatedAt(t)
+	return lac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (lac *LoginAttemptCreate) SetNillableCreatedAt(t *time.Time) *LoginAttemptCreate {
+	if t != nil {
+		lac.SetCreatedAt(*t)
+	}
+	return lac
+}
+
+// Mutation returns the LoginAttemptMutation object of the builder.
+func (lac *LoginAttemptCreate) Mutation() *LoginAttemptMutation {
+	return lac.mutation
+}
+
+// Save creates the LoginAttempt in the database.
+func (lac *LoginAttemptCreate) Save(ctx context.Context) (*LoginAttempt, error) {
+	lac.defaults()
+	return withHooks(ctx, lac.sqlSave, lac.mutation, lac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lac *LoginAttemptCreate) SaveX(ctx context.Context) *LoginAttempt {
+	v, err := lac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lac *LoginAttemptCreate) Exec(ctx context.Context) error {
+	_, err := lac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lac *LoginAttemptCreate) ExecX(ctx context.Context) {
+	if err := lac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lac *LoginAttemptCreate) defaults() {
+	if _, ok := lac.mutation.CreatedAt(); !ok {
+		v := loginattempt.DefaultCreatedAt()
+		lac.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lac *LoginAttemptCreate) check() error {
+	if _, ok := lac.mutation.Username(); !ok {
+		return &ValidationError{Name: "username", err: errors.New(`ent: missing required field "LoginAttempt.username"`)}
+	}
+	if _, ok := lac.mutation.Success(); !ok {
+		return &ValidationError{Name: "success", err: errors.New(`ent: missing required field "LoginAttempt.success"`)}
+	}
+	if _, ok := lac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LoginAttempt.created_at"`)}
+	}
+	return nil
+}
+
+func (lac *LoginAttemptCreate) sqlSave(ctx context.Context) (*LoginAttempt, error) {
+	if err := lac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	lac.mutation.id = &_node.ID
+	lac.mutation.done = true
+	return _node, nil
+}
+
+func (lac *LoginAttemptCreate) createSpec() (*LoginAttempt, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LoginAttempt{config: lac.config}
+		_spec = sqlgraph.NewCreateSpec(loginattempt.Table, sqlgraph.NewFieldSpec(loginattempt.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = lac.conflict
+	if value, ok := lac.mutation.Username(); ok {
+		_spec.SetField(loginattempt.FieldUsername, field.TypeString, value)
+		_node.Username = value
+	}
+	if value, ok := lac.mutation.IP(); ok {
+		_spec.SetField(loginattempt.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := lac.mutation.UserAgent(); ok {
+		_spec.SetField(loginattempt.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := lac.mutation.Success(); ok {
+		_spec.SetField(loginattempt.FieldSuccess, field.TypeBool, value)
+		_node.Success = value
+	}
+	if value, ok := lac.mutation.Reason(); ok {
+		_spec.SetField(loginattempt.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := lac.mutation.CreatedAt(); ok {
+		_spec.SetField(loginattempt.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LoginAttempt.Create().
+//		SetUsername(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LoginAttemptUpsert) {
+//			SetUsername(v+v).
+//		}).
+//		Exec(ctx)
+func (lac *LoginAttemptCreate) OnConflict(opts ...sql.ConflictOption) *LoginAttemptUpsertOne {
+	lac.conflict = opts
+	return &LoginAttemptUpsertOne{
+		create: lac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LoginAttempt.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (lac *LoginAttemptCreate) OnConflictColumns(columns ...string) *LoginAttemptUpsertOne {
+	lac.conflict = append(lac.conflict, sql.ConflictColumns(columns...))
+	return &LoginAttemptUpsertOne{
+		create: lac,
+	}
+}
+
+type (
+	// LoginAttemptUpsertOne is the builder for "upsert"-ing
+	//  one LoginAttempt node.
+	LoginAttemptUpsertOne struct {
+		create *LoginAttemptCreate
+	}
+
+	// LoginAttemptUpsert is the "OnConflict" setter.
+	LoginAttemptUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUsername sets the "username" field.
+func (u *LoginAttemptUpsert) SetUsername(v string) *LoginAttemptUpsert {
+	u.Set(loginattempt.FieldUsername, v)
+	return u
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *LoginAttemptUpsert) UpdateUsername() *LoginAttemptUpsert {
+	u.SetExcluded(loginattempt.FieldUsername)
+	return u
+}
+
+// SetIP sets the "ip" field.
+func (u *LoginAttemptUpsert) SetIP(v string) *LoginAttemptUpsert {
+	u.Set(loginattempt.FieldIP, v)
+	return u
+}
+
+// UpdateIP sets the "ip" field to the value that was provided on create.
+func (u *LoginAttemptUpsert) UpdateIP() *LoginAttemptUpsert {
+	u.SetExcluded(loginattempt.FieldIP)
+	return u
+}
+
+// ClearIP clears the value of the "ip" field.
+func (u *LoginAttemptUpsert) ClearIP() *LoginAttemptUpsert {
+	u.SetNull(loginattempt.FieldIP)
+	return u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (u *LoginAttemptUpsert) SetUserAgent(v string) *LoginAttemptUpsert {
+	u.Set(loginattempt.FieldUserAgent, v)
+	return u
+}
+
+// UpdateUserAgent sets the "user_agent" field to the value that was provided on create.
+func (u *LoginAttemptUpsert) UpdateUserAgent() *LoginAttemptUpsert {
+	u.SetExcluded(loginattempt.FieldUserAgent)
+	return u
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (u *LoginAttemptUpsert) ClearUserAgent() *LoginAttemptUpsert {
+	u.SetNull(loginattempt.FieldUserAgent)
+	return u
+}
+
+// SetSuccess sets the "success" field.
+func (u *LoginAttemptUpsert) SetSuccess(v bool) *LoginAttemptUpsert {
+	u.Set(loginattempt.FieldSuccess, v)
+	return u
+}
+
+// UpdateSuccess sets the "success" field to the value that was provided on create.
+func (u *LoginAttemptUpsert) UpdateSuccess() *LoginAttemptUpsert {
+	u.SetExcluded(loginattempt.FieldSuccess)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *LoginAttemptUpsert) SetReason(v string) *LoginAttemptUpsert {
+	u.Set(loginattempt.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *LoginAttemptUpsert) UpdateReason() *LoginAttemptUpsert {
+	u.SetExcluded(loginattempt.FieldReason)
+	return u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *LoginAttemptUpsert) ClearReason() *LoginAttemptUpsert {
+	u.SetNull(loginattempt.FieldReason)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.LoginAttempt.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *LoginAttemptUpsertOne) UpdateNewValues() *LoginAttemptUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(loginattempt.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LoginAttempt.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LoginAttemptUpsertOne) Ignore() *LoginAttemptUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LoginAttemptUpsertOne) DoNothing() *LoginAttemptUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LoginAttemptCreate.OnConflict
+// documentation for more info.
+func (u *LoginAttemptUpsertOne) Update(set func(*LoginAttemptUpsert)) *LoginAttemptUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LoginAttemptUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUsername sets the "username" field.
+func (u *LoginAttemptUpsertOne) SetUsername(v string) *LoginAttemptUpsertOne {
+	return u.Update(func(s *LoginAttemptUpsert) {
+		s.SetUsername(v)
+	})
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *LoginAttemptUpsertOne) UpdateUsername() *LoginAttemptUpsertOne {
+	return u.Update(func(s *LoginAttemptUpsert) {
+		s.UpdateUsername()
+	})
+}
+
+// SetIP sets the "ip" field.
+func (u *LoginAttemptUpsertOne) SetIP(v string) *LoginAttemptUpsertOne {
+	return u.Update(func(s *LoginAttemptUpsert) {
+		s.SetIP(v)
+	})
+}
+
+// UpdateIP sets the "ip" field to the value that was provided on create.
+func (u *LoginAttemptUpsertOne) UpdateIP() *LoginAttemptUpsertOne {
+	return u.Update(func(s *LoginAttemptUpsert) {
+		s.UpdateIP()
+	})
+}
+
+// ClearIP clears the value of the "ip" field.
+func (u *LoginAttemptUpsertOne) ClearIP() *LoginAttemptUpsertOne {
+	return u.Update(func(s *LoginAttemptUpsert) {
+		s.ClearIP()
+	})
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (u *LoginAttemptUpsertOne) SetUserAgent(v string) *LoginAttemptUpsertOne {
+	return u.Update(func(s *LoginAttemptUpsert) {
+		s.SetUserAgent(v)
+	})
+}
+
+// UpdateUserAgent sets the "user_agent" field to the value that was provided on create.
+func (u *LoginAttemptUpsertOne) UpdateUserAgent() *LoginAttemptUpsertOne {
+	return u.Update(func(s *LoginAttemptUpsert) {
+		s.UpdateUserAgent()
+	})
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (u *LoginAttemptUpsertOne) ClearUserAgent() *LoginAttemptUpsertOne {
+	return u.Update(func(s *LoginAttemptUpsert) {
+		s.ClearUserAgent()
+	})
+}
+
+// SetSuccess sets the "success" field.
+func (u *LoginAttemptUpsertOne) SetSuccess(v bool) *LoginAttemptUpsertOne {
+	return u.Update(func(s *LoginAttemptUpsert) {
+		s.SetSuccess(v)
+	})
+}
+
+// UpdateSuccess sets the "success" field to the value that was provided on create.
+func (u *LoginAttemptUpsertOne) UpdateSuccess() *LoginAttemptUpsertOne {
+	return u.Update(func(s *LoginAttemptUpsert) {
+		s.UpdateSuccess()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *LoginAttemptUpsertOne) SetReason(v string) *LoginAttemptUpsertOne {
+	return u.Update(func(s *LoginAttemptUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *LoginAttemptUpsertOne) UpdateReason() *LoginAttemptUpsertOne {
+	return u.Update(func(s *LoginAttemptUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *LoginAttemptUpsertOne) ClearReason() *LoginAttemptUpsertOne {
+	return u.Update(func(s *LoginAttemptUpsert) {
+		s.ClearReason()
+	})
+}
+
+// Exec executes the query.
+func (u *LoginAttemptUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LoginAttemptCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LoginAttemptUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LoginAttemptUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LoginAttemptUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LoginAttemptCreateBulk is the builder for creating many LoginAttempt entities in bulk.
+type LoginAttemptCreateBulk struct {
+	config
+	err      error
+	builders []*LoginAttemptCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LoginAttempt entities in the database.
+func (lacb *LoginAttemptCreateBulk) Save(ctx context.Context) ([]*LoginAttempt, error) {
+	if lacb.err != nil {
+		return nil, lacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(lacb.builders))
+	nodes := make([]*LoginAttempt, len(lacb.builders))
+	mutators := make([]Mutator, len(lacb.builders))
+	for i := range lacb.builders {
+		func(i int, root context.Context) {
+			builder := lacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LoginAttemptMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = lacb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lacb *LoginAttemptCreateBulk) SaveX(ctx context.Context) []*LoginAttempt {
+	v, err := lacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lacb *LoginAttemptCreateBulk) Exec(ctx context.Context) error {
+	_, err := lacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lacb *LoginAttemptCreateBulk) ExecX(ctx context.Context) {
+	if err := lacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LoginAttempt.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LoginAttemptUpsert) {
+//			SetUsername(v+v).
+//		}).
+//		Exec(ctx)
+func (lacb *LoginAttemptCreateBulk) OnConflict(opts ...sql.ConflictOption) *LoginAttemptUpsertBulk {
+	lacb.conflict = opts
+	return &LoginAttemptUpsertBulk{
+		create: lacb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LoginAttempt.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (lacb *LoginAttemptCreateBulk) OnConflictColumns(columns ...string) *LoginAttemptUpsertBulk {
+	lacb.conflict = append(lacb.conflict, sql.ConflictColumns(columns...))
+	return &LoginAttemptUpsertBulk{
+		create: lacb,
+	}
+}
+
+// LoginAttemptUpsertBulk is the builder for "upsert"-ing
+// a bulk of LoginAttempt nodes.
+type LoginAttemptUpsertBulk struct {
+	create *LoginAttemptCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LoginAttempt.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *LoginAttemptUpsertBulk) UpdateNewValues() *LoginAttemptUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(loginattempt.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LoginAttempt.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LoginAttemptUpsertBulk) Ignore() *LoginAttemptUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LoginAttemptUpsertBulk) DoNothing() *LoginAttemptUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LoginAttemptCreateBulk.OnConflict
+// documentation for more info.
+func (u *LoginAttemptUpsertBulk) Update(set func(*LoginAttemptUpsert)) *LoginAttemptUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LoginAttemptUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUsername sets the "username" field.
+func (u *LoginAttemptUpsertBulk) SetUsername(v string) *LoginAttemptUpsertBulk {
+	return u.Update(func(s *LoginAttemptUpsert) {
+		s.SetUsername(v)
+	})
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *LoginAttemptUpsertBulk) UpdateUsername() *LoginAttemptUpsertBulk {
+	return u.Update(func(s *LoginAttemptUpsert) {
+		s.UpdateUsername()
+	})
+}
+
+// SetIP sets the "ip" field.
+func (u *LoginAttemptUpsertBulk) SetIP(v string) *LoginAttemptUpsertBulk {
+	return u.Update(func(s *LoginAttemptUpsert) {
+		s.SetIP(v)
+	})
+}
+
+// UpdateIP sets the "ip" field to the value that was provided on create.
+func (u *LoginAttemptUpsertBulk) UpdateIP() *LoginAttemptUpsertBulk {
+	return u.Update(func(s *LoginAttemptUpsert) {
+		s.UpdateIP()
+	})
+}
+
+// ClearIP clears the value of the "ip" field.
+func (u *LoginAttemptUpsertBulk) ClearIP() *LoginAttemptUpsertBulk {
+	return u.Update(func(s *LoginAttemptUpsert) {
+		s.ClearIP()
+	})
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (u *LoginAttemptUpsertBulk) SetUserAgent(v string) *LoginAttemptUpsertBulk {
+	return u.Update(func(s *LoginAttemptUpsert) {
+		s.SetUserAgent(v)
+	})
+}
+
+// UpdateUserAgent sets the "user_agent" field to the value that was provided on create.
+func (u *LoginAttemptUpsertBulk) UpdateUserAgent() *LoginAttemptUpsertBulk {
+	return u.Update(func(s *LoginAttemptUpsert) {
+		s.UpdateUserAgent()
+	})
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (u *LoginAttemptUpsertBulk) ClearUserAgent() *LoginAttemptUpsertBulk {
+	return u.Update(func(s *LoginAttemptUpsert) {
+		s.ClearUserAgent()
+	})
+}
+
+// SetSuccess sets the "success" field.
+func (u *LoginAttemptUpsertBulk) SetSuccess(v bool) *LoginAttemptUpsertBulk {
+	return u.Update(func(s *LoginAttemptUpsert) {
+		s.SetSuccess(v)
+	})
+}
+
+// UpdateSuccess sets the "success" field to the value that was provided on create.
+func (u *LoginAttemptUpsertBulk) UpdateSuccess() *LoginAttemptUpsertBulk {
+	return u.Update(func(s *LoginAttemptUpsert) {
+		s.UpdateSuccess()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *LoginAttemptUpsertBulk) SetReason(v string) *LoginAttemptUpsertBulk {
+	return u.Update(func(s *LoginAttemptUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *LoginAttemptUpsertBulk) UpdateReason() *LoginAttemptUpsertBulk {
+	return u.Update(func(s *LoginAttemptUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *LoginAttemptUpsertBulk) ClearReason() *LoginAttemptUpsertBulk {
+	return u.Update(func(s *LoginAttemptUpsert) {
+		s.ClearReason()
+	})
+}
+
+// Exec executes the query.
+func (u *LoginAttemptUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LoginAttemptCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LoginAttemptCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LoginAttemptUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,142 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"goblog/ent/loginthrottle"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// LoginThrottle is the model entity for the LoginThrottle schema.
+type LoginThrottle struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 限制对象，如 user:admin 或 ip:10.0.0.1
+	Key string `json:"key,omitempty"`
+	// 连续失败次数
+	Failures int `json:"failures,omitempty"`
+	// 最近一次失败时间
+	LastFailureAt time.Time `json:"last_failure_at,omitempty"`
+	// 锁定截止时间
+	LockedUntil  *time.Time `json:"locked_until,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LoginThrottle) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case loginthrottle.FieldID, loginthrottle.FieldFailures:
+			values[i] = new(sql.NullInt64)
+		case loginthrottle.FieldKey:
+			values[i] = new(sql.NullString)
+		case loginthrottle.FieldLastFailureAt, loginthrottle.FieldLockedUntil:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LoginThrottle fields.
+func (lt *LoginThrottle) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case loginthrottle.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			lt.ID = int(value.Int64)
+		case loginthrottle.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				lt.Key = value.String
+			}
+		case loginthrottle.FieldFailures:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failures", values[i])
+			} else if value.Valid {
+				lt.Failures = int(value.Int64)
+			}
+		case loginthrottle.FieldLastFailureAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_failure_at", values[i])
+			} else if value.Valid {
+				lt.LastFailureAt = value.Time
+			}
+		case loginthrottle.FieldLockedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field locked_until", values[i])
+			} else if value.Valid {
+				lt.LockedUntil = new(time.Time)
+				*lt.LockedUntil = value.Time
+			}
+		default:
+			lt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LoginThrottle.
+// This includes values selected through modifiers, order, etc.
+func (lt *LoginThrottle) Value(name string) (ent.Value, error) {
+	return lt.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this LoginThrottle.
+// Note that you need to call LoginThrottle.Unwrap() before calling this method if this LoginThrottle
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (lt *LoginThrottle) Update() *LoginThrottleUpdateOne {
+	return NewLoginThrottleClient(lt.config).UpdateOne(lt)
+}
+
+// Unwrap unwraps the LoginThrottle entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (lt *LoginThrottle) Unwrap() *LoginThrottle {
+	_tx, ok := lt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LoginThrottle is not a transactional entity")
+	}
+	lt.config.driver = _tx.drv
+	return lt
+}
+
+// String implements the fmt.Stringer.
+func (lt *LoginThrottle) String() string {
+	var builder strings.Builder
+	builder.WriteString("LoginThrottle(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", lt.ID))
+	builder.WriteString("key=")
+	builder.WriteString(lt.Key)
+	builder.WriteString(", ")
+	builder.WriteString("failures=")
+	builder.WriteString(fmt.Sprintf("%v", lt.Failures))
+	builder.WriteString(", ")
+	builder.WriteString("last_failure_at=")
+	builder.WriteString(lt.LastFailureAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := lt.LockedUntil; v != nil {
+		builder.WriteString("locked_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LoginThrottles is a parsable slice of LoginThrottle.
+type LoginThrottles []*LoginThrottle
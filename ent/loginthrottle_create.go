@@ -0,0 +1,682 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"goblog/ent/loginthrottle"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LoginThrottleCreate is the builder for creating a LoginThrottle entity.
+type LoginThrottleCreate struct {
+	config
+	mutation *LoginThrottleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetKey sets the "key" field.
+func (ltc *LoginThrottleCreate) SetKey(s string) *LoginThrottleCreate {
+	ltc.mutation.SetKey(s)
+	return ltc
+}
+
+// SetFailures sets the "failures" field.
+func (ltc *LoginThrottleCreate) SetFailures(i int) *LoginThrottleCreate {
+	ltc.mutation.SetFailures(i)
+	return ltc
+}
+
+// SetNillableFailures sets the "failures" field if the given value is not nil.
+func (ltc *LoginThrottleCreate) SetNillableFailures(i *int) *LoginThrottleCreate {
+	if i != nil {
+		ltc.SetFailures(*i)
+	}
+	return ltc
+}
+
+// SetLastFailureAt sets the "last_failure_at" field.
+func (ltc *LoginThrottleCreate) SetLastFailureAt(t time.Time) *LoginThrottleCreate {
+	ltc.mutation.SetLastFailureAt(t)
+	return ltc
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (ltc *LoginThrottleCreate) SetLockedUntil(t time.Time) *LoginThrottleCreate {
+	ltc.mutation.SetLockedUntil(t)
+	return ltc
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (ltc *LoginThrottleCreate) SetNillableLockedUntil(t *time.Time) *LoginThrottleCreate {
+	if t != nil {
+		ltc.SetLockedUntil(*t)
+	}
+	return ltc
+}
+
+// Mutation returns the LoginThrottleMutation object of the builder.
+func (ltc *LoginThrottleCreate) Mutation() *LoginThrottleMutation {
+	return ltc.mutation
+}
+
+// Save creates the LoginThrottle in the database.
+func (ltc *LoginThrottleCreate) Save(ctx context.Context) (*LoginThrottle, error) {
+	ltc.defaults()
+	return withHooks(ctx, ltc.sqlSave, ltc.mutation, ltc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ltc *LoginThrottleCreate) SaveX(ctx context.Context) *LoginThrottle {
+	v, err := ltc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ltc *LoginThrottleCreate) Exec(ctx context.Context) error {
+	_, err := ltc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ltc *LoginThrottleCreate) ExecX(ctx context.Context) {
+	if err := ltc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ltc *LoginThrottleCreate) defaults() {
+	if _, ok := ltc.mutation.Failures(); !ok {
+		v := loginthrottle.DefaultFailures
+		ltc.mutation.SetFailures(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ltc *LoginThrottleCreate) check() error {
+	if _, ok := ltc.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "LoginThrottle.key"`)}
+	}
+	if v, ok := ltc.mutation.Key(); ok {
+		if err := loginthrottle.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "LoginThrottle.key": %w`, err)}
+		}
+	}
+	if _, ok := ltc.mutation.Failures(); !ok {
+		return &ValidationError{Name: "failures", err: errors.New(`ent: missing required field "LoginThrottle.failures"`)}
+	}
+	if _, ok := ltc.mutation.LastFailureAt(); !ok {
+		return &ValidationError{Name: "last_failure_at", err: errors.New(`ent: missing required field "LoginThrottle.last_failure_at"`)}
+	}
+	return nil
+}
+
+func (ltc *LoginThrottleCreate) sqlSave(ctx context.Context) (*LoginThrottle, error) {
+	if err := ltc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ltc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ltc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ltc.mutation.id = &_node.ID
+	ltc.mutation.done = true
+	return _node, nil
+}
+
+func (ltc *LoginThrottleCreate) createSpec() (*LoginThrottle, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LoginThrottle{config: ltc.config}
+		_spec = sqlgraph.NewCreateSpec(loginthrottle.Table, sqlgraph.NewFieldSpec(loginthrottle.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = ltc.conflict
+	if value, ok := ltc.mutation.Key(); ok {
+		_spec.SetField(loginthrottle.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := ltc.mutation.Failures(); ok {
+		_spec.SetField(loginthrottle.FieldFailures, field.TypeInt, value)
+		_node.Failures = value
+	}
+	if value, ok := ltc.mutation.LastFailureAt(); ok {
+		_spec.SetField(loginthrottle.FieldLastFailureAt, field.TypeTime, value)
+		_node.LastFailureAt = value
+	}
+	if value, ok := ltc.mutation.LockedUntil(); ok {
+		_spec.SetField(loginthrottle.FieldLockedUntil, field.TypeTime, value)
+		_node.LockedUntil = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LoginThrottle.Create().
+//		SetKey(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LoginThrottleUpsert) {
+//			SetKey(v+v).
+//		}).
+//		Exec(ctx)
+func (ltc *LoginThrottleCreate) OnConflict(opts ...sql.ConflictOption) *LoginThrottleUpsertOne {
+	ltc.conflict = opts
+	return &LoginThrottleUpsertOne{
+		create: ltc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LoginThrottle.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ltc *LoginThrottleCreate) OnConflictColumns(columns ...string) *LoginThrottleUpsertOne {
+	ltc.conflict = append(ltc.conflict, sql.ConflictColumns(columns...))
+	return &LoginThrottleUpsertOne{
+		create: ltc,
+	}
+}
+
+type (
+	// LoginThrottleUpsertOne is the builder for "upsert"-ing
+	//  one LoginThrottle node.
+	LoginThrottleUpsertOne struct {
+		create *LoginThrottleCreate
+	}
+
+	// LoginThrottleUpsert is the "OnConflict" setter.
+	LoginThrottleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetKey sets the "key" field.
+func (u *LoginThrottleUpsert) SetKey(v string) *LoginThrottleUpsert {
+	u.Set(loginthrottle.FieldKey, v)
+	return u
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *LoginThrottleUpsert) UpdateKey() *LoginThrottleUpsert {
+	u.SetExcluded(loginthrottle.FieldKey)
+	return u
+}
+
+// SetFailures sets the "failures" field.
+func (u *LoginThrottleUpsert) SetFailures(v int) *LoginThrottleUpsert {
+	u.Set(loginthrottle.FieldFailures, v)
+	return u
+}
+
+// UpdateFailures sets the "failures" field to the value that was provided on create.
+func (u *LoginThrottleUpsert) UpdateFailures() *LoginThrottleUpsert {
+	u.SetExcluded(loginthrottle.FieldFailures)
+	return u
+}
+
+// AddFailures adds v to the "failures" field.
+func (u *LoginThrottleUpsert) AddFailures(v int) *LoginThrottleUpsert {
+	u.Add(loginthrottle.FieldFailures, v)
+	return u
+}
+
+// SetLastFailureAt sets the "last_failure_at" field.
+func (u *LoginThrottleUpsert) SetLastFailureAt(v time.Time) *LoginThrottleUpsert {
+	u.Set(loginthrottle.FieldLastFailureAt, v)
+	return u
+}
+
+// UpdateLastFailureAt sets the "last_failure_at" field to the value that was provided on create.
+func (u *LoginThrottleUpsert) UpdateLastFailureAt() *LoginThrottleUpsert {
+	u.SetExcluded(loginthrottle.FieldLastFailureAt)
+	return u
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (u *LoginThrottleUpsert) SetLockedUntil(v time.Time) *LoginThrottleUpsert {
+	u.Set(loginthrottle.FieldLockedUntil, v)
+	return u
+}
+
+// UpdateLockedUntil sets the "locked_until" field to the value that was provided on create.
+func (u *LoginThrottleUpsert) UpdateLockedUntil() *LoginThrottleUpsert {
+	u.SetExcluded(loginthrottle.FieldLockedUntil)
+	return u
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (u *LoginThrottleUpsert) ClearLockedUntil() *LoginThrottleUpsert {
+	u.SetNull(loginthrottle.FieldLockedUntil)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.LoginThrottle.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *LoginThrottleUpsertOne) UpdateNewValues() *LoginThrottleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LoginThrottle.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LoginThrottleUpsertOne) Ignore() *LoginThrottleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LoginThrottleUpsertOne) DoNothing() *LoginThrottleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LoginThrottleCreate.OnConflict
+// documentation for more info.
+func (u *LoginThrottleUpsertOne) Update(set func(*LoginThrottleUpsert)) *LoginThrottleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LoginThrottleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetKey sets the "key" field.
+func (u *LoginThrottleUpsertOne) SetKey(v string) *LoginThrottleUpsertOne {
+	return u.Update(func(s *LoginThrottleUpsert) {
+		s.SetKey(v)
+	})
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *LoginThrottleUpsertOne) UpdateKey() *LoginThrottleUpsertOne {
+	return u.Update(func(s *LoginThrottleUpsert) {
+		s.UpdateKey()
+	})
+}
+
+// SetFailures sets the "failures" field.
+func (u *LoginThrottleUpsertOne) SetFailures(v int) *LoginThrottleUpsertOne {
+	return u.Update(func(s *LoginThrottleUpsert) {
+		s.SetFailures(v)
+	})
+}
+
+// AddFailures adds v to the "failures" field.
+func (u *LoginThrottleUpsertOne) AddFailures(v int) *LoginThrottleUpsertOne {
+	return u.Update(func(s *LoginThrottleUpsert) {
+		s.AddFailures(v)
+	})
+}
+
+// UpdateFailures sets the "failures" field to the value that was provided on create.
+func (u *LoginThrottleUpsertOne) UpdateFailures() *LoginThrottleUpsertOne {
+	return u.Update(func(s *LoginThrottleUpsert) {
+		s.UpdateFailures()
+	})
+}
+
+// SetLastFailureAt sets the "last_failure_at" field.
+func (u *LoginThrottleUpsertOne) SetLastFailureAt(v time.Time) *LoginThrottleUpsertOne {
+	return u.Update(func(s *LoginThrottleUpsert) {
+		s.SetLastFailureAt(v)
+	})
+}
+
+// UpdateLastFailureAt sets the "last_failure_at" field to the value that was provided on create.
+func (u *LoginThrottleUpsertOne) UpdateLastFailureAt() *LoginThrottleUpsertOne {
+	return u.Update(func(s *LoginThrottleUpsert) {
+		s.UpdateLastFailureAt()
+	})
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (u *LoginThrottleUpsertOne) SetLockedUntil(v time.Time) *LoginThrottleUpsertOne {
+	return u.Update(func(s *LoginThrottleUpsert) {
+		s.SetLockedUntil(v)
+	})
+}
+
+// UpdateLockedUntil sets the "locked_until" field to the value that was provided on create.
+func (u *LoginThrottleUpsertOne) UpdateLockedUntil() *LoginThrottleUpsertOne {
+	return u.Update(func(s *LoginThrottleUpsert) {
+		s.UpdateLockedUntil()
+	})
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (u *LoginThrottleUpsertOne) ClearLockedUntil() *LoginThrottleUpsertOne {
+	return u.Update(func(s *LoginThrottleUpsert) {
+		s.ClearLockedUntil()
+	})
+}
+
+// Exec executes the query.
+func (u *LoginThrottleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LoginThrottleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LoginThrottleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LoginThrottleUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LoginThrottleUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LoginThrottleCreateBulk is the builder for creating many LoginThrottle entities in bulk.
+type LoginThrottleCreateBulk struct {
+	config
+	err      error
+	builders []*LoginThrottleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LoginThrottle entities in the database.
+func (ltcb *LoginThrottleCreateBulk) Save(ctx context.Context) ([]*LoginThrottle, error) {
+	if ltcb.err != nil {
+		return nil, ltcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ltcb.builders))
+	nodes := make([]*LoginThrottle, len(ltcb.builders))
+	mutators := make([]Mutator, len(ltcb.builders))
+	for i := range ltcb.builders {
+		func(i int, root context.Context) {
+			builder := ltcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LoginThrottleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ltcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ltcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ltcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ltcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ltcb *LoginThrottleCreateBulk) SaveX(ctx context.Context) []*LoginThrottle {
+	v, err := ltcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ltcb *LoginThrottleCreateBulk) Exec(ctx context.Context) error {
+	_, err := ltcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ltcb *LoginThrottleCreateBulk) ExecX(ctx context.Context) {
+	if err := ltcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LoginThrottle.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LoginThrottleUpsert) {
+//			SetKey(v+v).
+//		}).
+//		Exec(ctx)
+func (ltcb *LoginThrottleCreateBulk) OnConflict(opts ...sql.ConflictOption) *LoginThrottleUpsertBulk {
+	ltcb.conflict = opts
+	return &LoginThrottleUpsertBulk{
+		create: ltcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LoginThrottle.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ltcb *LoginThrottleCreateBulk) OnConflictColumns(columns ...string) *LoginThrottleUpsertBulk {
+	ltcb.conflict = append(ltcb.conflict, sql.ConflictColumns(columns...))
+	return &LoginThrottleUpsertBulk{
+		create: ltcb,
+	}
+}
+
+// LoginThrottleUpsertBulk is the builder for "upsert"-ing
+// a bulk of LoginThrottle nodes.
+type LoginThrottleUpsertBulk struct {
+	create *LoginThrottleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LoginThrottle.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *LoginThrottleUpsertBulk) UpdateNewValues() *LoginThrottleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LoginThrottle.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LoginThrottleUpsertBulk) Ignore() *LoginThrottleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LoginThrottleUpsertBulk) DoNothing() *LoginThrottleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LoginThrottleCreateBulk.OnConflict
+// documentation for more info.
+func (u *LoginThrottleUpsertBulk) Update(set func(*LoginThrottleUpsert)) *LoginThrottleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LoginThrottleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetKey sets the "key" field.
+func (u *LoginThrottleUpsertBulk) SetKey(v string) *LoginThrottleUpsertBulk {
+	return u.Update(func(s *LoginThrottleUpsert) {
+		s.SetKey(v)
+	})
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *LoginThrottleUpsertBulk) UpdateKey() *LoginThrottleUpsertBulk {
+	return u.Update(func(s *LoginThrottleUpsert) {
+		s.UpdateKey()
+	})
+}
+
+// SetFailures sets the "failures" field.
+func (u *LoginThrottleUpsertBulk) SetFailures(v int) *LoginThrottleUpsertBulk {
+	return u.Update(func(s *LoginThrottleUpsert) {
+		s.SetFailures(v)
+	})
+}
+
+// AddFailures adds v to the "failures" field.
+func (u *LoginThrottleUpsertBulk) AddFailures(v int) *LoginThrottleUpsertBulk {
+	return u.Update(func(s *LoginThrottleUpsert) {
+		s.AddFailures(v)
+	})
+}
+
+// UpdateFailures sets the "failures" field to the value that was provided on create.
+func (u *LoginThrottleUpsertBulk) UpdateFailures() *LoginThrottleUpsertBulk {
+	return u.Update(func(s *LoginThrottleUpsert) {
+		s.UpdateFailures()
+	})
+}
+
+// SetLastFailureAt sets the "last_failure_at" field.
+func (u *LoginThrottleUpsertBulk) SetLastFailureAt(v time.Time) *LoginThrottleUpsertBulk {
+	return u.Update(func(s *LoginThrottleUpsert) {
+		s.SetLastFailureAt(v)
+	})
+}
+
+// UpdateLastFailureAt sets the "last_failure_at" field to the value that was provided on create.
+func (u *LoginThrottleUpsertBulk) UpdateLastFailureAt() *LoginThrottleUpsertBulk {
+	return u.Update(func(s *LoginThrottleUpsert) {
+		s.UpdateLastFailureAt()
+	})
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (u *LoginThrottleUpsertBulk) SetLockedUntil(v time.Time) *LoginThrottleUpsertBulk {
+	return u.Update(func(s *LoginThrottleUpsert) {
+		s.SetLockedUntil(v)
+	})
+}
+
+// UpdateLockedUntil sets the "locked_until" field to the value that was provided on create.
+func (u *LoginThrottleUpsertBulk) UpdateLockedUntil() *LoginThrottleUpsertBulk {
+	return u.Update(func(s *LoginThrottleUpsert) {
+		s.UpdateLockedUntil()
+	})
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (u *LoginThrottleUpsertBulk) ClearLockedUntil() *LoginThrottleUpsertBulk {
+	return u.Update(func(s *LoginThrottleUpsert) {
+		s.ClearLockedUntil()
+	})
+}
+
+// Exec executes the query.
+func (u *LoginThrottleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LoginThrottleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LoginThrottleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LoginThrottleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,564 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"goblog/ent/loginthrottle"
+	"goblog/ent/predicate"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LoginThrottleQuery is the builder for querying LoginThrottle entities.
+type LoginThrottleQuery struct {
+	config
+	ctx        *QueryContext
+	order      []loginthrottle.OrderOption
+	inters     []Interceptor
+	predicates []predicate.LoginThrottle
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the LoginThrottleQuery builder.
+func (ltq *LoginThrottleQuery) Where(ps ...predicate.LoginThrottle) *LoginThrottleQuery {
+	ltq.predicates = append(ltq.predicates, ps...)
+	return ltq
+}
+
+// Limit the number of records to be returned by this query.
+func (ltq *LoginThrottleQuery) Limit(limit int) *LoginThrottleQuery {
+	ltq.ctx.Limit = &limit
+	return ltq
+}
+
+// Offset to start from.
+func (ltq *LoginThrottleQuery) Offset(offset int) *LoginThrottleQuery {
+	ltq.ctx.Offset = &offset
+	return ltq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ltq *LoginThrottleQuery) Unique(unique bool) *LoginThrottleQuery {
+	ltq.ctx.Unique = &unique
+	return ltq
+}
+
+// Order specifies how the records should be ordered.
+func (ltq *LoginThrottleQuery) Order(o ...loginthrottle.OrderOption) *LoginThrottleQuery {
+	ltq.order = append(ltq.order, o...)
+	return ltq
+}
+
+// First returns the first LoginThrottle entity from the query.
+// Returns a *NotFoundError when no LoginThrottle was found.
+func (ltq *LoginThrottleQuery) First(ctx context.Context) (*LoginThrottle, error) {
+	nodes, err := ltq.Limit(1).All(setContextOp(ctx, ltq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{loginthrottle.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ltq *LoginThrottleQuery) FirstX(ctx context.Context) *LoginThrottle {
+	node, err := ltq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first LoginThrottle ID from the query.
+// Returns a *NotFoundError when no LoginThrottle ID was found.
+func (ltq *LoginThrottleQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ltq.Limit(1).IDs(setContextOp(ctx, ltq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{loginthrottle.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ltq *LoginThrottleQuery) FirstIDX(ctx context.Context) int {
+	id, err := ltq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single LoginThrottle entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one LoginThrottle entity is found.
+// Returns a *NotFoundError when no LoginThrottle entities are found.
+func (ltq *LoginThrottleQuery) Only(ctx context.Context) (*LoginThrottle, error) {
+	nodes, err := ltq.Limit(2).All(setContextOp(ctx, ltq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{loginthrottle.Label}
+	default:
+		return nil, &NotSingularError{loginthrottle.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ltq *LoginThrottleQuery) OnlyX(ctx context.Context) *LoginThrottle {
+	node, err := ltq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only LoginThrottle ID in the query.
+// Returns a *NotSingularError when more than one LoginThrottle ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ltq *LoginThrottleQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ltq.Limit(2).IDs(setContextOp(ctx, ltq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{loginthrottle.Label}
+	default:
+		err = &NotSingularError{loginthrottle.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ltq *LoginThrottleQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ltq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of LoginThrottles.
+func (ltq *LoginThrottleQuery) All(ctx context.Context) ([]*LoginThrottle, error) {
+	ctx = setContextOp(ctx, ltq.ctx, ent.OpQueryAll)
+	if err := ltq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*LoginThrottle, *LoginThrottleQuery]()
+	return withInterceptors[[]*LoginThrottle](ctx, ltq, qr, ltq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ltq *LoginThrottleQuery) AllX(ctx context.Context) []*LoginThrottle {
+	nodes, err := ltq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of LoginThrottle IDs.
+func (ltq *LoginThrottleQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ltq.ctx.Unique == nil && ltq.path != nil {
+		ltq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ltq.ctx, ent.OpQueryIDs)
+	if err = ltq.Select(loginthrottle.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ltq *LoginThrottleQuery) IDsX(ctx context.Context) []int {
+	ids, err := ltq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ltq *LoginThrottleQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ltq.ctx, ent.OpQueryCount)
+	if err := ltq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ltq, querierCount[*LoginThrottleQuery](), ltq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ltq *LoginThrottleQuery) CountX(ctx context.Context) int {
+	count, err := ltq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ltq *LoginThrottleQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ltq.ctx, ent.OpQueryExist)
+	switch _, err := ltq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ltq *LoginThrottleQuery) ExistX(ctx context.Context) bool {
+	exist, err := ltq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the LoginThrottleQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ltq *LoginThrottleQuery) Clone() *LoginThrottleQuery {
+	if ltq == nil {
+		return nil
+	}
+	return &LoginThrottleQuery{
+		config:     ltq.config,
+		ctx:        ltq.ctx.Clone(),
+		order:      append([]loginthrottle.OrderOption{}, ltq.order...),
+		inters:     append([]Interceptor{}, ltq.inters...),
+		predicates: append([]predicate.LoginThrottle{}, ltq.predicates...),
+		// clone intermediate query.
+		sql:  ltq.sql.Clone(),
+		path: ltq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Key string `json:"key,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.LoginThrottle.Query().
+//		GroupBy(loginthrottle.FieldKey).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ltq *LoginThrottleQuery) GroupBy(field string, fields ...string) *LoginThrottleGroupBy {
+	ltq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &LoginThrottleGroupBy{build: ltq}
+	grbuild.flds = &ltq.ctx.Fields
+	grbuild.label = loginthrottle.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Key string `json:"key,omitempty"`
+//	}
+//
+//	client.LoginThrottle.Query().
+//		Select(loginthrottle.FieldKey).
+//		Scan(ctx, &v)
+func (ltq *LoginThrottleQuery) Select(fields ...string) *LoginThrottleSelect {
+	ltq.ctx.Fields = append(ltq.ctx.Fields, fields...)
+	sbuild := &LoginThrottleSelect{LoginThrottleQuery: ltq}
+	sbuild.label = loginthrottle.Label
+	sbuild.flds, sbuild.scan = &ltq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a LoginThrottleSelect configured with the given aggregations.
+func (ltq *LoginThrottleQuery) Aggregate(fns ...AggregateFunc) *LoginThrottleSelect {
+	return ltq.Select().Aggregate(fns...)
+}
+
+func (ltq *LoginThrottleQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ltq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ltq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ltq.ctx.Fields {
+		if !loginthrottle.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ltq.path != nil {
+		prev, err := ltq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ltq.sql = prev
+	}
+	return nil
+}
+
+func (ltq *LoginThrottleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*LoginThrottle, error) {
+	var (
+		nodes = []*LoginThrottle{}
+		_spec = ltq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*LoginThrottle).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &LoginThrottle{config: ltq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(ltq.modifiers) > 0 {
+		_spec.Modifiers = ltq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ltq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ltq *LoginThrottleQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ltq.querySpec()
+	if len(ltq.modifiers) > 0 {
+		_spec.Modifiers = ltq.modifiers
+	}
+	_spec.Node.Columns = ltq.ctx.Fields
+	if len(ltq.ctx.Fields) > 0 {
+		_spec.Unique = ltq.ctx.Unique != nil && *ltq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ltq.driver, _spec)
+}
+
+func (ltq *LoginThrottleQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(loginthrottle.Table, loginthrottle.Columns, sqlgraph.NewFieldSpec(loginthrottle.FieldID, field.TypeInt))
+	_spec.From = ltq.sql
+	if unique := ltq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ltq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ltq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, loginthrottle.FieldID)
+		for i := range fields {
+			if fields[i] != loginthrottle.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ltq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ltq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ltq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ltq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ltq *LoginThrottleQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ltq.driver.Dialect())
+	t1 := builder.Table(loginthrottle.Table)
+	columns := ltq.ctx.Fields
+	if len(columns) == 0 {
+		columns = loginthrottle.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ltq.sql != nil {
+		selector = ltq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ltq.ctx.Unique != nil && *ltq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range ltq.modifiers {
+		m(selector)
+	}
+	for _, p := range ltq.predicates {
+		p(selector)
+	}
+	for _, p := range ltq.order {
+		p(selector)
+	}
+	if offset := ltq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ltq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (ltq *LoginThrottleQuery) ForUpdate(opts ...sql.LockOption) *LoginThrottleQuery {
+	if ltq.driver.Dialect() == dialect.Postgres {
+		ltq.Unique(false)
+	}
+	ltq.modifiers = append(ltq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return ltq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (ltq *LoginThrottleQuery) ForShare(opts ...sql.LockOption) *LoginThrottleQuery {
+	if ltq.driver.Dialect() == dialect.Postgres {
+		ltq.Unique(false)
+	}
+	ltq.modifiers = append(ltq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return ltq
+}
+
+// LoginThrottleGroupBy is the group-by builder for LoginThrottle entities.
+type LoginThrottleGroupBy struct {
+	selector
+	build *LoginThrottleQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ltgb *LoginThrottleGroupBy) Aggregate(fns ...AggregateFunc) *LoginThrottleGroupBy {
+	ltgb.fns = append(ltgb.fns, fns...)
+	return ltgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ltgb *LoginThrottleGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ltgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ltgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LoginThrottleQuery, *LoginThrottleGroupBy](ctx, ltgb.build, ltgb, ltgb.build.inters, v)
+}
+
+func (ltgb *LoginThrottleGroupBy) sqlScan(ctx context.Context, root *LoginThrottleQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ltgb.fns))
+	for _, fn := range ltgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ltgb.flds)+len(ltgb.fns))
+		for _, f := range *ltgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ltgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ltgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// LoginThrottleSelect is the builder for selecting fields of LoginThrottle entities.
+type LoginThrottleSelect struct {
+	*LoginThrottleQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (lts *LoginThrottleSelect) Aggregate(fns ...AggregateFunc) *LoginThrottleSelect {
+	lts.fns = append(lts.fns, fns...)
+	return lts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (lts *LoginThrottleSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, lts.ctx, ent.OpQuerySelect)
+	if err := lts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LoginThrottleQuery, *LoginThrottleSelect](ctx, lts.LoginThrottleQuery, lts, lts.inters, v)
+}
+
+func (lts *LoginThrottleSelect) sqlScan(ctx context.Context, root *LoginThrottleQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(lts.fns))
+	for _, fn := range lts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*lts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,514 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"goblog/ent/predicate"
+	"goblog/ent/refreshtoken"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RefreshTokenUpdate is the builder for updating RefreshToken entities.
+type RefreshTokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RefreshTokenMutation
+}
+
+// Where appends a list predicates to the RefreshTokenUpdate builder.
+func (rtu *RefreshTokenUpdate) Where(ps ...predicate.RefreshToken) *RefreshTokenUpdate {
+	rtu.mutation.Where(ps...)
+	return rtu
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (rtu *RefreshTokenUpdate) SetTokenHash(s string) *RefreshTokenUpdate {
+	rtu.mutation.SetTokenHash(s)
+	return rtu
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (rtu *RefreshTokenUpdate) SetNillableTokenHash(s *string) *RefreshTokenUpdate {
+	if s != nil {
+		rtu.SetTokenHash(*s)
+	}
+	return rtu
+}
+
+// SetFamilyID sets the "family_id" field.
+func (rtu *RefreshTokenUpdate) SetFamilyID(s string) *RefreshTokenUpdate {
+	rtu.mutation.SetFamilyID(s)
+	return rtu
+}
+
+// SetNillableFamilyID sets the "family_id" field if the given value is not nil.
+func (rtu *RefreshTokenUpdate) SetNillableFamilyID(s *string) *RefreshTokenUpdate {
+	if s != nil {
+		rtu.SetFamilyID(*s)
+	}
+	return rtu
+}
+
+// SetUsername sets the "username" field.
+func (rtu *RefreshTokenUpdate) SetUsername(s string) *RefreshTokenUpdate {
+	rtu.mutation.SetUsername(s)
+	return rtu
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (rtu *RefreshTokenUpdate) SetNillableUsername(s *string) *RefreshTokenUpdate {
+	if s != nil {
+		rtu.SetUsername(*s)
+	}
+	return rtu
+}
+
+// SetIP sets the "ip" field.
+func (rtu *RefreshTokenUpdate) SetIP(s string) *RefreshTokenUpdate {
+	rtu.mutation.SetIP(s)
+	return rtu
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (rtu *RefreshTokenUpdate) SetNillableIP(s *string) *RefreshTokenUpdate {
+	if s != nil {
+		rtu.SetIP(*s)
+	}
+	return rtu
+}
+
+// ClearIP clears the value of the "ip" field.
+func (rtu *RefreshTokenUpdate) ClearIP() *RefreshTokenUpdate {
+	rtu.mutation.ClearIP()
+	return rtu
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (rtu *RefreshTokenUpdate) SetUserAgent(s string) *RefreshTokenUpdate {
+	rtu.mutation.SetUserAgent(s)
+	return rtu
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (rtu *RefreshTokenUpdate) SetNillableUserAgent(s *string) *RefreshTokenUpdate {
+	if s != nil {
+		rtu.SetUserAgent(*s)
+	}
+	return rtu
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (rtu *RefreshTokenUpdate) ClearUserAgent() *RefreshTokenUpdate {
+	rtu.mutation.ClearUserAgent()
+	return rtu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (rtu *RefreshTokenUpdate) SetExpiresAt(t time.Time) *RefreshTokenUpdate {
+	rtu.mutation.SetExpiresAt(t)
+	return rtu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (rtu *RefreshTokenUpdate) SetNillableExpiresAt(t *time.Time) *RefreshTokenUpdate {
+	if t != nil {
+		rtu.SetExpiresAt(*t)
+	}
+	return rtu
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (rtu *RefreshTokenUpdate) SetRevokedAt(t time.Time) *RefreshTokenUpdate {
+	rtu.mutation.SetRevokedAt(t)
+	return rtu
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (rtu *RefreshTokenUpdate) SetNillableRevokedAt(t *time.Time) *RefreshTokenUpdate {
+	if t != nil {
+		rtu.SetRevokedAt(*t)
+	}
+	return rtu
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (rtu *RefreshTokenUpdate) ClearRevokedAt() *RefreshTokenUpdate {
+	rtu.mutation.ClearRevokedAt()
+	return rtu
+}
+
+// Mutation returns the RefreshTokenMutation object of the builder.
+func (rtu *RefreshTokenUpdate) Mutation() *RefreshTokenMutation {
+	return rtu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rtu *RefreshTokenUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, rtu.sqlSave, rtu.mutation, rtu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rtu *RefreshTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := rtu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rtu *RefreshTokenUpdate) Exec(ctx context.Context) error {
+	_, err := rtu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtu *RefreshTokenUpdate) ExecX(ctx context.Context) {
+	if err := rtu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rtu *RefreshTokenUpdate) check() error {
+	if v, ok := rtu.mutation.TokenHash(); ok {
+		if err := refreshtoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "RefreshToken.token_hash": %w`, err)}
+		}
+	}
+	if v, ok := rtu.mutation.FamilyID(); ok {
+		if err := refreshtoken.FamilyIDValidator(v); err != nil {
+			return &ValidationError{Name: "family_id", err: fmt.Errorf(`ent: validator failed for field "RefreshToken.family_id": %w`, err)}
+		}
+	}
+	if v, ok := rtu.mutation.Username(); ok {
+		if err := refreshtoken.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "RefreshToken.username": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rtu *RefreshTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rtu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(refreshtoken.Table, refreshtoken.Columns, sqlgraph.NewFieldSpec(refreshtoken.FieldID, field.TypeInt))
+	if ps := rtu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rtu.mutation.TokenHash(); ok {
+		_spec.SetField(refreshtoken.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := rtu.mutation.FamilyID(); ok {
+		_spec.SetField(refreshtoken.FieldFamilyID, field.TypeString, value)
+	}
+	if value, ok := rtu.mutation.Username(); ok {
+		_spec.SetField(refreshtoken.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := rtu.mutation.IP(); ok {
+		_spec.SetField(refreshtoken.FieldIP, field.TypeString, value)
+	}
+	if rtu.mutation.IPCleared() {
+		_spec.ClearField(refreshtoken.FieldIP, field.TypeString)
+	}
+	if value, ok := rtu.mutation.UserAgent(); ok {
+		_spec.SetField(refreshtoken.FieldUserAgent, field.TypeString, value)
+	}
+	if rtu.mutation.UserAgentCleared() {
+		_spec.ClearField(refreshtoken.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := rtu.mutation.ExpiresAt(); ok {
+		_spec.SetField(refreshtoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := rtu.mutation.RevokedAt(); ok {
+		_spec.SetField(refreshtoken.FieldRevokedAt, field.TypeTime, value)
+	}
+	if rtu.mutation.RevokedAtCleared() {
+		_spec.ClearField(refreshtoken.FieldRevokedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rtu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{refreshtoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rtu.mutation.done = true
+	return n, nil
+}
+
+// RefreshTokenUpdateOne is the builder for updating a single RefreshToken entity.
+type RefreshTokenUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RefreshTokenMutation
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (rtuo *RefreshTokenUpdateOne) SetTokenHash(s string) *RefreshTokenUpdateOne {
+	rtuo.mutation.SetTokenHash(s)
+	return rtuo
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (rtuo *RefreshTokenUpdateOne) SetNillableTokenHash(s *string) *RefreshTokenUpdateOne {
+	if s != nil {
+		rtuo.SetTokenHash(*s)
+	}
+	return rtuo
+}
+
+// SetFamilyID sets the "family_id" field.
+func (rtuo *RefreshTokenUpdateOne) SetFamilyID(s string) *RefreshTokenUpdateOne {
+	rtuo.mutation.SetFamilyID(s)
+	return rtuo
+}
+
+// SetNillableFamilyID sets the "family_id" field if the given value is not nil.
+func (rtuo *RefreshTokenUpdateOne) SetNillableFamilyID(s *string) *RefreshTokenUpdateOne {
+	if s != nil {
+		rtuo.SetFamilyID(*s)
+	}
+	return rtuo
+}
+
+// SetUsername sets the "username" field.
+func (rtuo *RefreshTokenUpdateOne) SetUsername(s string) *RefreshTokenUpdateOne {
+	rtuo.mutation.SetUsername(s)
+	return rtuo
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (rtuo *RefreshTokenUpdateOne) SetNillableUsername(s *string) *RefreshTokenUpdateOne {
+	if s != nil {
+		rtuo.SetUsername(*s)
+	}
+	return rtuo
+}
+
+// SetIP sets the "ip" field.
+func (rtuo *RefreshTokenUpdateOne) SetIP(s string) *RefreshTokenUpdateOne {
+	rtuo.mutation.SetIP(s)
+	return rtuo
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (rtuo *RefreshTokenUpdateOne) SetNillableIP(s *string) *RefreshTokenUpdateOne {
+	if s != nil {
+		rtuo.SetIP(*s)
+	}
+	return rtuo
+}
+
+// ClearIP clears the value of the "ip" field.
+func (rtuo *RefreshTokenUpdateOne) ClearIP() *RefreshTokenUpdateOne {
+	rtuo.mutation.ClearIP()
+	return rtuo
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (rtuo *RefreshTokenUpdateOne) SetUserAgent(s string) *RefreshTokenUpdateOne {
+	rtuo.mutation.SetUserAgent(s)
+	return rtuo
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (rtuo *RefreshTokenUpdateOne) SetNillableUserAgent(s *string) *RefreshTokenUpdateOne {
+	if s != nil {
+		rtuo.SetUserAgent(*s)
+	}
+	return rtuo
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (rtuo *RefreshTokenUpdateOne) ClearUserAgent() *RefreshTokenUpdateOne {
+	rtuo.mutation.ClearUserAgent()
+	return rtuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (rtuo *RefreshTokenUpdateOne) SetExpiresAt(t time.Time) *RefreshTokenUpdateOne {
+	rtuo.mutation.SetExpiresAt(t)
+	return rtuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (rtuo *RefreshTokenUpdateOne) SetNillableExpiresAt(t *time.Time) *RefreshTokenUpdateOne {
+	if t != nil {
+		rtuo.SetExpiresAt(*t)
+	}
+	return rtuo
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (rtuo *RefreshTokenUpdateOne) SetRevokedAt(t time.Time) *RefreshTokenUpdateOne {
+	rtuo.mutation.SetRevokedAt(t)
+	return rtuo
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (rtuo *RefreshTokenUpdateOne) SetNillableRevokedAt(t *time.Time) *RefreshTokenUpdateOne {
+	if t != nil {
+		rtuo.SetRevokedAt(*t)
+	}
+	return rtuo
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (rtuo *RefreshTokenUpdateOne) ClearRevokedAt() *RefreshTokenUpdateOne {
+	rtuo.mutation.ClearRevokedAt()
+	return rtuo
+}
+
+// Mutation returns the RefreshTokenMutation object of the builder.
+func (rtuo *RefreshTokenUpdateOne) Mutation() *RefreshTokenMutation {
+	return rtuo.mutation
+}
+
+// Where appends a list predicates to the RefreshTokenUpdate builder.
+func (rtuo *RefreshTokenUpdateOne) Where(ps ...predicate.RefreshToken) *RefreshTokenUpdateOne {
+	rtuo.mutation.Where(ps...)
+	return rtuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rtuo *RefreshTokenUpdateOne) Select(field string, fields ...string) *RefreshTokenUpdateOne {
+	rtuo.fields = append([]string{field}, fields...)
+	return rtuo
+}
+
+// Save executes the query and returns the updated RefreshToken entity.
+func (rtuo *RefreshTokenUpdateOne) Save(ctx context.Context) (*RefreshToken, error) {
+	return withHooks(ctx, rtuo.sqlSave, rtuo.mutation, rtuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rtuo *RefreshTokenUpdateOne) SaveX(ctx context.Context) *RefreshToken {
+	node, err := rtuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rtuo *RefreshTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := rtuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtuo *RefreshTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := rtuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rtuo *RefreshTokenUpdateOne) check() error {
+	if v, ok := rtuo.mutation.TokenHash(); ok {
+		if err := refreshtoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "RefreshToken.token_hash": %w`, err)}
+		}
+	}
+	if v, ok := rtuo.mutation.FamilyID(); ok {
+		if err := refreshtoken.FamilyIDValidator(v); err != nil {
+			return &ValidationError{Name: "family_id", err: fmt.Errorf(`ent: validator failed for field "RefreshToken.family_id": %w`, err)}
+		}
+	}
+	if v, ok := rtuo.mutation.Username(); ok {
+		if err := refreshtoken.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "RefreshToken.username": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rtuo *RefreshTokenUpdateOne) sqlSave(ctx context.Context) (_node *RefreshToken, err error) {
+	if err := rtuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(refreshtoken.Table, refreshtoken.Columns, sqlgraph.NewFieldSpec(refreshtoken.FieldID, field.TypeInt))
+	id, ok := rtuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RefreshToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rtuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, refreshtoken.FieldID)
+		for _, f := range fields {
+			if !refreshtoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != refreshtoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rtuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rtuo.mutation.TokenHash(); ok {
+		_spec.SetField(refreshtoken.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := rtuo.mutation.FamilyID(); ok {
+		_spec.SetField(refreshtoken.FieldFamilyID, field.TypeString, value)
+	}
+	if value, ok := rtuo.mutation.Username(); ok {
+		_spec.SetField(refreshtoken.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := rtuo.mutation.IP(); ok {
+		_spec.SetField(refreshtoken.FieldIP, field.TypeString, value)
+	}
+	if rtuo.mutation.IPCleared() {
+		_spec.ClearField(refreshtoken.FieldIP, field.TypeString)
+	}
+	if value, ok := rtuo.mutation.UserAgent(); ok {
+		_spec.SetField(refreshtoken.FieldUserAgent, field.TypeString, value)
+	}
+	if rtuo.mutation.UserAgentCleared() {
+		_spec.ClearField(refreshtoken.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := rtuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(refreshtoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := rtuo.mutation.RevokedAt(); ok {
+		_spec.SetField(refreshtoken.FieldRevokedAt, field.TypeTime, value)
+	}
+	if rtuo.mutation.RevokedAtCleared() {
+		_spec.ClearField(refreshtoken.FieldRevokedAt, field.TypeTime)
+	}
+	_node = &RefreshToken{config: rtuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rtuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{refreshtoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rtuo.mutation.done = true
+	return _node, nil
+}
@@ -3,10 +3,27 @@
 package ent
 
 import (
+	"goblog/ent/apikey"
 	"goblog/ent/article"
+	"goblog/ent/articlelock"
+	"goblog/ent/articlereview"
+	"goblog/ent/auditlog"
 	"goblog/ent/category"
+	"goblog/ent/comment"
+	"goblog/ent/loginattempt"
+	"goblog/ent/loginthrottle"
+	"goblog/ent/outboxevent"
+	"goblog/ent/recoverycode"
+	"goblog/ent/refreshtoken"
+	"goblog/ent/revokedtoken"
 	"goblog/ent/schema"
+	"goblog/ent/spamtoken"
 	"goblog/ent/tag"
+	"goblog/ent/tagalias"
+	"goblog/ent/twofactor"
+	"goblog/ent/user"
+	"goblog/ent/webhook"
+	"goblog/ent/webhookdelivery"
 	"time"
 )
 
@@ -14,6 +31,42 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	apikeyFields := schema.APIKey{}.Fields()
+	_ = apikeyFields
+	// apikeyDescName is the schema descriptor for name field.
+	apikeyDescName := apikeyFields[0].Descriptor()
+	// apikey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apikey.NameValidator = func() func(string) error {
+		validators := apikeyDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// apikeyDescUsername is the schema descriptor for username field.
+	apikeyDescUsername := apikeyFields[1].Descriptor()
+	// apikey.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	apikey.UsernameValidator = apikeyDescUsername.Validators[0].(func(string) error)
+	// apikeyDescPrefix is the schema descriptor for prefix field.
+	apikeyDescPrefix := apikeyFields[2].Descriptor()
+	// apikey.PrefixValidator is a validator for the "prefix" field. It is called by the builders before save.
+	apikey.PrefixValidator = apikeyDescPrefix.Validators[0].(func(string) error)
+	// apikeyDescKeyHash is the schema descriptor for key_hash field.
+	apikeyDescKeyHash := apikeyFields[3].Descriptor()
+	// apikey.KeyHashValidator is a validator for the "key_hash" field. It is called by the builders before save.
+	apikey.KeyHashValidator = apikeyDescKeyHash.Validators[0].(func(string) error)
+	// apikeyDescCreatedAt is the schema descriptor for created_at field.
+	apikeyDescCreatedAt := apikeyFields[8].Descriptor()
+	// apikey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apikey.DefaultCreatedAt = apikeyDescCreatedAt.Default.(func() time.Time)
 	articleFields := schema.Article{}.Fields()
 	_ = articleFields
 	// articleDescTitle is the schema descriptor for title field.
@@ -34,10 +87,54 @@ func init() {
 	article.DefaultUpdatedAt = articleDescUpdatedAt.Default.(func() time.Time)
 	// article.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	article.UpdateDefaultUpdatedAt = articleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// articleDescVersion is the schema descriptor for version field.
+	articleDescVersion := articleFields[6].Descriptor()
+	// article.DefaultVersion holds the default value on creation for the version field.
+	article.DefaultVersion = articleDescVersion.Default.(int)
+	// article.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	article.VersionValidator = articleDescVersion.Validators[0].(func(int) error)
 	// articleDescPublished is the schema descriptor for published field.
-	articleDescPublished := articleFields[5].Descriptor()
+	articleDescPublished := articleFields[7].Descriptor()
 	// article.DefaultPublished holds the default value on creation for the published field.
 	article.DefaultPublished = articleDescPublished.Default.(bool)
+	// articleDescWordCount is the schema descriptor for word_count field.
+	articleDescWordCount := articleFields[10].Descriptor()
+	// article.DefaultWordCount holds the default value on creation for the word_count field.
+	article.DefaultWordCount = articleDescWordCount.Default.(int)
+	// article.WordCountValidator is a validator for the "word_count" field. It is called by the builders before save.
+	article.WordCountValidator = articleDescWordCount.Validators[0].(func(int) error)
+	// articleDescReadingMinutes is the schema descriptor for reading_minutes field.
+	articleDescReadingMinutes := articleFields[11].Descriptor()
+	// article.DefaultReadingMinutes holds the default value on creation for the reading_minutes field.
+	article.DefaultReadingMinutes = articleDescReadingMinutes.Default.(int)
+	// article.ReadingMinutesValidator is a validator for the "reading_minutes" field. It is called by the builders before save.
+	article.ReadingMinutesValidator = articleDescReadingMinutes.Validators[0].(func(int) error)
+	articlelockFields := schema.ArticleLock{}.Fields()
+	_ = articlelockFields
+	// articlelockDescHolder is the schema descriptor for holder field.
+	articlelockDescHolder := articlelockFields[1].Descriptor()
+	// articlelock.HolderValidator is a validator for the "holder" field. It is called by the builders before save.
+	articlelock.HolderValidator = articlelockDescHolder.Validators[0].(func(string) error)
+	// articlelockDescAcquiredAt is the schema descriptor for acquired_at field.
+	articlelockDescAcquiredAt := articlelockFields[2].Descriptor()
+	// articlelock.DefaultAcquiredAt holds the default value on creation for the acquired_at field.
+	articlelock.DefaultAcquiredAt = articlelockDescAcquiredAt.Default.(func() time.Time)
+	articlereviewFields := schema.ArticleReview{}.Fields()
+	_ = articlereviewFields
+	// articlereviewDescCreatedAt is the schema descriptor for created_at field.
+	articlereviewDescCreatedAt := articlereviewFields[4].Descriptor()
+	// articlereview.DefaultCreatedAt holds the default value on creation for the created_at field.
+	articlereview.DefaultCreatedAt = articlereviewDescCreatedAt.Default.(func() time.Time)
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescEntityType is the schema descriptor for entity_type field.
+	auditlogDescEntityType := auditlogFields[3].Descriptor()
+	// auditlog.EntityTypeValidator is a validator for the "entity_type" field. It is called by the builders before save.
+	auditlog.EntityTypeValidator = auditlogDescEntityType.Validators[0].(func(string) error)
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogFields[10].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
 	categoryFields := schema.Category{}.Fields()
 	_ = categoryFields
 	// categoryDescName is the schema descriptor for name field.
@@ -45,15 +142,147 @@ func init() {
 	// category.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	category.NameValidator = categoryDescName.Validators[0].(func(string) error)
 	// categoryDescCreatedAt is the schema descriptor for created_at field.
-	categoryDescCreatedAt := categoryFields[2].Descriptor()
+	categoryDescCreatedAt := categoryFields[3].Descriptor()
 	// category.DefaultCreatedAt holds the default value on creation for the created_at field.
 	category.DefaultCreatedAt = categoryDescCreatedAt.Default.(func() time.Time)
 	// categoryDescUpdatedAt is the schema descriptor for updated_at field.
-	categoryDescUpdatedAt := categoryFields[3].Descriptor()
+	categoryDescUpdatedAt := categoryFields[4].Descriptor()
 	// category.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	category.DefaultUpdatedAt = categoryDescUpdatedAt.Default.(func() time.Time)
 	// category.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	category.UpdateDefaultUpdatedAt = categoryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	commentFields := schema.Comment{}.Fields()
+	_ = commentFields
+	// commentDescAuthorName is the schema descriptor for author_name field.
+	commentDescAuthorName := commentFields[0].Descriptor()
+	// comment.AuthorNameValidator is a validator for the "author_name" field. It is called by the builders before save.
+	comment.AuthorNameValidator = commentDescAuthorName.Validators[0].(func(string) error)
+	// commentDescContent is the schema descriptor for content field.
+	commentDescContent := commentFields[2].Descriptor()
+	// comment.ContentValidator is a validator for the "content" field. It is called by the builders before save.
+	comment.ContentValidator = commentDescContent.Validators[0].(func(string) error)
+	// commentDescModerated is the schema descriptor for moderated field.
+	commentDescModerated := commentFields[4].Descriptor()
+	// comment.DefaultModerated holds the default value on creation for the moderated field.
+	comment.DefaultModerated = commentDescModerated.Default.(bool)
+	// commentDescSpamScore is the schema descriptor for spam_score field.
+	commentDescSpamScore := commentFields[5].Descriptor()
+	// comment.DefaultSpamScore holds the default value on creation for the spam_score field.
+	comment.DefaultSpamScore = commentDescSpamScore.Default.(float64)
+	// commentDescCreatedAt is the schema descriptor for created_at field.
+	commentDescCreatedAt := commentFields[9].Descriptor()
+	// comment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	comment.DefaultCreatedAt = commentDescCreatedAt.Default.(func() time.Time)
+	// commentDescUpdatedAt is the schema descriptor for updated_at field.
+	commentDescUpdatedAt := commentFields[10].Descriptor()
+	// comment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	comment.DefaultUpdatedAt = commentDescUpdatedAt.Default.(func() time.Time)
+	// comment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	comment.UpdateDefaultUpdatedAt = commentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	loginattemptFields := schema.LoginAttempt{}.Fields()
+	_ = loginattemptFields
+	// loginattemptDescCreatedAt is the schema descriptor for created_at field.
+	loginattemptDescCreatedAt := loginattemptFields[5].Descriptor()
+	// loginattempt.DefaultCreatedAt holds the default value on creation for the created_at field.
+	loginattempt.DefaultCreatedAt = loginattemptDescCreatedAt.Default.(func() time.Time)
+	loginthrottleFields := schema.LoginThrottle{}.Fields()
+	_ = loginthrottleFields
+	// loginthrottleDescKey is the schema descriptor for key field.
+	loginthrottleDescKey := loginthrottleFields[0].Descriptor()
+	// loginthrottle.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	loginthrottle.KeyValidator = loginthrottleDescKey.Validators[0].(func(string) error)
+	// loginthrottleDescFailures is the schema descriptor for failures field.
+	loginthrottleDescFailures := loginthrottleFields[1].Descriptor()
+	// loginthrottle.DefaultFailures holds the default value on creation for the failures field.
+	loginthrottle.DefaultFailures = loginthrottleDescFailures.Default.(int)
+	outboxeventFields := schema.OutboxEvent{}.Fields()
+	_ = outboxeventFields
+	// outboxeventDescEventID is the schema descriptor for event_id field.
+	outboxeventDescEventID := outboxeventFields[0].Descriptor()
+	// outboxevent.EventIDValidator is a validator for the "event_id" field. It is called by the builders before save.
+	outboxevent.EventIDValidator = outboxeventDescEventID.Validators[0].(func(string) error)
+	// outboxeventDescEvent is the schema descriptor for event field.
+	outboxeventDescEvent := outboxeventFields[1].Descriptor()
+	// outboxevent.EventValidator is a validator for the "event" field. It is called by the builders before save.
+	outboxevent.EventValidator = outboxeventDescEvent.Validators[0].(func(string) error)
+	// outboxeventDescSubscriber is the schema descriptor for subscriber field.
+	outboxeventDescSubscriber := outboxeventFields[2].Descriptor()
+	// outboxevent.SubscriberValidator is a validator for the "subscriber" field. It is called by the builders before save.
+	outboxevent.SubscriberValidator = outboxeventDescSubscriber.Validators[0].(func(string) error)
+	// outboxeventDescAttempts is the schema descriptor for attempts field.
+	outboxeventDescAttempts := outboxeventFields[10].Descriptor()
+	// outboxevent.DefaultAttempts holds the default value on creation for the attempts field.
+	outboxevent.DefaultAttempts = outboxeventDescAttempts.Default.(int)
+	// outboxeventDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	outboxeventDescNextAttemptAt := outboxeventFields[11].Descriptor()
+	// outboxevent.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	outboxevent.DefaultNextAttemptAt = outboxeventDescNextAttemptAt.Default.(func() time.Time)
+	// outboxeventDescCreatedAt is the schema descriptor for created_at field.
+	outboxeventDescCreatedAt := outboxeventFields[14].Descriptor()
+	// outboxevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	outboxevent.DefaultCreatedAt = outboxeventDescCreatedAt.Default.(func() time.Time)
+	recoverycodeFields := schema.RecoveryCode{}.Fields()
+	_ = recoverycodeFields
+	// recoverycodeDescUsername is the schema descriptor for username field.
+	recoverycodeDescUsername := recoverycodeFields[0].Descriptor()
+	// recoverycode.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	recoverycode.UsernameValidator = recoverycodeDescUsername.Validators[0].(func(string) error)
+	// recoverycodeDescCodeHash is the schema descriptor for code_hash field.
+	recoverycodeDescCodeHash := recoverycodeFields[1].Descriptor()
+	// recoverycode.CodeHashValidator is a validator for the "code_hash" field. It is called by the builders before save.
+	recoverycode.CodeHashValidator = recoverycodeDescCodeHash.Validators[0].(func(string) error)
+	// recoverycodeDescCreatedAt is the schema descriptor for created_at field.
+	recoverycodeDescCreatedAt := recoverycodeFields[3].Descriptor()
+	// recoverycode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	recoverycode.DefaultCreatedAt = recoverycodeDescCreatedAt.Default.(func() time.Time)
+	refreshtokenFields := schema.RefreshToken{}.Fields()
+	_ = refreshtokenFields
+	// refreshtokenDescTokenHash is the schema descriptor for token_hash field.
+	refreshtokenDescTokenHash := refreshtokenFields[0].Descriptor()
+	// refreshtoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	refreshtoken.TokenHashValidator = refreshtokenDescTokenHash.Validators[0].(func(string) error)
+	// refreshtokenDescFamilyID is the schema descriptor for family_id field.
+	refreshtokenDescFamilyID := refreshtokenFields[1].Descriptor()
+	// refreshtoken.FamilyIDValidator is a validator for the "family_id" field. It is called by the builders before save.
+	refreshtoken.FamilyIDValidator = refreshtokenDescFamilyID.Validators[0].(func(string) error)
+	// refreshtokenDescUsername is the schema descriptor for username field.
+	refreshtokenDescUsername := refreshtokenFields[2].Descriptor()
+	// refreshtoken.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	refreshtoken.UsernameValidator = refreshtokenDescUsername.Validators[0].(func(string) error)
+	// refreshtokenDescCreatedAt is the schema descriptor for created_at field.
+	refreshtokenDescCreatedAt := refreshtokenFields[7].Descriptor()
+	// refreshtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	refreshtoken.DefaultCreatedAt = refreshtokenDescCreatedAt.Default.(func() time.Time)
+	revokedtokenFields := schema.RevokedToken{}.Fields()
+	_ = revokedtokenFields
+	// revokedtokenDescJti is the schema descriptor for jti field.
+	revokedtokenDescJti := revokedtokenFields[0].Descriptor()
+	// revokedtoken.JtiValidator is a validator for the "jti" field. It is called by the builders before save.
+	revokedtoken.JtiValidator = revokedtokenDescJti.Validators[0].(func(string) error)
+	// revokedtokenDescCreatedAt is the schema descriptor for created_at field.
+	revokedtokenDescCreatedAt := revokedtokenFields[2].Descriptor()
+	// revokedtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	revokedtoken.DefaultCreatedAt = revokedtokenDescCreatedAt.Default.(func() time.Time)
+	spamtokenFields := schema.SpamToken{}.Fields()
+	_ = spamtokenFields
+	// spamtokenDescToken is the schema descriptor for token field.
+	spamtokenDescToken := spamtokenFields[0].Descriptor()
+	// spamtoken.TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	spamtoken.TokenValidator = spamtokenDescToken.Validators[0].(func(string) error)
+	// spamtokenDescSpamCount is the schema descriptor for spam_count field.
+	spamtokenDescSpamCount := spamtokenFields[1].Descriptor()
+	// spamtoken.DefaultSpamCount holds the default value on creation for the spam_count field.
+	spamtoken.DefaultSpamCount = spamtokenDescSpamCount.Default.(int)
+	// spamtokenDescHamCount is the schema descriptor for ham_count field.
+	spamtokenDescHamCount := spamtokenFields[2].Descriptor()
+	// spamtoken.DefaultHamCount holds the default value on creation for the ham_count field.
+	spamtoken.DefaultHamCount = spamtokenDescHamCount.Default.(int)
+	// spamtokenDescUpdatedAt is the schema descriptor for updated_at field.
+	spamtokenDescUpdatedAt := spamtokenFields[3].Descriptor()
+	// spamtoken.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	spamtoken.DefaultUpdatedAt = spamtokenDescUpdatedAt.Default.(func() time.Time)
+	// spamtoken.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	spamtoken.UpdateDefaultUpdatedAt = spamtokenDescUpdatedAt.UpdateDefault.(func() time.Time)
 	tagFields := schema.Tag{}.Fields()
 	_ = tagFields
 	// tagDescName is the schema descriptor for name field.
@@ -74,4 +303,132 @@ func init() {
 	tag.DefaultUpdatedAt = tagDescUpdatedAt.Default.(func() time.Time)
 	// tag.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	tag.UpdateDefaultUpdatedAt = tagDescUpdatedAt.UpdateDefault.(func() time.Time)
+	tagaliasFields := schema.TagAlias{}.Fields()
+	_ = tagaliasFields
+	// tagaliasDescName is the schema descriptor for name field.
+	tagaliasDescName := tagaliasFields[0].Descriptor()
+	// tagalias.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tagalias.NameValidator = tagaliasDescName.Validators[0].(func(string) error)
+	// tagaliasDescCreatedAt is the schema descriptor for created_at field.
+	tagaliasDescCreatedAt := tagaliasFields[3].Descriptor()
+	// tagalias.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tagalias.DefaultCreatedAt = tagaliasDescCreatedAt.Default.(func() time.Time)
+	twofactorFields := schema.TwoFactor{}.Fields()
+	_ = twofactorFields
+	// twofactorDescUsername is the schema descriptor for username field.
+	twofactorDescUsername := twofactorFields[0].Descriptor()
+	// twofactor.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	twofactor.UsernameValidator = twofactorDescUsername.Validators[0].(func(string) error)
+	// twofactorDescSecret is the schema descriptor for secret field.
+	twofactorDescSecret := twofactorFields[1].Descriptor()
+	// twofactor.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	twofactor.SecretValidator = twofactorDescSecret.Validators[0].(func(string) error)
+	// twofactorDescEnabled is the schema descriptor for enabled field.
+	twofactorDescEnabled := twofactorFields[2].Descriptor()
+	// twofactor.DefaultEnabled holds the default value on creation for the enabled field.
+	twofactor.DefaultEnabled = twofactorDescEnabled.Default.(bool)
+	// twofactorDescLastUsedStep is the schema descriptor for last_used_step field.
+	twofactorDescLastUsedStep := twofactorFields[3].Descriptor()
+	// twofactor.DefaultLastUsedStep holds the default value on creation for the last_used_step field.
+	twofactor.DefaultLastUsedStep = twofactorDescLastUsedStep.Default.(int64)
+	// twofactorDescCreatedAt is the schema descriptor for created_at field.
+	twofactorDescCreatedAt := twofactorFields[4].Descriptor()
+	// twofactor.DefaultCreatedAt holds the default value on creation for the created_at field.
+	twofactor.DefaultCreatedAt = twofactorDescCreatedAt.Default.(func() time.Time)
+	// twofactorDescUpdatedAt is the schema descriptor for updated_at field.
+	twofactorDescUpdatedAt := twofactorFields[5].Descriptor()
+	// twofactor.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	twofactor.DefaultUpdatedAt = twofactorDescUpdatedAt.Default.(func() time.Time)
+	// twofactor.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	twofactor.UpdateDefaultUpdatedAt = twofactorDescUpdatedAt.UpdateDefault.(func() time.Time)
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescUsername is the schema descriptor for username field.
+	userDescUsername := userFields[0].Descriptor()
+	// user.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	user.UsernameValidator = userDescUsername.Validators[0].(func(string) error)
+	// userDescCreatedAt is the schema descriptor for created_at field.
+	userDescCreatedAt := userFields[7].Descriptor()
+	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
+	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	// userDescUpdatedAt is the schema descriptor for updated_at field.
+	userDescUpdatedAt := userFields[8].Descriptor()
+	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
+	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	webhookFields := schema.Webhook{}.Fields()
+	_ = webhookFields
+	// webhookDescURL is the schema descriptor for url field.
+	webhookDescURL := webhookFields[0].Descriptor()
+	// webhook.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhook.URLValidator = func() func(string) error {
+		validators := webhookDescURL.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(url string) error {
+			for _, fn := range fns {
+				if err := fn(url); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// webhookDescSecret is the schema descriptor for secret field.
+	webhookDescSecret := webhookFields[1].Descriptor()
+	// webhook.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	webhook.SecretValidator = webhookDescSecret.Validators[0].(func(string) error)
+	// webhookDescDescription is the schema descriptor for description field.
+	webhookDescDescription := webhookFields[3].Descriptor()
+	// webhook.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	webhook.DescriptionValidator = webhookDescDescription.Validators[0].(func(string) error)
+	// webhookDescActive is the schema descriptor for active field.
+	webhookDescActive := webhookFields[4].Descriptor()
+	// webhook.DefaultActive holds the default value on creation for the active field.
+	webhook.DefaultActive = webhookDescActive.Default.(bool)
+	// webhookDescCreatedAt is the schema descriptor for created_at field.
+	webhookDescCreatedAt := webhookFields[6].Descriptor()
+	// webhook.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhook.DefaultCreatedAt = webhookDescCreatedAt.Default.(func() time.Time)
+	// webhookDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookDescUpdatedAt := webhookFields[7].Descriptor()
+	// webhook.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhook.DefaultUpdatedAt = webhookDescUpdatedAt.Default.(func() time.Time)
+	// webhook.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhook.UpdateDefaultUpdatedAt = webhookDescUpdatedAt.UpdateDefault.(func() time.Time)
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescEventID is the schema descriptor for event_id field.
+	webhookdeliveryDescEventID := webhookdeliveryFields[1].Descriptor()
+	// webhookdelivery.EventIDValidator is a validator for the "event_id" field. It is called by the builders before save.
+	webhookdelivery.EventIDValidator = webhookdeliveryDescEventID.Validators[0].(func(string) error)
+	// webhookdeliveryDescEvent is the schema descriptor for event field.
+	webhookdeliveryDescEvent := webhookdeliveryFields[2].Descriptor()
+	// webhookdelivery.EventValidator is a validator for the "event" field. It is called by the builders before save.
+	webhookdelivery.EventValidator = webhookdeliveryDescEvent.Validators[0].(func(string) error)
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[5].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdeliveryDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	webhookdeliveryDescNextAttemptAt := webhookdeliveryFields[6].Descriptor()
+	// webhookdelivery.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	webhookdelivery.DefaultNextAttemptAt = webhookdeliveryDescNextAttemptAt.Default.(func() time.Time)
+	// webhookdeliveryDescResponseBody is the schema descriptor for response_body field.
+	webhookdeliveryDescResponseBody := webhookdeliveryFields[8].Descriptor()
+	// webhookdelivery.ResponseBodyValidator is a validator for the "response_body" field. It is called by the builders before save.
+	webhookdelivery.ResponseBodyValidator = webhookdeliveryDescResponseBody.Validators[0].(func(string) error)
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryFields[13].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
+	// webhookdeliveryDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookdeliveryDescUpdatedAt := webhookdeliveryFields[14].Descriptor()
+	// webhookdelivery.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhookdelivery.DefaultUpdatedAt = webhookdeliveryDescUpdatedAt.Default.(func() time.Time)
+	// webhookdelivery.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhookdelivery.UpdateDefaultUpdatedAt = webhookdeliveryDescUpdatedAt.UpdateDefault.(func() time.Time)
 }
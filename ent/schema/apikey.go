@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// APIKey holds the schema definition for the APIKey entity.
+type APIKey struct {
+	ent.Schema
+}
+
+// Fields of the APIKey.
+func (APIKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			MaxLen(100).
+			Comment("密钥名称"),
+		field.String("username").
+			NotEmpty().
+			Comment("所属用户"),
+		field.String("prefix").
+			NotEmpty().
+			Comment("密钥前缀，用于识别密钥"),
+		field.String("key_hash").
+			NotEmpty().
+			Unique().
+			Sensitive().
+			Comment("密钥摘要(SHA-256)"),
+		field.Strings("scopes").
+			Comment("权限范围"),
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Comment("过期时间，为空表示永不过期"),
+		field.Time("last_used_at").
+			Optional().
+			Nillable().
+			Comment("最近使用时间"),
+		field.Time("revoked_at").
+			Optional().
+			Nillable().
+			Comment("吊销时间"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+	}
+}
+
+// Indexes of the APIKey.
+func (APIKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("username"),
+	}
+}
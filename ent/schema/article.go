@@ -4,8 +4,10 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Article holds the schema definition for the Article entity.
@@ -32,9 +34,36 @@ func (Article) Fields() []ent.Field {
 			Default(time.Now).
 			UpdateDefault(time.Now).
 			Comment("更新时间"),
+		field.Enum("status").
+			Values("draft", "in_review", "approved", "published", "archived").
+			Default("draft").
+			Comment("编辑流程状态"),
+		field.Int("version").
+			Default(1).
+			Positive().
+			Comment("乐观锁版本号，每次修改加一"),
 		field.Bool("published").
 			Default(false).
-			Comment("是否发布"),
+			Comment("是否发布，与status保持同步以兼容旧的查询"),
+		field.Time("published_at").
+			Optional().
+			Nillable().
+			Comment("首次发布时间"),
+		field.String("author").
+			Optional().
+			Immutable().
+			Comment("作者用户名"),
+		field.Int("word_count").
+			Default(0).
+			NonNegative().
+			Comment("正文字数，中日文按字计数，其余按词计数"),
+		field.Int("reading_minutes").
+			Default(0).
+			NonNegative().
+			Comment("预计阅读分钟数"),
+		field.Text("excerpt").
+			Optional().
+			Comment("从正文生成的摘要，文章没有填写摘要时使用"),
 	}
 }
 
@@ -46,5 +75,23 @@ func (Article) Edges() []ent.Edge {
 			Unique(),
 		edge.From("tags", Tag.Type).
 			Ref("articles"),
+		edge.To("comments", Comment.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("reviews", ArticleReview.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("lock", ArticleLock.Type).
+			Unique().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Article.
+func (Article) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+		index.Fields("updated_at"),
+		index.Fields("published_at"),
+		index.Fields("title"),
+		index.Fields("author"),
 	}
 }
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// ArticleLock holds the schema definition for the ArticleLock entity.
+type ArticleLock struct {
+	ent.Schema
+}
+
+// Fields of the ArticleLock.
+func (ArticleLock) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("article_id").
+			Unique().
+			Immutable().
+			Comment("文章ID，每篇文章最多一个编辑租约"),
+		field.String("holder").
+			NotEmpty().
+			Comment("持有者用户名"),
+		field.Time("acquired_at").
+			Default(time.Now).
+			Comment("持有者获得租约的时间，续期不变"),
+		field.Time("expires_at").
+			Comment("过期时间，过期后其他人可以直接获取"),
+	}
+}
+
+// Edges of the ArticleLock.
+func (ArticleLock) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("article", Article.Type).
+			Ref("lock").
+			Field("article_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ArticleReview holds the schema definition for the ArticleReview entity.
+type ArticleReview struct {
+	ent.Schema
+}
+
+// Fields of the ArticleReview.
+func (ArticleReview) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("reviewer").
+			Immutable().
+			Comment("操作者用户名"),
+		field.String("from_status").
+			Immutable().
+			Comment("流转前状态"),
+		field.String("to_status").
+			Immutable().
+			Comment("流转后状态，仅添加备注时与流转前相同"),
+		field.Text("note").
+			Optional().
+			Immutable().
+			Comment("审阅备注"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+	}
+}
+
+// Edges of the ArticleReview.
+func (ArticleReview) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("article", Article.Type).
+			Ref("reviews").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the ArticleReview.
+func (ArticleReview) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("article").
+			Fields("created_at"),
+	}
+}
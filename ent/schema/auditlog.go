@@ -0,0 +1,80 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditChange 单个字段的变更前后值
+type AuditChange struct {
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+// AuditLog holds the schema definition for the AuditLog entity.
+// 审计日志只允许追加，所有字段都不可修改
+type AuditLog struct {
+	ent.Schema
+}
+
+// Fields of the AuditLog.
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("actor").
+			Immutable().
+			Comment("操作者用户名"),
+		field.String("actor_role").
+			Optional().
+			Immutable().
+			Comment("操作者角色"),
+		field.Int("api_key_id").
+			Optional().
+			Immutable().
+			Comment("使用API密钥操作时的密钥ID"),
+		field.String("entity_type").
+			NotEmpty().
+			Immutable().
+			Comment("对象类型"),
+		field.Int("entity_id").
+			Immutable().
+			Comment("对象ID"),
+		field.Enum("action").
+			Values("create", "update", "delete").
+			Immutable().
+			Comment("操作类型"),
+		field.JSON("changes", map[string]AuditChange{}).
+			Optional().
+			Immutable().
+			Comment("字段变更"),
+		field.String("ip").
+			Optional().
+			Immutable().
+			Comment("来源IP"),
+		field.String("request_id").
+			Optional().
+			Immutable().
+			Comment("请求ID"),
+		field.String("event_id").
+			Optional().
+			Nillable().
+			Unique().
+			Immutable().
+			Comment("发件箱事件ID，同一事件重复投递时只记录一次"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("操作时间"),
+	}
+}
+
+// Indexes of the AuditLog.
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("entity_type", "entity_id"),
+		index.Fields("actor", "created_at"),
+		index.Fields("created_at"),
+	}
+}
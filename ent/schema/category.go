@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
@@ -23,6 +24,10 @@ func (Category) Fields() []ent.Field {
 		field.String("description").
 			Optional().
 			Comment("分类描述"),
+		field.Int("parent_id").
+			Optional().
+			Nillable().
+			Comment("上级分类ID，为空时是顶级分类"),
 		field.Time("created_at").
 			Default(time.Now).
 			Comment("创建时间"),
@@ -37,5 +42,10 @@ func (Category) Fields() []ent.Field {
 func (Category) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("articles", Article.Type),
+		edge.To("children", Category.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)).
+			From("parent").
+			Unique().
+			Field("parent_id"),
 	}
 }
@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Comment holds the schema definition for the Comment entity.
+type Comment struct {
+	ent.Schema
+}
+
+// Fields of the Comment.
+func (Comment) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("author_name").
+			NotEmpty().
+			Comment("评论者昵称"),
+		field.String("author_email").
+			Optional().
+			Comment("评论者邮箱"),
+		field.Text("content").
+			NotEmpty().
+			Comment("评论内容"),
+		field.Enum("status").
+			Values("pending", "approved", "spam").
+			Default("pending").
+			Comment("审核状态"),
+		field.Bool("moderated").
+			Default(false).
+			Comment("是否已经过人工审核"),
+		field.Float("spam_score").
+			Default(0).
+			Comment("垃圾评论评分(0-1)"),
+		field.Strings("spam_reasons").
+			Optional().
+			Comment("垃圾评论评分依据"),
+		field.String("ip").
+			Optional().
+			Comment("评论者IP"),
+		field.String("user_agent").
+			Optional().
+			Comment("评论者User-Agent"),
+		field.Time("created_at").
+			Default(time.Now).
+			Comment("创建时间"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新时间"),
+	}
+}
+
+// Edges of the Comment.
+func (Comment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("article", Article.Type).
+			Ref("comments").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Comment.
+func (Comment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "created_at"),
+	}
+}
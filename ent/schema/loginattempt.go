@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LoginAttempt holds the schema definition for the LoginAttempt entity.
+type LoginAttempt struct {
+	ent.Schema
+}
+
+// Fields of the LoginAttempt.
+func (LoginAttempt) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("username").
+			Comment("尝试登录的用户名"),
+		field.String("ip").
+			Optional().
+			Comment("来源IP"),
+		field.String("user_agent").
+			Optional().
+			Comment("来源User-Agent"),
+		field.Bool("success").
+			Comment("是否登录成功"),
+		field.String("reason").
+			Optional().
+			Comment("失败原因"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("尝试时间"),
+	}
+}
+
+// Indexes of the LoginAttempt.
+func (LoginAttempt) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("username", "created_at"),
+		index.Fields("ip", "created_at"),
+	}
+}
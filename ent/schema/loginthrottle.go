@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// LoginThrottle holds the schema definition for the LoginThrottle entity.
+type LoginThrottle struct {
+	ent.Schema
+}
+
+// Fields of the LoginThrottle.
+func (LoginThrottle) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("key").
+			NotEmpty().
+			Unique().
+			Comment("限制对象，如 user:admin 或 ip:10.0.0.1"),
+		field.Int("failures").
+			Default(0).
+			Comment("连续失败次数"),
+		field.Time("last_failure_at").
+			Comment("最近一次失败时间"),
+		field.Time("locked_until").
+			Optional().
+			Nillable().
+			Comment("锁定截止时间"),
+	}
+}
@@ -0,0 +1,85 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OutboxEvent holds the schema definition for the OutboxEvent entity.
+// 领域事件与数据变更在同一事务中写入，由后台任务分发，每个订阅者一条记录
+type OutboxEvent struct {
+	ent.Schema
+}
+
+// Fields of the OutboxEvent.
+func (OutboxEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("event_id").
+			NotEmpty().
+			Immutable().
+			Comment("事件ID，同一事件的各订阅者记录相同"),
+		field.String("event").
+			NotEmpty().
+			Immutable().
+			Comment("事件名称"),
+		field.String("subscriber").
+			NotEmpty().
+			Immutable().
+			Comment("订阅者名称"),
+		field.Text("payload").
+			Immutable().
+			Comment("事件内容(JSON)"),
+		field.String("actor").
+			Optional().
+			Immutable().
+			Comment("操作者用户名"),
+		field.String("actor_role").
+			Optional().
+			Immutable().
+			Comment("操作者角色"),
+		field.Int("api_key_id").
+			Optional().
+			Immutable().
+			Comment("操作使用的API密钥ID"),
+		field.String("ip").
+			Optional().
+			Immutable().
+			Comment("来源IP"),
+		field.String("request_id").
+			Optional().
+			Immutable().
+			Comment("请求ID"),
+		field.Enum("status").
+			Values("pending", "dispatched", "dead").
+			Default("pending").
+			Comment("分发状态"),
+		field.Int("attempts").
+			Default(0).
+			Comment("已尝试次数"),
+		field.Time("next_attempt_at").
+			Default(time.Now).
+			Comment("下次尝试时间，分发中时为租约到期时间"),
+		field.String("last_error").
+			Optional().
+			Comment("最近一次错误"),
+		field.Time("dispatched_at").
+			Optional().
+			Nillable().
+			Comment("分发成功时间"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+	}
+}
+
+// Indexes of the OutboxEvent.
+func (OutboxEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_at"),
+		index.Fields("event_id"),
+	}
+}
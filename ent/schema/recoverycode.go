@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RecoveryCode holds the schema definition for the RecoveryCode entity.
+type RecoveryCode struct {
+	ent.Schema
+}
+
+// Fields of the RecoveryCode.
+func (RecoveryCode) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("username").
+			NotEmpty().
+			Comment("所属用户"),
+		field.String("code_hash").
+			NotEmpty().
+			Sensitive().
+			Comment("恢复码的SHA-256摘要"),
+		field.Time("used_at").
+			Optional().
+			Nillable().
+			Comment("使用时间，恢复码只能使用一次"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+	}
+}
+
+// Indexes of the RecoveryCode.
+func (RecoveryCode) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("username", "code_hash").Unique(),
+	}
+}
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RefreshToken holds the schema definition for the RefreshToken entity.
+type RefreshToken struct {
+	ent.Schema
+}
+
+// Fields of the RefreshToken.
+func (RefreshToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("token_hash").
+			NotEmpty().
+			Unique().
+			Sensitive().
+			Comment("刷新令牌的SHA-256摘要"),
+		field.String("family_id").
+			NotEmpty().
+			Comment("令牌家族，同一次登录轮换出的令牌属于同一家族"),
+		field.String("username").
+			NotEmpty().
+			Comment("所属用户"),
+		field.String("ip").
+			Optional().
+			Comment("签发时的IP"),
+		field.String("user_agent").
+			Optional().
+			Comment("签发时的User-Agent"),
+		field.Time("expires_at").
+			Comment("过期时间"),
+		field.Time("revoked_at").
+			Optional().
+			Nillable().
+			Comment("吊销或被轮换的时间"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+	}
+}
+
+// Indexes of the RefreshToken.
+func (RefreshToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("family_id"),
+	}
+}
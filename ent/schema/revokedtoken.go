@@ -0,0 +1,29 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// RevokedToken holds the schema definition for the RevokedToken entity.
+type RevokedToken struct {
+	ent.Schema
+}
+
+// Fields of the RevokedToken.
+func (RevokedToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("jti").
+			NotEmpty().
+			Unique().
+			Comment("被吊销的访问令牌ID"),
+		field.Time("expires_at").
+			Comment("访问令牌本身的过期时间，之后记录可以清理"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("吊销时间"),
+	}
+}
@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// SpamToken holds the schema definition for the SpamToken entity.
+type SpamToken struct {
+	ent.Schema
+}
+
+// Fields of the SpamToken.
+func (SpamToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("token").
+			NotEmpty().
+			Unique().
+			Comment("词元"),
+		field.Int("spam_count").
+			Default(0).
+			Comment("在垃圾评论中出现的次数"),
+		field.Int("ham_count").
+			Default(0).
+			Comment("在正常评论中出现的次数"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新时间"),
+	}
+}
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// TagAlias holds the schema definition for the TagAlias entity.
+type TagAlias struct {
+	ent.Schema
+}
+
+// Fields of the TagAlias.
+func (TagAlias) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			Unique().
+			Comment("旧的标签名称"),
+		field.Int("former_id").
+			Optional().
+			Nillable().
+			Unique().
+			Immutable().
+			Comment("被合并的标签原来的ID，改名产生的别名为空"),
+		field.Int("tag_id").
+			Comment("别名指向的标签ID"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+	}
+}
+
+// Edges of the TagAlias.
+func (TagAlias) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tag", Tag.Type).
+			Ref("aliases").
+			Field("tag_id").
+			Unique().
+			Required(),
+	}
+}
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// TwoFactor holds the schema definition for the TwoFactor entity.
+type TwoFactor struct {
+	ent.Schema
+}
+
+// Fields of the TwoFactor.
+func (TwoFactor) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("username").
+			NotEmpty().
+			Unique().
+			Comment("所属用户"),
+		field.String("secret").
+			NotEmpty().
+			Sensitive().
+			Comment("TOTP密钥(Base32)"),
+		field.Bool("enabled").
+			Default(false).
+			Comment("是否已完成绑定"),
+		field.Int64("last_used_step").
+			Default(0).
+			Comment("最近一次使用的时间步，防止验证码重放"),
+		field.Time("created_at").
+			Default(time.Now).
+			Comment("创建时间"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新时间"),
+	}
+}
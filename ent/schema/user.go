@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// User holds the schema definition for the User entity.
+type User struct {
+	ent.Schema
+}
+
+// Fields of the User.
+func (User) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("username").
+			NotEmpty().
+			Unique().
+			Comment("用户名"),
+		field.String("email").
+			Optional().
+			Comment("邮箱"),
+		field.String("display_name").
+			Optional().
+			Comment("显示名称"),
+		field.Enum("role").
+			Values("admin", "editor", "author").
+			Default("author").
+			Comment("角色"),
+		field.String("issuer").
+			Optional().
+			Nillable().
+			Comment("外部身份提供方"),
+		field.String("subject").
+			Optional().
+			Nillable().
+			Comment("外部身份提供方中的用户标识(sub)"),
+		field.Time("last_login_at").
+			Optional().
+			Nillable().
+			Comment("最近登录时间"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新时间"),
+	}
+}
+
+// Indexes of the User.
+func (User) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("issuer", "subject").
+			Unique(),
+	}
+}
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Webhook holds the schema definition for the Webhook entity.
+type Webhook struct {
+	ent.Schema
+}
+
+// Fields of the Webhook.
+func (Webhook) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("url").
+			NotEmpty().
+			MaxLen(2048).
+			Comment("回调地址"),
+		field.String("secret").
+			NotEmpty().
+			Sensitive().
+			Comment("签名密钥"),
+		field.Strings("events").
+			Comment("订阅的事件，*表示全部事件"),
+		field.String("description").
+			Optional().
+			MaxLen(200).
+			Comment("描述"),
+		field.Bool("active").
+			Default(true).
+			Comment("是否启用"),
+		field.String("created_by").
+			Optional().
+			Comment("创建者"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新时间"),
+	}
+}
@@ -0,0 +1,83 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery entity.
+// 投递记录同时作为持久化的重试队列和投递日志
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("webhook_id").
+			Comment("订阅ID"),
+		field.String("event_id").
+			NotEmpty().
+			Comment("事件ID，同一事件投递到多个订阅时相同"),
+		field.String("event").
+			NotEmpty().
+			Comment("事件名称"),
+		field.Text("payload").
+			Comment("请求体，签名基于原始内容"),
+		field.Enum("status").
+			Values("pending", "succeeded", "failed").
+			Default("pending").
+			Comment("投递状态"),
+		field.Int("attempts").
+			Default(0).
+			Comment("已尝试次数"),
+		field.Time("next_attempt_at").
+			Default(time.Now).
+			Comment("下次尝试时间，投递中时为租约到期时间"),
+		field.Int("response_status").
+			Optional().
+			Comment("最近一次响应状态码"),
+		field.String("response_body").
+			Optional().
+			MaxLen(1024).
+			Comment("最近一次响应内容(截断)"),
+		field.String("last_error").
+			Optional().
+			Comment("最近一次错误"),
+		field.Int("duration_ms").
+			Optional().
+			Comment("最近一次请求耗时(毫秒)"),
+		field.Int("replay_of").
+			Optional().
+			Comment("重放来源的投递ID"),
+		field.Time("delivered_at").
+			Optional().
+			Nillable().
+			Comment("投递成功时间"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新时间"),
+	}
+}
+
+// Indexes of the WebhookDelivery.
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_at"),
+		index.Fields("webhook_id", "created_at"),
+		index.Fields("event_id"),
+		// 同一事件对每个订阅只创建一次投递，重放记录沿用原事件ID，不受此约束
+		index.Fields("webhook_id", "event_id").
+			Unique().
+			Annotations(entsql.IndexWhere("replay_of = 0")),
+	}
+}
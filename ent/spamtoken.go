@@ -0,0 +1,139 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"goblog/ent/spamtoken"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// SpamToken is the model entity for the SpamToken schema.
+type SpamToken struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 词元
+	Token string `json:"token,omitempty"`
+	// 在垃圾评论中出现的次数
+	SpamCount int `json:"spam_count,omitempty"`
+	// 在正常评论中出现的次数
+	HamCount int `json:"ham_count,omitempty"`
+	// 更新时间
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SpamToken) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case spamtoken.FieldID, spamtoken.FieldSpamCount, spamtoken.FieldHamCount:
+			values[i] = new(sql.NullInt64)
+		case spamtoken.FieldToken:
+			values[i] = new(sql.NullString)
+		case spamtoken.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SpamToken fields.
+func (st *SpamToken) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case spamtoken.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			st.ID = int(value.Int64)
+		case spamtoken.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				st.Token = value.String
+			}
+		case spamtoken.FieldSpamCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field spam_count", values[i])
+			} else if value.Valid {
+				st.SpamCount = int(value.Int64)
+			}
+		case spamtoken.FieldHamCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field ham_count", values[i])
+			} else if value.Valid {
+				st.HamCount = int(value.Int64)
+			}
+		case spamtoken.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				st.UpdatedAt = value.Time
+			}
+		default:
+			st.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SpamToken.
+// This includes values selected through modifiers, order, etc.
+func (st *SpamToken) Value(name string) (ent.Value, error) {
+	return st.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SpamToken.
+// Note that you need to call SpamToken.Unwrap() before calling this method if this SpamToken
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (st *SpamToken) Update() *SpamTokenUpdateOne {
+	return NewSpamTokenClient(st.config).UpdateOne(st)
+}
+
+// Unwrap unwraps the SpamToken entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (st *SpamToken) Unwrap() *SpamToken {
+	_tx, ok := st.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SpamToken is not a transactional entity")
+	}
+	st.config.driver = _tx.drv
+	return st
+}
+
+// String implements the fmt.Stringer.
+func (st *SpamToken) String() string {
+	var builder strings.Builder
+	builder.WriteString("SpamToken(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", st.ID))
+	builder.WriteString("token=")
+	builder.WriteString(st.Token)
+	builder.WriteString(", ")
+	builder.WriteString("spam_count=")
+	builder.WriteString(fmt.Sprintf("%v", st.SpamCount))
+	builder.WriteString(", ")
+	builder.WriteString("ham_count=")
+	builder.WriteString(fmt.Sprintf("%v", st.HamCount))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(st.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SpamTokens is a parsable slice of SpamToken.
+type SpamTokens []*SpamToken
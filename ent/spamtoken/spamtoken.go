@@ -0,0 +1,86 @@
+// Code generated by ent, DO NOT EDIT.
+
+package spamtoken
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the spamtoken type in the database.
+	Label = "spam_token"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldToken holds the string denoting the token field in the database.
+	FieldToken = "token"
+	// FieldSpamCount holds the string denoting the spam_count field in the database.
+	FieldSpamCount = "spam_count"
+	// FieldHamCount holds the string denoting the ham_count field in the database.
+	FieldHamCount = "ham_count"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the spamtoken in the database.
+	Table = "spam_tokens"
+)
+
+// Columns holds all SQL columns for spamtoken fields.
+var Columns = []string{
+	FieldID,
+	FieldToken,
+	FieldSpamCount,
+	FieldHamCount,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	TokenValidator func(string) error
+	// DefaultSpamCount holds the default value on creation for the "spam_count" field.
+	DefaultSpamCount int
+	// DefaultHamCount holds the default value on creation for the "ham_count" field.
+	DefaultHamCount int
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the SpamToken queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByToken orders the results by the token field.
+func ByToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToken, opts...).ToFunc()
+}
+
+// BySpamCount orders the results by the spam_count field.
+func BySpamCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpamCount, opts...).ToFunc()
+}
+
+// ByHamCount orders the results by the ham_count field.
+func ByHamCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHamCount, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,275 @@
+// Code generated by ent, DO NOT EDIT.
+
+package spamtoken
+
+import (
+	"goblog/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldLTE(FieldID, id))
+}
+
+// Token applies equality check predicate on the "token" field. It's identical to TokenEQ.
+func Token(v string) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldEQ(FieldToken, v))
+}
+
+// SpamCount applies equality check predicate on the "spam_count" field. It's identical to SpamCountEQ.
+func SpamCount(v int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldEQ(FieldSpamCount, v))
+}
+
+// HamCount applies equality check predicate on the "ham_count" field. It's identical to HamCountEQ.
+func HamCount(v int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldEQ(FieldHamCount, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TokenEQ applies the EQ predicate on the "token" field.
+func TokenEQ(v string) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldEQ(FieldToken, v))
+}
+
+// TokenNEQ applies the NEQ predicate on the "token" field.
+func TokenNEQ(v string) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldNEQ(FieldToken, v))
+}
+
+// TokenIn applies the In predicate on the "token" field.
+func TokenIn(vs ...string) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldIn(FieldToken, vs...))
+}
+
+// TokenNotIn applies the NotIn predicate on the "token" field.
+func TokenNotIn(vs ...string) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldNotIn(FieldToken, vs...))
+}
+
+// TokenGT applies the GT predicate on the "token" field.
+func TokenGT(v string) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldGT(FieldToken, v))
+}
+
+// TokenGTE applies the GTE predicate on the "token" field.
+func TokenGTE(v string) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldGTE(FieldToken, v))
+}
+
+// TokenLT applies the LT predicate on the "token" field.
+func TokenLT(v string) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldLT(FieldToken, v))
+}
+
+// TokenLTE applies the LTE predicate on the "token" field.
+func TokenLTE(v string) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldLTE(FieldToken, v))
+}
+
+// TokenContains applies the Contains predicate on the "token" field.
+func TokenContains(v string) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldContains(FieldToken, v))
+}
+
+// TokenHasPrefix applies the HasPrefix predicate on the "token" field.
+func TokenHasPrefix(v string) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldHasPrefix(FieldToken, v))
+}
+
+// TokenHasSuffix applies the HasSuffix predicate on the "token" field.
+func TokenHasSuffix(v string) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldHasSuffix(FieldToken, v))
+}
+
+// TokenEqualFold applies the EqualFold predicate on the "token" field.
+func TokenEqualFold(v string) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldEqualFold(FieldToken, v))
+}
+
+// TokenContainsFold applies the ContainsFold predicate on the "token" field.
+func TokenContainsFold(v string) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldContainsFold(FieldToken, v))
+}
+
+// SpamCountEQ applies the EQ predicate on the "spam_count" field.
+func SpamCountEQ(v int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldEQ(FieldSpamCount, v))
+}
+
+// SpamCountNEQ applies the NEQ predicate on the "spam_count" field.
+func SpamCountNEQ(v int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldNEQ(FieldSpamCount, v))
+}
+
+// SpamCountIn applies the In predicate on the "spam_count" field.
+func SpamCountIn(vs ...int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldIn(FieldSpamCount, vs...))
+}
+
+// SpamCountNotIn applies the NotIn predicate on the "spam_count" field.
+func SpamCountNotIn(vs ...int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldNotIn(FieldSpamCount, vs...))
+}
+
+// SpamCountGT applies the GT predicate on the "spam_count" field.
+func SpamCountGT(v int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldGT(FieldSpamCount, v))
+}
+
+// SpamCountGTE applies the GTE predicate on the "spam_count" field.
+func SpamCountGTE(v int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldGTE(FieldSpamCount, v))
+}
+
+// SpamCountLT applies the LT predicate on the "spam_count" field.
+func SpamCountLT(v int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldLT(FieldSpamCount, v))
+}
+
+// SpamCountLTE applies the LTE predicate on the "spam_count" field.
+func SpamCountLTE(v int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldLTE(FieldSpamCount, v))
+}
+
+// HamCountEQ applies the EQ predicate on the "ham_count" field.
+func HamCountEQ(v int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldEQ(FieldHamCount, v))
+}
+
+// HamCountNEQ applies the NEQ predicate on the "ham_count" field.
+func HamCountNEQ(v int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldNEQ(FieldHamCount, v))
+}
+
+// HamCountIn applies the In predicate on the "ham_count" field.
+func HamCountIn(vs ...int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldIn(FieldHamCount, vs...))
+}
+
+// HamCountNotIn applies the NotIn predicate on the "ham_count" field.
+func HamCountNotIn(vs ...int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldNotIn(FieldHamCount, vs...))
+}
+
+// HamCountGT applies the GT predicate on the "ham_count" field.
+func HamCountGT(v int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldGT(FieldHamCount, v))
+}
+
+// HamCountGTE applies the GTE predicate on the "ham_count" field.
+func HamCountGTE(v int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldGTE(FieldHamCount, v))
+}
+
+// HamCountLT applies the LT predicate on the "ham_count" field.
+func HamCountLT(v int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldLT(FieldHamCount, v))
+}
+
+// HamCountLTE applies the LTE predicate on the "ham_count" field.
+func HamCountLTE(v int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldLTE(FieldHamCount, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SpamToken) predicate.SpamToken {
+	return predicate.SpamToken(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SpamToken) predicate.SpamToken {
+	return predicate.SpamToken(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SpamToken) predicate.SpamToken {
+	return predicate.SpamToken(sql.NotPredicates(p))
+}
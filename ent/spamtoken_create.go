@@ -0,0 +1,701 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"goblog/ent/spamtoken"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SpamTokenCreate is the builder for creating a SpamToken entity.
+type SpamTokenCreate struct {
+	config
+	mutation *SpamTokenMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetToken sets the "token" field.
+func (stc *SpamTokenCreate) SetToken(s string) *SpamTokenCreate {
+	stc.mutation.SetToken(s)
+	return stc
+}
+
+// SetSpamCount sets the "spam_count" field.
+func (stc *SpamTokenCreate) SetSpamCount(i int) *SpamTokenCreate {
+	stc.mutation.SetSpamCount(i)
+	return stc
+}
+
+// SetNillableSpamCount sets the "spam_count" field if the given value is not nil.
+func (stc *SpamTokenCreate) SetNillableSpamCount(i *int) *SpamTokenCreate {
+	if i != nil {
+		stc.SetSpamCount(*i)
+	}
+	return stc
+}
+
+// SetHamCount sets the "ham_count" field.
+func (stc *SpamTokenCreate) SetHamCount(i int) *SpamTokenCreate {
+	stc.mutation.SetHamCount(i)
+	return stc
+}
+
+// SetNillableHamCount sets the "ham_count" field if the given value is not nil.
+func (stc *SpamTokenCreate) SetNillableHamCount(i *int) *SpamTokenCreate {
+	if i != nil {
+		stc.SetHamCount(*i)
+	}
+	return stc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (stc *SpamTokenCreate) SetUpdatedAt(t time.Time) *SpamTokenCreate {
+	stc.mutation.SetUpdatedAt(t)
+	return stc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (stc *SpamTokenCreate) SetNillableUpdatedAt(t *time.Time) *SpamTokenCreate {
+	if t != nil {
+		stc.SetUpdatedAt(*t)
+	}
+	return stc
+}
+
+// Mutation returns the SpamTokenMutation object of the builder.
+func (stc *SpamTokenCreate) Mutation() *SpamTokenMutation {
+	return stc.mutation
+}
+
+// Save creates the SpamToken in the database.
+func (stc *SpamTokenCreate) Save(ctx context.Context) (*SpamToken, error) {
+	stc.defaults()
+	return withHooks(ctx, stc.sqlSave, stc.mutation, stc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (stc *SpamTokenCreate) SaveX(ctx context.Context) *SpamToken {
+	v, err := stc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (stc *SpamTokenCreate) Exec(ctx context.Context) error {
+	_, err := stc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (stc *SpamTokenCreate) ExecX(ctx context.Context) {
+	if err := stc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (stc *SpamTokenCreate) defaults() {
+	if _, ok := stc.mutation.SpamCount(); !ok {
+		v := spamtoken.DefaultSpamCount
+		stc.mutation.SetSpamCount(v)
+	}
+	if _, ok := stc.mutation.HamCount(); !ok {
+		v := spamtoken.DefaultHamCount
+		stc.mutation.SetHamCount(v)
+	}
+	if _, ok := stc.mutation.UpdatedAt(); !ok {
+		v := spamtoken.DefaultUpdatedAt()
+		stc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (stc *SpamTokenCreate) check() error {
+	if _, ok := stc.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "SpamToken.token"`)}
+	}
+	if v, ok := stc.mutation.Token(); ok {
+		if err := spamtoken.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "SpamToken.token": %w`, err)}
+		}
+	}
+	if _, ok := stc.mutation.SpamCount(); !ok {
+		return &ValidationError{Name: "spam_count", err: errors.New(`ent: missing required field "SpamToken.spam_count"`)}
+	}
+	if _, ok := stc.mutation.HamCount(); !ok {
+		return &ValidationError{Name: "ham_count", err: errors.New(`ent: missing required field "SpamToken.ham_count"`)}
+	}
+	if _, ok := stc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SpamToken.updated_at"`)}
+	}
+	return nil
+}
+
+func (stc *SpamTokenCreate) sqlSave(ctx context.Context) (*SpamToken, error) {
+	if err := stc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := stc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, stc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	stc.mutation.id = &_node.ID
+	stc.mutation.done = true
+	return _node, nil
+}
+
+func (stc *SpamTokenCreate) createSpec() (*SpamToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SpamToken{config: stc.config}
+		_spec = sqlgraph.NewCreateSpec(spamtoken.Table, sqlgraph.NewFieldSpec(spamtoken.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = stc.conflict
+	if value, ok := stc.mutation.Token(); ok {
+		_spec.SetField(spamtoken.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := stc.mutation.SpamCount(); ok {
+		_spec.SetField(spamtoken.FieldSpamCount, field.TypeInt, value)
+		_node.SpamCount = value
+	}
+	if value, ok := stc.mutation.HamCount(); ok {
+		_spec.SetField(spamtoken.FieldHamCount, field.TypeInt, value)
+		_node.HamCount = value
+	}
+	if value, ok := stc.mutation.UpdatedAt(); ok {
+		_spec.SetField(spamtoken.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SpamToken.Create().
+//		SetToken(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SpamTokenUpsert) {
+//			SetToken(v+v).
+//		}).
+//		Exec(ctx)
+func (stc *SpamTokenCreate) OnConflict(opts ...sql.ConflictOption) *SpamTokenUpsertOne {
+	stc.conflict = opts
+	return &SpamTokenUpsertOne{
+		create: stc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SpamToken.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (stc *SpamTokenCreate) OnConflictColumns(columns ...string) *SpamTokenUpsertOne {
+	stc.conflict = append(stc.conflict, sql.ConflictColumns(columns...))
+	return &SpamTokenUpsertOne{
+		create: stc,
+	}
+}
+
+type (
+	// SpamTokenUpsertOne is the builder for "upsert"-ing
+	//  one SpamToken node.
+	SpamTokenUpsertOne struct {
+		create *SpamTokenCreate
+	}
+
+	// SpamTokenUpsert is the "OnConflict" setter.
+	SpamTokenUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetToken sets the "token" field.
+func (u *SpamTokenUpsert) SetToken(v string) *SpamTokenUpsert {
+	u.Set(spamtoken.FieldToken, v)
+	return u
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *SpamTokenUpsert) UpdateToken() *SpamTokenUpsert {
+	u.SetExcluded(spamtoken.FieldToken)
+	return u
+}
+
+// SetSpamCount sets the "spam_count" field.
+func (u *SpamTokenUpsert) SetSpamCount(v int) *SpamTokenUpsert {
+	u.Set(spamtoken.FieldSpamCount, v)
+	return u
+}
+
+// UpdateSpamCount sets the "spam_count" field to the value that was provided on create.
+func (u *SpamTokenUpsert) UpdateSpamCount() *SpamTokenUpsert {
+	u.SetExcluded(spamtoken.FieldSpamCount)
+	return u
+}
+
+// AddSpamCount adds v to the "spam_count" field.
+func (u *SpamTokenUpsert) AddSpamCount(v int) *SpamTokenUpsert {
+	u.Add(spamtoken.FieldSpamCount, v)
+	return u
+}
+
+// SetHamCount sets the "ham_count" field.
+func (u *SpamTokenUpsert) SetHamCount(v int) *SpamTokenUpsert {
+	u.Set(spamtoken.FieldHamCount, v)
+	return u
+}
+
+// UpdateHamCount sets the "ham_count" field to the value that was provided on create.
+func (u *SpamTokenUpsert) UpdateHamCount() *SpamTokenUpsert {
+	u.SetExcluded(spamtoken.FieldHamCount)
+	return u
+}
+
+// AddHamCount adds v to the "ham_count" field.
+func (u *SpamTokenUpsert) AddHamCount(v int) *SpamTokenUpsert {
+	u.Add(spamtoken.FieldHamCount, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SpamTokenUpsert) SetUpdatedAt(v time.Time) *SpamTokenUpsert {
+	u.Set(spamtoken.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SpamTokenUpsert) UpdateUpdatedAt() *SpamTokenUpsert {
+	u.SetExcluded(spamtoken.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.SpamToken.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SpamTokenUpsertOne) UpdateNewValues() *SpamTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SpamToken.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SpamTokenUpsertOne) Ignore() *SpamTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SpamTokenUpsertOne) DoNothing() *SpamTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SpamTokenCreate.OnConflict
+// documentation for more info.
+func (u *SpamTokenUpsertOne) Update(set func(*SpamTokenUpsert)) *SpamTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SpamTokenUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetToken sets the "token" field.
+func (u *SpamTokenUpsertOne) SetToken(v string) *SpamTokenUpsertOne {
+	return u.Update(func(s *SpamTokenUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *SpamTokenUpsertOne) UpdateToken() *SpamTokenUpsertOne {
+	return u.Update(func(s *SpamTokenUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// SetSpamCount sets the "spam_count" field.
+func (u *SpamTokenUpsertOne) SetSpamCount(v int) *SpamTokenUpsertOne {
+	return u.Update(func(s *SpamTokenUpsert) {
+		s.SetSpamCount(v)
+	})
+}
+
+// AddSpamCount adds v to the "spam_count" field.
+func (u *SpamTokenUpsertOne) AddSpamCount(v int) *SpamTokenUpsertOne {
+	return u.Update(func(s *SpamTokenUpsert) {
+		s.AddSpamCount(v)
+	})
+}
+
+// UpdateSpamCount sets the "spam_count" field to the value that was provided on create.
+func (u *SpamTokenUpsertOne) UpdateSpamCount() *SpamTokenUpsertOne {
+	return u.Update(func(s *SpamTokenUpsert) {
+		s.UpdateSpamCount()
+	})
+}
+
+// SetHamCount sets the "ham_count" field.
+func (u *SpamTokenUpsertOne) SetHamCount(v int) *SpamTokenUpsertOne {
+	return u.Update(func(s *SpamTokenUpsert) {
+		s.SetHamCount(v)
+	})
+}
+
+// AddHamCount adds v to the "ham_count" field.
+func (u *SpamTokenUpsertOne) AddHamCount(v int) *SpamTokenUpsertOne {
+	return u.Update(func(s *SpamTokenUpsert) {
+		s.AddHamCount(v)
+	})
+}
+
+// UpdateHamCount sets the "ham_count" field to the value that was provided on create.
+func (u *SpamTokenUpsertOne) UpdateHamCount() *SpamTokenUpsertOne {
+	return u.Update(func(s *SpamTokenUpsert) {
+		s.UpdateHamCount()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SpamTokenUpsertOne) SetUpdatedAt(v time.Time) *SpamTokenUpsertOne {
+	return u.Update(func(s *SpamTokenUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SpamTokenUpsertOne) UpdateUpdatedAt() *SpamTokenUpsertOne {
+	return u.Update(func(s *SpamTokenUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SpamTokenUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SpamTokenCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SpamTokenUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SpamTokenUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SpamTokenUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SpamTokenCreateBulk is the builder for creating many SpamToken entities in bulk.
+type SpamTokenCreateBulk struct {
+	config
+	err      error
+	builders []*SpamTokenCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SpamToken entities in the database.
+func (stcb *SpamTokenCreateBulk) Save(ctx context.Context) ([]*SpamToken, error) {
+	if stcb.err != nil {
+		return nil, stcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(stcb.builders))
+	nodes := make([]*SpamToken, len(stcb.builders))
+	mutators := make([]Mutator, len(stcb.builders))
+	for i := range stcb.builders {
+		func(i int, root context.Context) {
+			builder := stcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SpamTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, stcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = stcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, stcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, stcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (stcb *SpamTokenCreateBulk) SaveX(ctx context.Context) []*SpamToken {
+	v, err := stcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (stcb *SpamTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := stcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (stcb *SpamTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := stcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SpamToken.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SpamTokenUpsert) {
+//			SetToken(v+v).
+//		}).
+//		Exec(ctx)
+func (stcb *SpamTokenCreateBulk) OnConflict(opts ...sql.ConflictOption) *SpamTokenUpsertBulk {
+	stcb.conflict = opts
+	return &SpamTokenUpsertBulk{
+		create: stcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SpamToken.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (stcb *SpamTokenCreateBulk) OnConflictColumns(columns ...string) *SpamTokenUpsertBulk {
+	stcb.conflict = append(stcb.conflict, sql.ConflictColumns(columns...))
+	return &SpamTokenUpsertBulk{
+		create: stcb,
+	}
+}
+
+// SpamTokenUpsertBulk is the builder for "upsert"-ing
+// a bulk of SpamToken nodes.
+type SpamTokenUpsertBulk struct {
+	create *SpamTokenCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SpamToken.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SpamTokenUpsertBulk) UpdateNewValues() *SpamTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SpamToken.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SpamTokenUpsertBulk) Ignore() *SpamTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SpamTokenUpsertBulk) DoNothing() *SpamTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SpamTokenCreateBulk.OnConflict
+// documentation for more info.
+func (u *SpamTokenUpsertBulk) Update(set func(*SpamTokenUpsert)) *SpamTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SpamTokenUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetToken sets the "token" field.
+func (u *SpamTokenUpsertBulk) SetToken(v string) *SpamTokenUpsertBulk {
+	return u.Update(func(s *SpamTokenUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *SpamTokenUpsertBulk) UpdateToken() *SpamTokenUpsertBulk {
+	return u.Update(func(s *SpamTokenUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// SetSpamCount sets the "spam_count" field.
+func (u *SpamTokenUpsertBulk) SetSpamCount(v int) *SpamTokenUpsertBulk {
+	return u.Update(func(s *SpamTokenUpsert) {
+		s.SetSpamCount(v)
+	})
+}
+
+// AddSpamCount adds v to the "spam_count" field.
+func (u *SpamTokenUpsertBulk) AddSpamCount(v int) *SpamTokenUpsertBulk {
+	return u.Update(func(s *SpamTokenUpsert) {
+		s.AddSpamCount(v)
+	})
+}
+
+// UpdateSpamCount sets the "spam_count" field to the value that was provided on create.
+func (u *SpamTokenUpsertBulk) UpdateSpamCount() *SpamTokenUpsertBulk {
+	return u.Update(func(s *SpamTokenUpsert) {
+		s.UpdateSpamCount()
+	})
+}
+
+// SetHamCount sets the "ham_count" field.
+func (u *SpamTokenUpsertBulk) SetHamCount(v int) *SpamTokenUpsertBulk {
+	return u.Update(func(s *SpamTokenUpsert) {
+		s.SetHamCount(v)
+	})
+}
+
+// AddHamCount adds v to the "ham_count" field.
+func (u *SpamTokenUpsertBulk) AddHamCount(v int) *SpamTokenUpsertBulk {
+	return u.Update(func(s *SpamTokenUpsert) {
+		s.AddHamCount(v)
+	})
+}
+
+// UpdateHamCount sets the "ham_count" field to the value that was provided on create.
+func (u *SpamTokenUpsertBulk) UpdateHamCount() *SpamTokenUpsertBulk {
+	return u.Update(func(s *SpamTokenUpsert) {
+		s.UpdateHamCount()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SpamTokenUpsertBulk) SetUpdatedAt(v time.Time) *SpamTokenUpsertBulk {
+	return u.Update(func(s *SpamTokenUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SpamTokenUpsertBulk) UpdateUpdatedAt() *SpamTokenUpsertBulk {
+	return u.Update(func(s *SpamTokenUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SpamTokenUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SpamTokenCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SpamTokenCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SpamTokenUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
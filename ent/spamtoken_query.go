@@ -0,0 +1,564 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"goblog/ent/predicate"
+	"goblog/ent/spamtoken"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SpamTokenQuery is the builder for querying SpamToken entities.
+type SpamTokenQuery struct {
+	config
+	ctx        *QueryContext
+	order      []spamtoken.OrderOption
+	inters     []Interceptor
+	predicates []predicate.SpamToken
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SpamTokenQuery builder.
+func (stq *SpamTokenQuery) Where(ps ...predicate.SpamToken) *SpamTokenQuery {
+	stq.predicates = append(stq.predicates, ps...)
+	return stq
+}
+
+// Limit the number of records to be returned by this query.
+func (stq *SpamTokenQuery) Limit(limit int) *SpamTokenQuery {
+	stq.ctx.Limit = &limit
+	return stq
+}
+
+// Offset to start from.
+func (stq *SpamTokenQuery) Offset(offset int) *SpamTokenQuery {
+	stq.ctx.Offset = &offset
+	return stq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (stq *SpamTokenQuery) Unique(unique bool) *SpamTokenQuery {
+	stq.ctx.Unique = &unique
+	return stq
+}
+
+// Order specifies how the records should be ordered.
+func (stq *SpamTokenQuery) Order(o ...spamtoken.OrderOption) *SpamTokenQuery {
+	stq.order = append(stq.order, o...)
+	return stq
+}
+
+// First returns the first SpamToken entity from the query.
+// Returns a *NotFoundError when no SpamToken was found.
+func (stq *SpamTokenQuery) First(ctx context.Context) (*SpamToken, error) {
+	nodes, err := stq.Limit(1).All(setContextOp(ctx, stq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{spamtoken.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (stq *SpamTokenQuery) FirstX(ctx context.Context) *SpamToken {
+	node, err := stq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SpamToken ID from the query.
+// Returns a *NotFoundError when no SpamToken ID was found.
+func (stq *SpamTokenQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = stq.Limit(1).IDs(setContextOp(ctx, stq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{spamtoken.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (stq *SpamTokenQuery) FirstIDX(ctx context.Context) int {
+	id, err := stq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SpamToken entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SpamToken entity is found.
+// Returns a *NotFoundError when no SpamToken entities are found.
+func (stq *SpamTokenQuery) Only(ctx context.Context) (*SpamToken, error) {
+	nodes, err := stq.Limit(2).All(setContextOp(ctx, stq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{spamtoken.Label}
+	default:
+		return nil, &NotSingularError{spamtoken.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (stq *SpamTokenQuery) OnlyX(ctx context.Context) *SpamToken {
+	node, err := stq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SpamToken ID in the query.
+// Returns a *NotSingularError when more than one SpamToken ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (stq *SpamTokenQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = stq.Limit(2).IDs(setContextOp(ctx, stq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{spamtoken.Label}
+	default:
+		err = &NotSingularError{spamtoken.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (stq *SpamTokenQuery) OnlyIDX(ctx context.Context) int {
+	id, err := stq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SpamTokens.
+func (stq *SpamTokenQuery) All(ctx context.Context) ([]*SpamToken, error) {
+	ctx = setContextOp(ctx, stq.ctx, ent.OpQueryAll)
+	if err := stq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SpamToken, *SpamTokenQuery]()
+	return withInterceptors[[]*SpamToken](ctx, stq, qr, stq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (stq *SpamTokenQuery) AllX(ctx context.Context) []*SpamToken {
+	nodes, err := stq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SpamToken IDs.
+func (stq *SpamTokenQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if stq.ctx.Unique == nil && stq.path != nil {
+		stq.Unique(true)
+	}
+	ctx = setContextOp(ctx, stq.ctx, ent.OpQueryIDs)
+	if err = stq.Select(spamtoken.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (stq *SpamTokenQuery) IDsX(ctx context.Context) []int {
+	ids, err := stq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (stq *SpamTokenQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, stq.ctx, ent.OpQueryCount)
+	if err := stq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, stq, querierCount[*SpamTokenQuery](), stq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (stq *SpamTokenQuery) CountX(ctx context.Context) int {
+	count, err := stq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (stq *SpamTokenQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, stq.ctx, ent.OpQueryExist)
+	switch _, err := stq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (stq *SpamTokenQuery) ExistX(ctx context.Context) bool {
+	exist, err := stq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SpamTokenQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (stq *SpamTokenQuery) Clone() *SpamTokenQuery {
+	if stq == nil {
+		return nil
+	}
+	return &SpamTokenQuery{
+		config:     stq.config,
+		ctx:        stq.ctx.Clone(),
+		order:      append([]spamtoken.OrderOption{}, stq.order...),
+		inters:     append([]Interceptor{}, stq.inters...),
+		predicates: append([]predicate.SpamToken{}, stq.predicates...),
+		// clone intermediate query.
+		sql:  stq.sql.Clone(),
+		path: stq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Token string `json:"token,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SpamToken.Query().
+//		GroupBy(spamtoken.FieldToken).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (stq *SpamTokenQuery) GroupBy(field string, fields ...string) *SpamTokenGroupBy {
+	stq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SpamTokenGroupBy{build: stq}
+	grbuild.flds = &stq.ctx.Fields
+	grbuild.label = spamtoken.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Token string `json:"token,omitempty"`
+//	}
+//
+//	client.SpamToken.Query().
+//		Select(spamtoken.FieldToken).
+//		Scan(ctx, &v)
+func (stq *SpamTokenQuery) Select(fields ...string) *SpamTokenSelect {
+	stq.ctx.Fields = append(stq.ctx.Fields, fields...)
+	sbuild := &SpamTokenSelect{SpamTokenQuery: stq}
+	sbuild.label = spamtoken.Label
+	sbuild.flds, sbuild.scan = &stq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SpamTokenSelect configured with the given aggregations.
+func (stq *SpamTokenQuery) Aggregate(fns ...AggregateFunc) *SpamTokenSelect {
+	return stq.Select().Aggregate(fns...)
+}
+
+func (stq *SpamTokenQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range stq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, stq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range stq.ctx.Fields {
+		if !spamtoken.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if stq.path != nil {
+		prev, err := stq.path(ctx)
+		if err != nil {
+			return err
+		}
+		stq.sql = prev
+	}
+	return nil
+}
+
+func (stq *SpamTokenQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SpamToken, error) {
+	var (
+		nodes = []*SpamToken{}
+		_spec = stq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SpamToken).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SpamToken{config: stq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(stq.modifiers) > 0 {
+		_spec.Modifiers = stq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, stq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (stq *SpamTokenQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := stq.querySpec()
+	if len(stq.modifiers) > 0 {
+		_spec.Modifiers = stq.modifiers
+	}
+	_spec.Node.Columns = stq.ctx.Fields
+	if len(stq.ctx.Fields) > 0 {
+		_spec.Unique = stq.ctx.Unique != nil && *stq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, stq.driver, _spec)
+}
+
+func (stq *SpamTokenQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(spamtoken.Table, spamtoken.Columns, sqlgraph.NewFieldSpec(spamtoken.FieldID, field.TypeInt))
+	_spec.From = stq.sql
+	if unique := stq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if stq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := stq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, spamtoken.FieldID)
+		for i := range fields {
+			if fields[i] != spamtoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := stq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := stq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := stq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := stq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (stq *SpamTokenQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(stq.driver.Dialect())
+	t1 := builder.Table(spamtoken.Table)
+	columns := stq.ctx.Fields
+	if len(columns) == 0 {
+		columns = spamtoken.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if stq.sql != nil {
+		selector = stq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if stq.ctx.Unique != nil && *stq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range stq.modifiers {
+		m(selector)
+	}
+	for _, p := range stq.predicates {
+		p(selector)
+	}
+	for _, p := range stq.order {
+		p(selector)
+	}
+	if offset := stq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := stq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (stq *SpamTokenQuery) ForUpdate(opts ...sql.LockOption) *SpamTokenQuery {
+	if stq.driver.Dialect() == dialect.Postgres {
+		stq.Unique(false)
+	}
+	stq.modifiers = append(stq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return stq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (stq *SpamTokenQuery) ForShare(opts ...sql.LockOption) *SpamTokenQuery {
+	if stq.driver.Dialect() == dialect.Postgres {
+		stq.Unique(false)
+	}
+	stq.modifiers = append(stq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return stq
+}
+
+// SpamTokenGroupBy is the group-by builder for SpamToken entities.
+type SpamTokenGroupBy struct {
+	selector
+	build *SpamTokenQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (stgb *SpamTokenGroupBy) Aggregate(fns ...AggregateFunc) *SpamTokenGroupBy {
+	stgb.fns = append(stgb.fns, fns...)
+	return stgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (stgb *SpamTokenGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, stgb.build.ctx, ent.OpQueryGroupBy)
+	if err := stgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SpamTokenQuery, *SpamTokenGroupBy](ctx, stgb.build, stgb, stgb.build.inters, v)
+}
+
+func (stgb *SpamTokenGroupBy) sqlScan(ctx context.Context, root *SpamTokenQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(stgb.fns))
+	for _, fn := range stgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*stgb.flds)+len(stgb.fns))
+		for _, f := range *stgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*stgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := stgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SpamTokenSelect is the builder for selecting fields of SpamToken entities.
+type SpamTokenSelect struct {
+	*SpamTokenQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sts *SpamTokenSelect) Aggregate(fns ...AggregateFunc) *SpamTokenSelect {
+	sts.fns = append(sts.fns, fns...)
+	return sts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sts *SpamTokenSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sts.ctx, ent.OpQuerySelect)
+	if err := sts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SpamTokenQuery, *SpamTokenSelect](ctx, sts.SpamTokenQuery, sts, sts.inters, v)
+}
+
+func (sts *SpamTokenSelect) sqlScan(ctx context.Context, root *SpamTokenQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sts.fns))
+	for _, fn := range sts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
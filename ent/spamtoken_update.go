@@ -0,0 +1,380 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"goblog/ent/predicate"
+	"goblog/ent/spamtoken"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SpamTokenUpdate is the builder for updating SpamToken entities.
+type SpamTokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SpamTokenMutation
+}
+
+// Where appends a list predicates to the SpamTokenUpdate builder.
+func (stu *SpamTokenUpdate) Where(ps ...predicate.SpamToken) *SpamTokenUpdate {
+	stu.mutation.Where(ps...)
+	return stu
+}
+
+// SetToken sets the "token" field.
+func (stu *SpamTokenUpdate) SetToken(s string) *SpamTokenUpdate {
+	stu.mutation.SetToken(s)
+	return stu
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (stu *SpamTokenUpdate) SetNillableToken(s *string) *SpamTokenUpdate {
+	if s != nil {
+		stu.SetToken(*s)
+	}
+	return stu
+}
+
+// SetSpamCount sets the "spam_count" field.
+func (stu *SpamTokenUpdate) SetSpamCount(i int) *SpamTokenUpdate {
+	stu.mutation.ResetSpamCount()
+	stu.mutation.SetSpamCount(i)
+	return stu
+}
+
+// SetNillableSpamCount sets the "spam_count" field if the given value is not nil.
+func (stu *SpamTokenUpdate) SetNillableSpamCount(i *int) *SpamTokenUpdate {
+	if i != nil {
+		stu.SetSpamCount(*i)
+	}
+	return stu
+}
+
+// AddSpamCount adds i to the "spam_count" field.
+func (stu *SpamTokenUpdate) AddSpamCount(i int) *SpamTokenUpdate {
+	stu.mutation.AddSpamCount(i)
+	return stu
+}
+
+// SetHamCount sets the "ham_count" field.
+func (stu *SpamTokenUpdate) SetHamCount(i int) *SpamTokenUpdate {
+	stu.mutation.ResetHamCount()
+	stu.mutation.SetHamCount(i)
+	return stu
+}
+
+// SetNillableHamCount sets the "ham_count" field if the given value is not nil.
+func (stu *SpamTokenUpdate) SetNillableHamCount(i *int) *SpamTokenUpdate {
+	if i != nil {
+		stu.SetHamCount(*i)
+	}
+	return stu
+}
+
+// AddHamCount adds i to the "ham_count" field.
+func (stu *SpamTokenUpdate) AddHamCount(i int) *SpamTokenUpdate {
+	stu.mutation.AddHamCount(i)
+	return stu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (stu *SpamTokenUpdate) SetUpdatedAt(t time.Time) *SpamTokenUpdate {
+	stu.mutation.SetUpdatedAt(t)
+	return stu
+}
+
+// Mutation returns the SpamTokenMutation object of the builder.
+func (stu *SpamTokenUpdate) Mutation() *SpamTokenMutation {
+	return stu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (stu *SpamTokenUpdate) Save(ctx context.Context) (int, error) {
+	stu.defaults()
+	return withHooks(ctx, stu.sqlSave, stu.mutation, stu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (stu *SpamTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := stu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (stu *SpamTokenUpdate) Exec(ctx context.Context) error {
+	_, err := stu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (stu *SpamTokenUpdate) ExecX(ctx context.Context) {
+	if err := stu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (stu *SpamTokenUpdate) defaults() {
+	if _, ok := stu.mutation.UpdatedAt(); !ok {
+		v := spamtoken.UpdateDefaultUpdatedAt()
+		stu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (stu *SpamTokenUpdate) check() error {
+	if v, ok := stu.mutation.Token(); ok {
+		if err := spamtoken.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "SpamToken.token": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (stu *SpamTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := stu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(spamtoken.Table, spamtoken.Columns, sqlgraph.NewFieldSpec(spamtoken.FieldID, field.TypeInt))
+	if ps := stu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := stu.mutation.Token(); ok {
+		_spec.SetField(spamtoken.FieldToken, field.TypeString, value)
+	}
+	if value, ok := stu.mutation.SpamCount(); ok {
+		_spec.SetField(spamtoken.FieldSpamCount, field.TypeInt, value)
+	}
+	if value, ok := stu.mutation.AddedSpamCount(); ok {
+		_spec.AddField(spamtoken.FieldSpamCount, field.TypeInt, value)
+	}
+	if value, ok := stu.mutation.HamCount(); ok {
+		_spec.SetField(spamtoken.FieldHamCount, field.TypeInt, value)
+	}
+	if value, ok := stu.mutation.AddedHamCount(); ok {
+		_spec.AddField(spamtoken.FieldHamCount, field.TypeInt, value)
+	}
+	if value, ok := stu.mutation.UpdatedAt(); ok {
+		_spec.SetField(spamtoken.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, stu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{spamtoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	stu.mutation.done = true
+	return n, nil
+}
+
+// SpamTokenUpdateOne is the builder for updating a single SpamToken entity.
+type SpamTokenUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SpamTokenMutation
+}
+
+// SetToken sets the "token" field.
+func (stuo *SpamTokenUpdateOne) SetToken(s string) *SpamTokenUpdateOne {
+	stuo.mutation.SetToken(s)
+	return stuo
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (stuo *SpamTokenUpdateOne) SetNillableToken(s *string) *SpamTokenUpdateOne {
+	if s != nil {
+		stuo.SetToken(*s)
+	}
+	return stuo
+}
+
+// SetSpamCount sets the "spam_count" field.
+func (stuo *SpamTokenUpdateOne) SetSpamCount(i int) *SpamTokenUpdateOne {
+	stuo.mutation.ResetSpamCount()
+	stuo.mutation.SetSpamCount(i)
+	return stuo
+}
+
+// SetNillableSpamCount sets the "spam_count" field if the given value is not nil.
+func (stuo *SpamTokenUpdateOne) SetNillableSpamCount(i *int) *SpamTokenUpdateOne {
+	if i != nil {
+		stuo.SetSpamCount(*i)
+	}
+	return stuo
+}
+
+// AddSpamCount adds i to the "spam_count" field.
+func (stuo *SpamTokenUpdateOne) AddSpamCount(i int) *SpamTokenUpdateOne {
+	stuo.mutation.AddSpamCount(i)
+	return stuo
+}
+
+// SetHamCount sets the "ham_count" field.
+func (stuo *SpamTokenUpdateOne) SetHamCount(i int) *SpamTokenUpdateOne {
+	stuo.mutation.ResetHamCount()
+	stuo.mutation.SetHamCount(i)
+	return stuo
+}
+
+// SetNillableHamCount sets the "ham_count" field if the given value is not nil.
+func (stuo *SpamTokenUpdateOne) SetNillableHamCount(i *int) *SpamTokenUpdateOne {
+	if i != nil {
+		stuo.SetHamCount(*i)
+	}
+	return stuo
+}
+
+// AddHamCount adds i to the "ham_count" field.
+func (stuo *SpamTokenUpdateOne) AddHamCount(i int) *SpamTokenUpdateOne {
+	stuo.mutation.AddHamCount(i)
+	return stuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (stuo *SpamTokenUpdateOne) SetUpdatedAt(t time.Time) *SpamTokenUpdateOne {
+	stuo.mutation.SetUpdatedAt(t)
+	return stuo
+}
+
+// Mutation returns the SpamTokenMutation object of the builder.
+func (stuo *SpamTokenUpdateOne) Mutation() *SpamTokenMutation {
+	return stuo.mutation
+}
+
+// Where appends a list predicates to the SpamTokenUpdate builder.
+func (stuo *SpamTokenUpdateOne) Where(ps ...predicate.SpamToken) *SpamTokenUpdateOne {
+	stuo.mutation.Where(ps...)
+	return stuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (stuo *SpamTokenUpdateOne) Select(field string, fields ...string) *SpamTokenUpdateOne {
+	stuo.fields = append([]string{field}, fields...)
+	return stuo
+}
+
+// Save executes the query and returns the updated SpamToken entity.
+func (stuo *SpamTokenUpdateOne) Save(ctx context.Context) (*SpamToken, error) {
+	stuo.defaults()
+	return withHooks(ctx, stuo.sqlSave, stuo.mutation, stuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (stuo *SpamTokenUpdateOne) SaveX(ctx context.Context) *SpamToken {
+	node, err := stuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (stuo *SpamTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := stuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (stuo *SpamTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := stuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (stuo *SpamTokenUpdateOne) defaults() {
+	if _, ok := stuo.mutation.UpdatedAt(); !ok {
+		v := spamtoken.UpdateDefaultUpdatedAt()
+		stuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (stuo *SpamTokenUpdateOne) check() error {
+	if v, ok := stuo.mutation.Token(); ok {
+		if err := spamtoken.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "SpamToken.token": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (stuo *SpamTokenUpdateOne) sqlSave(ctx context.Context) (_node *SpamToken, err error) {
+	if err := stuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(spamtoken.Table, spamtoken.Columns, sqlgraph.NewFieldSpec(spamtoken.FieldID, field.TypeInt))
+	id, ok := stuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SpamToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := stuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, spamtoken.FieldID)
+		for _, f := range fields {
+			if !spamtoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != spamtoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := stuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := stuo.mutation.Token(); ok {
+		_spec.SetField(spamtoken.FieldToken, field.TypeString, value)
+	}
+	if value, ok := stuo.mutation.SpamCount(); ok {
+		_spec.SetField(spamtoken.FieldSpamCount, field.TypeInt, value)
+	}
+	if value, ok := stuo.mutation.AddedSpamCount(); ok {
+		_spec.AddField(spamtoken.FieldSpamCount, field.TypeInt, value)
+	}
+	if value, ok := stuo.mutation.HamCount(); ok {
+		_spec.SetField(spamtoken.FieldHamCount, field.TypeInt, value)
+	}
+	if value, ok := stuo.mutation.AddedHamCount(); ok {
+		_spec.AddField(spamtoken.FieldHamCount, field.TypeInt, value)
+	}
+	if value, ok := stuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(spamtoken.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &SpamToken{config: stuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, stuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{spamtoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	stuo.mutation.done = true
+	return _node, nil
+}
@@ -35,9 +35,11 @@ type Tag struct {
 type TagEdges struct {
 	// Articles holds the value of the articles edge.
 	Articles []*Article `json:"articles,omitempty"`
+	// Aliases holds the value of the aliases edge.
+	Aliases []*TagAlias `json:"aliases,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // ArticlesOrErr returns the Articles value or an error if the edge
@@ -49,6 +51,15 @@ func (e TagEdges) ArticlesOrErr() ([]*Article, error) {
 	return nil, &NotLoadedError{edge: "articles"}
 }
 
+// AliasesOrErr returns the Aliases value or an error if the edge
+// was not loaded in eager-loading.
+func (e TagEdges) AliasesOrErr() ([]*TagAlias, error) {
+	if e.loadedTypes[1] {
+		return e.Aliases, nil
+	}
+	return nil, &NotLoadedError{edge: "aliases"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Tag) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -123,6 +134,11 @@ func (t *Tag) QueryArticles() *ArticleQuery {
 	return NewTagClient(t.config).QueryArticles(t)
 }
 
+// QueryAliases queries the "aliases" edge of the Tag entity.
+func (t *Tag) QueryAliases() *TagAliasQuery {
+	return NewTagClient(t.config).QueryAliases(t)
+}
+
 // Update returns a builder for updating this Tag.
 // Note that you need to call Tag.Unwrap() before calling this method if this Tag
 // was returned from a transaction, and the transaction was committed or rolled back.
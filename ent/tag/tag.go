@@ -24,6 +24,8 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// EdgeArticles holds the string denoting the articles edge name in mutations.
 	EdgeArticles = "articles"
+	// EdgeAliases holds the string denoting the aliases edge name in mutations.
+	EdgeAliases = "aliases"
 	// Table holds the table name of the tag in the database.
 	Table = "tags"
 	// ArticlesTable is the table that holds the articles relation/edge. The primary key declared below.
@@ -31,6 +33,13 @@ const (
 	// ArticlesInverseTable is the table name for the Article entity.
 	// It exists in this package in order to avoid circular dependency with the "article" package.
 	ArticlesInverseTable = "articles"
+	// AliasesTable is the table that holds the aliases relation/edge.
+	AliasesTable = "tag_alias"
+	// AliasesInverseTable is the table name for the TagAlias entity.
+	// It exists in this package in order to avoid circular dependency with the "tagalias" package.
+	AliasesInverseTable = "tag_alias"
+	// AliasesColumn is the table column denoting the aliases relation/edge.
+	AliasesColumn = "tag_id"
 )
 
 // Columns holds all SQL columns for tag fields.
@@ -112,6 +121,20 @@ func ByArticles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newArticlesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByAliasesCount orders the results by aliases count.
+func ByAliasesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAliasesStep(), opts...)
+	}
+}
+
+// ByAliases orders the results by aliases terms.
+func ByAliases(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAliasesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newArticlesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -119,3 +142,10 @@ func newArticlesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, false, ArticlesTable, ArticlesPrimaryKey...),
 	)
 }
+func newAliasesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AliasesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AliasesTable, AliasesColumn),
+	)
+}
@@ -9,6 +9,7 @@ import (
 	"goblog/ent/article"
 	"goblog/ent/predicate"
 	"goblog/ent/tag"
+	"goblog/ent/tagalias"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -98,6 +99,21 @@ func (tu *TagUpdate) AddArticles(a ...*Article) *TagUpdate {
 	return tu.AddArticleIDs(ids...)
 }
 
+// AddAliasIDs adds the "aliases" edge to the TagAlias entity by IDs.
+func (tu *TagUpdate) AddAliasIDs(ids ...int) *TagUpdate {
+	tu.mutation.AddAliasIDs(ids...)
+	return tu
+}
+
+// AddAliases adds the "aliases" edges to the TagAlias entity.
+func (tu *TagUpdate) AddAliases(t ...*TagAlias) *TagUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddAliasIDs(ids...)
+}
+
 // Mutation returns the TagMutation object of the builder.
 func (tu *TagUpdate) Mutation() *TagMutation {
 	return tu.mutation
@@ -124,6 +140,27 @@ func (tu *TagUpdate) RemoveArticles(a ...*Article) *TagUpdate {
 	return tu.RemoveArticleIDs(ids...)
 }
 
+// ClearAliases clears all "aliases" edges to the TagAlias entity.
+func (tu *TagUpdate) ClearAliases() *TagUpdate {
+	tu.mutation.ClearAliases()
+	return tu
+}
+
+// RemoveAliasIDs removes the "aliases" edge to TagAlias entities by IDs.
+func (tu *TagUpdate) RemoveAliasIDs(ids ...int) *TagUpdate {
+	tu.mutation.RemoveAliasIDs(ids...)
+	return tu
+}
+
+// RemoveAliases removes "aliases" edges to TagAlias entities.
+func (tu *TagUpdate) RemoveAliases(t ...*TagAlias) *TagUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveAliasIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (tu *TagUpdate) Save(ctx context.Context) (int, error) {
 	tu.defaults()
@@ -242,6 +279,51 @@ func (tu *TagUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tu.mutation.AliasesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.AliasesTable,
+			Columns: []string{tag.AliasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagalias.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedAliasesIDs(); len(nodes) > 0 && !tu.mutation.AliasesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.AliasesTable,
+			Columns: []string{tag.AliasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagalias.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.AliasesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.AliasesTable,
+			Columns: []string{tag.AliasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagalias.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{tag.Label}
@@ -331,6 +413,21 @@ func (tuo *TagUpdateOne) AddArticles(a ...*Article) *TagUpdateOne {
 	return tuo.AddArticleIDs(ids...)
 }
 
+// AddAliasIDs adds the "aliases" edge to the TagAlias entity by IDs.
+func (tuo *TagUpdateOne) AddAliasIDs(ids ...int) *TagUpdateOne {
+	tuo.mutation.AddAliasIDs(ids...)
+	return tuo
+}
+
+// AddAliases adds the "aliases" edges to the TagAlias entity.
+func (tuo *TagUpdateOne) AddAliases(t ...*TagAlias) *TagUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddAliasIDs(ids...)
+}
+
 // Mutation returns the TagMutation object of the builder.
 func (tuo *TagUpdateOne) Mutation() *TagMutation {
 	return tuo.mutation
@@ -357,6 +454,27 @@ func (tuo *TagUpdateOne) RemoveArticles(a ...*Article) *TagUpdateOne {
 	return tuo.RemoveArticleIDs(ids...)
 }
 
+// ClearAliases clears all "aliases" edges to the TagAlias entity.
+func (tuo *TagUpdateOne) ClearAliases() *TagUpdateOne {
+	tuo.mutation.ClearAliases()
+	return tuo
+}
+
+// RemoveAliasIDs removes the "aliases" edge to TagAlias entities by IDs.
+func (tuo *TagUpdateOne) RemoveAliasIDs(ids ...int) *TagUpdateOne {
+	tuo.mutation.RemoveAliasIDs(ids...)
+	return tuo
+}
+
+// RemoveAliases removes "aliases" edges to TagAlias entities.
+func (tuo *TagUpdateOne) RemoveAliases(t ...*TagAlias) *TagUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.RemoveAliasIDs(ids...)
+}
+
 // Where appends a list predicates to the TagUpdate builder.
 func (tuo *TagUpdateOne) Where(ps ...predicate.Tag) *TagUpdateOne {
 	tuo.mutation.Where(ps...)
@@ -505,6 +623,51 @@ func (tuo *TagUpdateOne) sqlSave(ctx context.Context) (_node *Tag, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tuo.mutation.AliasesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.AliasesTable,
+			Columns: []string{tag.AliasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagalias.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedAliasesIDs(); len(nodes) > 0 && !tuo.mutation.AliasesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.AliasesTable,
+			Columns: []string{tag.AliasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagalias.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.AliasesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.AliasesTable,
+			Columns: []string{tag.AliasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagalias.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Tag{config: tuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
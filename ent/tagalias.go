@@ -0,0 +1,171 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"goblog/ent/tag"
+	"goblog/ent/tagalias"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// TagAlias is the model entity for the TagAlias schema.
+type TagAlias struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 旧的标签名称
+	Name string `json:"name,omitempty"`
+	// 被合并的标签原来的ID，改名产生的别名为空
+	FormerID *int `json:"former_id,omitempty"`
+	// 别名指向的标签ID
+	TagID int `json:"tag_id,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TagAliasQuery when eager-loading is set.
+	Edges        TagAliasEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TagAliasEdges holds the relations/edges for other nodes in the graph.
+type TagAliasEdges struct {
+	// Tag holds the value of the tag edge.
+	Tag *Tag `json:"tag,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TagOrErr returns the Tag value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TagAliasEdges) TagOrErr() (*Tag, error) {
+	if e.Tag != nil {
+		return e.Tag, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tag.Label}
+	}
+	return nil, &NotLoadedError{edge: "tag"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TagAlias) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tagalias.FieldID, tagalias.FieldFormerID, tagalias.FieldTagID:
+			values[i] = new(sql.NullInt64)
+		case tagalias.FieldName:
+			values[i] = new(sql.NullString)
+		case tagalias.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TagAlias fields.
+func (ta *TagAlias) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tagalias.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ta.ID = int(value.Int64)
+		case tagalias.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ta.Name = value.String
+			}
+		case tagalias.FieldFormerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field former_id", values[i])
+			} else if value.Valid {
+				ta.FormerID = new(int)
+				*ta.FormerID = int(value.Int64)
+			}
+		case tagalias.FieldTagID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tag_id", values[i])
+			} else if value.Valid {
+				ta.TagID = int(value.Int64)
+			}
+		case tagalias.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ta.CreatedAt = value.Time
+			}
+		default:
+			ta.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TagAlias.
+// This includes values selected through modifiers, order, etc.
+func (ta *TagAlias) Value(name string) (ent.Value, error) {
+	return ta.selectValues.Get(name)
+}
+
+// QueryTag queries the "tag" edge of the TagAlias entity.
+func (ta *TagAlias) QueryTag() *TagQuery {
+	return NewTagAliasClient(ta.config).QueryTag(ta)
+}
+
+// Update returns a builder for updating this TagAlias.
+// Note that you need to call TagAlias.Unwrap() before calling this method if this TagAlias
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ta *TagAlias) Update() *TagAliasUpdateOne {
+	return NewTagAliasClient(ta.config).UpdateOne(ta)
+}
+
+// Unwrap unwraps the TagAlias entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ta *TagAlias) Unwrap() *TagAlias {
+	_tx, ok := ta.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TagAlias is not a transactional entity")
+	}
+	ta.config.driver = _tx.drv
+	return ta
+}
+
+// String implements the fmt.Stringer.
+func (ta *TagAlias) String() string {
+	var builder strings.Builder
+	builder.WriteString("TagAlias(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ta.ID))
+	builder.WriteString("name=")
+	builder.WriteString(ta.Name)
+	builder.WriteString(", ")
+	if v := ta.FormerID; v != nil {
+		builder.WriteString("former_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("tag_id=")
+	builder.WriteString(fmt.Sprintf("%v", ta.TagID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ta.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TagAliasSlice is a parsable slice of TagAlias.
+type TagAliasSlice []*TagAlias
@@ -0,0 +1,289 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tagalias
+
+import (
+	"goblog/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldEQ(FieldName, v))
+}
+
+// FormerID applies equality check predicate on the "former_id" field. It's identical to FormerIDEQ.
+func FormerID(v int) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldEQ(FieldFormerID, v))
+}
+
+// TagID applies equality check predicate on the "tag_id" field. It's identical to TagIDEQ.
+func TagID(v int) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldEQ(FieldTagID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldContainsFold(FieldName, v))
+}
+
+// FormerIDEQ applies the EQ predicate on the "former_id" field.
+func FormerIDEQ(v int) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldEQ(FieldFormerID, v))
+}
+
+// FormerIDNEQ applies the NEQ predicate on the "former_id" field.
+func FormerIDNEQ(v int) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldNEQ(FieldFormerID, v))
+}
+
+// FormerIDIn applies the In predicate on the "former_id" field.
+func FormerIDIn(vs ...int) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldIn(FieldFormerID, vs...))
+}
+
+// FormerIDNotIn applies the NotIn predicate on the "former_id" field.
+func FormerIDNotIn(vs ...int) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldNotIn(FieldFormerID, vs...))
+}
+
+// FormerIDGT applies the GT predicate on the "former_id" field.
+func FormerIDGT(v int) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldGT(FieldFormerID, v))
+}
+
+// FormerIDGTE applies the GTE predicate on the "former_id" field.
+func FormerIDGTE(v int) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldGTE(FieldFormerID, v))
+}
+
+// FormerIDLT applies the LT predicate on the "former_id" field.
+func FormerIDLT(v int) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldLT(FieldFormerID, v))
+}
+
+// FormerIDLTE applies the LTE predicate on the "former_id" field.
+func FormerIDLTE(v int) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldLTE(FieldFormerID, v))
+}
+
+// FormerIDIsNil applies the IsNil predicate on the "former_id" field.
+func FormerIDIsNil() predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldIsNull(FieldFormerID))
+}
+
+// FormerIDNotNil applies the NotNil predicate on the "former_id" field.
+func FormerIDNotNil() predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldNotNull(FieldFormerID))
+}
+
+// TagIDEQ applies the EQ predicate on the "tag_id" field.
+func TagIDEQ(v int) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldEQ(FieldTagID, v))
+}
+
+// TagIDNEQ applies the NEQ predicate on the "tag_id" field.
+func TagIDNEQ(v int) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldNEQ(FieldTagID, v))
+}
+
+// TagIDIn applies the In predicate on the "tag_id" field.
+func TagIDIn(vs ...int) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldIn(FieldTagID, vs...))
+}
+
+// TagIDNotIn applies the NotIn predicate on the "tag_id" field.
+func TagIDNotIn(vs ...int) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldNotIn(FieldTagID, vs...))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasTag applies the HasEdge predicate on the "tag" edge.
+func HasTag() predicate.TagAlias {
+	return predicate.TagAlias(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TagTable, TagColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagWith applies the HasEdge predicate on the "tag" edge with a given conditions (other predicates).
+func HasTagWith(preds ...predicate.Tag) predicate.TagAlias {
+	return predicate.TagAlias(func(s *sql.Selector) {
+		step := newTagStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TagAlias) predicate.TagAlias {
+	return predicate.TagAlias(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TagAlias) predicate.TagAlias {
+	return predicate.TagAlias(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TagAlias) predicate.TagAlias {
+	return predicate.TagAlias(sql.NotPredicates(p))
+}
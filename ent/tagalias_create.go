@@ -0,0 +1,602 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"goblog/ent/tag"
+	"goblog/ent/tagalias"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TagAliasCreate is the builder for creating a TagAlias entity.
+type TagAliasCreate struct {
+	config
+	mutation *TagAliasMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (tac *TagAliasCreate) SetName(s string) *TagAliasCreate {
+	tac.mutation.SetName(s)
+	return tac
+}
+
+// SetFormerID sets the "former_id" field.
+func (tac *TagAliasCreate) SetFormerID(i int) *TagAliasCreate {
+	tac.mutation.SetFormerID(i)
+	return tac
+}
+
+// SetNillableFormerID sets the "former_id" field if the given value is not nil.
+func (tac *TagAliasCreate) SetNillableFormerID(i *int) *TagAliasCreate {
+	if i != nil {
+		tac.SetFormerID(*i)
+	}
+	return tac
+}
+
+// SetTagID sets the "tag_id" field.
+func (tac *TagAliasCreate) SetTagID(i int) *TagAliasCreate {
+	tac.mutation.SetTagID(i)
+	return tac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tac *TagAliasCreate) SetCreatedAt(t time.Time) *TagAliasCreate {
+	tac.mutation.SetCreatedAt(t)
+	return tac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tac *TagAliasCreate) SetNillableCreatedAt(t *time.Time) *TagAliasCreate {
+	if t != nil {
+		tac.SetCreatedAt(*t)
+	}
+	return tac
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (tac *TagAliasCreate) SetTag(t *Tag) *TagAliasCreate {
+	return tac.SetTagID(t.ID)
+}
+
+// Mutation returns the TagAliasMutation object of the builder.
+func (tac *TagAliasCreate) Mutation() *TagAliasMutation {
+	return tac.mutation
+}
+
+// Save creates the TagAlias in the database.
+func (tac *TagAliasCreate) Save(ctx context.Context) (*TagAlias, error) {
+	tac.defaults()
+	return withHooks(ctx, tac.sqlSave, tac.mutation, tac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tac *TagAliasCreate) SaveX(ctx context.Context) *TagAlias {
+	v, err := tac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tac *TagAliasCreate) Exec(ctx context.Context) error {
+	_, err := tac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tac *TagAliasCreate) ExecX(ctx context.Context) {
+	if err := tac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tac *TagAliasCreate) defaults() {
+	if _, ok := tac.mutation.CreatedAt(); !ok {
+		v := tagalias.DefaultCreatedAt()
+		tac.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tac *TagAliasCreate) check() error {
+	if _, ok := tac.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "TagAlias.name"`)}
+	}
+	if v, ok := tac.mutation.Name(); ok {
+		if err := tagalias.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TagAlias.name": %w`, err)}
+		}
+	}
+	if _, ok := tac.mutation.TagID(); !ok {
+		return &ValidationError{Name: "tag_id", err: errors.New(`ent: missing required field "TagAlias.tag_id"`)}
+	}
+	if _, ok := tac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TagAlias.created_at"`)}
+	}
+	if len(tac.mutation.TagIDs()) == 0 {
+		return &ValidationError{Name: "tag", err: errors.New(`ent: missing required edge "TagAlias.tag"`)}
+	}
+	return nil
+}
+
+func (tac *TagAliasCreate) sqlSave(ctx context.Context) (*TagAlias, error) {
+	if err := tac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	tac.mutation.id = &_node.ID
+	tac.mutation.done = true
+	return _node, nil
+}
+
+func (tac *TagAliasCreate) createSpec() (*TagAlias, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TagAlias{config: tac.config}
+		_spec = sqlgraph.NewCreateSpec(tagalias.Table, sqlgraph.NewFieldSpec(tagalias.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = tac.conflict
+	if value, ok := tac.mutation.Name(); ok {
+		_spec.SetField(tagalias.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := tac.mutation.FormerID(); ok {
+		_spec.SetField(tagalias.FieldFormerID, field.TypeInt, value)
+		_node.FormerID = &value
+	}
+	if value, ok := tac.mutation.CreatedAt(); ok {
+		_spec.SetField(tagalias.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := tac.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tagalias.TagTable,
+			Columns: []string{tagalias.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TagID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TagAlias.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TagAliasUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (tac *TagAliasCreate) OnConflict(opts ...sql.ConflictOption) *TagAliasUpsertOne {
+	tac.conflict = opts
+	return &TagAliasUpsertOne{
+		create: tac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TagAlias.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tac *TagAliasCreate) OnConflictColumns(columns ...string) *TagAliasUpsertOne {
+	tac.conflict = append(tac.conflict, sql.ConflictColumns(columns...))
+	return &TagAliasUpsertOne{
+		create: tac,
+	}
+}
+
+type (
+	// TagAliasUpsertOne is the builder for "upsert"-ing
+	//  one TagAlias node.
+	TagAliasUpsertOne struct {
+		create *TagAliasCreate
+	}
+
+	// TagAliasUpsert is the "OnConflict" setter.
+	TagAliasUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *TagAliasUpsert) SetName(v string) *TagAliasUpsert {
+	u.Set(tagalias.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TagAliasUpsert) UpdateName() *TagAliasUpsert {
+	u.SetExcluded(tagalias.FieldName)
+	return u
+}
+
+// SetTagID sets the "tag_id" field.
+func (u *TagAliasUpsert) SetTagID(v int) *TagAliasUpsert {
+	u.Set(tagalias.FieldTagID, v)
+	return u
+}
+
+// UpdateTagID sets the "tag_id" field to the value that was provided on create.
+func (u *TagAliasUpsert) UpdateTagID() *TagAliasUpsert {
+	u.SetExcluded(tagalias.FieldTagID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.TagAlias.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TagAliasUpsertOne) UpdateNewValues() *TagAliasUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.FormerID(); exists {
+			s.SetIgnore(tagalias.FieldFormerID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(tagalias.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TagAlias.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TagAliasUpsertOne) Ignore() *TagAliasUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TagAliasUpsertOne) DoNothing() *TagAliasUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TagAliasCreate.OnConflict
+// documentation for more info.
+func (u *TagAliasUpsertOne) Update(set func(*TagAliasUpsert)) *TagAliasUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TagAliasUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *TagAliasUpsertOne) SetName(v string) *TagAliasUpsertOne {
+	return u.Update(func(s *TagAliasUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TagAliasUpsertOne) UpdateName() *TagAliasUpsertOne {
+	return u.Update(func(s *TagAliasUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetTagID sets the "tag_id" field.
+func (u *TagAliasUpsertOne) SetTagID(v int) *TagAliasUpsertOne {
+	return u.Update(func(s *TagAliasUpsert) {
+		s.SetTagID(v)
+	})
+}
+
+// UpdateTagID sets the "tag_id" field to the value that was provided on create.
+func (u *TagAliasUpsertOne) UpdateTagID() *TagAliasUpsertOne {
+	return u.Update(func(s *TagAliasUpsert) {
+		s.UpdateTagID()
+	})
+}
+
+// Exec executes the query.
+func (u *TagAliasUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TagAliasCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TagAliasUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TagAliasUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TagAliasUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TagAliasCreateBulk is the builder for creating many TagAlias entities in bulk.
+type TagAliasCreateBulk struct {
+	config
+	err      error
+	builders []*TagAliasCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TagAlias entities in the database.
+func (tacb *TagAliasCreateBulk) Save(ctx context.Context) ([]*TagAlias, error) {
+	if tacb.err != nil {
+		return nil, tacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tacb.builders))
+	nodes := make([]*TagAlias, len(tacb.builders))
+	mutators := make([]Mutator, len(tacb.builders))
+	for i := range tacb.builders {
+		func(i int, root context.Context) {
+			builder := tacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TagAliasMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tacb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tacb *TagAliasCreateBulk) SaveX(ctx context.Context) []*TagAlias {
+	v, err := tacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tacb *TagAliasCreateBulk) Exec(ctx context.Context) error {
+	_, err := tacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tacb *TagAliasCreateBulk) ExecX(ctx context.Context) {
+	if err := tacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TagAlias.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TagAliasUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (tacb *TagAliasCreateBulk) OnConflict(opts ...sql.ConflictOption) *TagAliasUpsertBulk {
+	tacb.conflict = opts
+	return &TagAliasUpsertBulk{
+		create: tacb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TagAlias.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tacb *TagAliasCreateBulk) OnConflictColumns(columns ...string) *TagAliasUpsertBulk {
+	tacb.conflict = append(tacb.conflict, sql.ConflictColumns(columns...))
+	return &TagAliasUpsertBulk{
+		create: tacb,
+	}
+}
+
+// TagAliasUpsertBulk is the builder for "upsert"-ing
+// a bulk of TagAlias nodes.
+type TagAliasUpsertBulk struct {
+	create *TagAliasCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TagAlias.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TagAliasUpsertBulk) UpdateNewValues() *TagAliasUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.FormerID(); exists {
+				s.SetIgnore(tagalias.FieldFormerID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(tagalias.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TagAlias.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TagAliasUpsertBulk) Ignore() *TagAliasUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TagAliasUpsertBulk) DoNothing() *TagAliasUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TagAliasCreateBulk.OnConflict
+// documentation for more info.
+func (u *TagAliasUpsertBulk) Update(set func(*TagAliasUpsert)) *TagAliasUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TagAliasUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *TagAliasUpsertBulk) SetName(v string) *TagAliasUpsertBulk {
+	return u.Update(func(s *TagAliasUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TagAliasUpsertBulk) UpdateName() *TagAliasUpsertBulk {
+	return u.Update(func(s *TagAliasUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetTagID sets the "tag_id" field.
+func (u *TagAliasUpsertBulk) SetTagID(v int) *TagAliasUpsertBulk {
+	return u.Update(func(s *TagAliasUpsert) {
+		s.SetTagID(v)
+	})
+}
+
+// UpdateTagID sets the "tag_id" field to the value that was provided on create.
+func (u *TagAliasUpsertBulk) UpdateTagID() *TagAliasUpsertBulk {
+	return u.Update(func(s *TagAliasUpsert) {
+		s.UpdateTagID()
+	})
+}
+
+// Exec executes the query.
+func (u *TagAliasUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TagAliasCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TagAliasCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TagAliasUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
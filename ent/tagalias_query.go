@@ -0,0 +1,643 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"goblog/ent/predicate"
+	"goblog/ent/tag"
+	"goblog/ent/tagalias"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TagAliasQuery is the builder for querying TagAlias entities.
+type TagAliasQuery struct {
+	config
+	ctx        *QueryContext
+	order      []tagalias.OrderOption
+	inters     []Interceptor
+	predicates []predicate.TagAlias
+	withTag    *TagQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TagAliasQuery builder.
+func (taq *TagAliasQuery) Where(ps ...predicate.TagAlias) *TagAliasQuery {
+	taq.predicates = append(taq.predicates, ps...)
+	return taq
+}
+
+// Limit the number of records to be returned by this query.
+func (taq *TagAliasQuery) Limit(limit int) *TagAliasQuery {
+	taq.ctx.Limit = &limit
+	return taq
+}
+
+// Offset to start from.
+func (taq *TagAliasQuery) Offset(offset int) *TagAliasQuery {
+	taq.ctx.Offset = &offset
+	return taq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (taq *TagAliasQuery) Unique(unique bool) *TagAliasQuery {
+	taq.ctx.Unique = &unique
+	return taq
+}
+
+// Order specifies how the records should be ordered.
+func (taq *TagAliasQuery) Order(o ...tagalias.OrderOption) *TagAliasQuery {
+	taq.order = append(taq.order, o...)
+	return taq
+}
+
+// QueryTag chains the current query on the "tag" edge.
+func (taq *TagAliasQuery) QueryTag() *TagQuery {
+	query := (&TagClient{config: taq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := taq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := taq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tagalias.Table, tagalias.FieldID, selector),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, tagalias.TagTable, tagalias.TagColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(taq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TagAlias entity from the query.
+// Returns a *NotFoundError when no TagAlias was found.
+func (taq *TagAliasQuery) First(ctx context.Context) (*TagAlias, error) {
+	nodes, err := taq.Limit(1).All(setContextOp(ctx, taq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{tagalias.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (taq *TagAliasQuery) FirstX(ctx context.Context) *TagAlias {
+	node, err := taq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TagAlias ID from the query.
+// Returns a *NotFoundError when no TagAlias ID was found.
+func (taq *TagAliasQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = taq.Limit(1).IDs(setContextOp(ctx, taq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{tagalias.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (taq *TagAliasQuery) FirstIDX(ctx context.Context) int {
+	id, err := taq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TagAlias entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TagAlias entity is found.
+// Returns a *NotFoundError when no TagAlias entities are found.
+func (taq *TagAliasQuery) Only(ctx context.Context) (*TagAlias, error) {
+	nodes, err := taq.Limit(2).All(setContextOp(ctx, taq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{tagalias.Label}
+	default:
+		return nil, &NotSingularError{tagalias.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (taq *TagAliasQuery) OnlyX(ctx context.Context) *TagAlias {
+	node, err := taq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TagAlias ID in the query.
+// Returns a *NotSingularError when more than one TagAlias ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (taq *TagAliasQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = taq.Limit(2).IDs(setContextOp(ctx, taq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{tagalias.Label}
+	default:
+		err = &NotSingularError{tagalias.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (taq *TagAliasQuery) OnlyIDX(ctx context.Context) int {
+	id, err := taq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TagAliasSlice.
+func (taq *TagAliasQuery) All(ctx context.Context) ([]*TagAlias, error) {
+	ctx = setContextOp(ctx, taq.ctx, ent.OpQueryAll)
+	if err := taq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TagAlias, *TagAliasQuery]()
+	return withInterceptors[[]*TagAlias](ctx, taq, qr, taq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (taq *TagAliasQuery) AllX(ctx context.Context) []*TagAlias {
+	nodes, err := taq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TagAlias IDs.
+func (taq *TagAliasQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if taq.ctx.Unique == nil && taq.path != nil {
+		taq.Unique(true)
+	}
+	ctx = setContextOp(ctx, taq.ctx, ent.OpQueryIDs)
+	if err = taq.Select(tagalias.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (taq *TagAliasQuery) IDsX(ctx context.Context) []int {
+	ids, err := taq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (taq *TagAliasQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, taq.ctx, ent.OpQueryCount)
+	if err := taq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, taq, querierCount[*TagAliasQuery](), taq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (taq *TagAliasQuery) CountX(ctx context.Context) int {
+	count, err := taq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (taq *TagAliasQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, taq.ctx, ent.OpQueryExist)
+	switch _, err := taq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (taq *TagAliasQuery) ExistX(ctx context.Context) bool {
+	exist, err := taq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TagAliasQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (taq *TagAliasQuery) Clone() *TagAliasQuery {
+	if taq == nil {
+		return nil
+	}
+	return &TagAliasQuery{
+		config:     taq.config,
+		ctx:        taq.ctx.Clone(),
+		order:      append([]tagalias.OrderOption{}, taq.order...),
+		inters:     append([]Interceptor{}, taq.inters...),
+		predicates: append([]predicate.TagAlias{}, taq.predicates...),
+		withTag:    taq.withTag.Clone(),
+		// clone intermediate query.
+		sql:  taq.sql.Clone(),
+		path: taq.path,
+	}
+}
+
+// WithTag tells the query-builder to eager-load the nodes that are connected to
+// the "tag" edge. The optional arguments are used to configure the query builder of the edge.
+func (taq *TagAliasQuery) WithTag(opts ...func(*TagQuery)) *TagAliasQuery {
+	query := (&TagClient{config: taq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	taq.withTag = query
+	return taq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TagAlias.Query().
+//		GroupBy(tagalias.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (taq *TagAliasQuery) GroupBy(field string, fields ...string) *TagAliasGroupBy {
+	taq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TagAliasGroupBy{build: taq}
+	grbuild.flds = &taq.ctx.Fields
+	grbuild.label = tagalias.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.TagAlias.Query().
+//		Select(tagalias.FieldName).
+//		Scan(ctx, &v)
+func (taq *TagAliasQuery) Select(fields ...string) *TagAliasSelect {
+	taq.ctx.Fields = append(taq.ctx.Fields, fields...)
+	sbuild := &TagAliasSelect{TagAliasQuery: taq}
+	sbuild.label = tagalias.Label
+	sbuild.flds, sbuild.scan = &taq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TagAliasSelect configured with the given aggregations.
+func (taq *TagAliasQuery) Aggregate(fns ...AggregateFunc) *TagAliasSelect {
+	return taq.Select().Aggregate(fns...)
+}
+
+func (taq *TagAliasQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range taq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, taq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range taq.ctx.Fields {
+		if !tagalias.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if taq.path != nil {
+		prev, err := taq.path(ctx)
+		if err != nil {
+			return err
+		}
+		taq.sql = prev
+	}
+	return nil
+}
+
+func (taq *TagAliasQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TagAlias, error) {
+	var (
+		nodes       = []*TagAlias{}
+		_spec       = taq.querySpec()
+		loadedTypes = [1]bool{
+			taq.withTag != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TagAlias).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TagAlias{config: taq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(taq.modifiers) > 0 {
+		_spec.Modifiers = taq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, taq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := taq.withTag; query != nil {
+		if err := taq.loadTag(ctx, query, nodes, nil,
+			func(n *TagAlias, e *Tag) { n.Edges.Tag = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (taq *TagAliasQuery) loadTag(ctx context.Context, query *TagQuery, nodes []*TagAlias, init func(*TagAlias), assign func(*TagAlias, *Tag)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*TagAlias)
+	for i := range nodes {
+		fk := nodes[i].TagID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(tag.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "tag_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (taq *TagAliasQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := taq.querySpec()
+	if len(taq.modifiers) > 0 {
+		_spec.Modifiers = taq.modifiers
+	}
+	_spec.Node.Columns = taq.ctx.Fields
+	if len(taq.ctx.Fields) > 0 {
+		_spec.Unique = taq.ctx.Unique != nil && *taq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, taq.driver, _spec)
+}
+
+func (taq *TagAliasQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(tagalias.Table, tagalias.Columns, sqlgraph.NewFieldSpec(tagalias.FieldID, field.TypeInt))
+	_spec.From = taq.sql
+	if unique := taq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if taq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := taq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tagalias.FieldID)
+		for i := range fields {
+			if fields[i] != tagalias.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if taq.withTag != nil {
+			_spec.Node.AddColumnOnce(tagalias.FieldTagID)
+		}
+	}
+	if ps := taq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := taq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := taq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := taq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (taq *TagAliasQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(taq.driver.Dialect())
+	t1 := builder.Table(tagalias.Table)
+	columns := taq.ctx.Fields
+	if len(columns) == 0 {
+		columns = tagalias.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if taq.sql != nil {
+		selector = taq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if taq.ctx.Unique != nil && *taq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range taq.modifiers {
+		m(selector)
+	}
+	for _, p := range taq.predicates {
+		p(selector)
+	}
+	for _, p := range taq.order {
+		p(selector)
+	}
+	if offset := taq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := taq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (taq *TagAliasQuery) ForUpdate(opts ...sql.LockOption) *TagAliasQuery {
+	if taq.driver.Dialect() == dialect.Postgres {
+		taq.Unique(false)
+	}
+	taq.modifiers = append(taq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return taq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (taq *TagAliasQuery) ForShare(opts ...sql.LockOption) *TagAliasQuery {
+	if taq.driver.Dialect() == dialect.Postgres {
+		taq.Unique(false)
+	}
+	taq.modifiers = append(taq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return taq
+}
+
+// TagAliasGroupBy is the group-by builder for TagAlias entities.
+type TagAliasGroupBy struct {
+	selector
+	build *TagAliasQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tagb *TagAliasGroupBy) Aggregate(fns ...AggregateFunc) *TagAliasGroupBy {
+	tagb.fns = append(tagb.fns, fns...)
+	return tagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tagb *TagAliasGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tagb.build.ctx, ent.OpQueryGroupBy)
+	if err := tagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TagAliasQuery, *TagAliasGroupBy](ctx, tagb.build, tagb, tagb.build.inters, v)
+}
+
+func (tagb *TagAliasGroupBy) sqlScan(ctx context.Context, root *TagAliasQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tagb.fns))
+	for _, fn := range tagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tagb.flds)+len(tagb.fns))
+		for _, f := range *tagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TagAliasSelect is the builder for selecting fields of TagAlias entities.
+type TagAliasSelect struct {
+	*TagAliasQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tas *TagAliasSelect) Aggregate(fns ...AggregateFunc) *TagAliasSelect {
+	tas.fns = append(tas.fns, fns...)
+	return tas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tas *TagAliasSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tas.ctx, ent.OpQuerySelect)
+	if err := tas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TagAliasQuery, *TagAliasSelect](ctx, tas.TagAliasQuery, tas, tas.inters, v)
+}
+
+func (tas *TagAliasSelect) sqlScan(ctx context.Context, root *TagAliasQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tas.fns))
+	for _, fn := range tas.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,356 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"goblog/ent/predicate"
+	"goblog/ent/tag"
+	"goblog/ent/tagalias"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TagAliasUpdate is the builder for updating TagAlias entities.
+type TagAliasUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TagAliasMutation
+}
+
+// Where appends a list predicates to the TagAliasUpdate builder.
+func (tau *TagAliasUpdate) Where(ps ...predicate.TagAlias) *TagAliasUpdate {
+	tau.mutation.Where(ps...)
+	return tau
+}
+
+// SetName sets the "name" field.
+func (tau *TagAliasUpdate) SetName(s string) *TagAliasUpdate {
+	tau.mutation.SetName(s)
+	return tau
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (tau *TagAliasUpdate) SetNillableName(s *string) *TagAliasUpdate {
+	if s != nil {
+		tau.SetName(*s)
+	}
+	return tau
+}
+
+// SetTagID sets the "tag_id" field.
+func (tau *TagAliasUpdate) SetTagID(i int) *TagAliasUpdate {
+	tau.mutation.SetTagID(i)
+	return tau
+}
+
+// SetNillableTagID sets the "tag_id" field if the given value is not nil.
+func (tau *TagAliasUpdate) SetNillableTagID(i *int) *TagAliasUpdate {
+	if i != nil {
+		tau.SetTagID(*i)
+	}
+	return tau
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (tau *TagAliasUpdate) SetTag(t *Tag) *TagAliasUpdate {
+	return tau.SetTagID(t.ID)
+}
+
+// Mutation returns the TagAliasMutation object of the builder.
+func (tau *TagAliasUpdate) Mutation() *TagAliasMutation {
+	return tau.mutation
+}
+
+// ClearTag clears the "tag" edge to the Tag entity.
+func (tau *TagAliasUpdate) ClearTag() *TagAliasUpdate {
+	tau.mutation.ClearTag()
+	return tau
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tau *TagAliasUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tau.sqlSave, tau.mutation, tau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tau *TagAliasUpdate) SaveX(ctx context.Context) int {
+	affected, err := tau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tau *TagAliasUpdate) Exec(ctx context.Context) error {
+	_, err := tau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tau *TagAliasUpdate) ExecX(ctx context.Context) {
+	if err := tau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tau *TagAliasUpdate) check() error {
+	if v, ok := tau.mutation.Name(); ok {
+		if err := tagalias.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TagAlias.name": %w`, err)}
+		}
+	}
+	if tau.mutation.TagCleared() && len(tau.mutation.TagIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TagAlias.tag"`)
+	}
+	return nil
+}
+
+func (tau *TagAliasUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tagalias.Table, tagalias.Columns, sqlgraph.NewFieldSpec(tagalias.FieldID, field.TypeInt))
+	if ps := tau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tau.mutation.Name(); ok {
+		_spec.SetField(tagalias.FieldName, field.TypeString, value)
+	}
+	if tau.mutation.FormerIDCleared() {
+		_spec.ClearField(tagalias.FieldFormerID, field.TypeInt)
+	}
+	if tau.mutation.TagCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tagalias.TagTable,
+			Columns: []string{tagalias.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tau.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tagalias.TagTable,
+			Columns: []string{tagalias.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tagalias.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tau.mutation.done = true
+	return n, nil
+}
+
+// TagAliasUpdateOne is the builder for updating a single TagAlias entity.
+type TagAliasUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TagAliasMutation
+}
+
+// SetName sets the "name" field.
+func (tauo *TagAliasUpdateOne) SetName(s string) *TagAliasUpdateOne {
+	tauo.mutation.SetName(s)
+	return tauo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (tauo *TagAliasUpdateOne) SetNillableName(s *string) *TagAliasUpdateOne {
+	if s != nil {
+		tauo.SetName(*s)
+	}
+	return tauo
+}
+
+// SetTagID sets the "tag_id" field.
+func (tauo *TagAliasUpdateOne) SetTagID(i int) *TagAliasUpdateOne {
+	tauo.mutation.SetTagID(i)
+	return tauo
+}
+
+// SetNillableTagID sets the "tag_id" field if the given value is not nil.
+func (tauo *TagAliasUpdateOne) SetNillableTagID(i *int) *TagAliasUpdateOne {
+	if i != nil {
+		tauo.SetTagID(*i)
+	}
+	return tauo
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (tauo *TagAliasUpdateOne) SetTag(t *Tag) *TagAliasUpdateOne {
+	return tauo.SetTagID(t.ID)
+}
+
+// Mutation returns the TagAliasMutation object of the builder.
+func (tauo *TagAliasUpdateOne) Mutation() *TagAliasMutation {
+	return tauo.mutation
+}
+
+// ClearTag clears the "tag" edge to the Tag entity.
+func (tauo *TagAliasUpdateOne) ClearTag() *TagAliasUpdateOne {
+	tauo.mutation.ClearTag()
+	return tauo
+}
+
+// Where appends a list predicates to the TagAliasUpdate builder.
+func (tauo *TagAliasUpdateOne) Where(ps ...predicate.TagAlias) *TagAliasUpdateOne {
+	tauo.mutation.Where(ps...)
+	return tauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tauo *TagAliasUpdateOne) Select(field string, fields ...string) *TagAliasUpdateOne {
+	tauo.fields = append([]string{field}, fields...)
+	return tauo
+}
+
+// Save executes the query and returns the updated TagAlias entity.
+func (tauo *TagAliasUpdateOne) Save(ctx context.Context) (*TagAlias, error) {
+	return withHooks(ctx, tauo.sqlSave, tauo.mutation, tauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tauo *TagAliasUpdateOne) SaveX(ctx context.Context) *TagAlias {
+	node, err := tauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tauo *TagAliasUpdateOne) Exec(ctx context.Context) error {
+	_, err := tauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tauo *TagAliasUpdateOne) ExecX(ctx context.Context) {
+	if err := tauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tauo *TagAliasUpdateOne) check() error {
+	if v, ok := tauo.mutation.Name(); ok {
+		if err := tagalias.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TagAlias.name": %w`, err)}
+		}
+	}
+	if tauo.mutation.TagCleared() && len(tauo.mutation.TagIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TagAlias.tag"`)
+	}
+	return nil
+}
+
+func (tauo *TagAliasUpdateOne) sqlSave(ctx context.Context) (_node *TagAlias, err error) {
+	if err := tauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tagalias.Table, tagalias.Columns, sqlgraph.NewFieldSpec(tagalias.FieldID, field.TypeInt))
+	id, ok := tauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TagAlias.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tagalias.FieldID)
+		for _, f := range fields {
+			if !tagalias.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tagalias.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tauo.mutation.Name(); ok {
+		_spec.SetField(tagalias.FieldName, field.TypeString, value)
+	}
+	if tauo.mutation.FormerIDCleared() {
+		_spec.ClearField(tagalias.FieldFormerID, field.TypeInt)
+	}
+	if tauo.mutation.TagCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tagalias.TagTable,
+			Columns: []string{tagalias.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tauo.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tagalias.TagTable,
+			Columns: []string{tagalias.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TagAlias{config: tauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tagalias.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tauo.mutation.done = true
+	return _node, nil
+}
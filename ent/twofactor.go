@@ -0,0 +1,162 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"goblog/ent/twofactor"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// TwoFactor is the model entity for the TwoFactor schema.
+type TwoFactor struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 所属用户
+	Username string `json:"username,omitempty"`
+	// TOTP密钥(Base32)
+	Secret string `json:"-"`
+	// 是否已完成绑定
+	Enabled bool `json:"enabled,omitempty"`
+	// 最近一次使用的时间步，防止验证码重放
+	LastUsedStep int64 `json:"last_used_step,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TwoFactor) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case twofactor.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case twofactor.FieldID, twofactor.FieldLastUsedStep:
+			values[i] = new(sql.NullInt64)
+		case twofactor.FieldUsername, twofactor.FieldSecret:
+			values[i] = new(sql.NullString)
+		case twofactor.FieldCreatedAt, twofactor.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TwoFactor fields.
+func (tf *TwoFactor) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case twofactor.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			tf.ID = int(value.Int64)
+		case twofactor.FieldUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username", values[i])
+			} else if value.Valid {
+				tf.Username = value.String
+			}
+		case twofactor.FieldSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field secret", values[i])
+			} else if value.Valid {
+				tf.Secret = value.String
+			}
+		case twofactor.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				tf.Enabled = value.Bool
+			}
+		case twofactor.FieldLastUsedStep:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_step", values[i])
+			} else if value.Valid {
+				tf.LastUsedStep = value.Int64
+			}
+		case twofactor.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tf.CreatedAt = value.Time
+			}
+		case twofactor.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				tf.UpdatedAt = value.Time
+			}
+		default:
+			tf.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TwoFactor.
+// This includes values selected through modifiers, order, etc.
+func (tf *TwoFactor) Value(name string) (ent.Value, error) {
+	return tf.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TwoFactor.
+// Note that you need to call TwoFactor.Unwrap() before calling this method if this TwoFactor
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tf *TwoFactor) Update() *TwoFactorUpdateOne {
+	return NewTwoFactorClient(tf.config).UpdateOne(tf)
+}
+
+// Unwrap unwraps the TwoFactor entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tf *TwoFactor) Unwrap() *TwoFactor {
+	_tx, ok := tf.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TwoFactor is not a transactional entity")
+	}
+	tf.config.driver = _tx.drv
+	return tf
+}
+
+// String implements the fmt.Stringer.
+func (tf *TwoFactor) String() string {
+	var builder strings.Builder
+	builder.WriteString("TwoFactor(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tf.ID))
+	builder.WriteString("username=")
+	builder.WriteString(tf.Username)
+	builder.WriteString(", ")
+	builder.WriteString("secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", tf.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("last_used_step=")
+	builder.WriteString(fmt.Sprintf("%v", tf.LastUsedStep))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(tf.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(tf.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TwoFactors is a parsable slice of TwoFactor.
+type TwoFactors []*TwoFactor
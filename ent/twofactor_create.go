@@ -0,0 +1,804 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"goblog/ent/twofactor"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TwoFactorCreate is the builder for creating a TwoFactor entity.
+type TwoFactorCreate struct {
+	config
+	mutation *TwoFactorMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUsername sets the "username" field.
+func (tfc *TwoFactorCreate) SetUsername(s string) *TwoFactorCreate {
+	tfc.mutation.SetUsername(s)
+	return tfc
+}
+
+// SetSecret sets the "secret" field.
+func (tfc *TwoFactorCreate) SetSecret(s string) *TwoFactorCreate {
+	tfc.mutation.SetSecret(s)
+	return tfc
+}
+
+// SetEnabled sets the "enabled" field.
+func (tfc *TwoFactorCreate) SetEnabled(b bool) *TwoFactorCreate {
+	tfc.mutation.SetEnabled(b)
+	return tfc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (tfc *TwoFactorCreate) SetNillableEnabled(b *bool) *TwoFactorCreate {
+	if b != nil {
+		tfc.SetEnabled(*b)
+	}
+	return tfc
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (tfc *TwoFactorCreate) SetLastUsedStep(i int64) *TwoFactorCreate {
+	tfc.mutation.SetLastUsedStep(i)
+	return tfc
+}
+
+// SetNillableLastUsedStep sets the "last_used_step" field if the given value is not nil.
+func (tfc *TwoFactorCreate) SetNillableLastUsedStep(i *int64) *TwoFactorCreate {
+	if i != nil {
+		tfc.SetLastUsedStep(*i)
+	}
+	return tfc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tfc *TwoFactorCreate) SetCreatedAt(t time.Time) *TwoFactorCreate {
+	tfc.mutation.SetCreatedAt(t)
+	return tfc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tfc *TwoFactorCreate) SetNillableCreatedAt(t *time.Time) *TwoFactorCreate {
+	if t != nil {
+		tfc.SetCreatedAt(*t)
+	}
+	return tfc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tfc *TwoFactorCreate) SetUpdatedAt(t time.Time) *TwoFactorCreate {
+	tfc.mutation.SetUpdatedAt(t)
+	return tfc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (tfc *TwoFactorCreate) SetNillableUpdatedAt(t *time.Time) *TwoFactorCreate {
+	if t != nil {
+		tfc.SetUpdatedAt(*t)
+	}
+	return tfc
+}
+
+// Mutation returns the TwoFactorMutation object of the builder.
+func (tfc *TwoFactorCreate) Mutation() *TwoFactorMutation {
+	return tfc.mutation
+}
+
+// Save creates the TwoFactor in the database.
+func (tfc *TwoFactorCreate) Save(ctx context.Context) (*TwoFactor, error) {
+	tfc.defaults()
+	return withHooks(ctx, tfc.sqlSave, tfc.mutation, tfc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tfc *TwoFactorCreate) SaveX(ctx context.Context) *TwoFactor {
+	v, err := tfc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tfc *TwoFactorCreate) Exec(ctx context.Context) error {
+	_, err := tfc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tfc *TwoFactorCreate) ExecX(ctx context.Context) {
+	if err := tfc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tfc *TwoFactorCreate) defaults() {
+	if _, ok := tfc.mutation.Enabled(); !ok {
+		v := twofactor.DefaultEnabled
+		tfc.mutation.SetEnabled(v)
+	}
+	if _, ok := tfc.mutation.LastUsedStep(); !ok {
+		v := twofactor.DefaultLastUsedStep
+		tfc.mutation.SetLastUsedStep(v)
+	}
+	if _, ok := tfc.mutation.CreatedAt(); !ok {
+		v := twofactor.DefaultCreatedAt()
+		tfc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := tfc.mutation.UpdatedAt(); !ok {
+		v := twofactor.DefaultUpdatedAt()
+		tfc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tfc *TwoFactorCreate) check() error {
+	if _, ok := tfc.mutation.Username(); !ok {
+		return &ValidationError{Name: "username", err: errors.New(`ent: missing required field "TwoFactor.username"`)}
+	}
+	if v, ok := tfc.mutation.Username(); ok {
+		if err := twofactor.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "TwoFactor.username": %w`, err)}
+		}
+	}
+	if _, ok := tfc.mutation.Secret(); !ok {
+		return &ValidationError{Name: "secret", err: errors.New(`ent: missing required field "TwoFactor.secret"`)}
+	}
+	if v, ok := tfc.mutation.Secret(); ok {
+		if err := twofactor.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "TwoFactor.secret": %w`, err)}
+		}
+	}
+	if _, ok := tfc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "TwoFactor.enabled"`)}
+	}
+	if _, ok := tfc.mutation.LastUsedStep(); !ok {
+		return &ValidationError{Name: "last_used_step", err: errors.New(`ent: missing required field "TwoFactor.last_used_step"`)}
+	}
+	if _, ok := tfc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TwoFactor.created_at"`)}
+	}
+	if _, ok := tfc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TwoFactor.updated_at"`)}
+	}
+	return nil
+}
+
+func (tfc *TwoFactorCreate) sqlSave(ctx context.Context) (*TwoFactor, error) {
+	if err := tfc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tfc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tfc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	tfc.mutation.id = &_node.ID
+	tfc.mutation.done = true
+	return _node, nil
+}
+
+func (tfc *TwoFactorCreate) createSpec() (*TwoFactor, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TwoFactor{config: tfc.config}
+		_spec = sqlgraph.NewCreateSpec(twofactor.Table, sqlgraph.NewFieldSpec(twofactor.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = tfc.conflict
+	if value, ok := tfc.mutation.Username(); ok {
+		_spec.SetField(twofactor.FieldUsername, field.TypeString, value)
+		_node.Username = value
+	}
+	if value, ok := tfc.mutation.Secret(); ok {
+		_spec.SetField(twofactor.FieldSecret, field.TypeString, value)
+		_node.Secret = value
+	}
+	if value, ok := tfc.mutation.Enabled(); ok {
+		_spec.SetField(twofactor.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := tfc.mutation.LastUsedStep(); ok {
+		_spec.SetField(twofactor.FieldLastUsedStep, field.TypeInt64, value)
+		_node.LastUsedStep = value
+	}
+	if value, ok := tfc.mutation.CreatedAt(); ok {
+		_spec.SetField(twofactor.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := tfc.mutation.UpdatedAt(); ok {
+		_spec.SetField(twofactor.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TwoFactor.Create().
+//		SetUsername(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TwoFactorUpsert) {
+//			SetUsername(v+v).
+//		}).
+//		Exec(ctx)
+func (tfc *TwoFactorCreate) OnConflict(opts ...sql.ConflictOption) *TwoFactorUpsertOne {
+	tfc.conflict = opts
+	return &TwoFactorUpsertOne{
+		create: tfc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TwoFactor.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tfc *TwoFactorCreate) OnConflictColumns(columns ...string) *TwoFactorUpsertOne {
+	tfc.conflict = append(tfc.conflict, sql.ConflictColumns(columns...))
+	return &TwoFactorUpsertOne{
+		create: tfc,
+	}
+}
+
+type (
+	// TwoFactorUpsertOne is the builder for "upsert"-ing
+	//  one TwoFactor node.
+	TwoFactorUpsertOne struct {
+		create *TwoFactorCreate
+	}
+
+	// TwoFactorUpsert is the "OnConflict" setter.
+	TwoFactorUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUsername sets the "username" field.
+func (u *TwoFactorUpsert) SetUsername(v string) *TwoFactorUpsert {
+	u.Set(twofactor.FieldUsername, v)
+	return u
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *TwoFactorUpsert) UpdateUsername() *TwoFactorUpsert {
+	u.SetExcluded(twofactor.FieldUsername)
+	return u
+}
+
+// SetSecret sets the "secret" field.
+func (u *TwoFactorUpsert) SetSecret(v string) *TwoFactorUpsert {
+	u.Set(twofactor.FieldSecret, v)
+	return u
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *TwoFactorUpsert) UpdateSecret() *TwoFactorUpsert {
+	u.SetExcluded(twofactor.FieldSecret)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *TwoFactorUpsert) SetEnabled(v bool) *TwoFactorUpsert {
+	u.Set(twofactor.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *TwoFactorUpsert) UpdateEnabled() *TwoFactorUpsert {
+	u.SetExcluded(twofactor.FieldEnabled)
+	return u
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (u *TwoFactorUpsert) SetLastUsedStep(v int64) *TwoFactorUpsert {
+	u.Set(twofactor.FieldLastUsedStep, v)
+	return u
+}
+
+// UpdateLastUsedStep sets the "last_used_step" field to the value that was provided on create.
+func (u *TwoFactorUpsert) UpdateLastUsedStep() *TwoFactorUpsert {
+	u.SetExcluded(twofactor.FieldLastUsedStep)
+	return u
+}
+
+// AddLastUsedStep adds v to the "last_used_step" field.
+func (u *TwoFactorUpsert) AddLastUsedStep(v int64) *TwoFactorUpsert {
+	u.Add(twofactor.FieldLastUsedStep, v)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *TwoFactorUpsert) SetCreatedAt(v time.Time) *TwoFactorUpsert {
+	u.Set(twofactor.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *TwoFactorUpsert) UpdateCreatedAt() *TwoFactorUpsert {
+	u.SetExcluded(twofactor.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TwoFactorUpsert) SetUpdatedAt(v time.Time) *TwoFactorUpsert {
+	u.Set(twofactor.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TwoFactorUpsert) UpdateUpdatedAt() *TwoFactorUpsert {
+	u.SetExcluded(twofactor.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.TwoFactor.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TwoFactorUpsertOne) UpdateNewValues() *TwoFactorUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TwoFactor.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TwoFactorUpsertOne) Ignore() *TwoFactorUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TwoFactorUpsertOne) DoNothing() *TwoFactorUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TwoFactorCreate.OnConflict
+// documentation for more info.
+func (u *TwoFactorUpsertOne) Update(set func(*TwoFactorUpsert)) *TwoFactorUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TwoFactorUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUsername sets the "username" field.
+func (u *TwoFactorUpsertOne) SetUsername(v string) *TwoFactorUpsertOne {
+	return u.Update(func(s *TwoFactorUpsert) {
+		s.SetUsername(v)
+	})
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *TwoFactorUpsertOne) UpdateUsername() *TwoFactorUpsertOne {
+	return u.Update(func(s *TwoFactorUpsert) {
+		s.UpdateUsername()
+	})
+}
+
+// SetSecret sets the "secret" field.
+func (u *TwoFactorUpsertOne) SetSecret(v string) *TwoFactorUpsertOne {
+	return u.Update(func(s *TwoFactorUpsert) {
+		s.SetSecret(v)
+	})
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *TwoFactorUpsertOne) UpdateSecret() *TwoFactorUpsertOne {
+	return u.Update(func(s *TwoFactorUpsert) {
+		s.UpdateSecret()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *TwoFactorUpsertOne) SetEnabled(v bool) *TwoFactorUpsertOne {
+	return u.Update(func(s *TwoFactorUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *TwoFactorUpsertOne) UpdateEnabled() *TwoFactorUpsertOne {
+	return u.Update(func(s *TwoFactorUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (u *TwoFactorUpsertOne) SetLastUsedStep(v int64) *TwoFactorUpsertOne {
+	return u.Update(func(s *TwoFactorUpsert) {
+		s.SetLastUsedStep(v)
+	})
+}
+
+// AddLastUsedStep adds v to the "last_used_step" field.
+func (u *TwoFactorUpsertOne) AddLastUsedStep(v int64) *TwoFactorUpsertOne {
+	return u.Update(func(s *TwoFactorUpsert) {
+		s.AddLastUsedStep(v)
+	})
+}
+
+// UpdateLastUsedStep sets the "last_used_step" field to the value that was provided on create.
+func (u *TwoFactorUpsertOne) UpdateLastUsedStep() *TwoFactorUpsertOne {
+	return u.Update(func(s *TwoFactorUpsert) {
+		s.UpdateLastUsedStep()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *TwoFactorUpsertOne) SetCreatedAt(v time.Time) *TwoFactorUpsertOne {
+	return u.Update(func(s *TwoFactorUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *TwoFactorUpsertOne) UpdateCreatedAt() *TwoFactorUpsertOne {
+	return u.Update(func(s *TwoFactorUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TwoFactorUpsertOne) SetUpdatedAt(v time.Time) *TwoFactorUpsertOne {
+	return u.Update(func(s *TwoFactorUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TwoFactorUpsertOne) UpdateUpdatedAt() *TwoFactorUpsertOne {
+	return u.Update(func(s *TwoFactorUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TwoFactorUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TwoFactorCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TwoFactorUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TwoFactorUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TwoFactorUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TwoFactorCreateBulk is the builder for creating many TwoFactor entities in bulk.
+type TwoFactorCreateBulk struct {
+	config
+	err      error
+	builders []*TwoFactorCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TwoFactor entities in the database.
+func (tfcb *TwoFactorCreateBulk) Save(ctx context.Context) ([]*TwoFactor, error) {
+	if tfcb.err != nil {
+		return nil, tfcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tfcb.builders))
+	nodes := make([]*TwoFactor, len(tfcb.builders))
+	mutators := make([]Mutator, len(tfcb.builders))
+	for i := range tfcb.builders {
+		func(i int, root context.Context) {
+			builder := tfcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TwoFactorMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tfcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tfcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tfcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tfcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tfcb *TwoFactorCreateBulk) SaveX(ctx context.Context) []*TwoFactor {
+	v, err := tfcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tfcb *TwoFactorCreateBulk) Exec(ctx context.Context) error {
+	_, err := tfcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tfcb *TwoFactorCreateBulk) ExecX(ctx context.Context) {
+	if err := tfcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TwoFactor.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TwoFactorUpsert) {
+//			SetUsername(v+v).
+//		}).
+//		Exec(ctx)
+func (tfcb *TwoFactorCreateBulk) OnConflict(opts ...sql.ConflictOption) *TwoFactorUpsertBulk {
+	tfcb.conflict = opts
+	return &TwoFactorUpsertBulk{
+		create: tfcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TwoFactor.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tfcb *TwoFactorCreateBulk) OnConflictColumns(columns ...string) *TwoFactorUpsertBulk {
+	tfcb.conflict = append(tfcb.conflict, sql.ConflictColumns(columns...))
+	return &TwoFactorUpsertBulk{
+		create: tfcb,
+	}
+}
+
+// TwoFactorUpsertBulk is the builder for "upsert"-ing
+// a bulk of TwoFactor nodes.
+type TwoFactorUpsertBulk struct {
+	create *TwoFactorCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TwoFactor.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TwoFactorUpsertBulk) UpdateNewValues() *TwoFactorUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TwoFactor.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TwoFactorUpsertBulk) Ignore() *TwoFactorUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TwoFactorUpsertBulk) DoNothing() *TwoFactorUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TwoFactorCreateBulk.OnConflict
+// documentation for more info.
+func (u *TwoFactorUpsertBulk) Update(set func(*TwoFactorUpsert)) *TwoFactorUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TwoFactorUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUsername sets the "username" field.
+func (u *TwoFactorUpsertBulk) SetUsername(v string) *TwoFactorUpsertBulk {
+	return u.Update(func(s *TwoFactorUpsert) {
+		s.SetUsername(v)
+	})
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *TwoFactorUpsertBulk) UpdateUsername() *TwoFactorUpsertBulk {
+	return u.Update(func(s *TwoFactorUpsert) {
+		s.UpdateUsername()
+	})
+}
+
+// SetSecret sets the "secret" field.
+func (u *TwoFactorUpsertBulk) SetSecret(v string) *TwoFactorUpsertBulk {
+	return u.Update(func(s *TwoFactorUpsert) {
+		s.SetSecret(v)
+	})
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *TwoFactorUpsertBulk) UpdateSecret() *TwoFactorUpsertBulk {
+	return u.Update(func(s *TwoFactorUpsert) {
+		s.UpdateSecret()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *TwoFactorUpsertBulk) SetEnabled(v bool) *TwoFactorUpsertBulk {
+	return u.Update(func(s *TwoFactorUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *TwoFactorUpsertBulk) UpdateEnabled() *TwoFactorUpsertBulk {
+	return u.Update(func(s *TwoFactorUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (u *TwoFactorUpsertBulk) SetLastUsedStep(v int64) *TwoFactorUpsertBulk {
+	return u.Update(func(s *TwoFactorUpsert) {
+		s.SetLastUsedStep(v)
+	})
+}
+
+// AddLastUsedStep adds v to the "last_used_step" field.
+func (u *TwoFactorUpsertBulk) AddLastUsedStep(v int64) *TwoFactorUpsertBulk {
+	return u.Update(func(s *TwoFactorUpsert) {
+		s.AddLastUsedStep(v)
+	})
+}
+
+// UpdateLastUsedStep sets the "last_used_step" field to the value that was provided on create.
+func (u *TwoFactorUpsertBulk) UpdateLastUsedStep() *TwoFactorUpsertBulk {
+	return u.Update(func(s *TwoFactorUpsert) {
+		s.UpdateLastUsedStep()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *TwoFactorUpsertBulk) SetCreatedAt(v time.Time) *TwoFactorUpsertBulk {
+	return u.Update(func(s *TwoFactorUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *TwoFactorUpsertBulk) UpdateCreatedAt() *TwoFactorUpsertBulk {
+	return u.Update(func(s *TwoFactorUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TwoFactorUpsertBulk) SetUpdatedAt(v time.Time) *TwoFactorUpsertBulk {
+	return u.Update(func(s *TwoFactorUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TwoFactorUpsertBulk) UpdateUpdatedAt() *TwoFactorUpsertBulk {
+	return u.Update(func(s *TwoFactorUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TwoFactorUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TwoFactorCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TwoFactorCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TwoFactorUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
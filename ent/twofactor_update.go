@@ -0,0 +1,438 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"goblog/ent/predicate"
+	"goblog/ent/twofactor"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TwoFactorUpdate is the builder for updating TwoFactor entities.
+type TwoFactorUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TwoFactorMutation
+}
+
+// Where appends a list predicates to the TwoFactorUpdate builder.
+func (tfu *TwoFactorUpdate) Where(ps ...predicate.TwoFactor) *TwoFactorUpdate {
+	tfu.mutation.Where(ps...)
+	return tfu
+}
+
+// SetUsername sets the "username" field.
+func (tfu *TwoFactorUpdate) SetUsername(s string) *TwoFactorUpdate {
+	tfu.mutation.SetUsername(s)
+	return tfu
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (tfu *TwoFactorUpdate) SetNillableUsername(s *string) *TwoFactorUpdate {
+	if s != nil {
+		tfu.SetUsername(*s)
+	}
+	return tfu
+}
+
+// SetSecret sets the "secret" field.
+func (tfu *TwoFactorUpdate) SetSecret(s string) *TwoFactorUpdate {
+	tfu.mutation.SetSecret(s)
+	return tfu
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (tfu *TwoFactorUpdate) SetNillableSecret(s *string) *TwoFactorUpdate {
+	if s != nil {
+		tfu.SetSecret(*s)
+	}
+	return tfu
+}
+
+// SetEnabled sets the "enabled" field.
+func (tfu *TwoFactorUpdate) SetEnabled(b bool) *TwoFactorUpdate {
+	tfu.mutation.SetEnabled(b)
+	return tfu
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (tfu *TwoFactorUpdate) SetNillableEnabled(b *bool) *TwoFactorUpdate {
+	if b != nil {
+		tfu.SetEnabled(*b)
+	}
+	return tfu
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (tfu *TwoFactorUpdate) SetLastUsedStep(i int64) *TwoFactorUpdate {
+	tfu.mutation.ResetLastUsedStep()
+	tfu.mutation.SetLastUsedStep(i)
+	return tfu
+}
+
+// SetNillableLastUsedStep sets the "last_used_step" field if the given value is not nil.
+func (tfu *TwoFactorUpdate) SetNillableLastUsedStep(i *int64) *TwoFactorUpdate {
+	if i != nil {
+		tfu.SetLastUsedStep(*i)
+	}
+	return tfu
+}
+
+// AddLastUsedStep adds i to the "last_used_step" field.
+func (tfu *TwoFactorUpdate) AddLastUsedStep(i int64) *TwoFactorUpdate {
+	tfu.mutation.AddLastUsedStep(i)
+	return tfu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tfu *TwoFactorUpdate) SetCreatedAt(t time.Time) *TwoFactorUpdate {
+	tfu.mutation.SetCreatedAt(t)
+	return tfu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tfu *TwoFactorUpdate) SetNillableCreatedAt(t *time.Time) *TwoFactorUpdate {
+	if t != nil {
+		tfu.SetCreatedAt(*t)
+	}
+	return tfu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tfu *TwoFactorUpdate) SetUpdatedAt(t time.Time) *TwoFactorUpdate {
+	tfu.mutation.SetUpdatedAt(t)
+	return tfu
+}
+
+// Mutation returns the TwoFactorMutation object of the builder.
+func (tfu *TwoFactorUpdate) Mutation() *TwoFactorMutation {
+	return tfu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tfu *TwoFactorUpdate) Save(ctx context.Context) (int, error) {
+	tfu.defaults()
+	return withHooks(ctx, tfu.sqlSave, tfu.mutation, tfu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tfu *TwoFactorUpdate) SaveX(ctx context.Context) int {
+	affected, err := tfu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tfu *TwoFactorUpdate) Exec(ctx context.Context) error {
+	_, err := tfu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tfu *TwoFactorUpdate) ExecX(ctx context.Context) {
+	if err := tfu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tfu *TwoFactorUpdate) defaults() {
+	if _, ok := tfu.mutation.UpdatedAt(); !ok {
+		v := twofactor.UpdateDefaultUpdatedAt()
+		tfu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tfu *TwoFactorUpdate) check() error {
+	if v, ok := tfu.mutation.Username(); ok {
+		if err := twofactor.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "TwoFactor.username": %w`, err)}
+		}
+	}
+	if v, ok := tfu.mutation.Secret(); ok {
+		if err := twofactor.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "TwoFactor.secret": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (tfu *TwoFactorUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tfu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(twofactor.Table, twofactor.Columns, sqlgraph.NewFieldSpec(twofactor.FieldID, field.TypeInt))
+	if ps := tfu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tfu.mutation.Username(); ok {
+		_spec.SetField(twofactor.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := tfu.mutation.Secret(); ok {
+		_spec.SetField(twofactor.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := tfu.mutation.Enabled(); ok {
+		_spec.SetField(twofactor.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := tfu.mutation.LastUsedStep(); ok {
+		_spec.SetField(twofactor.FieldLastUsedStep, field.TypeInt64, value)
+	}
+	if value, ok := tfu.mutation.AddedLastUsedStep(); ok {
+		_spec.AddField(twofactor.FieldLastUsedStep, field.TypeInt64, value)
+	}
+	if value, ok := tfu.mutation.CreatedAt(); ok {
+		_spec.SetField(twofactor.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := tfu.mutation.UpdatedAt(); ok {
+		_spec.SetField(twofactor.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tfu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{twofactor.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tfu.mutation.done = true
+	return n, nil
+}
+
+// TwoFactorUpdateOne is the builder for updating a single TwoFactor entity.
+type TwoFactorUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TwoFactorMutation
+}
+
+// SetUsername sets the "username" field.
+func (tfuo *TwoFactorUpdateOne) SetUsername(s string) *TwoFactorUpdateOne {
+	tfuo.mutation.SetUsername(s)
+	return tfuo
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (tfuo *TwoFactorUpdateOne) SetNillableUsername(s *string) *TwoFactorUpdateOne {
+	if s != nil {
+		tfuo.SetUsername(*s)
+	}
+	return tfuo
+}
+
+// SetSecret sets the "secret" field.
+func (tfuo *TwoFactorUpdateOne) SetSecret(s string) *TwoFactorUpdateOne {
+	tfuo.mutation.SetSecret(s)
+	return tfuo
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (tfuo *TwoFactorUpdateOne) SetNillableSecret(s *string) *TwoFactorUpdateOne {
+	if s != nil {
+		tfuo.SetSecret(*s)
+	}
+	return tfuo
+}
+
+// SetEnabled sets the "enabled" field.
+func (tfuo *TwoFactorUpdateOne) SetEnabled(b bool) *TwoFactorUpdateOne {
+	tfuo.mutation.SetEnabled(b)
+	return tfuo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (tfuo *TwoFactorUpdateOne) SetNillableEnabled(b *bool) *TwoFactorUpdateOne {
+	if b != nil {
+		tfuo.SetEnabled(*b)
+	}
+	return tfuo
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (tfuo *TwoFactorUpdateOne) SetLastUsedStep(i int64) *TwoFactorUpdateOne {
+	tfuo.mutation.ResetLastUsedStep()
+	tfuo.mutation.SetLastUsedStep(i)
+	return tfuo
+}
+
+// SetNillableLastUsedStep sets the "last_used_step" field if the given value is not nil.
+func (tfuo *TwoFactorUpdateOne) SetNillableLastUsedStep(i *int64) *TwoFactorUpdateOne {
+	if i != nil {
+		tfuo.SetLastUsedStep(*i)
+	}
+	return tfuo
+}
+
+// AddLastUsedStep adds i to the "last_used_step" field.
+func (tfuo *TwoFactorUpdateOne) AddLastUsedStep(i int64) *TwoFactorUpdateOne {
+	tfuo.mutation.AddLastUsedStep(i)
+	return tfuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tfuo *TwoFactorUpdateOne) SetCreatedAt(t time.Time) *TwoFactorUpdateOne {
+	tfuo.mutation.SetCreatedAt(t)
+	return tfuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tfuo *TwoFactorUpdateOne) SetNillableCreatedAt(t *time.Time) *TwoFactorUpdateOne {
+	if t != nil {
+		tfuo.SetCreatedAt(*t)
+	}
+	return tfuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tfuo *TwoFactorUpdateOne) SetUpdatedAt(t time.Time) *TwoFactorUpdateOne {
+	tfuo.mutation.SetUpdatedAt(t)
+	return tfuo
+}
+
+// Mutation returns the TwoFactorMutation object of the builder.
+func (tfuo *TwoFactorUpdateOne) Mutation() *TwoFactorMutation {
+	return tfuo.mutation
+}
+
+// Where appends a list predicates to the TwoFactorUpdate builder.
+func (tfuo *TwoFactorUpdateOne) Where(ps ...predicate.TwoFactor) *TwoFactorUpdateOne {
+	tfuo.mutation.Where(ps...)
+	return tfuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tfuo *TwoFactorUpdateOne) Select(field string, fields ...string) *TwoFactorUpdateOne {
+	tfuo.fields = append([]string{field}, fields...)
+	return tfuo
+}
+
+// Save executes the query and returns the updated TwoFactor entity.
+func (tfuo *TwoFactorUpdateOne) Save(ctx context.Context) (*TwoFactor, error) {
+	tfuo.defaults()
+	return withHooks(ctx, tfuo.sqlSave, tfuo.mutation, tfuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tfuo *TwoFactorUpdateOne) SaveX(ctx context.Context) *TwoFactor {
+	node, err := tfuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tfuo *TwoFactorUpdateOne) Exec(ctx context.Context) error {
+	_, err := tfuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tfuo *TwoFactorUpdateOne) ExecX(ctx context.Context) {
+	if err := tfuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tfuo *TwoFactorUpdateOne) defaults() {
+	if _, ok := tfuo.mutation.UpdatedAt(); !ok {
+		v := twofactor.UpdateDefaultUpdatedAt()
+		tfuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tfuo *TwoFactorUpdateOne) check() error {
+	if v, ok := tfuo.mutation.Username(); ok {
+		if err := twofactor.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "TwoFactor.username": %w`, err)}
+		}
+	}
+	if v, ok := tfuo.mutation.Secret(); ok {
+		if err := twofactor.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "TwoFactor.secret": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (tfuo *TwoFactorUpdateOne) sqlSave(ctx context.Context) (_node *TwoFactor, err error) {
+	if err := tfuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(twofactor.Table, twofactor.Columns, sqlgraph.NewFieldSpec(twofactor.FieldID, field.TypeInt))
+	id, ok := tfuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TwoFactor.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tfuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, twofactor.FieldID)
+		for _, f := range fields {
+			if !twofactor.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != twofactor.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tfuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tfuo.mutation.Username(); ok {
+		_spec.SetField(twofactor.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := tfuo.mutation.Secret(); ok {
+		_spec.SetField(twofactor.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := tfuo.mutation.Enabled(); ok {
+		_spec.SetField(twofactor.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := tfuo.mutation.LastUsedStep(); ok {
+		_spec.SetField(twofactor.FieldLastUsedStep, field.TypeInt64, value)
+	}
+	if value, ok := tfuo.mutation.AddedLastUsedStep(); ok {
+		_spec.AddField(twofactor.FieldLastUsedStep, field.TypeInt64, value)
+	}
+	if value, ok := tfuo.mutation.CreatedAt(); ok {
+		_spec.SetField(twofactor.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := tfuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(twofactor.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &TwoFactor{config: tfuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tfuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{twofactor.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tfuo.mutation.done = true
+	return _node, nil
+}
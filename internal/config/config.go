@@ -1,24 +1,41 @@
 package config
 
 import (
+	"errors"
 	"os"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 )
 
 // Config 应用配置
 type Config struct {
-	Server   ServerConfig   `json:"server"`
-	Database DatabaseConfig `json:"database"`
-	JWT      JWTConfig      `json:"jwt"`
-	Admin    AdminConfig    `json:"admin"`
+	Server    ServerConfig    `json:"server"`
+	Database  DatabaseConfig  `json:"database"`
+	JWT       JWTConfig       `json:"jwt"`
+	Admin     AdminConfig     `json:"admin"`
+	Login     LoginConfig     `json:"login"`
+	TwoFactor TwoFactorConfig `json:"two_factor"`
+	OIDC      OIDCConfig      `json:"oidc"`
+	Article   ArticleConfig   `json:"article"`
+	Comment   CommentConfig   `json:"comment"`
+	RateLimit RateLimitConfig `json:"rate_limit"`
+	HTTPCache HTTPCacheConfig `json:"http_cache"`
+	Cache     CacheConfig     `json:"cache"`
+	Webhook   WebhookConfig   `json:"webhook"`
+	Outbox    OutboxConfig    `json:"outbox"`
+	Stream    StreamConfig    `json:"stream"`
 }
 
 // ServerConfig 服务器配置
 type ServerConfig struct {
+	Environment  string        `json:"environment"` // development 或 production
 	Port         string        `json:"port"`
 	ReadTimeout  time.Duration `json:"read_timeout"`
 	WriteTimeout time.Duration `json:"write_timeout"`
+	// TrustedProxies 受信任的反向代理网段（CIDR），只有来自这些地址的请求才读取X-Forwarded-For
+	TrustedProxies []string `json:"trusted_proxies"`
 }
 
 // DatabaseConfig 数据库配置
@@ -29,39 +46,253 @@ type DatabaseConfig struct {
 
 // JWTConfig JWT配置
 type JWTConfig struct {
-	Secret     string        `json:"secret"`
-	Expiration time.Duration `json:"expiration"`
+	Secret            string        `json:"secret"`
+	Expiration        time.Duration `json:"expiration"`         // 访问令牌有效期
+	RefreshExpiration time.Duration `json:"refresh_expiration"` // 刷新令牌有效期
+	Keys              []string      `json:"keys"`               // 非对称签名密钥，格式为 kid=PEM文件路径，配置后不再使用Secret签名
+	ActiveKeyID       string        `json:"active_key_id"`      // 用于签名的密钥kid，为空时使用第一个包含私钥的密钥
 }
 
+// insecureSecrets 示例配置中的默认JWT密钥，生产环境禁止使用
+var insecureSecrets = []string{"", "your-secret-key", "your-production-secret-key", "secret"}
+
+// minSecretLength 生产环境JWT共享密钥的最小长度
+const minSecretLength = 32
+
 // AdminConfig 管理员配置
 type AdminConfig struct {
 	Username string `json:"username"`
 	Password string `json:"password"`
 }
 
+// LoginConfig 登录防暴力破解配置
+type LoginConfig struct {
+	MaxFailures      int           `json:"max_failures"`        // 同一用户名连续失败多少次后锁定
+	MaxFailuresPerIP int           `json:"max_failures_per_ip"` // 同一IP连续失败多少次后锁定
+	FailureWindow    time.Duration `json:"failure_window"`      // 超过该时间未再失败则重新计数
+	BaseBackoff      time.Duration `json:"base_backoff"`        // 首次失败后的等待时间，之后每次翻倍
+	MaxBackoff       time.Duration `json:"max_backoff"`
+	LockoutDuration  time.Duration `json:"lockout_duration"`
+}
+
+// TwoFactorConfig 两步验证配置
+type TwoFactorConfig struct {
+	Issuer              string        `json:"issuer"`               // 验证器应用中显示的发行方
+	RequireForAdmin     bool          `json:"require_for_admin"`    // 管理员必须开启两步验证
+	ChallengeExpiration time.Duration `json:"challenge_expiration"` // 密码验证通过后完成两步验证的时限
+}
+
+// OIDCConfig 单点登录配置
+type OIDCConfig struct {
+	Enabled         bool          `json:"enabled"`
+	Issuer          string        `json:"issuer"` // 身份提供方地址，用于发现 /.well-known/openid-configuration
+	ClientID        string        `json:"client_id"`
+	ClientSecret    string        `json:"client_secret"`
+	RedirectURL     string        `json:"redirect_url"` // 回调地址，指向 /auth/oidc/callback
+	Scopes          []string      `json:"scopes"`
+	UsernameClaim   string        `json:"username_claim"` // 作为本地用户名的声明，缺失时依次使用email和sub
+	RoleClaim       string        `json:"role_claim"`     // 包含用户组的声明
+	AdminGroups     []string      `json:"admin_groups"`   // 映射为管理员的用户组
+	EditorGroups    []string      `json:"editor_groups"`  // 映射为编辑的用户组
+	DefaultRole     string        `json:"default_role"`   // 不属于以上用户组时的角色
+	StateExpiration time.Duration `json:"state_expiration"`
+}
+
+// CommentConfig 评论配置
+type CommentConfig struct {
+	SpamThreshold  float64 `json:"spam_threshold"`
+	MaxLinks       int     `json:"max_links"`
+	MaxLinkDensity float64 `json:"max_link_density"`
+}
+
+// WebhookConfig Webhook投递配置，失败后按 BackoffBase*2^(n-1) 重试，不超过BackoffMax
+type WebhookConfig struct {
+	MaxAttempts  int           `json:"max_attempts"`
+	BackoffBase  time.Duration `json:"backoff_base"`
+	BackoffMax   time.Duration `json:"backoff_max"`
+	Timeout      time.Duration `json:"timeout"`
+	PollInterval time.Duration `json:"poll_interval"`
+	BatchSize    int           `json:"batch_size"`
+}
+
+// OutboxConfig 事件发件箱分发配置，订阅者处理失败后按 BackoffBase*2^(n-1) 重试
+type OutboxConfig struct {
+	MaxAttempts  int           `json:"max_attempts"`
+	BackoffBase  time.Duration `json:"backoff_base"`
+	BackoffMax   time.Duration `json:"backoff_max"`
+	PollInterval time.Duration `json:"poll_interval"`
+	BatchSize    int           `json:"batch_size"`
+}
+
+// ArticleConfig 文章编辑配置
+type ArticleConfig struct {
+	LockTTL time.Duration `json:"lock_ttl"` // 编辑租约有效期，编辑器应在到期前续期
+}
+
+// StreamConfig 实时事件流配置
+type StreamConfig struct {
+	BufferSize int           `json:"buffer_size"` // 保留供断线续传的最近事件数量
+	Heartbeat  time.Duration `json:"heartbeat"`
+	Store      string        `json:"store"` // memory 或 postgres，postgres时通过LISTEN/NOTIFY推送给所有实例的连接
+}
+
+// HTTPCacheConfig 各路由组读接口的Cache-Control策略，为空时不设置
+type HTTPCacheConfig struct {
+	Public  string `json:"public"`  // 公开读接口
+	Private string `json:"private"` // 需要认证的接口
+}
+
+// CacheConfig 文章、分类、标签仓储的读穿缓存配置
+type CacheConfig struct {
+	Enabled  bool          `json:"enabled"`
+	Store    string        `json:"store"`     // memory、redis 或 postgres，后两者多实例共享缓存和失效
+	RedisURL string        `json:"redis_url"` // redis时的连接地址
+	Size     int           `json:"size"`      // 进程内缓存最多保存的条目数
+	TTL      time.Duration `json:"ttl"`       // 条目有效期
+	LocalTTL time.Duration `json:"local_ttl"` // 使用共享存储时进程内副本的有效期
+}
+
+// RateLimitConfig 限流配置
+type RateLimitConfig struct {
+	Store      string          `json:"store"` // memory 或 postgres
+	Login      RateLimitPolicy `json:"login"`
+	PublicRead RateLimitPolicy `json:"public_read"`
+	Write      RateLimitPolicy `json:"write"`
+	Comment    RateLimitPolicy `json:"comment"`
+	Stream     RateLimitPolicy `json:"stream"` // 建立实时事件流连接，与写接口分开计数
+}
+
+// RateLimitPolicy 令牌桶限流策略，每个Period内允许Requests次请求，Requests为0表示不限流
+type RateLimitPolicy struct {
+	Requests int           `json:"requests"`
+	Period   time.Duration `json:"period"`
+	Burst    int           `json:"burst"`
+}
+
 // Load 加载配置
 func Load() *Config {
 	return &Config{
 		Server: ServerConfig{
-			Port:         getPortEnv(),
-			ReadTimeout:  getDurationEnv("SERVER_READ_TIMEOUT", 10*time.Second),
-			WriteTimeout: getDurationEnv("SERVER_WRITE_TIMEOUT", 10*time.Second),
+			Environment:    getEnv("APP_ENV", "development"),
+			Port:           getPortEnv(),
+			ReadTimeout:    getDurationEnv("SERVER_READ_TIMEOUT", 10*time.Second),
+			WriteTimeout:   getDurationEnv("SERVER_WRITE_TIMEOUT", 10*time.Second),
+			TrustedProxies: getListEnv("SERVER_TRUSTED_PROXIES", nil),
 		},
 		Database: DatabaseConfig{
 			Driver: getEnv("DB_DRIVER", "postgres"),
 			DSN:    getEnv("DB_DSN", "host=localhost port=5432 user=goblog password=goblog123 dbname=goblog sslmode=disable"),
 		},
 		JWT: JWTConfig{
-			Secret:     getEnv("JWT_SECRET", "your-secret-key"),
-			Expiration: getDurationEnv("JWT_EXPIRATION", 24*time.Hour),
+			Secret:            getEnv("JWT_SECRET", "your-secret-key"),
+			Expiration:        getDurationEnv("JWT_EXPIRATION", 15*time.Minute),
+			RefreshExpiration: getDurationEnv("JWT_REFRESH_EXPIRATION", 30*24*time.Hour),
+			Keys:              getListEnv("JWT_KEYS", nil),
+			ActiveKeyID:       getEnv("JWT_ACTIVE_KID", ""),
 		},
 		Admin: AdminConfig{
 			Username: getEnv("ADMIN_USERNAME", "admin"),
 			Password: getEnv("ADMIN_PASSWORD", "admin123"),
 		},
+		Login: LoginConfig{
+			MaxFailures:      getIntEnv("LOGIN_MAX_FAILURES", 5),
+			MaxFailuresPerIP: getIntEnv("LOGIN_MAX_FAILURES_PER_IP", 20),
+			FailureWindow:    getDurationEnv("LOGIN_FAILURE_WINDOW", 15*time.Minute),
+			BaseBackoff:      getDurationEnv("LOGIN_BASE_BACKOFF", time.Second),
+			MaxBackoff:       getDurationEnv("LOGIN_MAX_BACKOFF", 30*time.Second),
+			LockoutDuration:  getDurationEnv("LOGIN_LOCKOUT_DURATION", 15*time.Minute),
+		},
+		TwoFactor: TwoFactorConfig{
+			Issuer:              getEnv("TWO_FACTOR_ISSUER", "goblog"),
+			RequireForAdmin:     getBoolEnv("TWO_FACTOR_REQUIRED_FOR_ADMIN", false),
+			ChallengeExpiration: getDurationEnv("TWO_FACTOR_CHALLENGE_EXPIRATION", 5*time.Minute),
+		},
+		OIDC: OIDCConfig{
+			Enabled:         getBoolEnv("OIDC_ENABLED", false),
+			Issuer:          getEnv("OIDC_ISSUER", ""),
+			ClientID:        getEnv("OIDC_CLIENT_ID", ""),
+			ClientSecret:    getEnv("OIDC_CLIENT_SECRET", ""),
+			RedirectURL:     getEnv("OIDC_REDIRECT_URL", "http://localhost:8080/auth/oidc/callback"),
+			Scopes:          getListEnv("OIDC_SCOPES", []string{"openid", "profile", "email"}),
+			UsernameClaim:   getEnv("OIDC_USERNAME_CLAIM", "preferred_username"),
+			RoleClaim:       getEnv("OIDC_ROLE_CLAIM", "groups"),
+			AdminGroups:     getListEnv("OIDC_ADMIN_GROUPS", nil),
+			EditorGroups:    getListEnv("OIDC_EDITOR_GROUPS", nil),
+			DefaultRole:     getEnv("OIDC_DEFAULT_ROLE", "author"),
+			StateExpiration: getDurationEnv("OIDC_STATE_EXPIRATION", 10*time.Minute),
+		},
+		Article: ArticleConfig{
+			LockTTL: getDurationEnv("ARTICLE_LOCK_TTL", 5*time.Minute),
+		},
+		Comment: CommentConfig{
+			SpamThreshold:  getFloatEnv("COMMENT_SPAM_THRESHOLD", 0.9),
+			MaxLinks:       getIntEnv("COMMENT_MAX_LINKS", 2),
+			MaxLinkDensity: getFloatEnv("COMMENT_MAX_LINK_DENSITY", 0.2),
+		},
+		RateLimit: RateLimitConfig{
+			Store:      getEnv("RATE_LIMIT_STORE", "memory"),
+			Login:      getRateLimitPolicyEnv("RATE_LIMIT_LOGIN", 5, time.Minute),
+			PublicRead: getRateLimitPolicyEnv("RATE_LIMIT_PUBLIC", 120, time.Minute),
+			Write:      getRateLimitPolicyEnv("RATE_LIMIT_WRITE", 60, time.Minute),
+			Comment:    getRateLimitPolicyEnv("RATE_LIMIT_COMMENT", 5, time.Minute),
+			Stream:     getRateLimitPolicyEnv("RATE_LIMIT_STREAM", 30, time.Minute),
+		},
+		HTTPCache: HTTPCacheConfig{
+			Public:  getEnv("CACHE_CONTROL_PUBLIC", "public, max-age=0, must-revalidate"),
+			Private: getEnv("CACHE_CONTROL_PRIVATE", "private, no-cache"),
+		},
+		Cache: CacheConfig{
+			Enabled:  getBoolEnv("CACHE_ENABLED", true),
+			Store:    getEnv("CACHE_STORE", "memory"),
+			RedisURL: getEnv("CACHE_REDIS_URL", "redis://localhost:6379/0"),
+			Size:     getIntEnv("CACHE_SIZE", 10000),
+			TTL:      getDurationEnv("CACHE_TTL", 5*time.Minute),
+			LocalTTL: getDurationEnv("CACHE_LOCAL_TTL", 5*time.Second),
+		},
+		Webhook: WebhookConfig{
+			MaxAttempts:  getIntEnv("WEBHOOK_MAX_ATTEMPTS", 8),
+			BackoffBase:  getDurationEnv("WEBHOOK_BACKOFF_BASE", 30*time.Second),
+			BackoffMax:   getDurationEnv("WEBHOOK_BACKOFF_MAX", 6*time.Hour),
+			Timeout:      getDurationEnv("WEBHOOK_TIMEOUT", 10*time.Second),
+			PollInterval: getDurationEnv("WEBHOOK_POLL_INTERVAL", 5*time.Second),
+			BatchSize:    getIntEnv("WEBHOOK_BATCH_SIZE", 20),
+		},
+		Outbox: OutboxConfig{
+			MaxAttempts:  getIntEnv("OUTBOX_MAX_ATTEMPTS", 10),
+			BackoffBase:  getDurationEnv("OUTBOX_BACKOFF_BASE", 5*time.Second),
+			BackoffMax:   getDurationEnv("OUTBOX_BACKOFF_MAX", 10*time.Minute),
+			PollInterval: getDurationEnv("OUTBOX_POLL_INTERVAL", time.Second),
+			BatchSize:    getIntEnv("OUTBOX_BATCH_SIZE", 50),
+		},
+		Stream: StreamConfig{
+			BufferSize: getIntEnv("STREAM_BUFFER_SIZE", 1000),
+			Heartbeat:  getDurationEnv("STREAM_HEARTBEAT", 15*time.Second),
+			Store:      getEnv("STREAM_STORE", "memory"),
+		},
 	}
 }
 
+// IsProduction 是否为生产环境
+func (c *Config) IsProduction() bool {
+	return c.Server.Environment == "production"
+}
+
+// Validate 校验配置，生产环境拒绝使用默认或过短的JWT共享密钥
+func (c *Config) Validate() error {
+	if !c.IsProduction() || len(c.JWT.Keys) > 0 {
+		return nil
+	}
+
+	if slices.Contains(insecureSecrets, c.JWT.Secret) {
+		return errors.New("生产环境禁止使用默认的JWT_SECRET，请设置JWT_SECRET或JWT_KEYS")
+	}
+	if len(c.JWT.Secret) < minSecretLength {
+		return errors.New("生产环境的JWT_SECRET长度不能少于32个字符")
+	}
+
+	return nil
+}
+
 // getEnv 获取环境变量，如果不存在则返回默认值
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
@@ -109,3 +340,49 @@ func getIntEnv(key string, defaultValue int) int {
 	}
 	return defaultValue
 }
+
+// getBoolEnv 获取布尔环境变量
+func getBoolEnv(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if boolValue, err := strconv.ParseBool(value); err == nil {
+			return boolValue
+		}
+	}
+	return defaultValue
+}
+
+// getListEnv 获取逗号分隔的列表环境变量
+func getListEnv(key string, defaultValue []string) []string {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// getFloatEnv 获取浮点数环境变量
+func getFloatEnv(key string, defaultValue float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if floatValue, err := strconv.ParseFloat(value, 64); err == nil {
+			return floatValue
+		}
+	}
+	return defaultValue
+}
+
+// getRateLimitPolicyEnv 获取限流策略环境变量，如 RATE_LIMIT_LOGIN_REQUESTS、RATE_LIMIT_LOGIN_PERIOD、RATE_LIMIT_LOGIN_BURST
+func getRateLimitPolicyEnv(prefix string, defaultRequests int, defaultPeriod time.Duration) RateLimitPolicy {
+	requests := getIntEnv(prefix+"_REQUESTS", defaultRequests)
+	return RateLimitPolicy{
+		Requests: requests,
+		Period:   getDurationEnv(prefix+"_PERIOD", defaultPeriod),
+		Burst:    getIntEnv(prefix+"_BURST", requests),
+	}
+}
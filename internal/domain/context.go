@@ -0,0 +1,38 @@
+package domain
+
+import "context"
+
+// Actor 发起当前请求的操作者，由认证中间件写入请求上下文，供审计等服务使用
+type Actor struct {
+	Username  string
+	Role      string
+	APIKeyID  int
+	IP        string
+	RequestID string
+}
+
+type actorContextKey struct{}
+
+// WithActor 返回携带操作者信息的上下文
+func WithActor(ctx context.Context, actor *Actor) context.Context {
+	return context.WithValue(ctx, actorContextKey{}, actor)
+}
+
+// ActorFromContext 从上下文中获取操作者信息，不存在时返回nil
+func ActorFromContext(ctx context.Context) *Actor {
+	actor, _ := ctx.Value(actorContextKey{}).(*Actor)
+	return actor
+}
+
+type eventIDContextKey struct{}
+
+// WithEventID 返回携带发件箱事件ID的上下文，订阅者据此识别重复投递
+func WithEventID(ctx context.Context, eventID string) context.Context {
+	return context.WithValue(ctx, eventIDContextKey{}, eventID)
+}
+
+// EventIDFromContext 从上下文中获取发件箱事件ID，同步发布时不存在，返回空字符串
+func EventIDFromContext(ctx context.Context) string {
+	eventID, _ := ctx.Value(eventIDContextKey{}).(string)
+	return eventID
+}
@@ -1,6 +1,10 @@
 package domain
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"time"
+)
 
 // 领域错误定义
 var (
@@ -10,4 +14,58 @@ var (
 	ErrUnauthorized      = errors.New("unauthorized")
 	ErrForbidden         = errors.New("forbidden")
 	ErrInternalError     = errors.New("internal server error")
+	ErrTooManyAttempts   = errors.New("too many attempts")
+	ErrInvalidTransition = errors.New("invalid state transition")
+	ErrVersionConflict   = errors.New("version conflict")
+	ErrLocked            = errors.New("resource is locked")
+	ErrCategoryCycle     = errors.New("category cycle")
+	ErrCategoryInUse     = errors.New("category has articles")
+	ErrMoved             = errors.New("resource moved")
 )
+
+// ConflictError 与服务端当前状态冲突的错误，Current 为服务端当前的资源，返回给客户端用于合并或提示
+type ConflictError struct {
+	Err     error
+	Current interface{}
+}
+
+// Error 实现error接口
+func (e *ConflictError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap 返回原始错误
+func (e *ConflictError) Unwrap() error {
+	return e.Err
+}
+
+// MovedError 资源已合并到其他资源，Location 为新资源的ID，用于重定向旧的链接
+type MovedError struct {
+	Location int
+}
+
+// Error 实现error接口
+func (e *MovedError) Error() string {
+	return fmt.Sprintf("%v to %d", ErrMoved, e.Location)
+}
+
+// Unwrap 返回原始错误
+func (e *MovedError) Unwrap() error {
+	return ErrMoved
+}
+
+// RetryAfterError 需要等待一段时间后才能重试的错误
+type RetryAfterError struct {
+	Err        error
+	RetryAfter time.Duration
+}
+
+// Error 实现error接口
+func (e *RetryAfterError) Error() string {
+	return fmt.Sprintf("%v, retry after %s", e.Err, e.RetryAfter)
+}
+
+// Unwrap 返回原始错误
+func (e *RetryAfterError) Unwrap() error {
+	return e.Err
+}
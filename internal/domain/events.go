@@ -0,0 +1,273 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// 领域事件名称
+const (
+	EventArticleCreated   = "article.created"
+	EventArticlePublished = "article.published"
+	EventArticleUpdated   = "article.updated"
+	EventArticleDeleted   = "article.deleted"
+	EventCategoryCreated  = "category.created"
+	EventCategoryUpdated  = "category.updated"
+	EventCategoryDeleted  = "category.deleted"
+	EventTagCreated       = "tag.created"
+	EventTagUpdated       = "tag.updated"
+	EventTagDeleted       = "tag.deleted"
+	EventCommentCreated   = "comment.created"
+	EventCommentModerated = "comment.moderated"
+	EventCommentDeleted   = "comment.deleted"
+)
+
+// ErrNoSubscriber 订阅者不存在，例如发件箱中的记录对应的订阅者已被移除
+var ErrNoSubscriber = errors.New("no such event subscriber")
+
+// Event 领域事件
+type Event interface {
+	EventName() string
+}
+
+// ArticleCreated 文章已创建
+type ArticleCreated struct {
+	Article *Article `json:"article"`
+}
+
+// ArticlePublished 文章已发布，创建时直接发布或草稿首次发布时产生
+type ArticlePublished struct {
+	Article *Article `json:"article"`
+}
+
+// ArticleUpdated 文章已更新
+type ArticleUpdated struct {
+	Before  *Article `json:"before"`
+	Article *Article `json:"article"`
+}
+
+// ArticleDeleted 文章已删除
+type ArticleDeleted struct {
+	Article *Article `json:"article"`
+}
+
+// CategoryCreated 分类已创建
+type CategoryCreated struct {
+	Category *Category `json:"category"`
+}
+
+// CategoryUpdated 分类已更新
+type CategoryUpdated struct {
+	Before   *Category `json:"before"`
+	Category *Category `json:"category"`
+}
+
+// CategoryDeleted 分类已删除，Deletion 记录文章和子分类的去向
+type CategoryDeleted struct {
+	Category *Category         `json:"category"`
+	Deletion *CategoryDeletion `json:"deletion,omitempty"`
+}
+
+// TagCreated 标签已创建
+type TagCreated struct {
+	Tag *Tag `json:"tag"`
+}
+
+// TagUpdated 标签已更新
+type TagUpdated struct {
+	Before *Tag `json:"before"`
+	Tag    *Tag `json:"tag"`
+}
+
+// TagDeleted 标签已删除，MergedInto 为合并时的目标标签
+type TagDeleted struct {
+	Tag        *Tag `json:"tag"`
+	MergedInto *Tag `json:"merged_into,omitempty"`
+}
+
+// CommentCreated 评论已发表，包含被自动判定为垃圾的评论
+type CommentCreated struct {
+	Comment *Comment `json:"comment"`
+}
+
+// CommentModerated 评论审核状态已变更
+type CommentModerated struct {
+	Before  *Comment `json:"before"`
+	Comment *Comment `json:"comment"`
+}
+
+// CommentDeleted 评论已删除
+type CommentDeleted struct {
+	Comment *Comment `json:"comment"`
+}
+
+// EventName 实现Event接口
+func (ArticleCreated) EventName() string { return EventArticleCreated }
+
+// EventName 实现Event接口
+func (ArticlePublished) EventName() string { return EventArticlePublished }
+
+// EventName 实现Event接口
+func (ArticleUpdated) EventName() string { return EventArticleUpdated }
+
+// EventName 实现Event接口
+func (ArticleDeleted) EventName() string { return EventArticleDeleted }
+
+// EventName 实现Event接口
+func (CategoryCreated) EventName() string { return EventCategoryCreated }
+
+// EventName 实现Event接口
+func (CategoryUpdated) EventName() string { return EventCategoryUpdated }
+
+// EventName 实现Event接口
+func (CategoryDeleted) EventName() string { return EventCategoryDeleted }
+
+// EventName 实现Event接口
+func (TagCreated) EventName() string { return EventTagCreated }
+
+// EventName 实现Event接口
+func (TagUpdated) EventName() string { return EventTagUpdated }
+
+// EventName 实现Event接口
+func (TagDeleted) EventName() string { return EventTagDeleted }
+
+// EventName 实现Event接口
+func (CommentCreated) EventName() string { return EventCommentCreated }
+
+// EventName 实现Event接口
+func (CommentModerated) EventName() string { return EventCommentModerated }
+
+// EventName 实现Event接口
+func (CommentDeleted) EventName() string { return EventCommentDeleted }
+
+// eventDecoders 事件名称到反序列化函数的映射，用于从发件箱恢复事件
+var eventDecoders = map[string]func(data []byte) (Event, error){
+	EventArticleCreated:   decodeEvent[ArticleCreated],
+	EventArticlePublished: decodeEvent[ArticlePublished],
+	EventArticleUpdated:   decodeEvent[ArticleUpdated],
+	EventArticleDeleted:   decodeEvent[ArticleDeleted],
+	EventCategoryCreated:  decodeEvent[CategoryCreated],
+	EventCategoryUpdated:  decodeEvent[CategoryUpdated],
+	EventCategoryDeleted:  decodeEvent[CategoryDeleted],
+	EventTagCreated:       decodeEvent[TagCreated],
+	EventTagUpdated:       decodeEvent[TagUpdated],
+	EventTagDeleted:       decodeEvent[TagDeleted],
+	EventCommentCreated:   decodeEvent[CommentCreated],
+	EventCommentModerated: decodeEvent[CommentModerated],
+	EventCommentDeleted:   decodeEvent[CommentDeleted],
+}
+
+// DecodeEvent 根据事件名称反序列化事件
+func DecodeEvent(name string, data []byte) (Event, error) {
+	decode, ok := eventDecoders[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown event %q", name)
+	}
+	return decode(data)
+}
+
+func decodeEvent[E Event](data []byte) (Event, error) {
+	var event E
+	if err := json.Unmarshal(data, &event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// EventHandler 事件处理函数
+type EventHandler func(ctx context.Context, event Event) error
+
+// EventBus 进程内事件总线。每个订阅者有唯一名称，发件箱按订阅者分别记录和重试，
+// 一个订阅者失败不会导致其他订阅者重复处理
+type EventBus struct {
+	mu       sync.RWMutex
+	handlers map[string]map[string]EventHandler // 事件名称 -> 订阅者 -> 处理函数
+	all      map[string]EventHandler            // 订阅全部事件的订阅者
+}
+
+// NewEventBus 创建事件总线
+func NewEventBus() *EventBus {
+	return &EventBus{
+		handlers: make(map[string]map[string]EventHandler),
+		all:      make(map[string]EventHandler),
+	}
+}
+
+// Subscribe 注册订阅者对指定事件的处理函数，重复注册会覆盖
+func (b *EventBus) Subscribe(subscriber, event string, handler EventHandler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.handlers[event] == nil {
+		b.handlers[event] = make(map[string]EventHandler)
+	}
+	b.handlers[event][subscriber] = handler
+}
+
+// SubscribeAll 注册订阅者对全部事件的处理函数
+func (b *EventBus) SubscribeAll(subscriber string, handler EventHandler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.all[subscriber] = handler
+}
+
+// Subscribers 返回订阅了指定事件的订阅者名称，按名称排序
+func (b *EventBus) Subscribers(event string) []string {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	subscribers := make([]string, 0, len(b.handlers[event])+len(b.all))
+	for subscriber := range b.handlers[event] {
+		subscribers = append(subscribers, subscriber)
+	}
+	for subscriber := range b.all {
+		if _, ok := b.handlers[event][subscriber]; !ok {
+			subscribers = append(subscribers, subscriber)
+		}
+	}
+	sort.Strings(subscribers)
+	return subscribers
+}
+
+// Deliver 将事件交给指定订阅者处理
+func (b *EventBus) Deliver(ctx context.Context, subscriber string, event Event) error {
+	b.mu.RLock()
+	handler, ok := b.handlers[event.EventName()][subscriber]
+	if !ok {
+		handler, ok = b.all[subscriber]
+	}
+	b.mu.RUnlock()
+
+	if !ok {
+		return ErrNoSubscriber
+	}
+	return handler(ctx, event)
+}
+
+// Publish 同步将事件交给全部订阅者处理，不经过发件箱
+func (b *EventBus) Publish(ctx context.Context, event Event) error {
+	var errs []error
+	for _, subscriber := range b.Subscribers(event.EventName()) {
+		if err := b.Deliver(ctx, subscriber, event); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", subscriber, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// On 以具体事件类型注册处理函数
+func On[E Event](bus *EventBus, subscriber string, handler func(ctx context.Context, event E) error) {
+	var zero E
+	bus.Subscribe(subscriber, zero.EventName(), func(ctx context.Context, event Event) error {
+		e, ok := event.(E)
+		if !ok {
+			return fmt.Errorf("unexpected event type %T for %s", event, zero.EventName())
+		}
+		return handler(ctx, e)
+	})
+}
@@ -1,26 +1,57 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // ArticleRepository 文章仓储接口
 type ArticleRepository interface {
 	Create(ctx context.Context, article *Article) (*Article, error)
 	GetByID(ctx context.Context, id int) (*Article, error)
+	// Update 更新文章，article.Version 大于0时仅在版本一致时更新，否则返回ErrVersionConflict；
+	// article.Status 不为空时同时变更状态，与 UpdateStatus 相同地同步published字段和发布时间
 	Update(ctx context.Context, id int, article *Article) (*Article, error)
+	UpdateStatus(ctx context.Context, id int, status string) (*Article, error)
 	Delete(ctx context.Context, id int) error
-	List(ctx context.Context, params QueryParams) ([]*Article, int64, error)
-	ListByCategory(ctx context.Context, categoryID int, params QueryParams) ([]*Article, int64, error)
-	ListByTag(ctx context.Context, tagID int, params QueryParams) ([]*Article, int64, error)
+	List(ctx context.Context, params QueryParams) (*ArticlePage, error)
+	ListByCategory(ctx context.Context, categoryID int, params QueryParams) (*ArticlePage, error)
+	ListByTag(ctx context.Context, tagID int, params QueryParams) (*ArticlePage, error)
+	// Retag 为过滤条件选出的文章批量添加和移除标签
+	Retag(ctx context.Context, params QueryParams, add, remove []int) (*ArticleRetag, error)
+}
+
+// ArticleLockRepository 文章编辑租约仓储接口
+type ArticleLockRepository interface {
+	Get(ctx context.Context, articleID int) (*ArticleLock, error)
+	// Acquire 在租约空闲、已过期或由holder持有时获取或续期，force为true时无条件接管；
+	// 被他人持有时返回当前租约和ErrLocked
+	Acquire(ctx context.Context, articleID int, holder string, expiresAt, now time.Time, force bool) (*ArticleLock, error)
+	Delete(ctx context.Context, articleID int) error
+}
+
+// ArticleReviewRepository 文章审阅记录仓储接口
+type ArticleReviewRepository interface {
+	Create(ctx context.Context, review *ArticleReview) (*ArticleReview, error)
+	ListByArticle(ctx context.Context, articleID int) ([]*ArticleReview, error)
 }
 
 // CategoryRepository 分类仓储接口
 type CategoryRepository interface {
 	Create(ctx context.Context, category *Category) (*Category, error)
 	GetByID(ctx context.Context, id int) (*Category, error)
+	// GetForUpdate 在事务中读取分类并加行锁直到事务结束，不经过缓存
+	GetForUpdate(ctx context.Context, id int) (*Category, error)
 	Update(ctx context.Context, id int, category *Category) (*Category, error)
 	Delete(ctx context.Context, id int) error
 	List(ctx context.Context) ([]*Category, error)
 	GetByName(ctx context.Context, name string) (*Category, error)
+	CountArticles(ctx context.Context, id int) (int, error)
+	CountChildren(ctx context.Context, id int) (int, error)
+	ReassignArticles(ctx context.Context, from, to int) (int, error)
+	ReparentChildren(ctx context.Context, from int, to *int) ([]int, error)
+	// ArticleCounts 按分类统计文章总数和已发布数，没有文章的分类不在结果中
+	ArticleCounts(ctx context.Context) (map[int]ArticleCounts, error)
 }
 
 // TagRepository 标签仓储接口
@@ -32,6 +63,134 @@ type TagRepository interface {
 	List(ctx context.Context) ([]*Tag, error)
 	GetByName(ctx context.Context, name string) (*Tag, error)
 	GetByIDs(ctx context.Context, ids []int) ([]*Tag, error)
+	GetAliasByName(ctx context.Context, name string) (*TagAlias, error)
+	GetAliasByFormerID(ctx context.Context, formerID int) (*TagAlias, error)
+	CreateAlias(ctx context.Context, alias *TagAlias) error
+	DeleteAlias(ctx context.Context, name string) error
+	Merge(ctx context.Context, targetID int, sourceIDs []int) (int, error)
+	// ArticleCounts 按标签统计文章总数和已发布数，没有文章的标签不在结果中
+	ArticleCounts(ctx context.Context) (map[int]ArticleCounts, error)
+}
+
+// CommentRepository 评论仓储接口
+type CommentRepository interface {
+	Create(ctx context.Context, comment *Comment) (*Comment, error)
+	GetByID(ctx context.Context, id int) (*Comment, error)
+	// UpdateStatus 仅在评论仍处于before的状态时更新，否则返回ErrVersionConflict
+	UpdateStatus(ctx context.Context, before *Comment, status string) (*Comment, error)
+	Delete(ctx context.Context, id int) error
+	List(ctx context.Context, params CommentQueryParams) ([]*Comment, int64, error)
+	ListByArticle(ctx context.Context, articleID int, status string) ([]*Comment, error)
+}
+
+// SpamTokenRepository 垃圾评论词元统计仓储接口
+type SpamTokenRepository interface {
+	GetCounts(ctx context.Context, tokens []string) (map[string]SpamTokenCount, error)
+	Add(ctx context.Context, tokens []string, delta SpamTokenCount) error
+}
+
+// LoginAttemptRepository 登录尝试记录仓储接口
+type LoginAttemptRepository interface {
+	Create(ctx context.Context, attempt *LoginAttempt) error
+	List(ctx context.Context, params LoginAttemptQueryParams) ([]*LoginAttempt, int64, error)
+}
+
+// LoginThrottleRepository 登录失败限制仓储接口
+type LoginThrottleRepository interface {
+	Get(ctx context.Context, key string) (*LoginThrottle, error)
+	RecordFailure(ctx context.Context, key string, window time.Duration) (*LoginThrottle, error)
+	Lock(ctx context.Context, key string, until time.Time) error
+	Reset(ctx context.Context, key string) error
+	ListLocked(ctx context.Context, now time.Time) ([]*LoginThrottle, error)
+}
+
+// RefreshTokenRepository 刷新令牌仓储接口
+type RefreshTokenRepository interface {
+	Create(ctx context.Context, token *RefreshToken) error
+	GetByHash(ctx context.Context, tokenHash string) (*RefreshToken, error)
+	// Revoke 吊销仍有效的令牌，返回false表示令牌此前已被吊销
+	Revoke(ctx context.Context, tokenHash string) (bool, error)
+	RevokeFamily(ctx context.Context, familyID string) error
+}
+
+// TokenDenylistRepository 访问令牌黑名单仓储接口
+type TokenDenylistRepository interface {
+	Add(ctx context.Context, jti string, expiresAt time.Time) error
+	Contains(ctx context.Context, jti string) (bool, error)
+}
+
+// TwoFactorRepository 两步验证仓储接口
+type TwoFactorRepository interface {
+	GetByUsername(ctx context.Context, username string) (*TwoFactor, error)
+	Save(ctx context.Context, twoFactor *TwoFactor) error
+	Delete(ctx context.Context, username string) error
+	// UseStep 记录已使用的时间步，返回false表示该时间步或更晚的验证码已被使用
+	UseStep(ctx context.Context, username string, step int64) (bool, error)
+	// ReplaceRecoveryCodes 用新的恢复码摘要替换用户所有恢复码
+	ReplaceRecoveryCodes(ctx context.Context, username string, codeHashes []string) error
+	// ConsumeRecoveryCode 消耗一个恢复码，返回false表示恢复码不存在或已使用
+	ConsumeRecoveryCode(ctx context.Context, username, codeHash string) (bool, error)
+}
+
+// UserRepository 用户仓储接口
+type UserRepository interface {
+	GetByUsername(ctx context.Context, username string) (*User, error)
+	GetBySubject(ctx context.Context, issuer, subject string) (*User, error)
+	Create(ctx context.Context, user *User) (*User, error)
+	Update(ctx context.Context, id int, user *User) (*User, error)
+}
+
+// APIKeyRepository API密钥仓储接口
+type APIKeyRepository interface {
+	Create(ctx context.Context, key *APIKey) (*APIKey, error)
+	GetByHash(ctx context.Context, keyHash string) (*APIKey, error)
+	ListByUsername(ctx context.Context, username string) ([]*APIKey, error)
+	// Revoke 吊销属于指定用户的密钥，密钥不存在或不属于该用户时返回ErrNotFound
+	Revoke(ctx context.Context, id int, username string) error
+	Touch(ctx context.Context, id int, usedAt time.Time) error
+}
+
+// AuditRepository 审计日志仓储接口，只提供追加和查询
+type AuditRepository interface {
+	Create(ctx context.Context, log *AuditLog) (*AuditLog, error)
+	List(ctx context.Context, params AuditQueryParams) ([]*AuditLog, int64, error)
+}
+
+// WebhookRepository Webhook订阅仓储接口
+type WebhookRepository interface {
+	Create(ctx context.Context, webhook *Webhook) (*Webhook, error)
+	GetByID(ctx context.Context, id int) (*Webhook, error)
+	Update(ctx context.Context, id int, webhook *Webhook) (*Webhook, error)
+	Delete(ctx context.Context, id int) error
+	List(ctx context.Context) ([]*Webhook, error)
+	ListActive(ctx context.Context) ([]*Webhook, error)
+}
+
+// WebhookDeliveryRepository Webhook投递仓储接口
+type WebhookDeliveryRepository interface {
+	CreateBulk(ctx context.Context, deliveries []*WebhookDelivery) error
+	// CreateForEvent 创建一个事件的投递记录，订阅已有该事件的投递时跳过，事件重复投递时不会重复通知
+	CreateForEvent(ctx context.Context, deliveries []*WebhookDelivery) error
+	GetByID(ctx context.Context, id int) (*WebhookDelivery, error)
+	List(ctx context.Context, webhookID int, params WebhookDeliveryQueryParams) ([]*WebhookDelivery, int64, error)
+	// ClaimDue 领取到期的待投递记录，并将下次尝试时间推迟lease作为租约，避免多个实例重复投递
+	ClaimDue(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]*WebhookDelivery, error)
+	// SaveResult 保存一次投递尝试的结果
+	SaveResult(ctx context.Context, delivery *WebhookDelivery) error
+}
+
+// Transactor 事务管理接口，fn中使用传入的ctx调用仓储即可加入同一事务，已在事务中时直接复用
+type Transactor interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// OutboxRepository 事件发件箱仓储接口
+type OutboxRepository interface {
+	Add(ctx context.Context, events []*OutboxEvent) error
+	// ClaimDue 领取到期的待分发记录，并将下次尝试时间推迟lease作为租约，避免多个实例重复分发
+	ClaimDue(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]*OutboxEvent, error)
+	// SaveResult 保存一次分发的结果
+	SaveResult(ctx context.Context, event *OutboxEvent) error
 }
 
 // ArticleService 文章服务接口
@@ -40,10 +199,21 @@ type ArticleService interface {
 	GetByID(ctx context.Context, id int) (*Article, error)
 	Update(ctx context.Context, id int, req *ArticleUpdateRequest) (*Article, error)
 	Delete(ctx context.Context, id int) error
-	List(ctx context.Context, params QueryParams) ([]*Article, int64, error)
-	ListByCategory(ctx context.Context, categoryID int, params QueryParams) ([]*Article, int64, error)
-	ListByTag(ctx context.Context, tagID int, params QueryParams) ([]*Article, int64, error)
+	List(ctx context.Context, params QueryParams) (*ArticlePage, error)
+	ListByCategory(ctx context.Context, categoryID int, params QueryParams) (*ArticlePage, error)
+	ListByTag(ctx context.Context, tagID int, params QueryParams) (*ArticlePage, error)
 	BackupAll(ctx context.Context) ([]byte, error)
+	Transition(ctx context.Context, id int, req *ArticleTransitionRequest) (*Article, error)
+	AddReviewNote(ctx context.Context, id int, req *ArticleReviewNoteRequest) (*ArticleReview, error)
+	ListReviews(ctx context.Context, id int) ([]*ArticleReview, error)
+	Retag(ctx context.Context, params QueryParams, req *ArticleRetagRequest) (*ArticleRetag, error)
+}
+
+// ArticleLockService 文章编辑租约服务接口
+type ArticleLockService interface {
+	Get(ctx context.Context, articleID int) (*ArticleLock, error)
+	Acquire(ctx context.Context, articleID int, req *ArticleLockRequest) (*ArticleLock, error)
+	Release(ctx context.Context, articleID int) error
 }
 
 // CategoryService 分类服务接口
@@ -51,8 +221,9 @@ type CategoryService interface {
 	Create(ctx context.Context, req *CategoryCreateRequest) (*Category, error)
 	GetByID(ctx context.Context, id int) (*Category, error)
 	Update(ctx context.Context, id int, req *CategoryUpdateRequest) (*Category, error)
-	Delete(ctx context.Context, id int) error
+	Delete(ctx context.Context, id int, opts CategoryDeleteOptions) (*CategoryDeletion, error)
 	List(ctx context.Context) ([]*Category, error)
+	Tree(ctx context.Context) ([]*Category, error)
 }
 
 // TagService 标签服务接口
@@ -62,11 +233,97 @@ type TagService interface {
 	Update(ctx context.Context, id int, req *TagUpdateRequest) (*Tag, error)
 	Delete(ctx context.Context, id int) error
 	List(ctx context.Context) ([]*Tag, error)
+	Merge(ctx context.Context, targetID int, req *TagMergeRequest) (*TagMerge, error)
+	// Cloud 返回有已发布文章的标签及其权重，limit大于0时只保留文章最多的limit个标签
+	Cloud(ctx context.Context, limit int) ([]*TagCloudItem, error)
+}
+
+// CommentService 评论服务接口
+type CommentService interface {
+	Create(ctx context.Context, articleID int, req *CommentCreateRequest) (*Comment, error)
+	ListByArticle(ctx context.Context, articleID int) ([]*Comment, error)
+	List(ctx context.Context, params CommentQueryParams) ([]*Comment, int64, error)
+	Approve(ctx context.Context, id int) (*Comment, error)
+	MarkSpam(ctx context.Context, id int) (*Comment, error)
+	Delete(ctx context.Context, id int) error
+}
+
+// TwoFactorService 两步验证服务接口
+type TwoFactorService interface {
+	IsEnabled(ctx context.Context, username string) (bool, error)
+	Verify(ctx context.Context, username, code string) error
+	Setup(ctx context.Context, username string) (*TwoFactorSetup, error)
+	Enable(ctx context.Context, username, code string) ([]string, error)
+	Disable(ctx context.Context, username, code string) error
+	RegenerateRecoveryCodes(ctx context.Context, username, code string) ([]string, error)
 }
 
 // AuthService 认证服务接口
 type AuthService interface {
 	Login(ctx context.Context, req *LoginRequest) (*LoginResponse, error)
+	VerifyTwoFactor(ctx context.Context, req *TwoFactorLoginRequest) (*LoginResponse, error)
+	ValidateChallenge(ctx context.Context, token string) (string, error)
+	Refresh(ctx context.Context, req *RefreshRequest) (*LoginResponse, error)
+	Logout(ctx context.Context, req *LogoutRequest) error
 	ValidateToken(ctx context.Context, token string) (string, error)
+	Authenticate(ctx context.Context, token string) (*Principal, error)
+	// IssueSession 为已由外部身份提供方完成认证的用户签发令牌
+	IssueSession(ctx context.Context, req *LoginRequest) (*LoginResponse, error)
 	GenerateToken(ctx context.Context, username string) (string, error)
+	ListLoginAttempts(ctx context.Context, params LoginAttemptQueryParams) ([]*LoginAttempt, int64, error)
+	ListLockouts(ctx context.Context) ([]*LoginThrottle, error)
+	ClearLockout(ctx context.Context, username, ip string) error
+}
+
+// OIDCService 单点登录服务接口
+type OIDCService interface {
+	AuthURL(ctx context.Context) (*OIDCAuthorization, error)
+	Callback(ctx context.Context, req *OIDCCallbackRequest) (*LoginResponse, error)
+}
+
+// APIKeyService API密钥服务接口
+type APIKeyService interface {
+	Create(ctx context.Context, username string, req *APIKeyCreateRequest) (*APIKeyCreated, error)
+	List(ctx context.Context, username string) ([]*APIKey, error)
+	Revoke(ctx context.Context, username string, id int) error
+	Authenticate(ctx context.Context, key string) (*Principal, error)
+}
+
+// AuditService 审计服务接口
+type AuditService interface {
+	// Record 记录一次写操作，操作者从上下文中获取，before和after为操作前后的领域对象
+	Record(ctx context.Context, entityType string, entityID int, action string, before, after interface{}) error
+	List(ctx context.Context, params AuditQueryParams) ([]*AuditLog, int64, error)
+}
+
+// WebhookService Webhook服务接口
+type WebhookService interface {
+	Create(ctx context.Context, username string, req *WebhookCreateRequest) (*WebhookCreated, error)
+	GetByID(ctx context.Context, id int) (*Webhook, error)
+	Update(ctx context.Context, id int, req *WebhookUpdateRequest) (*Webhook, error)
+	Delete(ctx context.Context, id int) error
+	List(ctx context.Context) ([]*Webhook, error)
+	ListDeliveries(ctx context.Context, webhookID int, params WebhookDeliveryQueryParams) ([]*WebhookDelivery, int64, error)
+	// Replay 重新投递一条记录，生成新的投递记录并保留原记录
+	Replay(ctx context.Context, webhookID, deliveryID int) (*WebhookDelivery, error)
+	// Trigger 为订阅了该事件的Webhook创建投递记录，由后台任务异步投递
+	Trigger(ctx context.Context, event string, data interface{}) error
+	// ProcessDue 投递一批到期的记录，返回处理的数量
+	ProcessDue(ctx context.Context) (int, error)
+}
+
+// EventPublisher 事件发布接口，事件写入发件箱，调用方应在数据变更的事务中发布
+type EventPublisher interface {
+	Publish(ctx context.Context, events ...Event) error
+}
+
+// StreamNotifier 实时事件流推送接口，在事务中调用时等事务提交后才推送
+type StreamNotifier interface {
+	Notify(ctx context.Context, name string, data []byte)
+}
+
+// EventDispatcher 发件箱分发接口
+type EventDispatcher interface {
+	// ProcessDue 分发一批到期的事件，返回处理的数量
+	ProcessDue(ctx context.Context) (int, error)
 }
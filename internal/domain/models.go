@@ -2,37 +2,200 @@ package domain
 
 import "time"
 
-// Article 文章领域模型
+// 文章编辑流程状态
+const (
+	ArticleStatusDraft     = "draft"
+	ArticleStatusInReview  = "in_review"
+	ArticleStatusApproved  = "approved"
+	ArticleStatusPublished = "published"
+	ArticleStatusArchived  = "archived"
+)
+
+// ArticleStatuses 全部文章状态
+var ArticleStatuses = []string{
+	ArticleStatusDraft,
+	ArticleStatusInReview,
+	ArticleStatusApproved,
+	ArticleStatusPublished,
+	ArticleStatusArchived,
+}
+
+// 文章列表排序字段
+const (
+	ArticleSortTitle      = "title"
+	ArticleSortCreatedAt  = "created_at"
+	ArticleSortUpdatedAt  = "updated_at"
+	ArticleSortPopularity = "popularity" // 已通过审核的评论数
+)
+
+// ArticleSortFields 允许的排序字段
+var ArticleSortFields = []string{
+	ArticleSortTitle,
+	ArticleSortCreatedAt,
+	ArticleSortUpdatedAt,
+	ArticleSortPopularity,
+}
+
+// 文章列表分面，统计当前过滤结果在各分类、标签、年份下的文章数
+const (
+	FacetCategory = "category"
+	FacetTag      = "tag"
+	FacetYear     = "year" // 按发布时间，未发布的文章按创建时间
+)
+
+// ArticleFacets 允许的分面
+var ArticleFacets = []string{FacetCategory, FacetTag, FacetYear}
+
+// 文章列表字段，取值与JSON字段名一致；category和tags是关联，请求时才预加载
+const (
+	ArticleFieldID          = "id"
+	ArticleFieldContent     = "content"
+	ArticleFieldExcerpt     = "excerpt"
+	ArticleFieldCategory    = "category"
+	ArticleFieldBreadcrumbs = "breadcrumbs"
+	ArticleFieldTags        = "tags"
+	ArticleFieldsAll        = "all" // fields=all 返回完整文章
+)
+
+// ArticleFields 列表允许请求的字段
+var ArticleFields = []string{
+	ArticleFieldID, "title", "summary", ArticleFieldContent, ArticleFieldExcerpt, "word_count", "reading_minutes",
+	"status", "published", "version", "author", "created_at", "updated_at", "published_at",
+	ArticleFieldCategory, ArticleFieldBreadcrumbs, ArticleFieldTags,
+}
+
+// ArticleSummaryFields 列表默认的摘要投影，不含正文
+var ArticleSummaryFields = []string{
+	ArticleFieldID, "title", "summary", ArticleFieldExcerpt, "word_count", "reading_minutes",
+	"status", "published", "version", "author", "created_at", "updated_at", "published_at",
+	ArticleFieldCategory, ArticleFieldBreadcrumbs, ArticleFieldTags,
+}
+
+// FacetBucket 分面中的一项
+type FacetBucket struct {
+	Value int    `json:"value"` // 分类ID、标签ID或年份
+	Name  string `json:"name,omitempty"`
+	Count int    `json:"count"`
+}
+
+// 按标签过滤文章的匹配方式
+const (
+	TagMatchAny  = "any"  // 包含任意一个标签
+	TagMatchAll  = "all"  // 包含全部标签
+	TagMatchNone = "none" // 不包含其中任何标签
+)
+
+// Article 文章领域模型，Published 由 Status 派生，保留以兼容旧客户端
 type Article struct {
-	ID        int       `json:"id"`
-	Title     string    `json:"title"`
-	Content   string    `json:"content"`
-	Summary   string    `json:"summary"`
-	Published bool      `json:"published"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
-	Category  *Category `json:"category,omitempty"`
-	Tags      []Tag     `json:"tags,omitempty"`
+	ID             int          `json:"id"`
+	Title          string       `json:"title"`
+	Content        string       `json:"content"`
+	Summary        string       `json:"summary"`
+	Excerpt        string       `json:"excerpt"` // 填写了摘要时为摘要，否则从正文生成
+	WordCount      int          `json:"word_count"`
+	ReadingMinutes int          `json:"reading_minutes"`
+	Status         string       `json:"status"`
+	Published      bool         `json:"published"`
+	Version        int          `json:"version"`
+	Author         string       `json:"author,omitempty"`
+	CreatedAt      time.Time    `json:"created_at"`
+	UpdatedAt      time.Time    `json:"updated_at"`
+	PublishedAt    *time.Time   `json:"published_at,omitempty"`
+	Category       *Category    `json:"category,omitempty"`
+	Breadcrumbs    []Breadcrumb `json:"breadcrumbs,omitempty"` // 从顶级分类到所属分类的路径
+	Tags           []Tag        `json:"tags,omitempty"`
 }
 
 // Category 分类领域模型
 type Category struct {
-	ID          int       `json:"id"`
-	Name        string    `json:"name"`
-	Description string    `json:"description"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID          int            `json:"id"`
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	ParentID    *int           `json:"parent_id"`
+	Children    []*Category    `json:"children,omitempty"` // 只在分类树中返回
+	Articles    *ArticleCounts `json:"articles,omitempty"` // 只在列表和分类树中返回，不含子分类的文章
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+}
+
+// ArticleCounts 分类或标签下的文章数
+type ArticleCounts struct {
+	Total     int `json:"total"`
+	Published int `json:"published"`
+}
+
+// Breadcrumb 分类路径中的一级，UpdatedAt 用于计算文章的ETag
+type Breadcrumb struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
+// CategoryMaxDepth 分类层级的最大深度，读取分类路径时防止异常数据导致无限循环
+const CategoryMaxDepth = 32
+
 // Tag 标签领域模型
 type Tag struct {
+	ID        int            `json:"id"`
+	Name      string         `json:"name"`
+	Color     string         `json:"color"`
+	Aliases   []string       `json:"aliases,omitempty"`  // 合并进来的标签和改名前的旧名称
+	Articles  *ArticleCounts `json:"articles,omitempty"` // 只在列表中返回
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+// TagCloudLevels 标签云的权重级数
+const TagCloudLevels = 5
+
+// TagCloudItem 标签云中的标签，Weight 为按已发布文章数对数缩放的权重，从1到TagCloudLevels。
+// UpdatedAt 用于计算ETag
+type TagCloudItem struct {
 	ID        int       `json:"id"`
 	Name      string    `json:"name"`
 	Color     string    `json:"color"`
-	CreatedAt time.Time `json:"created_at"`
+	Articles  int       `json:"articles"`
+	Weight    int       `json:"weight"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
+// TagAlias 标签别名，旧名称和被合并标签的旧ID都指向当前标签
+type TagAlias struct {
+	Name     string `json:"name"`
+	FormerID *int   `json:"former_id,omitempty"`
+	TagID    int    `json:"tag_id"`
+}
+
+// 评论审核状态
+const (
+	CommentStatusPending  = "pending"
+	CommentStatusApproved = "approved"
+	CommentStatusSpam     = "spam"
+)
+
+// Comment 评论领域模型
+type Comment struct {
+	ID          int       `json:"id"`
+	ArticleID   int       `json:"article_id"`
+	AuthorName  string    `json:"author_name"`
+	AuthorEmail string    `json:"author_email,omitempty"`
+	Content     string    `json:"content"`
+	Status      string    `json:"status"`
+	Moderated   bool      `json:"moderated"`
+	SpamScore   float64   `json:"spam_score"`
+	SpamReasons []string  `json:"spam_reasons,omitempty"`
+	IP          string    `json:"ip,omitempty"`
+	UserAgent   string    `json:"user_agent,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// SpamTokenCount 垃圾评论词元统计
+type SpamTokenCount struct {
+	Spam int `json:"spam"`
+	Ham  int `json:"ham"`
+}
+
 // ArticleCreateRequest 创建文章请求
 type ArticleCreateRequest struct {
 	Title      string `json:"title" validate:"required,min=1,max=200"`
@@ -43,26 +206,80 @@ type ArticleCreateRequest struct {
 	TagIDs     []int  `json:"tag_ids"`
 }
 
-// ArticleUpdateRequest 更新文章请求
+// ArticleUpdateRequest 更新文章请求，Published 不传时保持当前状态；
+// Version 为客户端读取文章时的版本号，与服务端不一致时拒绝更新
 type ArticleUpdateRequest struct {
+	Version    int    `json:"version" validate:"required,min=1"`
 	Title      string `json:"title" validate:"required,min=1,max=200"`
 	Content    string `json:"content" validate:"required,min=1"`
 	Summary    string `json:"summary" validate:"max=500"`
-	Published  bool   `json:"published"`
+	Published  *bool  `json:"published"`
 	CategoryID *int   `json:"category_id"`
 	TagIDs     []int  `json:"tag_ids"`
 }
 
+// ArticleLock 文章编辑租约，仅用于提示其他编辑者，不阻止更新
+type ArticleLock struct {
+	ArticleID  int       `json:"article_id"`
+	Holder     string    `json:"holder"`
+	AcquiredAt time.Time `json:"acquired_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+// ArticleLockRequest 获取编辑租约请求，Force 为true时接管他人持有的租约
+type ArticleLockRequest struct {
+	Force bool `json:"force"`
+}
+
+// ArticleTransitionRequest 文章状态流转请求
+type ArticleTransitionRequest struct {
+	Status string `json:"status" validate:"required"`
+	Note   string `json:"note" validate:"max=2000"`
+}
+
+// ArticleReviewNoteRequest 添加审阅备注请求
+type ArticleReviewNoteRequest struct {
+	Note string `json:"note" validate:"required,min=1,max=2000"`
+}
+
+// ArticleReview 文章状态流转和审阅备注记录
+type ArticleReview struct {
+	ID         int       `json:"id"`
+	ArticleID  int       `json:"article_id"`
+	Reviewer   string    `json:"reviewer"`
+	FromStatus string    `json:"from_status"`
+	ToStatus   string    `json:"to_status"`
+	Note       string    `json:"note,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
 // CategoryCreateRequest 创建分类请求
 type CategoryCreateRequest struct {
 	Name        string `json:"name" validate:"required,min=1,max=100"`
 	Description string `json:"description" validate:"max=500"`
+	ParentID    *int   `json:"parent_id"`
 }
 
-// CategoryUpdateRequest 更新分类请求
+// CategoryUpdateRequest 更新分类请求，ParentID 为空时移动为顶级分类
 type CategoryUpdateRequest struct {
 	Name        string `json:"name" validate:"required,min=1,max=100"`
 	Description string `json:"description" validate:"max=500"`
+	ParentID    *int   `json:"parent_id"`
+}
+
+// CategoryDeleteOptions 删除分类选项。分类下有文章时必须指定 ReassignTo，文章移到该分类后再删除
+type CategoryDeleteOptions struct {
+	ReassignTo *int
+	DryRun     bool // 只返回删除的影响，不执行删除
+}
+
+// CategoryDeletion 删除分类的影响，子分类移到被删除分类的上级分类下
+type CategoryDeletion struct {
+	CategoryID int  `json:"category_id"`
+	Articles   int  `json:"articles"`
+	Children   int  `json:"children"`
+	ReassignTo *int `json:"reassign_to,omitempty"`
+	DryRun     bool `json:"dry_run"`
 }
 
 // TagCreateRequest 创建标签请求
@@ -71,22 +288,424 @@ type TagCreateRequest struct {
 	Color string `json:"color" validate:"hexcolor"`
 }
 
-// TagUpdateRequest 更新标签请求
+// TagUpdateRequest 更新标签请求，KeepAlias 为true时改名前的名称保留为别名
 type TagUpdateRequest struct {
-	Name  string `json:"name" validate:"required,min=1,max=50"`
-	Color string `json:"color" validate:"hexcolor"`
+	Name      string `json:"name" validate:"required,min=1,max=50"`
+	Color     string `json:"color" validate:"hexcolor"`
+	KeepAlias bool   `json:"keep_alias"`
+}
+
+// TagMergeRequest 合并标签请求，来源标签的文章关联移到目标标签后删除来源标签
+type TagMergeRequest struct {
+	SourceIDs []int `json:"source_ids" validate:"required,min=1,dive,min=1"`
+}
+
+// TagMerge 合并结果
+type TagMerge struct {
+	Target    *Tag  `json:"target"`
+	SourceIDs []int `json:"source_ids"`
+	Articles  int   `json:"articles"` // 新增目标标签的文章数，已有目标标签的文章不重复计数
+}
+
+// ArticleRetagRequest 批量修改文章标签请求，作用于列表过滤条件选出的文章
+type ArticleRetagRequest struct {
+	AddTagIDs    []int `json:"add_tag_ids"`
+	RemoveTagIDs []int `json:"remove_tag_ids"`
+}
+
+// ArticleRetag 批量修改标签的结果
+type ArticleRetag struct {
+	Articles int `json:"articles"` // 过滤条件选出的文章数
+	Added    int `json:"added"`    // 新增的文章标签关联数
+	Removed  int `json:"removed"`  // 删除的文章标签关联数
+}
+
+// CommentCreateRequest 发表评论请求
+type CommentCreateRequest struct {
+	AuthorName  string `json:"author_name" validate:"required,min=1,max=50"`
+	AuthorEmail string `json:"author_email" validate:"omitempty,email,max=100"`
+	Content     string `json:"content" validate:"required,min=1,max=5000"`
+	// Homepage 蜜罐字段，前端应隐藏该输入框，正常用户不会填写
+	Homepage  string `json:"homepage"`
+	IP        string `json:"-"`
+	UserAgent string `json:"-"`
+}
+
+// CommentQueryParams 评论查询参数
+type CommentQueryParams struct {
+	Page   int    `query:"page"`
+	Limit  int    `query:"limit"`
+	Status string `query:"status"`
 }
 
 // LoginRequest 登录请求
 type LoginRequest struct {
-	Username string `json:"username" validate:"required,min=1"`
-	Password string `json:"password" validate:"required,min=1"`
+	Username  string `json:"username" validate:"required,min=1"`
+	Password  string `json:"password" validate:"required,min=1"`
+	IP        string `json:"-"`
+	UserAgent string `json:"-"`
 }
 
-// LoginResponse 登录响应
+// LoginResponse 登录响应，开启两步验证时只返回ChallengeToken，需要再调用两步验证接口换取令牌
 type LoginResponse struct {
-	Token    string `json:"token"`
-	Username string `json:"username"`
+	Token                  string `json:"token,omitempty"`
+	RefreshToken           string `json:"refresh_token,omitempty"`
+	ExpiresIn              int64  `json:"expires_in,omitempty"` // 访问令牌有效期（秒）
+	Username               string `json:"username"`
+	TwoFactorRequired      bool   `json:"two_factor_required,omitempty"`
+	TwoFactorSetupRequired bool   `json:"two_factor_setup_required,omitempty"`
+	ChallengeToken         string `json:"challenge_token,omitempty"`
+}
+
+// TwoFactorLoginRequest 两步验证登录请求，Code可以是TOTP验证码或恢复码
+type TwoFactorLoginRequest struct {
+	ChallengeToken string `json:"challenge_token" validate:"required"`
+	Code           string `json:"code" validate:"required"`
+	IP             string `json:"-"`
+	UserAgent      string `json:"-"`
+}
+
+// TwoFactorCodeRequest 两步验证操作确认请求
+type TwoFactorCodeRequest struct {
+	Code string `json:"code" validate:"required"`
+}
+
+// TwoFactorSetup 两步验证绑定信息，ProvisioningURI可生成二维码供验证器应用扫描
+type TwoFactorSetup struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+}
+
+// TwoFactor 用户的两步验证配置
+type TwoFactor struct {
+	Username     string
+	Secret       string
+	Enabled      bool
+	LastUsedStep int64
+}
+
+// 用户角色
+const (
+	RoleAdmin  = "admin"
+	RoleEditor = "editor"
+	RoleAuthor = "author"
+)
+
+// User 用户领域模型，目前只有通过单点登录创建的用户，配置中的管理员不在用户表中
+type User struct {
+	ID          int        `json:"id"`
+	Username    string     `json:"username"`
+	Email       string     `json:"email,omitempty"`
+	DisplayName string     `json:"display_name,omitempty"`
+	Role        string     `json:"role"`
+	Issuer      string     `json:"issuer,omitempty"`
+	Subject     string     `json:"-"`
+	LastLoginAt *time.Time `json:"last_login_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// Principal 通过认证的调用方
+type Principal struct {
+	Username string   `json:"username"`
+	Role     string   `json:"role"`
+	APIKeyID int      `json:"api_key_id,omitempty"` // 使用API密钥认证时的密钥ID
+	Scopes   []string `json:"scopes,omitempty"`     // API密钥的权限范围
+}
+
+// HasScope 是否拥有指定权限范围，登录会话不受权限范围限制
+func (p *Principal) HasScope(scope string) bool {
+	if p.APIKeyID == 0 {
+		return true
+	}
+	for _, s := range p.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// API密钥权限范围
+const (
+	ScopeArticlesWrite    = "articles:write"
+	ScopeCategoriesWrite  = "categories:write"
+	ScopeTagsWrite        = "tags:write"
+	ScopeCommentsModerate = "comments:moderate"
+	ScopeBackupRead       = "backup:read"
+	ScopeEventsRead       = "events:read"
+)
+
+// APIKeyScopes 可授予API密钥的全部权限范围
+var APIKeyScopes = []string{
+	ScopeArticlesWrite,
+	ScopeCategoriesWrite,
+	ScopeTagsWrite,
+	ScopeCommentsModerate,
+	ScopeBackupRead,
+	ScopeEventsRead,
+}
+
+// APIKey 个人API密钥，明文密钥只在创建时返回一次
+type APIKey struct {
+	ID         int        `json:"id"`
+	Name       string     `json:"name"`
+	Username   string     `json:"username"`
+	Prefix     string     `json:"prefix"`
+	KeyHash    string     `json:"-"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// APIKeyCreateRequest 创建API密钥请求
+type APIKeyCreateRequest struct {
+	Name      string     `json:"name" validate:"required,min=1,max=100"`
+	Scopes    []string   `json:"scopes" validate:"required,min=1"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// APIKeyCreated 创建API密钥响应，包含明文密钥
+type APIKeyCreated struct {
+	*APIKey
+	Key string `json:"key"`
+}
+
+// OIDCAuthorization 跳转到身份提供方的授权地址，State为签名后的状态令牌，需要保存到Cookie中
+type OIDCAuthorization struct {
+	URL       string
+	State     string
+	ExpiresAt time.Time
+}
+
+// OIDCCallbackRequest 身份提供方回调请求
+type OIDCCallbackRequest struct {
+	Code       string
+	State      string
+	StateToken string // 发起登录时写入Cookie的状态令牌
+	IP         string
+	UserAgent  string
+}
+
+// RefreshRequest 刷新令牌请求
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
+	IP           string `json:"-"`
+	UserAgent    string `json:"-"`
+}
+
+// LogoutRequest 登出请求
+type LogoutRequest struct {
+	AccessToken  string `json:"-"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+// RefreshToken 刷新令牌记录，只保存令牌摘要
+type RefreshToken struct {
+	ID        int        `json:"id"`
+	TokenHash string     `json:"-"`
+	FamilyID  string     `json:"family_id"`
+	Username  string     `json:"username"`
+	IP        string     `json:"ip"`
+	UserAgent string     `json:"user_agent"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// LoginAttempt 登录尝试记录
+type LoginAttempt struct {
+	ID        int       `json:"id"`
+	Username  string    `json:"username"`
+	IP        string    `json:"ip"`
+	UserAgent string    `json:"user_agent"`
+	Success   bool      `json:"success"`
+	Reason    string    `json:"reason,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// LoginThrottle 登录失败限制状态，Key形如 user:<用户名> 或 ip:<地址>
+type LoginThrottle struct {
+	Key           string     `json:"key"`
+	Failures      int        `json:"failures"`
+	LastFailureAt time.Time  `json:"last_failure_at"`
+	LockedUntil   *time.Time `json:"locked_until,omitempty"`
+}
+
+// LoginAttemptQueryParams 登录尝试查询参数
+type LoginAttemptQueryParams struct {
+	Page     int    `query:"page"`
+	Limit    int    `query:"limit"`
+	Username string `query:"username"`
+	IP       string `query:"ip"`
+	Success  *bool  `query:"success"`
+}
+
+// 审计操作类型
+const (
+	AuditActionCreate = "create"
+	AuditActionUpdate = "update"
+	AuditActionDelete = "delete"
+)
+
+// 审计对象类型
+const (
+	AuditEntityArticle  = "article"
+	AuditEntityCategory = "category"
+	AuditEntityTag      = "tag"
+)
+
+// AuditChange 单个字段的变更前后值，创建时Before为空，删除时After为空
+type AuditChange struct {
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+// AuditLog 审计日志，只允许追加
+type AuditLog struct {
+	ID         int                    `json:"id"`
+	Actor      string                 `json:"actor"`
+	ActorRole  string                 `json:"actor_role,omitempty"`
+	APIKeyID   int                    `json:"api_key_id,omitempty"`
+	EntityType string                 `json:"entity_type"`
+	EntityID   int                    `json:"entity_id"`
+	Action     string                 `json:"action"`
+	Changes    map[string]AuditChange `json:"changes,omitempty"`
+	IP         string                 `json:"ip,omitempty"`
+	RequestID  string                 `json:"request_id,omitempty"`
+	EventID    string                 `json:"event_id,omitempty"`
+	CreatedAt  time.Time              `json:"created_at"`
+}
+
+// AuditQueryParams 审计日志查询参数
+type AuditQueryParams struct {
+	Page       int        `query:"page"`
+	Limit      int        `query:"limit"`
+	Actor      string     `query:"actor"`
+	EntityType string     `query:"entity_type"`
+	EntityID   int        `query:"entity_id"`
+	Action     string     `query:"action"`
+	RequestID  string     `query:"request_id"`
+	From       *time.Time `query:"-"`
+	To         *time.Time `query:"-"`
+}
+
+// WebhookEventAll 订阅全部事件
+const WebhookEventAll = "*"
+
+// WebhookEvents 可订阅的全部事件
+var WebhookEvents = []string{
+	EventArticlePublished,
+	EventArticleUpdated,
+	EventArticleDeleted,
+	EventCommentCreated,
+}
+
+// Webhook投递状态
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliverySucceeded = "succeeded"
+	WebhookDeliveryFailed    = "failed"
+)
+
+// Webhook 事件订阅，签名密钥只在创建时返回
+type Webhook struct {
+	ID          int       `json:"id"`
+	URL         string    `json:"url"`
+	Secret      string    `json:"-"`
+	Events      []string  `json:"events"`
+	Description string    `json:"description,omitempty"`
+	Active      bool      `json:"active"`
+	CreatedBy   string    `json:"created_by,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// Matches 订阅是否接收指定事件
+func (w *Webhook) Matches(event string) bool {
+	for _, e := range w.Events {
+		if e == event || e == WebhookEventAll {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookCreateRequest 创建Webhook请求，不提供Secret时自动生成
+type WebhookCreateRequest struct {
+	URL         string   `json:"url" validate:"required,url,max=2048"`
+	Secret      string   `json:"secret" validate:"omitempty,min=16,max=128"`
+	Events      []string `json:"events" validate:"required,min=1"`
+	Description string   `json:"description" validate:"max=200"`
+	Active      *bool    `json:"active"`
+}
+
+// WebhookUpdateRequest 更新Webhook请求，提供Secret时轮换签名密钥
+type WebhookUpdateRequest struct {
+	URL         string   `json:"url" validate:"required,url,max=2048"`
+	Secret      string   `json:"secret" validate:"omitempty,min=16,max=128"`
+	Events      []string `json:"events" validate:"required,min=1"`
+	Description string   `json:"description" validate:"max=200"`
+	Active      *bool    `json:"active"`
+}
+
+// WebhookCreated 创建Webhook响应，包含签名密钥
+type WebhookCreated struct {
+	*Webhook
+	Secret string `json:"secret"`
+}
+
+// WebhookDelivery Webhook投递记录
+type WebhookDelivery struct {
+	ID             int        `json:"id"`
+	WebhookID      int        `json:"webhook_id"`
+	EventID        string     `json:"event_id"`
+	Event          string     `json:"event"`
+	Payload        string     `json:"payload"`
+	Status         string     `json:"status"`
+	Attempts       int        `json:"attempts"`
+	NextAttemptAt  time.Time  `json:"next_attempt_at"`
+	ResponseStatus int        `json:"response_status,omitempty"`
+	ResponseBody   string     `json:"response_body,omitempty"`
+	LastError      string     `json:"last_error,omitempty"`
+	DurationMS     int        `json:"duration_ms,omitempty"`
+	ReplayOf       int        `json:"replay_of,omitempty"`
+	DeliveredAt    *time.Time `json:"delivered_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// WebhookDeliveryQueryParams 投递记录查询参数
+type WebhookDeliveryQueryParams struct {
+	Page   int    `query:"page"`
+	Limit  int    `query:"limit"`
+	Status string `query:"status"`
+	Event  string `query:"event"`
+}
+
+// 发件箱记录状态
+const (
+	OutboxStatusPending    = "pending"
+	OutboxStatusDispatched = "dispatched"
+	OutboxStatusDead       = "dead"
+)
+
+// OutboxEvent 发件箱记录，每个事件按订阅者各写一条，Actor为产生事件的操作者
+type OutboxEvent struct {
+	ID            int
+	EventID       string
+	Event         string
+	Subscriber    string
+	Payload       string
+	Actor         *Actor
+	Status        string
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	DispatchedAt  *time.Time
+	CreatedAt     time.Time
 }
 
 // QueryParams 查询参数
@@ -94,5 +713,51 @@ type QueryParams struct {
 	Page      int    `query:"page"`
 	Limit     int    `query:"limit"`
 	Published *bool  `query:"published"`
+	Status    string `query:"status"`
 	Search    string `query:"search"`
+	After     string `query:"after"`  // 游标分页：返回该游标之后的文章
+	Before    string `query:"before"` // 游标分页：返回该游标之前的文章
+
+	// 时间范围，From包含，To不包含
+	CreatedFrom   *time.Time `query:"-"`
+	CreatedTo     *time.Time `query:"-"`
+	UpdatedFrom   *time.Time `query:"-"`
+	UpdatedTo     *time.Time `query:"-"`
+	PublishedFrom *time.Time `query:"-"`
+	PublishedTo   *time.Time `query:"-"`
+
+	CategoryIDs []int  `query:"-"` // 属于其中任意一个分类
+	TagIDs      []int  `query:"-"`
+	TagMatch    string `query:"tag_match"` // TagIDs的匹配方式，默认为any
+	Author      string `query:"author"`
+
+	Sort     string `query:"-"` // 排序字段，默认为created_at
+	SortDesc bool   `query:"-"`
+
+	Facets []string `query:"-"` // 需要统计的分面
+	Fields []string `query:"-"` // 需要返回的字段，为空时返回完整文章
+
+	IncludeDescendants bool `query:"-"` // 按分类查询时包含子孙分类的文章
+}
+
+// Filtered 是否带有过滤条件，批量操作要求至少一个过滤条件
+func (p QueryParams) Filtered() bool {
+	return p.Published != nil || p.Status != "" || p.Search != "" || p.Author != "" ||
+		p.CreatedFrom != nil || p.CreatedTo != nil || p.UpdatedFrom != nil || p.UpdatedTo != nil ||
+		p.PublishedFrom != nil || p.PublishedTo != nil || len(p.CategoryIDs) > 0 || len(p.TagIDs) > 0
+}
+
+// Cursored 是否使用游标分页
+func (p QueryParams) Cursored() bool {
+	return p.After != "" || p.Before != ""
+}
+
+// ArticlePage 文章列表的一页。游标分页时不统计总数，Total为-1；
+// 游标按(排序字段, id)定位，没有更多数据的方向游标为空
+type ArticlePage struct {
+	Items      []*Article
+	Total      int64
+	NextCursor string
+	PrevCursor string
+	Facets     map[string][]FacetBucket // 按请求的分面统计，不受分页影响
 }
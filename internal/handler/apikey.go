@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"errors"
+	"strconv"
+
+	"goblog/internal/domain"
+	"goblog/internal/pkg/response"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+// APIKeyHandler 个人API密钥处理器
+type APIKeyHandler struct {
+	apiKeyService domain.APIKeyService
+	validator     *validator.Validate
+}
+
+// NewAPIKeyHandler 创建API密钥处理器
+func NewAPIKeyHandler(apiKeyService domain.APIKeyService) *APIKeyHandler {
+	return &APIKeyHandler{
+		apiKeyService: apiKeyService,
+		validator:     validator.New(),
+	}
+}
+
+// Create 创建API密钥，明文密钥只在响应中出现一次
+func (h *APIKeyHandler) Create(c echo.Context) error {
+	username, _ := c.Get("username").(string)
+
+	var req domain.APIKeyCreateRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "无效的请求参数")
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		return response.BadRequest(c, "请求参数验证失败")
+	}
+
+	key, err := h.apiKeyService.Create(c.Request().Context(), username, &req)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Created(c, key)
+}
+
+// List 获取当前用户的API密钥
+func (h *APIKeyHandler) List(c echo.Context) error {
+	username, _ := c.Get("username").(string)
+
+	keys, err := h.apiKeyService.List(c.Request().Context(), username)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, keys)
+}
+
+// Revoke 吊销当前用户的API密钥
+func (h *APIKeyHandler) Revoke(c echo.Context) error {
+	username, _ := c.Get("username").(string)
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return response.BadRequest(c, "无效的密钥ID")
+	}
+
+	if err := h.apiKeyService.Revoke(c.Request().Context(), username, id); err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, map[string]string{"message": "API密钥已吊销"})
+}
+
+// handleError 处理错误
+func (h *APIKeyHandler) handleError(c echo.Context, err error) error {
+	if errors.Is(err, domain.ErrNotFound) {
+		return response.NotFound(c, "API密钥不存在")
+	}
+	if errors.Is(err, domain.ErrInvalidInput) {
+		return response.BadRequest(c, "无效的权限范围或过期时间")
+	}
+	return response.InternalServerError(c, "内部服务器错误")
+}
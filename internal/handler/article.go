@@ -1,12 +1,17 @@
 package handler
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"goblog/internal/domain"
+	"goblog/internal/pkg/httpcache"
 	"goblog/internal/pkg/response"
 
 	"github.com/go-playground/validator/v10"
@@ -58,6 +63,10 @@ func (h *ArticleHandler) GetByID(c echo.Context) error {
 		return h.handleError(c, err)
 	}
 
+	if httpcache.NotModified(c, articleETag(article), article.UpdatedAt) {
+		return c.NoContent(http.StatusNotModified)
+	}
+
 	return response.Success(c, article)
 }
 
@@ -77,11 +86,16 @@ func (h *ArticleHandler) Update(c echo.Context) error {
 		return response.BadRequest(c, "请求参数验证失败")
 	}
 
+	if ok, err := h.checkIfMatch(c, id); !ok {
+		return err
+	}
+
 	article, err := h.articleService.Update(c.Request().Context(), id, &req)
 	if err != nil {
 		return h.handleError(c, err)
 	}
 
+	httpcache.SetETag(c, articleETag(article))
 	return response.Success(c, article)
 }
 
@@ -92,6 +106,10 @@ func (h *ArticleHandler) Delete(c echo.Context) error {
 		return response.BadRequest(c, "无效的文章ID")
 	}
 
+	if ok, err := h.checkIfMatch(c, id); !ok {
+		return err
+	}
+
 	err = h.articleService.Delete(c.Request().Context(), id)
 	if err != nil {
 		return h.handleError(c, err)
@@ -100,53 +118,103 @@ func (h *ArticleHandler) Delete(c echo.Context) error {
 	return response.Success(c, map[string]string{"message": "文章删除成功"})
 }
 
-// List 获取文章列表
-func (h *ArticleHandler) List(c echo.Context) error {
-	params := h.parseQueryParams(c)
+// Transition 变更文章的编辑流程状态
+func (h *ArticleHandler) Transition(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return response.BadRequest(c, "无效的文章ID")
+	}
 
-	articles, total, err := h.articleService.List(c.Request().Context(), params)
+	var req domain.ArticleTransitionRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "无效的请求参数")
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		return response.BadRequest(c, "请求参数验证失败")
+	}
+
+	article, err := h.articleService.Transition(c.Request().Context(), id, &req)
 	if err != nil {
 		return h.handleError(c, err)
 	}
 
-	if params.Page > 0 && params.Limit > 0 {
-		meta := response.PageMeta{
-			Page:      params.Page,
-			Limit:     params.Limit,
-			Total:     total,
-			TotalPage: int((total + int64(params.Limit) - 1) / int64(params.Limit)),
-		}
-		return response.SuccessPaged(c, articles, meta)
+	return response.Success(c, article)
+}
+
+// AddReviewNote 添加审阅备注
+func (h *ArticleHandler) AddReviewNote(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return response.BadRequest(c, "无效的文章ID")
+	}
+
+	var req domain.ArticleReviewNoteRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "无效的请求参数")
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		return response.BadRequest(c, "请求参数验证失败")
 	}
 
-	return response.Success(c, articles)
+	review, err := h.articleService.AddReviewNote(c.Request().Context(), id, &req)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Created(c, review)
 }
 
-// ListByCategory 按分类获取文章
+// ListReviews 获取文章的状态流转和审阅备注记录
+func (h *ArticleHandler) ListReviews(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return response.BadRequest(c, "无效的文章ID")
+	}
+
+	reviews, err := h.articleService.ListReviews(c.Request().Context(), id)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, reviews)
+}
+
+// List 获取文章列表
+func (h *ArticleHandler) List(c echo.Context) error {
+	params, err := h.parseQueryParams(c)
+	if err != nil {
+		return response.BadRequest(c, err.Error())
+	}
+
+	page, err := h.articleService.List(c.Request().Context(), params)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return h.respondPage(c, params, page)
+}
+
+// ListByCategory 按分类获取文章，include_descendants=true 时包含子孙分类的文章
 func (h *ArticleHandler) ListByCategory(c echo.Context) error {
 	categoryID, err := strconv.Atoi(c.Param("categoryId"))
 	if err != nil {
 		return response.BadRequest(c, "无效的分类ID")
 	}
 
-	params := h.parseQueryParams(c)
-
-	articles, total, err := h.articleService.ListByCategory(c.Request().Context(), categoryID, params)
+	params, err := h.parseQueryParams(c)
 	if err != nil {
-		return h.handleError(c, err)
+		return response.BadRequest(c, err.Error())
 	}
+	params.IncludeDescendants = c.QueryParam("include_descendants") == "true"
 
-	if params.Page > 0 && params.Limit > 0 {
-		meta := response.PageMeta{
-			Page:      params.Page,
-			Limit:     params.Limit,
-			Total:     total,
-			TotalPage: int((total + int64(params.Limit) - 1) / int64(params.Limit)),
-		}
-		return response.SuccessPaged(c, articles, meta)
+	page, err := h.articleService.ListByCategory(c.Request().Context(), categoryID, params)
+	if err != nil {
+		return h.handleError(c, err)
 	}
 
-	return response.Success(c, articles)
+	return h.respondPage(c, params, page)
 }
 
 // ListByTag 按标签获取文章
@@ -156,37 +224,164 @@ func (h *ArticleHandler) ListByTag(c echo.Context) error {
 		return response.BadRequest(c, "无效的标签ID")
 	}
 
-	params := h.parseQueryParams(c)
+	params, err := h.parseQueryParams(c)
+	if err != nil {
+		return response.BadRequest(c, err.Error())
+	}
 
-	articles, total, err := h.articleService.ListByTag(c.Request().Context(), tagID, params)
+	page, err := h.articleService.ListByTag(c.Request().Context(), tagID, params)
 	if err != nil {
 		return h.handleError(c, err)
 	}
 
+	return h.respondPage(c, params, page)
+}
+
+// Retag 为查询参数过滤出的文章批量添加和移除标签，过滤参数与文章列表相同
+func (h *ArticleHandler) Retag(c echo.Context) error {
+	params, err := h.parseQueryParams(c)
+	if err != nil {
+		return response.BadRequest(c, err.Error())
+	}
+
+	var req domain.ArticleRetagRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "无效的请求参数")
+	}
+
+	if !params.Filtered() {
+		return response.BadRequest(c, "批量修改标签至少需要一个过滤条件")
+	}
+
+	result, err := h.articleService.Retag(c.Request().Context(), params, &req)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return response.BadRequest(c, "标签不存在，或同一标签同时被添加和移除")
+		}
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, result)
+}
+
+// respondPage 返回一页文章。游标分页时只返回游标，页码分页时同时返回可切换到游标分页的游标
+func (h *ArticleHandler) respondPage(c echo.Context, params domain.QueryParams, page *domain.ArticlePage) error {
+	if httpcache.NotModified(c, articlesETag(page), time.Time{}) {
+		return c.NoContent(http.StatusNotModified)
+	}
+
+	if params.Cursored() {
+		meta := response.CursorMeta{
+			Limit:      params.Limit,
+			NextCursor: page.NextCursor,
+			PrevCursor: page.PrevCursor,
+			Facets:     facetsMeta(page),
+		}
+		return response.SuccessCursor(c, projectArticles(page.Items, params.Fields), meta)
+	}
+
 	if params.Page > 0 && params.Limit > 0 {
 		meta := response.PageMeta{
-			Page:      params.Page,
-			Limit:     params.Limit,
-			Total:     total,
-			TotalPage: int((total + int64(params.Limit) - 1) / int64(params.Limit)),
+			Page:       params.Page,
+			Limit:      params.Limit,
+			Total:      page.Total,
+			TotalPage:  int((page.Total + int64(params.Limit) - 1) / int64(params.Limit)),
+			NextCursor: page.NextCursor,
+			PrevCursor: page.PrevCursor,
+			Facets:     facetsMeta(page),
+		}
+		return response.SuccessPaged(c, projectArticles(page.Items, params.Fields), meta)
+	}
+
+	return response.Success(c, projectArticles(page.Items, params.Fields))
+}
+
+// projectArticles 只保留请求的字段，未请求的字段不出现在响应中；fields为空时返回完整文章
+func projectArticles(articles []*domain.Article, fields []string) interface{} {
+	if len(fields) == 0 {
+		return articles
+	}
+
+	items := make([]map[string]json.RawMessage, len(articles))
+	for i, article := range articles {
+		data, err := json.Marshal(article)
+		if err != nil {
+			return articles
 		}
-		return response.SuccessPaged(c, articles, meta)
+		var all map[string]json.RawMessage
+		if err := json.Unmarshal(data, &all); err != nil {
+			return articles
+		}
+
+		item := map[string]json.RawMessage{domain.ArticleFieldID: all[domain.ArticleFieldID]}
+		for _, field := range fields {
+			if value, ok := all[field]; ok {
+				item[field] = value
+			}
+		}
+		items[i] = item
 	}
+	return items
+}
 
-	return response.Success(c, articles)
+// facetsMeta 没有请求分面时返回nil，使meta中省略facets
+func facetsMeta(page *domain.ArticlePage) interface{} {
+	if page.Facets == nil {
+		return nil
+	}
+	return page.Facets
 }
 
-// parseQueryParams 解析查询参数
-func (h *ArticleHandler) parseQueryParams(c echo.Context) domain.QueryParams {
+// parseQueryParams 解析并校验查询参数。页码和每页数量无效时使用默认值，其余参数无效时返回错误
+func (h *ArticleHandler) parseQueryParams(c echo.Context) (domain.QueryParams, error) {
 	params := domain.QueryParams{}
 
 	if page, err := strconv.Atoi(c.QueryParam("page")); err == nil && page > 0 {
 		params.Page = page
 	}
 
+	params.After = c.QueryParam("after")
+	params.Before = c.QueryParam("before")
+	if params.After != "" && params.Before != "" {
+		return params, errors.New("after和before不能同时使用")
+	}
+
+	// 分面统计放在分页元信息中返回，请求分面时默认分页
+	if facets := c.QueryParam("facets"); facets != "" {
+		for _, name := range strings.Split(facets, ",") {
+			name = strings.TrimSpace(name)
+			if !slices.Contains(domain.ArticleFacets, name) {
+				return params, errors.New("无效的分面: " + name)
+			}
+			if !slices.Contains(params.Facets, name) {
+				params.Facets = append(params.Facets, name)
+			}
+		}
+		if params.Page == 0 && !params.Cursored() {
+			params.Page = 1
+		}
+	}
+
+	// 列表默认返回不含正文的摘要投影，fields=all 返回完整文章
+	switch fields := c.QueryParam("fields"); fields {
+	case "":
+		params.Fields = domain.ArticleSummaryFields
+	case domain.ArticleFieldsAll:
+	default:
+		for _, field := range strings.Split(fields, ",") {
+			field = strings.TrimSpace(field)
+			if !slices.Contains(domain.ArticleFields, field) {
+				return params, errors.New("无效的字段: " + field)
+			}
+			if !slices.Contains(params.Fields, field) {
+				params.Fields = append(params.Fields, field)
+			}
+		}
+	}
+
 	if limit, err := strconv.Atoi(c.QueryParam("limit")); err == nil && limit > 0 && limit <= 100 {
 		params.Limit = limit
-	} else if params.Page > 0 {
+	} else if params.Page > 0 || params.Cursored() {
 		params.Limit = 10 // 默认限制
 	}
 
@@ -200,13 +395,101 @@ func (h *ArticleHandler) parseQueryParams(c echo.Context) domain.QueryParams {
 		}
 	}
 
+	params.Status = c.QueryParam("status")
+	if params.Status != "" && !slices.Contains(domain.ArticleStatuses, params.Status) {
+		return params, errors.New("无效的文章状态")
+	}
 	params.Search = c.QueryParam("search")
+	params.Author = c.QueryParam("author")
+
+	// 时间范围使用RFC3339格式，from包含，to不包含
+	for name, target := range map[string]**time.Time{
+		"created_from":   &params.CreatedFrom,
+		"created_to":     &params.CreatedTo,
+		"updated_from":   &params.UpdatedFrom,
+		"updated_to":     &params.UpdatedTo,
+		"published_from": &params.PublishedFrom,
+		"published_to":   &params.PublishedTo,
+	} {
+		value := c.QueryParam(name)
+		if value == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return params, errors.New("无效的时间格式: " + name)
+		}
+		*target = &t
+	}
+
+	var err error
+	if params.CategoryIDs, err = parseIDList(c.QueryParam("category")); err != nil {
+		return params, errors.New("无效的分类ID")
+	}
+	if params.TagIDs, err = parseIDList(c.QueryParam("tags")); err != nil {
+		return params, errors.New("无效的标签ID")
+	}
+
+	switch match := c.QueryParam("tag_match"); match {
+	case "", domain.TagMatchAny, domain.TagMatchAll, domain.TagMatchNone:
+		params.TagMatch = match
+	default:
+		return params, errors.New("无效的标签匹配方式")
+	}
+
+	// sort=title 升序，sort=-title 降序
+	if sort := c.QueryParam("sort"); sort != "" {
+		params.Sort = strings.TrimPrefix(sort, "-")
+		params.SortDesc = strings.HasPrefix(sort, "-")
+		if !slices.Contains(domain.ArticleSortFields, params.Sort) {
+			return params, errors.New("无效的排序字段")
+		}
+		if params.Sort == domain.ArticleSortPopularity && params.Cursored() {
+			return params, errors.New("按热度排序时不支持游标分页")
+		}
+	}
 
-	return params
+	return params, nil
 }
 
-// handleError 处理错误
+// parseIDList 解析逗号分隔的ID列表
+func parseIDList(value string) ([]int, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	var ids []int
+	for _, part := range strings.Split(value, ",") {
+		id, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil || id <= 0 {
+			return nil, domain.ErrInvalidInput
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// checkIfMatch 请求带有If-Match时校验文章当前的ETag，不一致时写入412响应并返回false
+func (h *ArticleHandler) checkIfMatch(c echo.Context, id int) (bool, error) {
+	if !httpcache.HasIfMatch(c) {
+		return true, nil
+	}
+
+	article, err := h.articleService.GetByID(c.Request().Context(), id)
+	if err != nil {
+		return false, h.handleError(c, err)
+	}
+	if !httpcache.IfMatch(c, articleETag(article)) {
+		return false, response.PreconditionFailed(c, "文章已被修改")
+	}
+	return true, nil
+}
+
+// handleError 处理错误，按已被合并的标签查询时重定向到目标标签
 func (h *ArticleHandler) handleError(c echo.Context, err error) error {
+	if ok, err := redirectMoved(c, err); ok {
+		return err
+	}
 	if errors.Is(err, domain.ErrNotFound) {
 		return response.NotFound(c, "文章不存在")
 	}
@@ -216,6 +499,16 @@ func (h *ArticleHandler) handleError(c echo.Context, err error) error {
 	if errors.Is(err, domain.ErrDuplicateResource) {
 		return response.BadRequest(c, "资源已存在")
 	}
+	if errors.Is(err, domain.ErrForbidden) {
+		return response.Forbidden(c, "当前角色无权执行该操作")
+	}
+	if errors.Is(err, domain.ErrInvalidTransition) {
+		return response.Conflict(c, "当前状态不允许该流转")
+	}
+	var conflict *domain.ConflictError
+	if errors.As(err, &conflict) && errors.Is(err, domain.ErrVersionConflict) {
+		return response.ConflictWithData(c, "文章已被他人修改，请基于最新版本重新提交", conflict.Current)
+	}
 	return response.InternalServerError(c, "内部服务器错误")
 }
 
@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"errors"
+	"strconv"
+
+	"goblog/internal/domain"
+	"goblog/internal/pkg/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ArticleLockHandler 文章编辑租约处理器
+type ArticleLockHandler struct {
+	lockService domain.ArticleLockService
+}
+
+// NewArticleLockHandler 创建文章编辑租约处理器
+func NewArticleLockHandler(lockService domain.ArticleLockService) *ArticleLockHandler {
+	return &ArticleLockHandler{lockService: lockService}
+}
+
+// Get 查看文章当前的编辑租约
+func (h *ArticleLockHandler) Get(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return response.BadRequest(c, "无效的文章ID")
+	}
+
+	lock, err := h.lockService.Get(c.Request().Context(), id)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, lock)
+}
+
+// Acquire 获取或续期编辑租约，请求体可省略
+func (h *ArticleLockHandler) Acquire(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return response.BadRequest(c, "无效的文章ID")
+	}
+
+	var req domain.ArticleLockRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "无效的请求参数")
+	}
+
+	lock, err := h.lockService.Acquire(c.Request().Context(), id, &req)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, lock)
+}
+
+// Release 释放编辑租约
+func (h *ArticleLockHandler) Release(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return response.BadRequest(c, "无效的文章ID")
+	}
+
+	if err := h.lockService.Release(c.Request().Context(), id); err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, map[string]string{"message": "编辑租约已释放"})
+}
+
+// handleError 处理错误
+func (h *ArticleLockHandler) handleError(c echo.Context, err error) error {
+	if errors.Is(err, domain.ErrNotFound) {
+		return response.NotFound(c, "文章不存在或没有有效的编辑租约")
+	}
+	if errors.Is(err, domain.ErrUnauthorized) {
+		return response.Unauthorized(c, "未认证")
+	}
+	if errors.Is(err, domain.ErrForbidden) {
+		return response.Forbidden(c, "当前角色无权接管或释放他人的编辑租约")
+	}
+	var conflict *domain.ConflictError
+	if errors.As(err, &conflict) {
+		return response.ConflictWithData(c, "文章正在被他人编辑", conflict.Current)
+	}
+	return response.InternalServerError(c, "内部服务器错误")
+}
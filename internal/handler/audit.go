@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"strconv"
+	"time"
+
+	"goblog/internal/domain"
+	"goblog/internal/pkg/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+// AuditHandler 审计日志处理器
+type AuditHandler struct {
+	auditService domain.AuditService
+}
+
+// NewAuditHandler 创建审计日志处理器
+func NewAuditHandler(auditService domain.AuditService) *AuditHandler {
+	return &AuditHandler{auditService: auditService}
+}
+
+// List 查询审计日志，审计日志数量不受控制，始终分页返回
+func (h *AuditHandler) List(c echo.Context) error {
+	params := domain.AuditQueryParams{
+		Page:       1,
+		Limit:      10,
+		Actor:      c.QueryParam("actor"),
+		EntityType: c.QueryParam("entity_type"),
+		RequestID:  c.QueryParam("request_id"),
+	}
+
+	if page, err := strconv.Atoi(c.QueryParam("page")); err == nil && page > 0 {
+		params.Page = page
+	}
+
+	if limit, err := strconv.Atoi(c.QueryParam("limit")); err == nil && limit > 0 && limit <= 100 {
+		params.Limit = limit
+	}
+
+	if entityID := c.QueryParam("entity_id"); entityID != "" {
+		id, err := strconv.Atoi(entityID)
+		if err != nil || id <= 0 {
+			return response.BadRequest(c, "无效的对象ID")
+		}
+		params.EntityID = id
+	}
+
+	switch action := c.QueryParam("action"); action {
+	case "", domain.AuditActionCreate, domain.AuditActionUpdate, domain.AuditActionDelete:
+		params.Action = action
+	default:
+		return response.BadRequest(c, "无效的操作类型")
+	}
+
+	// 时间范围使用RFC3339格式，from包含，to不包含
+	for name, target := range map[string]**time.Time{"from": &params.From, "to": &params.To} {
+		value := c.QueryParam(name)
+		if value == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return response.BadRequest(c, "无效的时间格式: "+name)
+		}
+		*target = &t
+	}
+
+	logs, total, err := h.auditService.List(c.Request().Context(), params)
+	if err != nil {
+		return response.InternalServerError(c, "内部服务器错误")
+	}
+
+	meta := response.PageMeta{
+		Page:      params.Page,
+		Limit:     params.Limit,
+		Total:     total,
+		TotalPage: int((total + int64(params.Limit) - 1) / int64(params.Limit)),
+	}
+	return response.SuccessPaged(c, logs, meta)
+}
@@ -0,0 +1,268 @@
+package handler
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"goblog/internal/domain"
+	"goblog/internal/pkg/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+// AuthHandler 认证处理器
+type AuthHandler struct {
+	authService      domain.AuthService
+	twoFactorService domain.TwoFactorService
+}
+
+// NewAuthHandler 创建认证处理器
+func NewAuthHandler(authService domain.AuthService, twoFactorService domain.TwoFactorService) *AuthHandler {
+	return &AuthHandler{
+		authService:      authService,
+		twoFactorService: twoFactorService,
+	}
+}
+
+// Login 用户登录
+func (h *AuthHandler) Login(c echo.Context) error {
+	var req struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "无效的请求参数"})
+	}
+
+	loginReq := &domain.LoginRequest{
+		Username:  req.Username,
+		Password:  req.Password,
+		IP:        c.RealIP(),
+		UserAgent: c.Request().UserAgent(),
+	}
+
+	resp, err := h.authService.Login(c.Request().Context(), loginReq)
+	if err != nil {
+		return h.loginError(c, err, "用户名或密码错误")
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// LoginTwoFactor 提交两步验证码完成登录
+func (h *AuthHandler) LoginTwoFactor(c echo.Context) error {
+	var req domain.TwoFactorLoginRequest
+	if err := c.Bind(&req); err != nil || req.ChallengeToken == "" || req.Code == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "无效的请求参数"})
+	}
+
+	req.IP = c.RealIP()
+	req.UserAgent = c.Request().UserAgent()
+
+	resp, err := h.authService.VerifyTwoFactor(c.Request().Context(), &req)
+	if err != nil {
+		return h.loginError(c, err, "验证码错误或挑战令牌已失效")
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// SetupTwoFactor 生成TOTP密钥，返回供验证器应用扫描的URI
+func (h *AuthHandler) SetupTwoFactor(c echo.Context) error {
+	username, _ := c.Get("username").(string)
+
+	setup, err := h.twoFactorService.Setup(c.Request().Context(), username)
+	if err != nil {
+		return h.handleTwoFactorError(c, err)
+	}
+
+	return response.Success(c, setup)
+}
+
+// EnableTwoFactor 提交验证码确认绑定，返回恢复码
+func (h *AuthHandler) EnableTwoFactor(c echo.Context) error {
+	username, _ := c.Get("username").(string)
+
+	var req domain.TwoFactorCodeRequest
+	if err := c.Bind(&req); err != nil || req.Code == "" {
+		return response.BadRequest(c, "无效的请求参数")
+	}
+
+	codes, err := h.twoFactorService.Enable(c.Request().Context(), username, req.Code)
+	if err != nil {
+		return h.handleTwoFactorError(c, err)
+	}
+
+	return response.Success(c, map[string]interface{}{"recovery_codes": codes})
+}
+
+// DisableTwoFactor 关闭两步验证
+func (h *AuthHandler) DisableTwoFactor(c echo.Context) error {
+	username, _ := c.Get("username").(string)
+
+	var req domain.TwoFactorCodeRequest
+	if err := c.Bind(&req); err != nil || req.Code == "" {
+		return response.BadRequest(c, "无效的请求参数")
+	}
+
+	if err := h.twoFactorService.Disable(c.Request().Context(), username, req.Code); err != nil {
+		return h.handleTwoFactorError(c, err)
+	}
+
+	return response.Success(c, map[string]string{"message": "两步验证已关闭"})
+}
+
+// RegenerateRecoveryCodes 重新生成恢复码
+func (h *AuthHandler) RegenerateRecoveryCodes(c echo.Context) error {
+	username, _ := c.Get("username").(string)
+
+	var req domain.TwoFactorCodeRequest
+	if err := c.Bind(&req); err != nil || req.Code == "" {
+		return response.BadRequest(c, "无效的请求参数")
+	}
+
+	codes, err := h.twoFactorService.RegenerateRecoveryCodes(c.Request().Context(), username, req.Code)
+	if err != nil {
+		return h.handleTwoFactorError(c, err)
+	}
+
+	return response.Success(c, map[string]interface{}{"recovery_codes": codes})
+}
+
+// Refresh 使用刷新令牌换取新的访问令牌，刷新令牌同时被轮换
+func (h *AuthHandler) Refresh(c echo.Context) error {
+	var req domain.RefreshRequest
+	if err := c.Bind(&req); err != nil || req.RefreshToken == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "无效的请求参数"})
+	}
+
+	req.IP = c.RealIP()
+	req.UserAgent = c.Request().UserAgent()
+
+	resp, err := h.authService.Refresh(c.Request().Context(), &req)
+	if err != nil {
+		if errors.Is(err, domain.ErrUnauthorized) {
+			return c.JSON(http.StatusUnauthorized, map[string]string{"error": "刷新令牌无效或已过期"})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "内部服务器错误"})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// Logout 登出，吊销Authorization头中的访问令牌和请求体中的刷新令牌
+func (h *AuthHandler) Logout(c echo.Context) error {
+	var req domain.LogoutRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "无效的请求参数"})
+	}
+
+	if authHeader := c.Request().Header.Get("Authorization"); strings.HasPrefix(authHeader, "Bearer ") {
+		req.AccessToken = authHeader
+	}
+
+	err := h.authService.Logout(c.Request().Context(), &req)
+	if err != nil {
+		if errors.Is(err, domain.ErrUnauthorized) {
+			return c.JSON(http.StatusUnauthorized, map[string]string{"error": "令牌无效"})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "内部服务器错误"})
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "已登出"})
+}
+
+// ListLoginAttempts 查询登录尝试记录
+func (h *AuthHandler) ListLoginAttempts(c echo.Context) error {
+	params := domain.LoginAttemptQueryParams{
+		Username: c.QueryParam("username"),
+		IP:       c.QueryParam("ip"),
+	}
+
+	if page, err := strconv.Atoi(c.QueryParam("page")); err == nil && page > 0 {
+		params.Page = page
+	}
+
+	if limit, err := strconv.Atoi(c.QueryParam("limit")); err == nil && limit > 0 && limit <= 100 {
+		params.Limit = limit
+	} else if params.Page > 0 {
+		params.Limit = 10 // 默认限制
+	}
+
+	if success := c.QueryParam("success"); success != "" {
+		if b, err := strconv.ParseBool(success); err == nil {
+			params.Success = &b
+		}
+	}
+
+	attempts, total, err := h.authService.ListLoginAttempts(c.Request().Context(), params)
+	if err != nil {
+		return response.InternalServerError(c, "内部服务器错误")
+	}
+
+	if params.Page > 0 && params.Limit > 0 {
+		meta := response.PageMeta{
+			Page:      params.Page,
+			Limit:     params.Limit,
+			Total:     total,
+			TotalPage: int((total + int64(params.Limit) - 1) / int64(params.Limit)),
+		}
+		return response.SuccessPaged(c, attempts, meta)
+	}
+
+	return response.Success(c, attempts)
+}
+
+// ListLockouts 获取当前被锁定的用户名和IP
+func (h *AuthHandler) ListLockouts(c echo.Context) error {
+	lockouts, err := h.authService.ListLockouts(c.Request().Context())
+	if err != nil {
+		return response.InternalServerError(c, "内部服务器错误")
+	}
+
+	return response.Success(c, lockouts)
+}
+
+// ClearLockout 解除锁定，通过 username 和/或 ip 查询参数指定对象
+func (h *AuthHandler) ClearLockout(c echo.Context) error {
+	err := h.authService.ClearLockout(c.Request().Context(), c.QueryParam("username"), c.QueryParam("ip"))
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return response.BadRequest(c, "需要指定username或ip")
+		}
+		return response.InternalServerError(c, "内部服务器错误")
+	}
+
+	return response.Success(c, map[string]string{"message": "锁定已解除"})
+}
+
+// loginError 处理登录流程中的错误
+func (h *AuthHandler) loginError(c echo.Context, err error, unauthorizedMessage string) error {
+	var retryErr *domain.RetryAfterError
+	if errors.As(err, &retryErr) {
+		seconds := int(math.Ceil(retryErr.RetryAfter.Seconds()))
+		c.Response().Header().Set("Retry-After", strconv.Itoa(max(seconds, 1)))
+		return c.JSON(http.StatusTooManyRequests, map[string]string{"error": "登录失败次数过多，请稍后再试"})
+	}
+	if errors.Is(err, domain.ErrUnauthorized) {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": unauthorizedMessage})
+	}
+	return c.JSON(http.StatusInternalServerError, map[string]string{"error": "内部服务器错误"})
+}
+
+// handleTwoFactorError 处理两步验证管理接口的错误
+func (h *AuthHandler) handleTwoFactorError(c echo.Context, err error) error {
+	if errors.Is(err, domain.ErrUnauthorized) {
+		return response.Unauthorized(c, "验证码错误")
+	}
+	if errors.Is(err, domain.ErrNotFound) {
+		return response.BadRequest(c, "请先生成两步验证密钥")
+	}
+	if errors.Is(err, domain.ErrDuplicateResource) {
+		return response.BadRequest(c, "两步验证已开启")
+	}
+	return response.InternalServerError(c, "内部服务器错误")
+}
@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"goblog/internal/pkg/cache"
+	"goblog/internal/pkg/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+// CacheHandler 仓储缓存统计处理器
+type CacheHandler struct {
+	cache *cache.Cache
+}
+
+// NewCacheHandler 创建缓存统计处理器，未启用缓存时c为nil
+func NewCacheHandler(c *cache.Cache) *CacheHandler {
+	return &CacheHandler{cache: c}
+}
+
+// cacheStats 缓存统计响应
+type cacheStats struct {
+	Enabled bool `json:"enabled"`
+	*cache.Snapshot
+}
+
+// Stats 返回各命名空间的命中、未命中次数以及进程内缓存的条目数和淘汰数，统计只包含当前实例
+func (h *CacheHandler) Stats(c echo.Context) error {
+	if h.cache == nil {
+		return response.Success(c, cacheStats{})
+	}
+
+	snapshot := h.cache.Stats()
+	return response.Success(c, cacheStats{Enabled: true, Snapshot: &snapshot})
+}
@@ -2,9 +2,12 @@ package handler
 
 import (
 	"errors"
+	"net/http"
 	"strconv"
+	"time"
 
 	"goblog/internal/domain"
+	"goblog/internal/pkg/httpcache"
 	"goblog/internal/pkg/response"
 
 	"github.com/go-playground/validator/v10"
@@ -56,6 +59,10 @@ func (h *CategoryHandler) GetByID(c echo.Context) error {
 		return h.handleError(c, err)
 	}
 
+	if httpcache.NotModified(c, categoryETag(category), category.UpdatedAt) {
+		return c.NoContent(http.StatusNotModified)
+	}
+
 	return response.Success(c, category)
 }
 
@@ -75,27 +82,46 @@ func (h *CategoryHandler) Update(c echo.Context) error {
 		return response.BadRequest(c, "请求参数验证失败")
 	}
 
+	if ok, err := h.checkIfMatch(c, id); !ok {
+		return err
+	}
+
 	category, err := h.categoryService.Update(c.Request().Context(), id, &req)
 	if err != nil {
 		return h.handleError(c, err)
 	}
 
+	httpcache.SetETag(c, categoryETag(category))
 	return response.Success(c, category)
 }
 
-// Delete 删除分类
+// Delete 删除分类。分类下有文章时需要 reassign_to 指定文章移到的分类，dry_run=true 只返回删除的影响
 func (h *CategoryHandler) Delete(c echo.Context) error {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
 		return response.BadRequest(c, "无效的分类ID")
 	}
 
-	err = h.categoryService.Delete(c.Request().Context(), id)
+	var opts domain.CategoryDeleteOptions
+	if value := c.QueryParam("reassign_to"); value != "" {
+		reassignTo, err := strconv.Atoi(value)
+		if err != nil || reassignTo <= 0 {
+			return response.BadRequest(c, "无效的目标分类ID")
+		}
+		opts.ReassignTo = &reassignTo
+	}
+	opts.DryRun = c.QueryParam("dry_run") == "true"
+
+	if ok, err := h.checkIfMatch(c, id); !ok {
+		return err
+	}
+
+	deletion, err := h.categoryService.Delete(c.Request().Context(), id, opts)
 	if err != nil {
 		return h.handleError(c, err)
 	}
 
-	return response.Success(c, map[string]string{"message": "分类删除成功"})
+	return response.Success(c, deletion)
 }
 
 // List 获取分类列表
@@ -105,9 +131,43 @@ func (h *CategoryHandler) List(c echo.Context) error {
 		return h.handleError(c, err)
 	}
 
+	if httpcache.NotModified(c, categoriesETag(categories), time.Time{}) {
+		return c.NoContent(http.StatusNotModified)
+	}
+
 	return response.Success(c, categories)
 }
 
+// Tree 获取分类树
+func (h *CategoryHandler) Tree(c echo.Context) error {
+	roots, err := h.categoryService.Tree(c.Request().Context())
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	if httpcache.NotModified(c, categoryTreeETag(roots), time.Time{}) {
+		return c.NoContent(http.StatusNotModified)
+	}
+
+	return response.Success(c, roots)
+}
+
+// checkIfMatch 请求带有If-Match时校验分类当前的ETag，不一致时写入412响应并返回false
+func (h *CategoryHandler) checkIfMatch(c echo.Context, id int) (bool, error) {
+	if !httpcache.HasIfMatch(c) {
+		return true, nil
+	}
+
+	category, err := h.categoryService.GetByID(c.Request().Context(), id)
+	if err != nil {
+		return false, h.handleError(c, err)
+	}
+	if !httpcache.IfMatch(c, categoryETag(category)) {
+		return false, response.PreconditionFailed(c, "分类已被修改")
+	}
+	return true, nil
+}
+
 // handleError 处理错误
 func (h *CategoryHandler) handleError(c echo.Context, err error) error {
 	if errors.Is(err, domain.ErrNotFound) {
@@ -119,5 +179,15 @@ func (h *CategoryHandler) handleError(c echo.Context, err error) error {
 	if errors.Is(err, domain.ErrDuplicateResource) {
 		return response.BadRequest(c, "分类名称已存在")
 	}
+	if errors.Is(err, domain.ErrCategoryCycle) {
+		return response.BadRequest(c, "不能将分类移动到自身或其子分类下")
+	}
+	if errors.Is(err, domain.ErrForbidden) {
+		return response.Forbidden(c, "当前角色无权执行该操作")
+	}
+	var conflict *domain.ConflictError
+	if errors.As(err, &conflict) && errors.Is(err, domain.ErrCategoryInUse) {
+		return response.ConflictWithData(c, "分类下还有文章，请通过reassign_to指定文章移到的分类", conflict.Current)
+	}
 	return response.InternalServerError(c, "内部服务器错误")
 }
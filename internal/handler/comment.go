@@ -0,0 +1,176 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"goblog/internal/domain"
+	"goblog/internal/pkg/httpcache"
+	"goblog/internal/pkg/response"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+// CommentHandler 评论处理器
+type CommentHandler struct {
+	commentService domain.CommentService
+	validator      *validator.Validate
+}
+
+// NewCommentHandler 创建评论处理器
+func NewCommentHandler(commentService domain.CommentService) *CommentHandler {
+	return &CommentHandler{
+		commentService: commentService,
+		validator:      validator.New(),
+	}
+}
+
+// Create 发表评论
+func (h *CommentHandler) Create(c echo.Context) error {
+	articleID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return response.BadRequest(c, "无效的文章ID")
+	}
+
+	var req domain.CommentCreateRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "无效的请求参数")
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		return response.BadRequest(c, "请求参数验证失败")
+	}
+
+	req.IP = c.RealIP()
+	req.UserAgent = c.Request().UserAgent()
+
+	comment, err := h.commentService.Create(c.Request().Context(), articleID, &req)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	// 不向评论者暴露评分细节
+	return response.Created(c, map[string]interface{}{
+		"id":     comment.ID,
+		"status": domain.CommentStatusPending,
+	})
+}
+
+// ListByArticle 获取文章下已通过审核的评论
+func (h *CommentHandler) ListByArticle(c echo.Context) error {
+	articleID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return response.BadRequest(c, "无效的文章ID")
+	}
+
+	comments, err := h.commentService.ListByArticle(c.Request().Context(), articleID)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	if httpcache.NotModified(c, commentsETag(comments), time.Time{}) {
+		return c.NoContent(http.StatusNotModified)
+	}
+
+	return response.Success(c, comments)
+}
+
+// List 获取审核队列
+func (h *CommentHandler) List(c echo.Context) error {
+	params := domain.CommentQueryParams{
+		Status: c.QueryParam("status"),
+	}
+
+	if page, err := strconv.Atoi(c.QueryParam("page")); err == nil && page > 0 {
+		params.Page = page
+	}
+
+	if limit, err := strconv.Atoi(c.QueryParam("limit")); err == nil && limit > 0 && limit <= 100 {
+		params.Limit = limit
+	} else if params.Page > 0 {
+		params.Limit = 10 // 默认限制
+	}
+
+	comments, total, err := h.commentService.List(c.Request().Context(), params)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	if params.Page > 0 && params.Limit > 0 {
+		meta := response.PageMeta{
+			Page:      params.Page,
+			Limit:     params.Limit,
+			Total:     total,
+			TotalPage: int((total + int64(params.Limit) - 1) / int64(params.Limit)),
+		}
+		return response.SuccessPaged(c, comments, meta)
+	}
+
+	return response.Success(c, comments)
+}
+
+// Approve 审核通过评论
+func (h *CommentHandler) Approve(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return response.BadRequest(c, "无效的评论ID")
+	}
+
+	comment, err := h.commentService.Approve(c.Request().Context(), id)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, comment)
+}
+
+// MarkSpam 标记为垃圾评论
+func (h *CommentHandler) MarkSpam(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return response.BadRequest(c, "无效的评论ID")
+	}
+
+	comment, err := h.commentService.MarkSpam(c.Request().Context(), id)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, comment)
+}
+
+// Delete 删除评论
+func (h *CommentHandler) Delete(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return response.BadRequest(c, "无效的评论ID")
+	}
+
+	err = h.commentService.Delete(c.Request().Context(), id)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, map[string]string{"message": "评论删除成功"})
+}
+
+// handleError 处理错误
+func (h *CommentHandler) handleError(c echo.Context, err error) error {
+	if errors.Is(err, domain.ErrNotFound) {
+		return response.NotFound(c, "评论或文章不存在")
+	}
+	if errors.Is(err, domain.ErrInvalidInput) {
+		return response.BadRequest(c, "无效的输入参数")
+	}
+	if errors.Is(err, domain.ErrForbidden) {
+		return response.Forbidden(c, "当前角色无权执行该操作")
+	}
+	var conflict *domain.ConflictError
+	if errors.As(err, &conflict) && errors.Is(err, domain.ErrVersionConflict) {
+		return response.ConflictWithData(c, "评论已被其他审核者处理", conflict.Current)
+	}
+	return response.InternalServerError(c, "内部服务器错误")
+}
@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	"goblog/internal/domain"
+	"goblog/internal/pkg/httpcache"
+)
+
+// articleVersions 文章及其内嵌的分类、分类路径和标签的版本
+func articleVersions(article *domain.Article) []httpcache.Version {
+	versions := []httpcache.Version{{ID: article.ID, UpdatedAt: article.UpdatedAt}}
+	if article.Category != nil {
+		versions = append(versions, httpcache.Version{ID: article.Category.ID, UpdatedAt: article.Category.UpdatedAt})
+	}
+	// 上级分类改名后路径随之变化
+	for _, crumb := range article.Breadcrumbs {
+		versions = append(versions, httpcache.Version{ID: crumb.ID, UpdatedAt: crumb.UpdatedAt})
+	}
+	for _, tag := range article.Tags {
+		versions = append(versions, httpcache.Version{ID: tag.ID, UpdatedAt: tag.UpdatedAt})
+	}
+	return versions
+}
+
+// articleETag 单篇文章的强ETag
+func articleETag(article *domain.Article) string {
+	return httpcache.StrongETag("article", articleVersions(article)...)
+}
+
+// articlesETag 文章列表的弱ETag。游标和分面统计也在响应中，一并参与计算，
+// 其他页的文章变化会改变游标，过滤结果之外的变化会改变分面统计
+func articlesETag(page *domain.ArticlePage) string {
+	var versions []httpcache.Version
+	for _, article := range page.Items {
+		versions = append(versions, articleVersions(article)...)
+	}
+
+	var kind strings.Builder
+	kind.WriteString("articles:" + page.NextCursor + ":" + page.PrevCursor)
+	for _, facet := range slices.Sorted(maps.Keys(page.Facets)) {
+		kind.WriteString(":" + facet)
+		for _, bucket := range page.Facets[facet] {
+			// 分类和标签改名后分面中的名称随之变化
+			kind.WriteString("," + bucket.Name)
+			versions = append(versions, httpcache.Version{ID: bucket.Value}, httpcache.Version{ID: bucket.Count})
+		}
+	}
+	return httpcache.WeakETag(kind.String(), page.Total, versions...)
+}
+
+// categoryETag 单个分类的强ETag
+func categoryETag(category *domain.Category) string {
+	return httpcache.StrongETag("category", httpcache.Version{ID: category.ID, UpdatedAt: category.UpdatedAt})
+}
+
+// categoriesETag 分类列表的弱ETag，文章数变化时也随之变化
+func categoriesETag(categories []*domain.Category) string {
+	var versions []httpcache.Version
+	for _, category := range categories {
+		versions = append(versions, httpcache.Version{ID: category.ID, UpdatedAt: category.UpdatedAt})
+		versions = append(versions, countVersions(category.Articles)...)
+	}
+	return httpcache.WeakETag("categories", int64(len(categories)), versions...)
+}
+
+// tagETag 单个标签的强ETag
+func tagETag(tag *domain.Tag) string {
+	return httpcache.StrongETag("tag", httpcache.Version{ID: tag.ID, UpdatedAt: tag.UpdatedAt})
+}
+
+// tagsETag 标签列表的弱ETag，文章数变化时也随之变化
+func tagsETag(tags []*domain.Tag) string {
+	var versions []httpcache.Version
+	for _, tag := range tags {
+		versions = append(versions, httpcache.Version{ID: tag.ID, UpdatedAt: tag.UpdatedAt})
+		versions = append(versions, countVersions(tag.Articles)...)
+	}
+	return httpcache.WeakETag("tags", int64(len(tags)), versions...)
+}
+
+// tagCloudETag 标签云的弱ETag，包含标签的文章数和权重
+func tagCloudETag(items []*domain.TagCloudItem) string {
+	var versions []httpcache.Version
+	for _, item := range items {
+		versions = append(versions,
+			httpcache.Version{ID: item.ID, UpdatedAt: item.UpdatedAt},
+			httpcache.Version{ID: item.Articles},
+			httpcache.Version{ID: item.Weight},
+		)
+	}
+	return httpcache.WeakETag("tag-cloud", int64(len(items)), versions...)
+}
+
+// countVersions 将文章数作为没有更新时间的版本参与ETag计算
+func countVersions(counts *domain.ArticleCounts) []httpcache.Version {
+	if counts == nil {
+		return nil
+	}
+	return []httpcache.Version{{ID: counts.Total}, {ID: counts.Published}}
+}
+
+// commentsETag 文章评论列表的弱ETag
+func commentsETag(comments []*domain.Comment) string {
+	versions := make([]httpcache.Version, len(comments))
+	for i, comment := range comments {
+		versions[i] = httpcache.Version{ID: comment.ID, UpdatedAt: comment.UpdatedAt}
+	}
+	return httpcache.WeakETag("comments", int64(len(comments)), versions...)
+}
+
+// categoryTreeETag 分类树的弱ETag，包含树中的全部分类
+func categoryTreeETag(roots []*domain.Category) string {
+	var categories []*domain.Category
+	var walk func(nodes []*domain.Category)
+	walk = func(nodes []*domain.Category) {
+		for _, node := range nodes {
+			categories = append(categories, node)
+			walk(node.Children)
+		}
+	}
+	walk(roots)
+	return categoriesETag(categories)
+}
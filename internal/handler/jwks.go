@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"net/http"
+
+	"goblog/internal/pkg/jwtkeys"
+
+	"github.com/labstack/echo/v4"
+)
+
+// JWKSHandler 公布令牌验证公钥的处理器
+type JWKSHandler struct {
+	keys *jwtkeys.KeySet
+}
+
+// NewJWKSHandler 创建JWKS处理器
+func NewJWKSHandler(keys *jwtkeys.KeySet) *JWKSHandler {
+	return &JWKSHandler{keys: keys}
+}
+
+// Keys 返回全部验证公钥，其他服务可据此验证goblog签发的令牌；使用共享密钥时返回空集合
+func (h *JWKSHandler) Keys(c echo.Context) error {
+	set, err := h.keys.JWKS()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "内部服务器错误"})
+	}
+
+	c.Response().Header().Set("Cache-Control", "public, max-age=300")
+	return c.JSON(http.StatusOK, set)
+}
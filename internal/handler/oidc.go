@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"goblog/internal/domain"
+
+	"github.com/labstack/echo/v4"
+)
+
+// oidcStateCookie 保存单点登录状态令牌的Cookie
+const oidcStateCookie = "goblog_oidc_state"
+
+// OIDCHandler 单点登录处理器
+type OIDCHandler struct {
+	oidcService domain.OIDCService
+}
+
+// NewOIDCHandler 创建单点登录处理器
+func NewOIDCHandler(oidcService domain.OIDCService) *OIDCHandler {
+	return &OIDCHandler{oidcService: oidcService}
+}
+
+// Login 跳转到身份提供方登录
+func (h *OIDCHandler) Login(c echo.Context) error {
+	auth, err := h.oidcService.AuthURL(c.Request().Context())
+	if err != nil {
+		return c.JSON(http.StatusBadGateway, map[string]string{"error": "身份提供方不可用"})
+	}
+
+	c.SetCookie(&http.Cookie{
+		Name:     oidcStateCookie,
+		Value:    auth.State,
+		Path:     "/auth/oidc",
+		Expires:  auth.ExpiresAt,
+		HttpOnly: true,
+		Secure:   c.Scheme() == "https",
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	return c.Redirect(http.StatusFound, auth.URL)
+}
+
+// Callback 身份提供方回调，成功后返回与 /auth/login 相同的令牌
+func (h *OIDCHandler) Callback(c echo.Context) error {
+	// 状态令牌只能使用一次
+	c.SetCookie(&http.Cookie{
+		Name:     oidcStateCookie,
+		Path:     "/auth/oidc",
+		Expires:  time.Unix(0, 0),
+		MaxAge:   -1,
+		HttpOnly: true,
+	})
+
+	if errCode := c.QueryParam("error"); errCode != "" {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "身份提供方拒绝登录: " + errCode})
+	}
+
+	cookie, err := c.Cookie(oidcStateCookie)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "登录状态已失效，请重新登录"})
+	}
+
+	resp, err := h.oidcService.Callback(c.Request().Context(), &domain.OIDCCallbackRequest{
+		Code:       c.QueryParam("code"),
+		State:      c.QueryParam("state"),
+		StateToken: cookie.Value,
+		IP:         c.RealIP(),
+		UserAgent:  c.Request().UserAgent(),
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrUnauthorized) {
+			return c.JSON(http.StatusUnauthorized, map[string]string{"error": "单点登录失败"})
+		}
+		if errors.Is(err, domain.ErrDuplicateResource) {
+			return c.JSON(http.StatusConflict, map[string]string{"error": "用户名已被其他账号使用"})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "内部服务器错误"})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
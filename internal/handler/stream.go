@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"goblog/internal/pkg/sse"
+
+	"github.com/labstack/echo/v4"
+)
+
+// 事件流控制事件
+const (
+	streamEventReset = "stream.reset" // 无法从Last-Event-ID续传，客户端应重新拉取完整数据
+)
+
+// streamRetry 建议客户端断线后的重连间隔
+const streamRetry = 3 * time.Second
+
+// defaultStreamHeartbeat 未配置心跳间隔时使用的默认值
+const defaultStreamHeartbeat = 15 * time.Second
+
+// StreamHandler 实时事件流处理器
+type StreamHandler struct {
+	broker    *sse.Broker
+	heartbeat time.Duration
+}
+
+// NewStreamHandler 创建实时事件流处理器
+func NewStreamHandler(broker *sse.Broker, heartbeat time.Duration) *StreamHandler {
+	if heartbeat <= 0 {
+		heartbeat = defaultStreamHeartbeat
+	}
+	return &StreamHandler{broker: broker, heartbeat: heartbeat}
+}
+
+// Stream 以Server-Sent Events推送文章、分类、标签和评论的变更。
+// 客户端重连时携带Last-Event-ID请求头可补发断线期间缓冲区中的事件
+func (h *StreamHandler) Stream(c echo.Context) error {
+	sub, backlog, resumed := h.broker.Subscribe(c.Request().Header.Get("Last-Event-ID"))
+	defer sub.Close()
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set("Cache-Control", "no-cache")
+	res.Header().Set("Connection", "keep-alive")
+	res.Header().Set("X-Accel-Buffering", "no")
+	res.WriteHeader(http.StatusOK)
+
+	// 长连接不受服务器写超时限制
+	_ = http.NewResponseController(res).SetWriteDeadline(time.Time{})
+
+	if err := sse.WriteRetry(res, streamRetry); err != nil {
+		return nil
+	}
+	if !resumed {
+		if err := sse.Write(res, sse.Event{Name: streamEventReset, Data: []byte("{}")}); err != nil {
+			return nil
+		}
+	}
+	for _, event := range backlog {
+		if err := sse.Write(res, event); err != nil {
+			return nil
+		}
+	}
+	res.Flush()
+
+	ticker := time.NewTicker(h.heartbeat)
+	defer ticker.Stop()
+
+	ctx := c.Request().Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-sub.Events():
+			if !ok {
+				return nil
+			}
+			if err := sse.Write(res, event); err != nil {
+				return nil
+			}
+			res.Flush()
+		case <-ticker.C:
+			if err := sse.WriteComment(res, "heartbeat"); err != nil {
+				return nil
+			}
+			res.Flush()
+		}
+	}
+}
@@ -2,9 +2,13 @@ package handler
 
 import (
 	"errors"
+	"net/http"
+	"path"
 	"strconv"
+	"time"
 
 	"goblog/internal/domain"
+	"goblog/internal/pkg/httpcache"
 	"goblog/internal/pkg/response"
 
 	"github.com/go-playground/validator/v10"
@@ -56,6 +60,10 @@ func (h *TagHandler) GetByID(c echo.Context) error {
 		return h.handleError(c, err)
 	}
 
+	if httpcache.NotModified(c, tagETag(tag), tag.UpdatedAt) {
+		return c.NoContent(http.StatusNotModified)
+	}
+
 	return response.Success(c, tag)
 }
 
@@ -75,11 +83,16 @@ func (h *TagHandler) Update(c echo.Context) error {
 		return response.BadRequest(c, "请求参数验证失败")
 	}
 
+	if ok, err := h.checkIfMatch(c, id); !ok {
+		return err
+	}
+
 	tag, err := h.tagService.Update(c.Request().Context(), id, &req)
 	if err != nil {
 		return h.handleError(c, err)
 	}
 
+	httpcache.SetETag(c, tagETag(tag))
 	return response.Success(c, tag)
 }
 
@@ -90,6 +103,10 @@ func (h *TagHandler) Delete(c echo.Context) error {
 		return response.BadRequest(c, "无效的标签ID")
 	}
 
+	if ok, err := h.checkIfMatch(c, id); !ok {
+		return err
+	}
+
 	err = h.tagService.Delete(c.Request().Context(), id)
 	if err != nil {
 		return h.handleError(c, err)
@@ -98,6 +115,33 @@ func (h *TagHandler) Delete(c echo.Context) error {
 	return response.Success(c, map[string]string{"message": "标签删除成功"})
 }
 
+// Merge 将请求中的来源标签合并到路径中的目标标签
+func (h *TagHandler) Merge(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return response.BadRequest(c, "无效的标签ID")
+	}
+
+	var req domain.TagMergeRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "无效的请求参数")
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		return response.BadRequest(c, "请求参数验证失败")
+	}
+
+	merge, err := h.tagService.Merge(c.Request().Context(), id, &req)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return response.BadRequest(c, "来源标签不存在或包含目标标签")
+		}
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, merge)
+}
+
 // List 获取标签列表
 func (h *TagHandler) List(c echo.Context) error {
 	tags, err := h.tagService.List(c.Request().Context())
@@ -105,11 +149,56 @@ func (h *TagHandler) List(c echo.Context) error {
 		return h.handleError(c, err)
 	}
 
+	if httpcache.NotModified(c, tagsETag(tags), time.Time{}) {
+		return c.NoContent(http.StatusNotModified)
+	}
+
 	return response.Success(c, tags)
 }
 
-// handleError 处理错误
+// Cloud 获取标签云，limit指定时只返回文章最多的若干个标签
+func (h *TagHandler) Cloud(c echo.Context) error {
+	limit := 0
+	if v := c.QueryParam("limit"); v != "" {
+		var err error
+		if limit, err = strconv.Atoi(v); err != nil || limit < 1 {
+			return response.BadRequest(c, "无效的数量限制")
+		}
+	}
+
+	items, err := h.tagService.Cloud(c.Request().Context(), limit)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	if httpcache.NotModified(c, tagCloudETag(items), time.Time{}) {
+		return c.NoContent(http.StatusNotModified)
+	}
+
+	return response.Success(c, items)
+}
+
+// checkIfMatch 请求带有If-Match时校验标签当前的ETag，不一致时写入412响应并返回false
+func (h *TagHandler) checkIfMatch(c echo.Context, id int) (bool, error) {
+	if !httpcache.HasIfMatch(c) {
+		return true, nil
+	}
+
+	tag, err := h.tagService.GetByID(c.Request().Context(), id)
+	if err != nil {
+		return false, h.handleError(c, err)
+	}
+	if !httpcache.IfMatch(c, tagETag(tag)) {
+		return false, response.PreconditionFailed(c, "标签已被修改")
+	}
+	return true, nil
+}
+
+// handleError 处理错误，读取已被合并的标签时重定向到目标标签
 func (h *TagHandler) handleError(c echo.Context, err error) error {
+	if ok, err := redirectMoved(c, err); ok {
+		return err
+	}
 	if errors.Is(err, domain.ErrNotFound) {
 		return response.NotFound(c, "标签不存在")
 	}
@@ -119,5 +208,21 @@ func (h *TagHandler) handleError(c echo.Context, err error) error {
 	if errors.Is(err, domain.ErrDuplicateResource) {
 		return response.BadRequest(c, "标签名称已存在")
 	}
+	if errors.Is(err, domain.ErrForbidden) {
+		return response.Forbidden(c, "当前角色无权执行该操作")
+	}
 	return response.InternalServerError(c, "内部服务器错误")
 }
+
+// redirectMoved 读请求的资源已被合并时永久重定向到新地址：路径最后一段替换为新ID，保留查询参数。
+// 写请求不重定向，按资源不存在处理
+func redirectMoved(c echo.Context, err error) (bool, error) {
+	var moved *domain.MovedError
+	if !errors.As(err, &moved) || c.Request().Method != http.MethodGet {
+		return false, nil
+	}
+
+	location := *c.Request().URL
+	location.Path = path.Join(path.Dir(location.Path), strconv.Itoa(moved.Location))
+	return true, c.Redirect(http.StatusMovedPermanently, location.RequestURI())
+}
@@ -0,0 +1,177 @@
+package handler
+
+import (
+	"errors"
+	"strconv"
+
+	"goblog/internal/domain"
+	"goblog/internal/pkg/response"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+// WebhookHandler Webhook处理器
+type WebhookHandler struct {
+	webhookService domain.WebhookService
+	validator      *validator.Validate
+}
+
+// NewWebhookHandler 创建Webhook处理器
+func NewWebhookHandler(webhookService domain.WebhookService) *WebhookHandler {
+	return &WebhookHandler{
+		webhookService: webhookService,
+		validator:      validator.New(),
+	}
+}
+
+// Create 创建订阅，签名密钥只在响应中出现一次
+func (h *WebhookHandler) Create(c echo.Context) error {
+	username, _ := c.Get("username").(string)
+
+	var req domain.WebhookCreateRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "无效的请求参数")
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		return response.BadRequest(c, "请求参数验证失败")
+	}
+
+	webhook, err := h.webhookService.Create(c.Request().Context(), username, &req)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Created(c, webhook)
+}
+
+// GetByID 获取单个订阅
+func (h *WebhookHandler) GetByID(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return response.BadRequest(c, "无效的订阅ID")
+	}
+
+	webhook, err := h.webhookService.GetByID(c.Request().Context(), id)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, webhook)
+}
+
+// Update 更新订阅
+func (h *WebhookHandler) Update(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return response.BadRequest(c, "无效的订阅ID")
+	}
+
+	var req domain.WebhookUpdateRequest
+	if err := c.Bind(&req); err != nil {
+		return response.BadRequest(c, "无效的请求参数")
+	}
+
+	if err := h.validator.Struct(&req); err != nil {
+		return response.BadRequest(c, "请求参数验证失败")
+	}
+
+	webhook, err := h.webhookService.Update(c.Request().Context(), id, &req)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, webhook)
+}
+
+// Delete 删除订阅
+func (h *WebhookHandler) Delete(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return response.BadRequest(c, "无效的订阅ID")
+	}
+
+	if err := h.webhookService.Delete(c.Request().Context(), id); err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, map[string]string{"message": "订阅删除成功"})
+}
+
+// List 获取全部订阅
+func (h *WebhookHandler) List(c echo.Context) error {
+	webhooks, err := h.webhookService.List(c.Request().Context())
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Success(c, webhooks)
+}
+
+// ListDeliveries 获取订阅的投递日志，始终分页返回
+func (h *WebhookHandler) ListDeliveries(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return response.BadRequest(c, "无效的订阅ID")
+	}
+
+	params := domain.WebhookDeliveryQueryParams{
+		Page:   1,
+		Limit:  10,
+		Status: c.QueryParam("status"),
+		Event:  c.QueryParam("event"),
+	}
+
+	if page, err := strconv.Atoi(c.QueryParam("page")); err == nil && page > 0 {
+		params.Page = page
+	}
+
+	if limit, err := strconv.Atoi(c.QueryParam("limit")); err == nil && limit > 0 && limit <= 100 {
+		params.Limit = limit
+	}
+
+	deliveries, total, err := h.webhookService.ListDeliveries(c.Request().Context(), id, params)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	meta := response.PageMeta{
+		Page:      params.Page,
+		Limit:     params.Limit,
+		Total:     total,
+		TotalPage: int((total + int64(params.Limit) - 1) / int64(params.Limit)),
+	}
+	return response.SuccessPaged(c, deliveries, meta)
+}
+
+// Replay 重新投递一条记录
+func (h *WebhookHandler) Replay(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return response.BadRequest(c, "无效的订阅ID")
+	}
+
+	deliveryID, err := strconv.Atoi(c.Param("deliveryId"))
+	if err != nil {
+		return response.BadRequest(c, "无效的投递ID")
+	}
+
+	delivery, err := h.webhookService.Replay(c.Request().Context(), id, deliveryID)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return response.Created(c, delivery)
+}
+
+// handleError 处理错误
+func (h *WebhookHandler) handleError(c echo.Context, err error) error {
+	if errors.Is(err, domain.ErrNotFound) {
+		return response.NotFound(c, "订阅或投递记录不存在")
+	}
+	if errors.Is(err, domain.ErrInvalidInput) {
+		return response.BadRequest(c, "无效的回调地址、事件或状态")
+	}
+	return response.InternalServerError(c, "内部服务器错误")
+}
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"slices"
 	"strings"
 
 	"goblog/internal/domain"
@@ -9,14 +10,18 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
-// AuthMiddleware JWT认证中间件
+// AuthMiddleware 认证中间件，支持JWT和API密钥
 type AuthMiddleware struct {
-	authService domain.AuthService
+	authService   domain.AuthService
+	apiKeyService domain.APIKeyService
 }
 
 // NewAuthMiddleware 创建认证中间件
-func NewAuthMiddleware(authService domain.AuthService) *AuthMiddleware {
-	return &AuthMiddleware{authService: authService}
+func NewAuthMiddleware(authService domain.AuthService, apiKeyService domain.APIKeyService) *AuthMiddleware {
+	return &AuthMiddleware{
+		authService:   authService,
+		apiKeyService: apiKeyService,
+	}
 }
 
 // RequireAuth 需要认证的中间件
@@ -29,20 +34,114 @@ func (m *AuthMiddleware) RequireAuth() echo.MiddlewareFunc {
 				return response.Unauthorized(c, "缺少Authorization header")
 			}
 
-			// 检查Bearer前缀
+			var principal *domain.Principal
+			var err error
+
+			switch {
+			case strings.HasPrefix(authHeader, "Bearer "):
+				// 验证token
+				principal, err = m.authService.Authenticate(c.Request().Context(), authHeader)
+			case strings.HasPrefix(authHeader, "ApiKey "):
+				// 验证API密钥
+				principal, err = m.apiKeyService.Authenticate(c.Request().Context(), strings.TrimPrefix(authHeader, "ApiKey "))
+			default:
+				return response.Unauthorized(c, "无效的token格式")
+			}
+			if err != nil {
+				return response.Unauthorized(c, "token验证失败")
+			}
+
+			// 将用户信息存储到context中
+			c.Set("username", principal.Username)
+			c.Set("role", principal.Role)
+			c.Set("principal", principal)
+
+			// 将操作者写入请求上下文，供服务层记录审计日志
+			actor := &domain.Actor{
+				Username:  principal.Username,
+				Role:      principal.Role,
+				APIKeyID:  principal.APIKeyID,
+				IP:        c.RealIP(),
+				RequestID: requestID(c),
+			}
+			c.SetRequest(c.Request().WithContext(domain.WithActor(c.Request().Context(), actor)))
+			return next(c)
+		}
+	}
+}
+
+// RequireChallenge 允许使用绑定两步验证的挑战令牌访问，用于强制开启两步验证的用户完成绑定
+func (m *AuthMiddleware) RequireChallenge() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			authHeader := c.Request().Header.Get("Authorization")
 			if !strings.HasPrefix(authHeader, "Bearer ") {
 				return response.Unauthorized(c, "无效的token格式")
 			}
 
-			// 验证token
-			username, err := m.authService.ValidateToken(c.Request().Context(), authHeader)
+			username, err := m.authService.ValidateChallenge(c.Request().Context(), authHeader)
 			if err != nil {
 				return response.Unauthorized(c, "token验证失败")
 			}
 
-			// 将用户信息存储到context中
 			c.Set("username", username)
 			return next(c)
 		}
 	}
 }
+
+// RequireScope 要求API密钥拥有指定权限范围，登录会话不受限制，需在RequireAuth之后使用
+func (m *AuthMiddleware) RequireScope(scope string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			principal, ok := c.Get("principal").(*domain.Principal)
+			if !ok {
+				return response.Unauthorized(c, "未认证")
+			}
+			if !principal.HasScope(scope) {
+				return response.Forbidden(c, "API密钥缺少权限: "+scope)
+			}
+			return next(c)
+		}
+	}
+}
+
+// RequireSession 要求使用登录会话，拒绝API密钥，用于管理密钥、两步验证等账号安全操作
+func (m *AuthMiddleware) RequireSession() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			principal, ok := c.Get("principal").(*domain.Principal)
+			if !ok {
+				return response.Unauthorized(c, "未认证")
+			}
+			if principal.APIKeyID != 0 {
+				return response.Forbidden(c, "该操作不能使用API密钥")
+			}
+			return next(c)
+		}
+	}
+}
+
+// RequireRole 要求调用方拥有指定角色之一，需在RequireAuth之后使用
+func (m *AuthMiddleware) RequireRole(roles ...string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			principal, ok := c.Get("principal").(*domain.Principal)
+			if !ok {
+				return response.Unauthorized(c, "未认证")
+			}
+			if !slices.Contains(roles, principal.Role) {
+				return response.Forbidden(c, "权限不足")
+			}
+			return next(c)
+		}
+	}
+}
+
+// requestID 获取请求ID，优先使用RequestID中间件生成并写入响应头的值
+func requestID(c echo.Context) string {
+	if id := c.Response().Header().Get(echo.HeaderXRequestID); id != "" {
+		return id
+	}
+	return c.Request().Header.Get(echo.HeaderXRequestID)
+}
@@ -0,0 +1,20 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// CacheControl 为路由组的读请求设置Cache-Control，处理器可以自行覆盖；policy为空时不设置
+func CacheControl(policy string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			method := c.Request().Method
+			if policy != "" && (method == http.MethodGet || method == http.MethodHead) {
+				c.Response().Header().Set("Cache-Control", policy)
+			}
+			return next(c)
+		}
+	}
+}
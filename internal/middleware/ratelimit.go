@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"goblog/internal/config"
+	"goblog/internal/pkg/logger"
+	"goblog/internal/pkg/ratelimit"
+	"goblog/internal/pkg/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+// RateLimitMiddleware 令牌桶限流中间件
+type RateLimitMiddleware struct {
+	store ratelimit.Store
+}
+
+// NewRateLimitMiddleware 创建限流中间件
+func NewRateLimitMiddleware(store ratelimit.Store) *RateLimitMiddleware {
+	return &RateLimitMiddleware{store: store}
+}
+
+// Limit 按策略限流，已认证的请求按用户计数，匿名请求按IP计数
+func (m *RateLimitMiddleware) Limit(name string, policy config.RateLimitPolicy) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		// 未配置的策略不限流
+		if policy.Requests <= 0 || policy.Period <= 0 {
+			return next
+		}
+
+		bucketPolicy := ratelimit.Policy{
+			Rate:  float64(policy.Requests) / policy.Period.Seconds(),
+			Burst: max(policy.Burst, 1),
+		}
+
+		return func(c echo.Context) error {
+			key := name + ":ip:" + c.RealIP()
+			if username, ok := c.Get("username").(string); ok && username != "" {
+				key = name + ":user:" + username
+			}
+
+			result, err := m.store.Take(c.Request().Context(), key, bucketPolicy)
+			if err != nil {
+				// 计数存储不可用时放行，避免限流组件拖垮整个服务
+				logger.Warn("限流存储不可用", "policy", name, "error", err)
+				return next(c)
+			}
+
+			header := c.Response().Header()
+			header.Set("X-RateLimit-Limit", strconv.Itoa(result.Limit))
+			header.Set("X-RateLimit-Remaining", strconv.Itoa(result.Remaining))
+			header.Set("X-RateLimit-Reset", strconv.Itoa(ceilSeconds(result.ResetAfter)))
+
+			if !result.Allowed {
+				header.Set("Retry-After", strconv.Itoa(max(ceilSeconds(result.RetryAfter), 1)))
+				return response.TooManyRequests(c, "请求过于频繁，请稍后再试")
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// ceilSeconds 将时间间隔向上取整为秒
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
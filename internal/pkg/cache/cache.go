@@ -0,0 +1,246 @@
+package cache
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// generationPrefix 命名空间世代的key前缀
+const generationPrefix = "gen:"
+
+// Backend 缓存存储
+type Backend interface {
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	Delete(ctx context.Context, keys ...string) error
+}
+
+// Options 缓存选项
+type Options struct {
+	TTL time.Duration // 条目有效期
+	// LocalTTL 使用共享存储时进程内副本的有效期，其他实例写入后本实例最多在该时间内读到旧数据
+	LocalTTL time.Duration
+}
+
+// Stats 一个命名空间的命中统计
+type Stats struct {
+	Hits   int64 `json:"hits"`
+	Misses int64 `json:"misses"`
+	Shared int64 `json:"shared"` // 未命中时合并到其他请求正在进行的加载
+}
+
+// Snapshot 缓存统计快照
+type Snapshot struct {
+	Namespaces map[string]Stats `json:"namespaces"`
+	Entries    int              `json:"entries"`
+	Evictions  int64            `json:"evictions"`
+	Shared     bool             `json:"shared_backend"`
+}
+
+// Cache 读穿缓存，进程内LRU在前，可选的共享存储在后。
+// 值以JSON保存，调用方每次拿到独立的副本，修改返回值不会污染缓存
+type Cache struct {
+	local   *Memory
+	shared  Backend
+	options Options
+	flight  flightGroup
+	mu      sync.Mutex
+	stats   map[string]*Stats
+}
+
+// New 创建缓存，shared为nil时只使用进程内缓存
+func New(local *Memory, shared Backend, opts Options) *Cache {
+	if shared == nil || opts.LocalTTL <= 0 || opts.LocalTTL > opts.TTL {
+		opts.LocalTTL = opts.TTL
+	}
+	return &Cache{
+		local:   local,
+		shared:  shared,
+		options: opts,
+		stats:   make(map[string]*Stats),
+	}
+}
+
+// Load 读取key对应的缓存并解码到dst，未命中时调用load加载并写入缓存，同一key的并发未命中只加载一次。
+// namespace用于分类统计；缓存存储出错时退化为直接加载
+func (c *Cache) Load(ctx context.Context, namespace, key string, dst interface{}, load func(ctx context.Context) (interface{}, error)) error {
+	if data, ok := c.get(ctx, key); ok && json.Unmarshal(data, dst) == nil {
+		c.record(namespace, func(s *Stats) { s.Hits++ })
+		return nil
+	}
+
+	// 加载不受发起请求的客户端断开影响，合并进来的其他请求仍能拿到结果
+	loadCtx := context.WithoutCancel(ctx)
+	data, err, shared := c.flight.do(key, func() ([]byte, error) {
+		value, err := load(loadCtx)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(value)
+	}, func(data []byte) {
+		c.set(loadCtx, key, data)
+	})
+	c.record(namespace, func(s *Stats) {
+		s.Misses++
+		if shared {
+			s.Shared++
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, dst)
+}
+
+// Delete 删除条目，正在进行的同key加载结果不再写入缓存
+func (c *Cache) Delete(ctx context.Context, keys ...string) error {
+	c.flight.forget(keys...)
+	c.local.Delete(ctx, keys...)
+	if c.shared != nil {
+		return c.shared.Delete(ctx, keys...)
+	}
+	return nil
+}
+
+// Generation 返回命名空间的当前世代。列表等无法逐条失效的条目把世代作为key的一部分，
+// 世代变化后旧条目不再被读取，等待过期或被淘汰
+func (c *Cache) Generation(ctx context.Context, name string) string {
+	key := generationPrefix + name
+	if data, ok := c.get(ctx, key); ok {
+		return string(data)
+	}
+
+	gen := newGeneration()
+	c.set(ctx, key, []byte(gen))
+	return gen
+}
+
+// Bump 使命名空间进入新的世代
+func (c *Cache) Bump(ctx context.Context, names ...string) error {
+	keys := make([]string, len(names))
+	for i, name := range names {
+		keys[i] = generationPrefix + name
+	}
+	return c.Delete(ctx, keys...)
+}
+
+// Stats 返回统计快照
+func (c *Cache) Stats() Snapshot {
+	c.mu.Lock()
+	namespaces := make(map[string]Stats, len(c.stats))
+	for name, s := range c.stats {
+		namespaces[name] = *s
+	}
+	c.mu.Unlock()
+
+	return Snapshot{
+		Namespaces: namespaces,
+		Entries:    c.local.Len(),
+		Evictions:  c.local.Evictions(),
+		Shared:     c.shared != nil,
+	}
+}
+
+// get 依次读取进程内缓存和共享存储，共享存储命中时回填进程内缓存
+func (c *Cache) get(ctx context.Context, key string) ([]byte, bool) {
+	if data, ok, _ := c.local.Get(ctx, key); ok {
+		return data, true
+	}
+	if c.shared == nil {
+		return nil, false
+	}
+
+	data, ok, err := c.shared.Get(ctx, key)
+	if err != nil || !ok {
+		return nil, false
+	}
+	c.local.Set(ctx, key, data, c.options.LocalTTL)
+	return data, true
+}
+
+// set 写入进程内缓存和共享存储，写入失败只影响命中率
+func (c *Cache) set(ctx context.Context, key string, data []byte) {
+	c.local.Set(ctx, key, data, c.options.LocalTTL)
+	if c.shared != nil {
+		c.shared.Set(ctx, key, data, c.options.TTL)
+	}
+}
+
+func (c *Cache) record(namespace string, fn func(s *Stats)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s, ok := c.stats[namespace]
+	if !ok {
+		s = &Stats{}
+		c.stats[namespace] = s
+	}
+	fn(s)
+}
+
+func newGeneration() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// flightGroup 合并同一key的并发加载
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flightCall
+}
+
+type flightCall struct {
+	wg   sync.WaitGroup
+	data []byte
+	err  error
+}
+
+// do 执行fn，同一key已有加载在进行时等待其结果，shared表示结果来自其他调用。
+// 加载期间key没有被forget时调用store写入结果，避免把失效前读到的旧数据写回缓存
+func (g *flightGroup) do(key string, fn func() ([]byte, error), store func(data []byte)) (data []byte, err error, shared bool) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*flightCall)
+	}
+	if call, ok := g.calls[key]; ok {
+		g.mu.Unlock()
+		call.wg.Wait()
+		return call.data, call.err, true
+	}
+	call := &flightCall{}
+	call.wg.Add(1)
+	g.calls[key] = call
+	g.mu.Unlock()
+
+	call.data, call.err = fn()
+
+	g.mu.Lock()
+	current := g.calls[key] == call
+	if current {
+		delete(g.calls, key)
+	}
+	g.mu.Unlock()
+
+	if current && call.err == nil {
+		store(call.data)
+	}
+	call.wg.Done()
+
+	return call.data, call.err, false
+}
+
+// forget 放弃正在进行的加载结果，之后的未命中重新加载
+func (g *flightGroup) forget(keys ...string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, key := range keys {
+		delete(g.calls, key)
+	}
+}
@@ -0,0 +1,90 @@
+package cache
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const (
+	// postgresSweepInterval 清理过期条目的间隔
+	postgresSweepInterval = 10 * time.Minute
+)
+
+// Postgres 基于PostgreSQL UNLOGGED表的共享缓存，多实例部署时共享缓存内容和失效
+type Postgres struct {
+	db        *sql.DB
+	mu        sync.Mutex
+	lastSweep time.Time
+}
+
+// NewPostgres 创建PostgreSQL共享缓存，并确保数据表存在。
+// 缓存表不写WAL，数据库崩溃后清空，不影响正确性
+func NewPostgres(ctx context.Context, db *sql.DB) (*Postgres, error) {
+	_, err := db.ExecContext(ctx, `
+CREATE UNLOGGED TABLE IF NOT EXISTS cache_entries (
+	key        TEXT PRIMARY KEY,
+	value      BYTEA NOT NULL,
+	expires_at TIMESTAMPTZ NOT NULL
+)`)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Postgres{db: db, lastSweep: time.Now()}, nil
+}
+
+// Get 获取未过期的条目
+func (p *Postgres) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	p.sweep()
+
+	var value []byte
+	err := p.db.QueryRowContext(ctx, `SELECT value FROM cache_entries WHERE key = $1 AND expires_at > now()`, key).Scan(&value)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+// Set 写入条目
+func (p *Postgres) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	_, err := p.db.ExecContext(ctx, `
+INSERT INTO cache_entries (key, value, expires_at)
+VALUES ($1, $2, now() + $3 * interval '1 millisecond')
+ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, expires_at = EXCLUDED.expires_at`,
+		key, value, ttl.Milliseconds())
+	return err
+}
+
+// Delete 删除条目
+func (p *Postgres) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	_, err := p.db.ExecContext(ctx, `DELETE FROM cache_entries WHERE key = ANY($1)`, pq.Array(keys))
+	return err
+}
+
+// sweep 定期在后台删除过期条目
+func (p *Postgres) sweep() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if time.Since(p.lastSweep) < postgresSweepInterval {
+		return
+	}
+	p.lastSweep = time.Now()
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		p.db.ExecContext(ctx, `DELETE FROM cache_entries WHERE expires_at <= now()`)
+	}()
+}
@@ -0,0 +1,67 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// redisKeyPrefix 缓存条目在Redis中的键前缀，与其他应用共用Redis时避免冲突
+const redisKeyPrefix = "goblog:cache:"
+
+// Redis 基于Redis的共享缓存，多实例部署时共享缓存内容和失效，过期由Redis处理
+type Redis struct {
+	client *redis.Client
+}
+
+// NewRedis 根据连接地址（如 redis://:password@localhost:6379/0）创建Redis共享缓存，并检查连接
+func NewRedis(ctx context.Context, url string) (*Redis, error) {
+	opts, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, err
+	}
+
+	client := redis.NewClient(opts)
+	if err := client.Ping(ctx).Err(); err != nil {
+		client.Close()
+		return nil, err
+	}
+
+	return &Redis{client: client}, nil
+}
+
+// Get 获取未过期的条目
+func (r *Redis) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	value, err := r.client.Get(ctx, redisKeyPrefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+// Set 写入条目
+func (r *Redis) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return r.client.Set(ctx, redisKeyPrefix+key, value, ttl).Err()
+}
+
+// Delete 删除条目
+func (r *Redis) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	prefixed := make([]string, len(keys))
+	for i, key := range keys {
+		prefixed[i] = redisKeyPrefix + key
+	}
+	return r.client.Del(ctx, prefixed...).Err()
+}
+
+// Close 关闭连接池
+func (r *Redis) Close() error {
+	return r.client.Close()
+}
@@ -0,0 +1,114 @@
+package httpcache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Version 参与ETag计算的资源版本
+type Version struct {
+	ID        int
+	UpdatedAt time.Time
+}
+
+// StrongETag 计算单个资源的强ETag。第一个版本为资源本身，其余为响应中内嵌的关联资源，
+// 关联资源修改后表示形式也随之变化
+func StrongETag(kind string, versions ...Version) string {
+	return `"` + digest(kind, versions) + `"`
+}
+
+// WeakETag 计算列表的弱ETag，total参与计算以便其他页的增删也能使缓存失效
+func WeakETag(kind string, total int64, versions ...Version) string {
+	return `W/"` + digest(kind+":"+strconv.FormatInt(total, 10), versions) + `"`
+}
+
+// LastModified 返回最近的更新时间
+func LastModified(versions ...Version) time.Time {
+	var latest time.Time
+	for _, v := range versions {
+		if v.UpdatedAt.After(latest) {
+			latest = v.UpdatedAt
+		}
+	}
+	return latest
+}
+
+// NotModified 设置ETag和Last-Modified响应头，并根据If-None-Match或If-Modified-Since判断客户端缓存是否仍然有效。
+// 同时存在时只使用If-None-Match；lastModified为零值时不设置Last-Modified
+func NotModified(c echo.Context, etag string, lastModified time.Time) bool {
+	header := c.Response().Header()
+	header.Set("ETag", etag)
+	if !lastModified.IsZero() {
+		header.Set("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+	}
+
+	req := c.Request()
+	if inm := req.Header.Get("If-None-Match"); inm != "" {
+		return matchAny(inm, etag, false)
+	}
+	if ims := req.Header.Get("If-Modified-Since"); ims != "" && !lastModified.IsZero() {
+		since, err := http.ParseTime(ims)
+		if err != nil {
+			return false
+		}
+		return !lastModified.Truncate(time.Second).After(since)
+	}
+	return false
+}
+
+// HasIfMatch 请求是否带有If-Match条件
+func HasIfMatch(c echo.Context) bool {
+	return c.Request().Header.Get("If-Match") != ""
+}
+
+// IfMatch 判断If-Match条件是否满足，按强比较，弱ETag永不匹配；没有该请求头时视为满足
+func IfMatch(c echo.Context, etag string) bool {
+	im := c.Request().Header.Get("If-Match")
+	if im == "" {
+		return true
+	}
+	return matchAny(im, etag, true)
+}
+
+// SetETag 设置ETag响应头，写操作成功后返回新的ETag供下一次If-Match使用
+func SetETag(c echo.Context, etag string) {
+	c.Response().Header().Set("ETag", etag)
+}
+
+// matchAny 判断逗号分隔的ETag列表中是否有与etag匹配的项
+func matchAny(list, etag string, strong bool) bool {
+	if strong && strings.HasPrefix(etag, "W/") {
+		return false
+	}
+	for _, candidate := range strings.Split(list, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" {
+			return true
+		}
+		if strong {
+			if candidate == etag {
+				return true
+			}
+			continue
+		}
+		if strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+func digest(kind string, versions []Version) string {
+	h := sha256.New()
+	h.Write([]byte(kind))
+	for _, v := range versions {
+		h.Write([]byte("|" + strconv.Itoa(v.ID) + ":" + strconv.FormatInt(v.UpdatedAt.UnixNano(), 10)))
+	}
+	return hex.EncodeToString(h.Sum(nil)[:16])
+}
@@ -0,0 +1,240 @@
+package jwtkeys
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"goblog/internal/pkg/jwk"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// minRSABits RSA密钥的最小长度
+const minRSABits = 2048
+
+// Key 签名密钥，只有公钥的密钥只用于验证已签发的令牌
+type Key struct {
+	ID        string
+	Method    jwt.SigningMethod
+	signKey   interface{}
+	verifyKey interface{}
+}
+
+// CanSign 是否包含私钥
+func (k *Key) CanSign() bool {
+	return k.signKey != nil
+}
+
+// KeySet 令牌签名密钥集合，使用当前密钥签名，集合中的所有密钥都可用于验证
+type KeySet struct {
+	active *Key
+	keys   map[string]*Key
+	order  []string
+}
+
+// NewHMAC 创建使用共享密钥HS256签名的密钥集合
+func NewHMAC(secret string) *KeySet {
+	key := &Key{
+		Method:    jwt.SigningMethodHS256,
+		signKey:   []byte(secret),
+		verifyKey: []byte(secret),
+	}
+	return &KeySet{
+		active: key,
+		keys:   map[string]*Key{"": key},
+		order:  []string{""},
+	}
+}
+
+// LoadFiles 从PEM文件加载密钥，specs中每一项的格式为 kid=path
+// activeID为空时使用第一个包含私钥的密钥签名；轮换时把新密钥设为当前密钥，旧密钥保留到其签发的令牌全部过期
+func LoadFiles(specs []string, activeID string) (*KeySet, error) {
+	keys := make([]*Key, 0, len(specs))
+	for _, spec := range specs {
+		kid, path, ok := strings.Cut(spec, "=")
+		if !ok || kid == "" || path == "" {
+			return nil, fmt.Errorf("jwtkeys: invalid key spec %q, expected kid=path", spec)
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("jwtkeys: read key %q: %w", kid, err)
+		}
+
+		key, err := ParsePEM(kid, data)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	return New(keys, activeID)
+}
+
+// New 创建密钥集合
+func New(keys []*Key, activeID string) (*KeySet, error) {
+	set := &KeySet{keys: make(map[string]*Key, len(keys))}
+
+	for _, key := range keys {
+		if _, exists := set.keys[key.ID]; exists {
+			return nil, fmt.Errorf("jwtkeys: duplicate key id %q", key.ID)
+		}
+		set.keys[key.ID] = key
+		set.order = append(set.order, key.ID)
+
+		if set.active == nil && activeID == "" && key.CanSign() {
+			set.active = key
+		}
+	}
+
+	if activeID != "" {
+		set.active = set.keys[activeID]
+		if set.active == nil {
+			return nil, fmt.Errorf("jwtkeys: active key %q not found", activeID)
+		}
+	}
+	if set.active == nil || !set.active.CanSign() {
+		return nil, errors.New("jwtkeys: no private key available for signing")
+	}
+
+	return set, nil
+}
+
+// ParsePEM 解析PEM格式的私钥或公钥，根据密钥类型确定签名算法
+func ParsePEM(kid string, data []byte) (*Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("jwtkeys: key %q is not PEM encoded", kid)
+	}
+
+	var parsed interface{}
+	var err error
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		parsed, err = x509.ParseECPrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		parsed, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("jwtkeys: key %q has unsupported PEM type %q", kid, block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("jwtkeys: parse key %q: %w", kid, err)
+	}
+
+	key := &Key{ID: kid}
+	if signer, ok := parsed.(crypto.Signer); ok {
+		key.signKey = parsed
+		parsed = signer.Public()
+	}
+	key.verifyKey = parsed
+
+	switch pub := parsed.(type) {
+	case *rsa.PublicKey:
+		if pub.N.BitLen() < minRSABits {
+			return nil, fmt.Errorf("jwtkeys: RSA key %q must be at least %d bits", kid, minRSABits)
+		}
+		key.Method = jwt.SigningMethodRS256
+	case ed25519.PublicKey:
+		key.Method = jwt.SigningMethodEdDSA
+	case *ecdsa.PublicKey:
+		switch pub.Curve {
+		case elliptic.P256():
+			key.Method = jwt.SigningMethodES256
+		case elliptic.P384():
+			key.Method = jwt.SigningMethodES384
+		default:
+			return nil, fmt.Errorf("jwtkeys: key %q uses unsupported curve", kid)
+		}
+	default:
+		return nil, fmt.Errorf("jwtkeys: key %q has unsupported type %T", kid, parsed)
+	}
+
+	return key, nil
+}
+
+// Active 当前签名密钥
+func (s *KeySet) Active() *Key {
+	return s.active
+}
+
+// Sign 使用当前密钥签名，非共享密钥时在头部写入kid
+func (s *KeySet) Sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(s.active.Method, claims)
+	if s.active.ID != "" {
+		token.Header["kid"] = s.active.ID
+	}
+	return token.SignedString(s.active.signKey)
+}
+
+// Parse 按kid选择密钥验证令牌并解析声明
+func (s *KeySet) Parse(tokenString string, claims jwt.Claims) error {
+	token, err := jwt.ParseWithClaims(tokenString, claims, s.keyfunc, jwt.WithValidMethods(s.methods()))
+	if err != nil {
+		return err
+	}
+	if !token.Valid {
+		return errors.New("jwtkeys: invalid token")
+	}
+	return nil
+}
+
+// keyfunc 根据kid查找验证密钥，令牌声明的算法必须与密钥一致，防止算法混淆
+func (s *KeySet) keyfunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	key, ok := s.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("jwtkeys: unknown key id %q", kid)
+	}
+	if token.Method.Alg() != key.Method.Alg() {
+		return nil, fmt.Errorf("jwtkeys: algorithm %q does not match key %q", token.Method.Alg(), kid)
+	}
+
+	return key.verifyKey, nil
+}
+
+// methods 集合中密钥使用的签名算法
+func (s *KeySet) methods() []string {
+	var methods []string
+	for _, id := range s.order {
+		alg := s.keys[id].Method.Alg()
+		if !slices.Contains(methods, alg) {
+			methods = append(methods, alg)
+		}
+	}
+	return methods
+}
+
+// JWKS 导出全部非对称密钥的公钥，共享密钥不会导出
+func (s *KeySet) JWKS() (jwk.Set, error) {
+	set := jwk.Set{Keys: []jwk.Key{}}
+	for _, id := range s.order {
+		key := s.keys[id]
+		if _, symmetric := key.verifyKey.([]byte); symmetric {
+			continue
+		}
+
+		publicKey, err := jwk.New(key.ID, key.Method.Alg(), key.verifyKey)
+		if err != nil {
+			return jwk.Set{}, err
+		}
+		set.Keys = append(set.Keys, publicKey)
+	}
+	return set, nil
+}
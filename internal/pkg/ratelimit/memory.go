@@ -0,0 +1,79 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// memorySweepInterval 清理空闲令牌桶的间隔
+const memorySweepInterval = time.Minute
+
+// bucket 令牌桶状态
+type bucket struct {
+	tokens    float64
+	updatedAt time.Time
+	policy    Policy
+}
+
+// MemoryStore 进程内令牌桶存储，仅适用于单实例部署
+type MemoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+// NewMemoryStore 创建进程内令牌桶存储
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		buckets:   make(map[string]*bucket),
+		lastSweep: time.Now(),
+	}
+}
+
+// Take 从指定令牌桶中取一个令牌
+func (s *MemoryStore) Take(ctx context.Context, key string, policy Policy) (*Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.sweep(now)
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(policy.Burst), updatedAt: now}
+		s.buckets[key] = b
+	}
+
+	// 按经过的时间补充令牌
+	elapsed := now.Sub(b.updatedAt).Seconds()
+	b.tokens = math.Min(float64(policy.Burst), b.tokens+elapsed*policy.Rate)
+	b.updatedAt = now
+	b.policy = policy
+
+	allowed := b.tokens >= 1
+	if allowed {
+		b.tokens--
+	}
+
+	return newResult(allowed, b.tokens, policy), nil
+}
+
+// sweep 定期删除已经装满的令牌桶，避免内存无限增长
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < memorySweepInterval {
+		return
+	}
+	s.lastSweep = now
+
+	for key, b := range s.buckets {
+		if b.policy.Rate <= 0 {
+			continue
+		}
+		elapsed := now.Sub(b.updatedAt).Seconds()
+		if b.tokens+elapsed*b.policy.Rate >= float64(b.policy.Burst) {
+			delete(s.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package ratelimit
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+)
+
+const (
+	// postgresSweepInterval 清理空闲令牌桶的间隔
+	postgresSweepInterval = 10 * time.Minute
+	// postgresIdleTTL 令牌桶空闲多久后可以删除
+	postgresIdleTTL = time.Hour
+)
+
+// takeQuery 在单条语句中完成补充令牌和取令牌，保证多实例并发下的原子性
+const takeQuery = `
+INSERT INTO rate_limit_buckets AS b (key, tokens, allowed, updated_at)
+VALUES ($1, $2::double precision - 1, TRUE, EXTRACT(EPOCH FROM now()))
+ON CONFLICT (key) DO UPDATE SET
+	tokens = CASE
+		WHEN LEAST($2::double precision, b.tokens + (EXTRACT(EPOCH FROM now()) - b.updated_at) * $3::double precision) >= 1
+		THEN LEAST($2::double precision, b.tokens + (EXTRACT(EPOCH FROM now()) - b.updated_at) * $3::double precision) - 1
+		ELSE LEAST($2::double precision, b.tokens + (EXTRACT(EPOCH FROM now()) - b.updated_at) * $3::double precision)
+	END,
+	allowed = LEAST($2::double precision, b.tokens + (EXTRACT(EPOCH FROM now()) - b.updated_at) * $3::double precision) >= 1,
+	updated_at = EXTRACT(EPOCH FROM now())
+RETURNING tokens, allowed`
+
+// PostgresStore 基于PostgreSQL的共享令牌桶存储，适用于多实例部署
+type PostgresStore struct {
+	db        *sql.DB
+	mu        sync.Mutex
+	lastSweep time.Time
+}
+
+// NewPostgresStore 创建PostgreSQL令牌桶存储，并确保数据表存在
+func NewPostgresStore(ctx context.Context, db *sql.DB) (*PostgresStore, error) {
+	_, err := db.ExecContext(ctx, `
+CREATE TABLE IF NOT EXISTS rate_limit_buckets (
+	key        TEXT PRIMARY KEY,
+	tokens     DOUBLE PRECISION NOT NULL,
+	allowed    BOOLEAN NOT NULL,
+	updated_at DOUBLE PRECISION NOT NULL
+)`)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PostgresStore{db: db, lastSweep: time.Now()}, nil
+}
+
+// Take 从指定令牌桶中取一个令牌
+func (s *PostgresStore) Take(ctx context.Context, key string, policy Policy) (*Result, error) {
+	s.sweep()
+
+	var tokens float64
+	var allowed bool
+	err := s.db.QueryRowContext(ctx, takeQuery, key, policy.Burst, policy.Rate).Scan(&tokens, &allowed)
+	if err != nil {
+		return nil, err
+	}
+
+	return newResult(allowed, tokens, policy), nil
+}
+
+// sweep 定期在后台删除长时间未访问的令牌桶
+func (s *PostgresStore) sweep() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if time.Since(s.lastSweep) < postgresSweepInterval {
+		return
+	}
+	s.lastSweep = time.Now()
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		s.db.ExecContext(ctx, `DELETE FROM rate_limit_buckets WHERE updated_at < EXTRACT(EPOCH FROM now()) - $1`, postgresIdleTTL.Seconds())
+	}()
+}
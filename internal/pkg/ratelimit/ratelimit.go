@@ -0,0 +1,55 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// Policy 令牌桶策略，Rate为每秒补充的令牌数，Burst为桶容量
+type Policy struct {
+	Rate  float64
+	Burst int
+}
+
+// Result 一次取令牌的结果
+type Result struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	RetryAfter time.Duration // 距离下一个令牌可用的时间，仅在被拒绝时有意义
+	ResetAfter time.Duration // 距离令牌桶重新装满的时间
+}
+
+// Store 令牌桶计数存储，多实例部署时需要使用共享存储
+type Store interface {
+	Take(ctx context.Context, key string, policy Policy) (*Result, error)
+}
+
+// newResult 根据取令牌后的剩余量计算结果
+func newResult(allowed bool, tokens float64, policy Policy) *Result {
+	result := &Result{
+		Allowed:   allowed,
+		Limit:     policy.Burst,
+		Remaining: int(math.Max(math.Floor(tokens), 0)),
+	}
+
+	if policy.Rate <= 0 {
+		return result
+	}
+
+	if !allowed {
+		result.RetryAfter = secondsToDuration((1 - tokens) / policy.Rate)
+	}
+	result.ResetAfter = secondsToDuration((float64(policy.Burst) - tokens) / policy.Rate)
+
+	return result
+}
+
+// secondsToDuration 将秒数转换为时间间隔
+func secondsToDuration(seconds float64) time.Duration {
+	if seconds <= 0 {
+		return 0
+	}
+	return time.Duration(seconds * float64(time.Second))
+}
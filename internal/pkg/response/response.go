@@ -23,10 +23,29 @@ type PagedResponse struct {
 
 // PageMeta 分页元信息
 type PageMeta struct {
-	Page      int   `json:"page"`
-	Limit     int   `json:"limit"`
-	Total     int64 `json:"total"`
-	TotalPage int   `json:"total_page"`
+	Page       int         `json:"page"`
+	Limit      int         `json:"limit"`
+	Total      int64       `json:"total"`
+	TotalPage  int         `json:"total_page"`
+	NextCursor string      `json:"next_cursor,omitempty"` // 支持游标分页的列表可以从当前页切换到游标分页
+	PrevCursor string      `json:"prev_cursor,omitempty"`
+	Facets     interface{} `json:"facets,omitempty"` // 支持分面的列表按请求返回分面统计
+}
+
+// CursorResponse 游标分页响应格式
+type CursorResponse struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+	Meta    CursorMeta  `json:"meta"`
+}
+
+// CursorMeta 游标分页元信息，某个方向没有更多数据时对应的游标为空
+type CursorMeta struct {
+	Limit      int         `json:"limit"`
+	NextCursor string      `json:"next_cursor,omitempty"`
+	PrevCursor string      `json:"prev_cursor,omitempty"`
+	Facets     interface{} `json:"facets,omitempty"`
 }
 
 // Success 成功响应
@@ -75,6 +94,30 @@ func NotFound(c echo.Context, message string) error {
 	return Error(c, http.StatusNotFound, message)
 }
 
+// Conflict 409错误
+func Conflict(c echo.Context, message string) error {
+	return Error(c, http.StatusConflict, message)
+}
+
+// ConflictWithData 409错误，附带服务端当前的资源
+func ConflictWithData(c echo.Context, message string, data interface{}) error {
+	return c.JSON(http.StatusConflict, Response{
+		Code:    http.StatusConflict,
+		Message: message,
+		Data:    data,
+	})
+}
+
+// PreconditionFailed 412错误
+func PreconditionFailed(c echo.Context, message string) error {
+	return Error(c, http.StatusPreconditionFailed, message)
+}
+
+// TooManyRequests 429错误
+func TooManyRequests(c echo.Context, message string) error {
+	return Error(c, http.StatusTooManyRequests, message)
+}
+
 // InternalServerError 500错误
 func InternalServerError(c echo.Context, message string) error {
 	return Error(c, http.StatusInternalServerError, message)
@@ -89,3 +132,13 @@ func SuccessPaged(c echo.Context, data interface{}, meta PageMeta) error {
 		Meta:    meta,
 	})
 }
+
+// SuccessCursor 游标分页成功响应
+func SuccessCursor(c echo.Context, data interface{}, meta CursorMeta) error {
+	return c.JSON(http.StatusOK, CursorResponse{
+		Code:    http.StatusOK,
+		Message: "success",
+		Data:    data,
+		Meta:    meta,
+	})
+}
@@ -0,0 +1,96 @@
+package sse
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const (
+	// postgresChannel 实例之间转发事件的通知通道
+	postgresChannel = "goblog_stream"
+	// postgresMaxPayload NOTIFY负载的长度上限，PostgreSQL默认为8000字节
+	postgresMaxPayload = 7999
+	// postgresPingInterval 空闲时检查监听连接的间隔，连接断开后自动重连
+	postgresPingInterval = time.Minute
+)
+
+// notification 实例之间转发的事件，Instance 用于忽略本实例发出的通知
+type notification struct {
+	Instance string          `json:"instance"`
+	Name     string          `json:"name"`
+	Data     json.RawMessage `json:"data"`
+}
+
+// PostgresPublisher 推送给本实例的连接，并通过PostgreSQL LISTEN/NOTIFY转发给其他实例。
+// 通知不持久化，监听连接断开期间其他实例的事件会丢失
+type PostgresPublisher struct {
+	db       *sql.DB
+	dsn      string
+	broker   *Broker
+	instance string
+}
+
+// NewPostgresPublisher 创建多实例事件发布器，dsn用于建立单独的监听连接
+func NewPostgresPublisher(db *sql.DB, dsn string, broker *Broker) (*PostgresPublisher, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	return &PostgresPublisher{db: db, dsn: dsn, broker: broker, instance: hex.EncodeToString(b)}, nil
+}
+
+// Publish 推送给本实例的连接，再通知其他实例
+func (p *PostgresPublisher) Publish(ctx context.Context, name string, data []byte) error {
+	p.broker.Publish(name, data)
+
+	payload, err := json.Marshal(notification{Instance: p.instance, Name: name, Data: data})
+	if err != nil {
+		return err
+	}
+	if len(payload) > postgresMaxPayload {
+		return fmt.Errorf("事件 %s 超过通知长度上限: %d 字节", name, len(payload))
+	}
+
+	_, err = p.db.ExecContext(ctx, `SELECT pg_notify($1, $2)`, postgresChannel, string(payload))
+	return err
+}
+
+// Listen 监听其他实例发出的事件并推送给本实例的连接，直到ctx取消
+func (p *PostgresPublisher) Listen(ctx context.Context) error {
+	listener := pq.NewListener(p.dsn, time.Second, time.Minute, nil)
+	defer listener.Close()
+
+	if err := listener.Listen(postgresChannel); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(postgresPingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			// 检查失败时监听器会自动重连
+			_ = listener.Ping()
+		case n := <-listener.Notify:
+			// 重连后收到nil
+			if n == nil {
+				continue
+			}
+			var msg notification
+			if err := json.Unmarshal([]byte(n.Extra), &msg); err != nil || msg.Instance == p.instance {
+				continue
+			}
+			p.broker.Publish(msg.Name, msg.Data)
+		}
+	}
+}
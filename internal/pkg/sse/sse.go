@@ -0,0 +1,206 @@
+package sse
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// subscriberBuffer 每个订阅者的待发送队列长度，队列写满说明客户端过慢，直接断开由其携带Last-Event-ID重连
+const subscriberBuffer = 64
+
+// Event 推送给客户端的事件
+type Event struct {
+	ID   string
+	Name string
+	Data []byte
+}
+
+// Broker 事件分发器，在内存环形缓冲区中保留最近的事件供断线重连的客户端补发。
+// 事件ID由进程启动时生成的纪元和递增序号组成，服务重启后旧ID无法续传
+type Broker struct {
+	mu          sync.Mutex
+	epoch       string
+	seq         uint64
+	buffer      []Event
+	start       int // 缓冲区中最早事件的位置
+	count       int
+	subscribers map[*Subscription]struct{}
+	closed      bool
+}
+
+// Publisher 推送事件，多实例部署时由实现负责把事件转发给其他实例的Broker
+type Publisher interface {
+	Publish(ctx context.Context, name string, data []byte) error
+}
+
+// localPublisher 只推送给本实例的连接
+type localPublisher struct {
+	broker *Broker
+}
+
+// NewLocalPublisher 创建只推送给本实例连接的发布器，用于单实例部署
+func NewLocalPublisher(broker *Broker) Publisher {
+	return &localPublisher{broker: broker}
+}
+
+// Publish 推送给本实例的连接
+func (p *localPublisher) Publish(_ context.Context, name string, data []byte) error {
+	p.broker.Publish(name, data)
+	return nil
+}
+
+// Subscription 一个客户端连接的订阅
+type Subscription struct {
+	broker *Broker
+	events chan Event
+	once   sync.Once
+}
+
+// NewBroker 创建事件分发器，size为缓冲区保留的事件数量
+func NewBroker(size int) *Broker {
+	if size < 1 {
+		size = 1
+	}
+	return &Broker{
+		epoch:       strconv.FormatInt(time.Now().UnixNano(), 36),
+		buffer:      make([]Event, size),
+		subscribers: make(map[*Subscription]struct{}),
+	}
+}
+
+// Publish 分配事件ID，写入缓冲区并推送给全部订阅者
+func (b *Broker) Publish(name string, data []byte) Event {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.seq++
+	event := Event{ID: b.epoch + "-" + strconv.FormatUint(b.seq, 10), Name: name, Data: data}
+	if b.closed {
+		return event
+	}
+
+	if b.count < len(b.buffer) {
+		b.buffer[(b.start+b.count)%len(b.buffer)] = event
+		b.count++
+	} else {
+		b.buffer[b.start] = event
+		b.start = (b.start + 1) % len(b.buffer)
+	}
+
+	for sub := range b.subscribers {
+		select {
+		case sub.events <- event:
+		default:
+			b.remove(sub)
+		}
+	}
+	return event
+}
+
+// Subscribe 订阅之后的事件。lastEventID不为空时补发缓冲区中该ID之后的事件；
+// 无法续传（ID来自旧进程或已被挤出缓冲区）时resumed为false，客户端应重新拉取完整数据
+func (b *Broker) Subscribe(lastEventID string) (sub *Subscription, backlog []Event, resumed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	sub = &Subscription{broker: b, events: make(chan Event, subscriberBuffer)}
+	if b.closed {
+		sub.once.Do(func() { close(sub.events) })
+		return sub, nil, lastEventID == ""
+	}
+	b.subscribers[sub] = struct{}{}
+
+	if lastEventID == "" {
+		return sub, nil, true
+	}
+
+	last, ok := b.parseID(lastEventID)
+	if !ok {
+		return sub, nil, false
+	}
+	oldest := b.seq - uint64(b.count) + 1
+	if last+1 < oldest || last > b.seq {
+		return sub, nil, false
+	}
+
+	for i := last + 1 - oldest; i < uint64(b.count); i++ {
+		backlog = append(backlog, b.buffer[(b.start+int(i))%len(b.buffer)])
+	}
+	return sub, backlog, true
+}
+
+// Close 关闭分发器并结束全部订阅，服务器关闭时调用以便长连接及时退出
+func (b *Broker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	for sub := range b.subscribers {
+		b.remove(sub)
+	}
+}
+
+// remove 移除订阅并关闭其事件通道，调用方需持有锁
+func (b *Broker) remove(sub *Subscription) {
+	delete(b.subscribers, sub)
+	sub.once.Do(func() { close(sub.events) })
+}
+
+// parseID 解析本进程签发的事件ID
+func (b *Broker) parseID(id string) (uint64, bool) {
+	epoch, seq, ok := strings.Cut(id, "-")
+	if !ok || epoch != b.epoch {
+		return 0, false
+	}
+	n, err := strconv.ParseUint(seq, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
+// Events 返回事件通道，分发器关闭或客户端过慢被断开时通道关闭
+func (s *Subscription) Events() <-chan Event {
+	return s.events
+}
+
+// Close 取消订阅
+func (s *Subscription) Close() {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+	s.broker.remove(s)
+}
+
+// Write 按text/event-stream格式写出事件，多行数据拆分为多个data字段
+func Write(w io.Writer, event Event) error {
+	var sb strings.Builder
+	if event.ID != "" {
+		fmt.Fprintf(&sb, "id: %s\n", event.ID)
+	}
+	if event.Name != "" {
+		fmt.Fprintf(&sb, "event: %s\n", event.Name)
+	}
+	for _, line := range strings.Split(string(event.Data), "\n") {
+		fmt.Fprintf(&sb, "data: %s\n", line)
+	}
+	sb.WriteString("\n")
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// WriteComment 写出注释行，用作心跳防止代理断开空闲连接
+func WriteComment(w io.Writer, text string) error {
+	_, err := fmt.Fprintf(w, ": %s\n\n", text)
+	return err
+}
+
+// WriteRetry 设置客户端断线后的重连间隔
+func WriteRetry(w io.Writer, retry time.Duration) error {
+	_, err := fmt.Fprintf(w, "retry: %d\n\n", retry.Milliseconds())
+	return err
+}
@@ -0,0 +1,119 @@
+package textstats
+
+import (
+	"math"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// 阅读速度：中日文按字计，其余按词计
+const (
+	cjkCharsPerMinute = 400
+	wordsPerMinute    = 200
+)
+
+// ExcerptLength 自动摘要的最大字符数
+const ExcerptLength = 200
+
+// Stats 正文统计
+type Stats struct {
+	WordCount      int // 中日文每个字计为一个词
+	ReadingMinutes int // 有内容时至少为1
+}
+
+var (
+	fencedCode  = regexp.MustCompile("(?ms)^\\s*(```|~~~).*?^\\s*(```|~~~)\\s*$")
+	htmlTag     = regexp.MustCompile(`<[^>]*>`)
+	image       = regexp.MustCompile(`!\[([^\]]*)\]\([^)]*\)`)
+	link        = regexp.MustCompile(`\[([^\]]*)\]\([^)]*\)`)
+	lineMarker  = regexp.MustCompile(`(?m)^\s{0,3}(#{1,6}\s+|>\s?|[-*+]\s+|\d+[.)]\s+)`)
+	rule        = regexp.MustCompile(`(?m)^\s{0,3}([-*_]\s*){3,}$`)
+	inlineMarks = regexp.MustCompile("(\\*{1,3}|~~|`+|__)")
+	whitespace  = regexp.MustCompile(`\s+`)
+)
+
+// PlainText 将Markdown正文渲染为纯文本：去掉代码块、HTML标签、图片和格式标记，链接只保留文字
+func PlainText(content string) string {
+	text := fencedCode.ReplaceAllString(content, " ")
+	text = htmlTag.ReplaceAllString(text, " ")
+	text = image.ReplaceAllString(text, "$1")
+	text = link.ReplaceAllString(text, "$1")
+	text = rule.ReplaceAllString(text, " ")
+	text = lineMarker.ReplaceAllString(text, "")
+	text = inlineMarks.ReplaceAllString(text, "")
+	return strings.TrimSpace(whitespace.ReplaceAllString(text, " "))
+}
+
+// Analyze 统计正文的字数和预计阅读时间
+func Analyze(content string) Stats {
+	cjk, words := count(PlainText(content))
+
+	stats := Stats{WordCount: cjk + words}
+	if stats.WordCount > 0 {
+		minutes := float64(cjk)/cjkCharsPerMinute + float64(words)/wordsPerMinute
+		stats.ReadingMinutes = max(1, int(math.Ceil(minutes)))
+	}
+	return stats
+}
+
+// Excerpt 从正文生成摘要，超过ExcerptLength个字符时在句子或词的边界截断并加省略号
+func Excerpt(content string) string {
+	text := PlainText(content)
+	if utf8.RuneCountInString(text) <= ExcerptLength {
+		return text
+	}
+
+	runes := []rune(text)[:ExcerptLength]
+	cut := len(runes)
+	// 优先在后半段的句末截断，其次在空格处截断；中日文之间没有空格，直接截断
+	if i := lastIndexFunc(runes, isSentenceEnd); i >= ExcerptLength/2 {
+		return string(runes[:i+1])
+	}
+	if i := lastIndexFunc(runes, unicode.IsSpace); i >= ExcerptLength/2 && !isCJK(runes[cut-1]) {
+		cut = i
+	}
+	return strings.TrimRightFunc(string(runes[:cut]), func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsPunct(r)
+	}) + "…"
+}
+
+// count 分别统计中日文字数和其他语言的词数。韩文以空格分词，按词计数
+func count(text string) (cjk, words int) {
+	inWord := false
+	for _, r := range text {
+		switch {
+		case isCJK(r):
+			cjk++
+			inWord = false
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if !inWord {
+				words++
+			}
+			inWord = true
+		case r == '\'' || r == '’' || r == '-':
+			// 词内的撇号和连字符不拆分单词
+		default:
+			inWord = false
+		}
+	}
+	return cjk, words
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana)
+}
+
+func isSentenceEnd(r rune) bool {
+	return strings.ContainsRune("。！？!?.", r)
+}
+
+func lastIndexFunc(runes []rune, f func(rune) bool) int {
+	for i := len(runes) - 1; i >= 0; i-- {
+		if f(runes[i]) {
+			return i
+		}
+	}
+	return -1
+}
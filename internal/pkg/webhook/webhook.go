@@ -0,0 +1,49 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 投递请求头
+const (
+	HeaderEvent     = "X-Goblog-Event"
+	HeaderEventID   = "X-Goblog-Event-Id"
+	HeaderDelivery  = "X-Goblog-Delivery"
+	HeaderTimestamp = "X-Goblog-Timestamp"
+	HeaderSignature = "X-Goblog-Signature-256"
+)
+
+// signaturePrefix 签名值前缀
+const signaturePrefix = "sha256="
+
+// Sign 计算签名，签名内容为 "<时间戳>.<请求体>"，时间戳参与签名以便接收方拒绝过期的重放请求
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify 校验签名和时间戳，tolerance为0时不校验时间戳
+func Verify(secret, signature, timestamp string, body []byte, tolerance time.Duration, now time.Time) bool {
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+	if tolerance > 0 {
+		diff := now.Sub(time.Unix(ts, 0))
+		if diff > tolerance || diff < -tolerance {
+			return false
+		}
+	}
+	if !strings.HasPrefix(signature, signaturePrefix) {
+		return false
+	}
+	return hmac.Equal([]byte(signature), []byte(Sign(secret, ts, body)))
+}
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"goblog/ent"
+	"goblog/ent/apikey"
+	"goblog/internal/domain"
+	"time"
+)
+
+// APIKeyRepository API密钥仓储实现
+type APIKeyRepository struct {
+	client *ent.Client
+}
+
+// NewAPIKeyRepository 创建API密钥仓储
+func NewAPIKeyRepository(client *ent.Client) domain.APIKeyRepository {
+	return &APIKeyRepository{client: client}
+}
+
+// Create 创建API密钥
+func (r *APIKeyRepository) Create(ctx context.Context, key *domain.APIKey) (*domain.APIKey, error) {
+	entKey, err := r.client.APIKey.Create().
+		SetName(key.Name).
+		SetUsername(key.Username).
+		SetPrefix(key.Prefix).
+		SetKeyHash(key.KeyHash).
+		SetScopes(key.Scopes).
+		SetNillableExpiresAt(key.ExpiresAt).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.entToDomain(entKey), nil
+}
+
+// GetByHash 根据密钥摘要获取API密钥
+func (r *APIKeyRepository) GetByHash(ctx context.Context, keyHash string) (*domain.APIKey, error) {
+	entKey, err := r.client.APIKey.Query().
+		Where(apikey.KeyHash(keyHash)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return r.entToDomain(entKey), nil
+}
+
+// ListByUsername 获取用户的全部API密钥
+func (r *APIKeyRepository) ListByUsername(ctx context.Context, username string) ([]*domain.APIKey, error) {
+	entKeys, err := r.client.APIKey.Query().
+		Where(apikey.Username(username)).
+		Order(ent.Desc(apikey.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]*domain.APIKey, len(entKeys))
+	for i, entKey := range entKeys {
+		keys[i] = r.entToDomain(entKey)
+	}
+
+	return keys, nil
+}
+
+// Revoke 吊销属于指定用户的API密钥
+func (r *APIKeyRepository) Revoke(ctx context.Context, id int, username string) error {
+	n, err := r.client.APIKey.Update().
+		Where(
+			apikey.ID(id),
+			apikey.Username(username),
+			apikey.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// Touch 记录API密钥的使用时间
+func (r *APIKeyRepository) Touch(ctx context.Context, id int, usedAt time.Time) error {
+	return r.client.APIKey.UpdateOneID(id).
+		SetLastUsedAt(usedAt).
+		Exec(ctx)
+}
+
+// entToDomain 将ent实体转换为领域模型
+func (r *APIKeyRepository) entToDomain(entKey *ent.APIKey) *domain.APIKey {
+	return &domain.APIKey{
+		ID:         entKey.ID,
+		Name:       entKey.Name,
+		Username:   entKey.Username,
+		Prefix:     entKey.Prefix,
+		KeyHash:    entKey.KeyHash,
+		Scopes:     entKey.Scopes,
+		ExpiresAt:  entKey.ExpiresAt,
+		LastUsedAt: entKey.LastUsedAt,
+		RevokedAt:  entKey.RevokedAt,
+		CreatedAt:  entKey.CreatedAt,
+	}
+}
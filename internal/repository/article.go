@@ -5,8 +5,12 @@ import (
 	"goblog/ent"
 	"goblog/ent/article"
 	"goblog/ent/category"
+	"goblog/ent/predicate"
 	"goblog/ent/tag"
 	"goblog/internal/domain"
+	"goblog/internal/pkg/textstats"
+	"slices"
+	"time"
 )
 
 // ArticleRepository 文章仓储实现
@@ -19,17 +23,35 @@ func NewArticleRepository(client *ent.Client) domain.ArticleRepository {
 	return &ArticleRepository{client: client}
 }
 
+// db 返回当前上下文使用的客户端，服务层开启事务时加入该事务
+func (r *ArticleRepository) db(ctx context.Context) *ent.Client {
+	return txClient(ctx, r.client)
+}
+
 // Create 创建文章
 func (r *ArticleRepository) Create(ctx context.Context, article *domain.Article) (*domain.Article, error) {
-	create := r.client.Article.Create().
+	stats := textstats.Analyze(article.Content)
+	create := r.db(ctx).Article.Create().
 		SetTitle(article.Title).
 		SetContent(article.Content).
-		SetPublished(article.Published)
+		SetWordCount(stats.WordCount).
+		SetReadingMinutes(stats.ReadingMinutes).
+		SetExcerpt(textstats.Excerpt(article.Content)).
+		SetStatus(articleStatus(article.Status)).
+		SetPublished(article.Status == domain.ArticleStatusPublished)
 
 	if article.Summary != "" {
 		create = create.SetSummary(article.Summary)
 	}
 
+	if article.Author != "" {
+		create = create.SetAuthor(article.Author)
+	}
+
+	if article.Status == domain.ArticleStatusPublished {
+		create = create.SetPublishedAt(time.Now())
+	}
+
 	if article.Category != nil {
 		create = create.SetCategoryID(article.Category.ID)
 	}
@@ -45,7 +67,7 @@ func (r *ArticleRepository) Create(ctx context.Context, article *domain.Article)
 		for i, tag := range article.Tags {
 			tagIDs[i] = tag.ID
 		}
-		_, err = r.client.Article.UpdateOneID(entArticle.ID).AddTagIDs(tagIDs...).Save(ctx)
+		_, err = r.db(ctx).Article.UpdateOneID(entArticle.ID).AddTagIDs(tagIDs...).Save(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -56,7 +78,7 @@ func (r *ArticleRepository) Create(ctx context.Context, article *domain.Article)
 
 // GetByID 根据ID获取文章
 func (r *ArticleRepository) GetByID(ctx context.Context, id int) (*domain.Article, error) {
-	entArticle, err := r.client.Article.Query().
+	entArticle, err := r.db(ctx).Article.Query().
 		Where(article.ID(id)).
 		WithCategory().
 		WithTags().
@@ -68,20 +90,37 @@ func (r *ArticleRepository) GetByID(ctx context.Context, id int) (*domain.Articl
 		return nil, err
 	}
 
-	return r.entToDomain(entArticle), nil
+	a := r.entToDomain(entArticle)
+	if err := r.withBreadcrumbs(ctx, a); err != nil {
+		return nil, err
+	}
+	return a, nil
 }
 
 // Update 更新文章
 func (r *ArticleRepository) Update(ctx context.Context, id int, article *domain.Article) (*domain.Article, error) {
-	update := r.client.Article.UpdateOneID(id).
+	stats := textstats.Analyze(article.Content)
+	update := r.db(ctx).Article.UpdateOneID(id).
 		SetTitle(article.Title).
 		SetContent(article.Content).
-		SetPublished(article.Published)
+		SetWordCount(stats.WordCount).
+		SetReadingMinutes(stats.ReadingMinutes).
+		SetExcerpt(textstats.Excerpt(article.Content)).
+		AddVersion(1)
+
+	if article.Version > 0 {
+		update = update.Where(versionIs(article.Version))
+	}
 
 	if article.Summary != "" {
 		update = update.SetSummary(article.Summary)
 	}
 
+	// 状态与内容在同一次更新中变更，版本只增加一次
+	if article.Status != "" {
+		update = setStatus(update, article.Status)
+	}
+
 	if article.Category != nil {
 		update = update.SetCategoryID(article.Category.ID)
 	} else {
@@ -94,7 +133,7 @@ func (r *ArticleRepository) Update(ctx context.Context, id int, article *domain.
 	entArticle, err := update.Save(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return nil, domain.ErrNotFound
+			return nil, r.notFoundOrConflict(ctx, id)
 		}
 		return nil, err
 	}
@@ -105,18 +144,70 @@ func (r *ArticleRepository) Update(ctx context.Context, id int, article *domain.
 		for i, tag := range article.Tags {
 			tagIDs[i] = tag.ID
 		}
-		_, err = r.client.Article.UpdateOneID(entArticle.ID).AddTagIDs(tagIDs...).Save(ctx)
+		_, err = r.db(ctx).Article.UpdateOneID(entArticle.ID).AddTagIDs(tagIDs...).Save(ctx)
 		if err != nil {
 			return nil, err
 		}
 	}
 
+	if article.Status == domain.ArticleStatusPublished {
+		if err := r.markPublished(ctx, entArticle.ID); err != nil {
+			return nil, err
+		}
+	}
+
 	return r.GetByID(ctx, entArticle.ID)
 }
 
+// UpdateStatus 更新文章状态，同时同步published字段，首次发布时记录发布时间
+func (r *ArticleRepository) UpdateStatus(ctx context.Context, id int, status string) (*domain.Article, error) {
+	err := setStatus(r.db(ctx).Article.UpdateOneID(id), status).
+		AddVersion(1).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+
+	if status == domain.ArticleStatusPublished {
+		if err := r.markPublished(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+
+	return r.GetByID(ctx, id)
+}
+
+// setStatus 设置文章状态，同时同步published字段
+func setStatus(update *ent.ArticleUpdateOne, status string) *ent.ArticleUpdateOne {
+	return update.SetStatus(article.Status(status)).SetPublished(status == domain.ArticleStatusPublished)
+}
+
+// markPublished 首次发布时记录发布时间，再次发布时保留原来的发布时间
+func (r *ArticleRepository) markPublished(ctx context.Context, id int) error {
+	return r.db(ctx).Article.Update().
+		Where(article.ID(id), article.PublishedAtIsNil()).
+		SetPublishedAt(time.Now()).
+		Exec(ctx)
+}
+
+// notFoundOrConflict 条件更新未命中时区分文章不存在和版本不一致
+func (r *ArticleRepository) notFoundOrConflict(ctx context.Context, id int) error {
+	exists, err := r.db(ctx).Article.Query().Where(article.ID(id)).Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return domain.ErrNotFound
+	}
+	return domain.ErrVersionConflict
+}
+
 // Delete 删除文章
 func (r *ArticleRepository) Delete(ctx context.Context, id int) error {
-	err := r.client.Article.DeleteOneID(id).Exec(ctx)
+	err := r.db(ctx).Article.DeleteOneID(id).Exec(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return domain.ErrNotFound
@@ -127,135 +218,399 @@ func (r *ArticleRepository) Delete(ctx context.Context, id int) error {
 }
 
 // List 获取文章列表
-func (r *ArticleRepository) List(ctx context.Context, params domain.QueryParams) ([]*domain.Article, int64, error) {
-	query := r.client.Article.Query().
-		WithCategory().
-		WithTags().
-		Order(ent.Desc(article.FieldCreatedAt))
+func (r *ArticleRepository) List(ctx context.Context, params domain.QueryParams) (*domain.ArticlePage, error) {
+	query := searchArticles(r.db(ctx).Article.Query(), params)
 
-	// 添加过滤条件
-	if params.Published != nil {
-		query = query.Where(article.Published(*params.Published))
+	return r.page(ctx, query, params)
+}
+
+// ListByCategory 按分类获取文章，IncludeDescendants 为true时包含子孙分类的文章
+func (r *ArticleRepository) ListByCategory(ctx context.Context, categoryID int, params domain.QueryParams) (*domain.ArticlePage, error) {
+	ids := []int{categoryID}
+	if params.IncludeDescendants {
+		var err error
+		if ids, err = r.descendantIDs(ctx, categoryID); err != nil {
+			return nil, err
+		}
 	}
 
-	if params.Search != "" {
-		query = query.Where(article.Or(
-			article.TitleContains(params.Search),
-			article.ContentContains(params.Search),
-		))
+	query := r.db(ctx).Article.Query().
+		Where(article.HasCategoryWith(category.IDIn(ids...)))
+
+	return r.page(ctx, query, params)
+}
+
+// descendantIDs 返回分类及其全部子孙分类的ID，逐层查询子分类
+func (r *ArticleRepository) descendantIDs(ctx context.Context, categoryID int) ([]int, error) {
+	ids := []int{categoryID}
+	level := ids
+	for depth := 1; depth < domain.CategoryMaxDepth && len(level) > 0; depth++ {
+		children, err := r.db(ctx).Category.Query().
+			Where(category.ParentIDIn(level...), category.IDNotIn(ids...)).
+			IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, children...)
+		level = children
 	}
+	return ids, nil
+}
 
-	// 获取总数
-	total, err := query.Count(ctx)
+// ListByTag 按标签获取文章
+func (r *ArticleRepository) ListByTag(ctx context.Context, tagID int, params domain.QueryParams) (*domain.ArticlePage, error) {
+	query := r.db(ctx).Article.Query().
+		Where(article.HasTagsWith(tag.ID(tagID)))
+
+	return r.page(ctx, query, params)
+}
+
+// Retag 为过滤条件选出的文章批量添加和移除标签，标签改变的文章版本号加一。应在事务中调用
+func (r *ArticleRepository) Retag(ctx context.Context, params domain.QueryParams, add, remove []int) (*domain.ArticleRetag, error) {
+	client := r.db(ctx)
+
+	ids, err := filterArticles(searchArticles(client.Article.Query(), params), params).IDs(ctx)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 
-	// 分页
-	if params.Page > 0 && params.Limit > 0 {
-		offset := (params.Page - 1) * params.Limit
-		query = query.Offset(offset).Limit(params.Limit)
+	result := &domain.ArticleRetag{Articles: len(ids)}
+	if len(ids) == 0 {
+		return result, nil
 	}
 
-	entArticles, err := query.All(ctx)
-	if err != nil {
-		return nil, 0, err
+	// 逐个标签处理，只更新标签确实改变的文章
+	for _, tagID := range add {
+		added, err := client.Article.Update().
+			Where(article.IDIn(ids...), article.Not(article.HasTagsWith(tag.ID(tagID)))).
+			AddTagIDs(tagID).
+			AddVersion(1).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result.Added += added
 	}
-
-	articles := make([]*domain.Article, len(entArticles))
-	for i, entArticle := range entArticles {
-		articles[i] = r.entToDomain(entArticle)
+	for _, tagID := range remove {
+		removed, err := client.Article.Update().
+			Where(article.IDIn(ids...), article.HasTagsWith(tag.ID(tagID))).
+			RemoveTagIDs(tagID).
+			AddVersion(1).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result.Removed += removed
 	}
 
-	return articles, int64(total), nil
+	return result, nil
 }
 
-// ListByCategory 按分类获取文章
-func (r *ArticleRepository) ListByCategory(ctx context.Context, categoryID int, params domain.QueryParams) ([]*domain.Article, int64, error) {
-	query := r.client.Article.Query().
-		Where(article.HasCategoryWith(category.ID(categoryID))).
-		WithCategory().
-		WithTags().
-		Order(ent.Desc(article.FieldCreatedAt))
+// page 过滤后统计分面并按投影分页查询，带游标时使用键集分页，否则按页码分页。
+// 页码分页同时返回首尾文章的游标，客户端可以从任意一页切换到游标分页
+func (r *ArticleRepository) page(ctx context.Context, query *ent.ArticleQuery, params domain.QueryParams) (*domain.ArticlePage, error) {
+	query = filterArticles(query, params)
 
-	// 添加过滤条件
-	if params.Published != nil {
-		query = query.Where(article.Published(*params.Published))
+	facets, err := r.facets(ctx, query, params.Facets)
+	if err != nil {
+		return nil, err
 	}
 
+	sort := articleSortOf(params)
+	projection := articleProjectionOf(params, sort)
+
+	var page *domain.ArticlePage
+	if params.Cursored() {
+		page, err = r.keysetPage(ctx, query, sort, projection, params)
+	} else {
+		page, err = r.offsetPage(ctx, query, sort, projection, params)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	page.Facets = facets
+	return page, nil
+}
+
+// offsetPage 按页码分页，同时统计总数
+func (r *ArticleRepository) offsetPage(ctx context.Context, query *ent.ArticleQuery, sort articleSort, projection articleProjection, params domain.QueryParams) (*domain.ArticlePage, error) {
+
 	// 获取总数
 	total, err := query.Count(ctx)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 
 	// 分页
-	if params.Page > 0 && params.Limit > 0 {
+	query = query.Order(sort.order(false)...)
+	paged := params.Page > 0 && params.Limit > 0
+	if paged {
 		offset := (params.Page - 1) * params.Limit
 		query = query.Offset(offset).Limit(params.Limit)
 	}
 
-	entArticles, err := query.All(ctx)
+	entArticles, err := projection.apply(query).All(ctx)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 
-	articles := make([]*domain.Article, len(entArticles))
-	for i, entArticle := range entArticles {
-		articles[i] = r.entToDomain(entArticle)
+	page := &domain.ArticlePage{Items: r.entsToDomain(entArticles), Total: int64(total)}
+	if projection.category {
+		if err := r.withBreadcrumbs(ctx, page.Items...); err != nil {
+			return nil, err
+		}
+	}
+	if paged && sort.seekable() && len(entArticles) > 0 {
+		if params.Page > 1 {
+			page.PrevCursor = sort.cursor(entArticles[0])
+		}
+		if int64(params.Page*params.Limit) < page.Total {
+			page.NextCursor = sort.cursor(entArticles[len(entArticles)-1])
+		}
 	}
 
-	return articles, int64(total), nil
+	return page, nil
 }
 
-// ListByTag 按标签获取文章
-func (r *ArticleRepository) ListByTag(ctx context.Context, tagID int, params domain.QueryParams) ([]*domain.Article, int64, error) {
-	query := r.client.Article.Query().
-		Where(article.HasTagsWith(tag.ID(tagID))).
-		WithCategory().
-		WithTags().
-		Order(ent.Desc(article.FieldCreatedAt))
+// keysetPage 按(排序字段, id)键集分页，多取一条判断该方向是否还有数据，不统计总数
+func (r *ArticleRepository) keysetPage(ctx context.Context, query *ent.ArticleQuery, sort articleSort, projection articleProjection, params domain.QueryParams) (*domain.ArticlePage, error) {
+	if params.After != "" && params.Before != "" {
+		return nil, domain.ErrInvalidInput
+	}
 
-	// 添加过滤条件
-	if params.Published != nil {
-		query = query.Where(article.Published(*params.Published))
+	backward := params.Before != ""
+	seek, err := sort.seek(params.After+params.Before, !backward)
+	if err != nil {
+		return nil, err
 	}
 
-	// 获取总数
-	total, err := query.Count(ctx)
+	limit := params.Limit
+	if limit <= 0 {
+		limit = defaultCursorLimit
+	}
+
+	entArticles, err := projection.apply(query).
+		Where(seek).
+		Order(sort.order(backward)...).
+		Limit(limit + 1).
+		All(ctx)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 
-	// 分页
-	if params.Page > 0 && params.Limit > 0 {
-		offset := (params.Page - 1) * params.Limit
-		query = query.Offset(offset).Limit(params.Limit)
+	more := len(entArticles) > limit
+	if more {
+		entArticles = entArticles[:limit]
+	}
+	if backward {
+		slices.Reverse(entArticles)
+	}
+
+	page := &domain.ArticlePage{Items: r.entsToDomain(entArticles), Total: -1}
+	if projection.category {
+		if err := r.withBreadcrumbs(ctx, page.Items...); err != nil {
+			return nil, err
+		}
+	}
+	if len(entArticles) == 0 {
+		return page, nil
+	}
+
+	// 来时的方向一定还有数据，另一个方向取决于是否多取到了一条
+	first := sort.cursor(entArticles[0])
+	last := sort.cursor(entArticles[len(entArticles)-1])
+	if backward {
+		page.NextCursor = last
+		if more {
+			page.PrevCursor = first
+		}
+	} else {
+		page.PrevCursor = first
+		if more {
+			page.NextCursor = last
+		}
+	}
+
+	return page, nil
+}
+
+// versionIs 版本号条件
+func versionIs(version int) predicate.Article {
+	return article.Version(version)
+}
+
+// articleStatus 转换为ent状态枚举，未设置时为草稿
+func articleStatus(status string) article.Status {
+	if status == "" {
+		return article.StatusDraft
 	}
+	return article.Status(status)
+}
 
-	entArticles, err := query.All(ctx)
+// BackfillArticleStatus 为升级前已发布的文章设置published状态，没有发布时间的以更新时间作为发布时间，保持原有的更新时间
+func BackfillArticleStatus(ctx context.Context, client *ent.Client) (int, error) {
+	articles, err := client.Article.Query().
+		Where(
+			article.Published(true),
+			article.Or(article.StatusEQ(article.StatusDraft), article.PublishedAtIsNil()),
+		).
+		All(ctx)
 	if err != nil {
-		return nil, 0, err
+		return 0, err
+	}
+
+	for _, a := range articles {
+		publishedAt := a.UpdatedAt
+		if a.PublishedAt != nil {
+			publishedAt = *a.PublishedAt
+		}
+		err := client.Article.UpdateOneID(a.ID).
+			SetStatus(article.StatusPublished).
+			SetPublishedAt(publishedAt).
+			SetUpdatedAt(a.UpdatedAt).
+			Exec(ctx)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return len(articles), nil
+}
+
+// backfillBatchSize 回填统计时每批读取的文章数
+const backfillBatchSize = 100
+
+// BackfillArticleTextStats 为文章计算字数、阅读时间和生成的摘要，all为false时只处理尚未计算过的文章。
+// 按ID分批处理，不修改更新时间和版本号，返回更新的文章数
+func BackfillArticleTextStats(ctx context.Context, client *ent.Client, all bool) (int, error) {
+	updated, lastID := 0, 0
+	for {
+		query := client.Article.Query().Where(article.IDGT(lastID))
+		if !all {
+			query = query.Where(article.WordCount(0))
+		}
+
+		articles, err := query.
+			Order(article.ByID()).
+			Limit(backfillBatchSize).
+			Select(article.FieldID, article.FieldContent, article.FieldUpdatedAt).
+			ArticleQuery.
+			All(ctx)
+		if err != nil {
+			return updated, err
+		}
+		if len(articles) == 0 {
+			return updated, nil
+		}
+
+		for _, a := range articles {
+			stats := textstats.Analyze(a.Content)
+			err := client.Article.UpdateOneID(a.ID).
+				SetWordCount(stats.WordCount).
+				SetReadingMinutes(stats.ReadingMinutes).
+				SetExcerpt(textstats.Excerpt(a.Content)).
+				SetUpdatedAt(a.UpdatedAt).
+				Exec(ctx)
+			if err != nil {
+				return updated, err
+			}
+			updated++
+		}
+		lastID = articles[len(articles)-1].ID
+	}
+}
+
+// withBreadcrumbs 为文章填充从顶级分类到所属分类的路径，同一层的上级分类一次查询
+func (r *ArticleRepository) withBreadcrumbs(ctx context.Context, articles ...*domain.Article) error {
+	type node struct {
+		crumb  domain.Breadcrumb
+		parent *int
+	}
+	nodes := make(map[int]node)
+	var pending []int
+	for _, a := range articles {
+		if c := a.Category; c != nil {
+			nodes[c.ID] = node{crumb: domain.Breadcrumb{ID: c.ID, Name: c.Name, UpdatedAt: c.UpdatedAt}, parent: c.ParentID}
+		}
+	}
+	for _, n := range nodes {
+		if n.parent != nil {
+			pending = append(pending, *n.parent)
+		}
 	}
 
+	for depth := 1; depth < domain.CategoryMaxDepth && len(pending) > 0; depth++ {
+		parents, err := r.db(ctx).Category.Query().
+			Where(category.IDIn(pending...)).
+			Select(category.FieldID, category.FieldName, category.FieldParentID, category.FieldUpdatedAt).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+
+		pending = nil
+		for _, p := range parents {
+			nodes[p.ID] = node{crumb: domain.Breadcrumb{ID: p.ID, Name: p.Name, UpdatedAt: p.UpdatedAt}, parent: p.ParentID}
+		}
+		for _, p := range parents {
+			if p.ParentID == nil {
+				continue
+			}
+			if _, ok := nodes[*p.ParentID]; !ok && !slices.Contains(pending, *p.ParentID) {
+				pending = append(pending, *p.ParentID)
+			}
+		}
+	}
+
+	for _, a := range articles {
+		if a.Category == nil {
+			continue
+		}
+		var path []domain.Breadcrumb
+		n, ok := nodes[a.Category.ID]
+		for ok && len(path) < domain.CategoryMaxDepth {
+			path = append(path, n.crumb)
+			if n.parent == nil {
+				break
+			}
+			n, ok = nodes[*n.parent]
+		}
+		slices.Reverse(path)
+		a.Breadcrumbs = path
+	}
+	return nil
+}
+
+// entsToDomain 批量转换为领域模型
+func (r *ArticleRepository) entsToDomain(entArticles []*ent.Article) []*domain.Article {
 	articles := make([]*domain.Article, len(entArticles))
 	for i, entArticle := range entArticles {
 		articles[i] = r.entToDomain(entArticle)
 	}
-
-	return articles, int64(total), nil
+	return articles
 }
 
 // entToDomain 将ent实体转换为领域模型
 func (r *ArticleRepository) entToDomain(entArticle *ent.Article) *domain.Article {
 	article := &domain.Article{
-		ID:        entArticle.ID,
-		Title:     entArticle.Title,
-		Content:   entArticle.Content,
-		Summary:   entArticle.Summary,
-		Published: entArticle.Published,
-		CreatedAt: entArticle.CreatedAt,
-		UpdatedAt: entArticle.UpdatedAt,
+		ID:             entArticle.ID,
+		Title:          entArticle.Title,
+		Content:        entArticle.Content,
+		Summary:        entArticle.Summary,
+		Excerpt:        entArticle.Summary,
+		WordCount:      entArticle.WordCount,
+		ReadingMinutes: entArticle.ReadingMinutes,
+		Status:         string(entArticle.Status),
+		Published:      entArticle.Published,
+		Version:        entArticle.Version,
+		Author:         entArticle.Author,
+		CreatedAt:      entArticle.CreatedAt,
+		UpdatedAt:      entArticle.UpdatedAt,
+		PublishedAt:    entArticle.PublishedAt,
+	}
+	if article.Excerpt == "" {
+		article.Excerpt = entArticle.Excerpt
 	}
 
 	// 转换分类
@@ -264,6 +619,7 @@ func (r *ArticleRepository) entToDomain(entArticle *ent.Article) *domain.Article
 			ID:          cat.ID,
 			Name:        cat.Name,
 			Description: cat.Description,
+			ParentID:    cat.ParentID,
 			CreatedAt:   cat.CreatedAt,
 			UpdatedAt:   cat.UpdatedAt,
 		}
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"goblog/ent"
+	"goblog/ent/articlelock"
+	"goblog/internal/domain"
+	"time"
+)
+
+// ArticleLockRepository 文章编辑租约仓储实现
+type ArticleLockRepository struct {
+	client *ent.Client
+}
+
+// NewArticleLockRepository 创建文章编辑租约仓储
+func NewArticleLockRepository(client *ent.Client) domain.ArticleLockRepository {
+	return &ArticleLockRepository{client: client}
+}
+
+// Get 获取文章的编辑租约，包括已过期的租约
+func (r *ArticleLockRepository) Get(ctx context.Context, articleID int) (*domain.ArticleLock, error) {
+	entLock, err := r.client.ArticleLock.Query().
+		Where(articlelock.ArticleID(articleID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return r.entToDomain(entLock), nil
+}
+
+// Acquire 获取或续期编辑租约。依次尝试续期自己的租约、接管过期或强制接管的租约、创建新租约，
+// 每一步都是单条条件语句，并发获取时唯一索引保证只有一人成功
+func (r *ArticleLockRepository) Acquire(ctx context.Context, articleID int, holder string, expiresAt, now time.Time, force bool) (*domain.ArticleLock, error) {
+	// 续期，获得租约的时间不变
+	n, err := r.client.ArticleLock.Update().
+		Where(articlelock.ArticleID(articleID), articlelock.Holder(holder)).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n > 0 {
+		return r.Get(ctx, articleID)
+	}
+
+	// 接管
+	takeover := r.client.ArticleLock.Update().
+		Where(articlelock.ArticleID(articleID))
+	if !force {
+		takeover = takeover.Where(articlelock.ExpiresAtLTE(now))
+	}
+	n, err = takeover.
+		SetHolder(holder).
+		SetAcquiredAt(now).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n > 0 {
+		return r.Get(ctx, articleID)
+	}
+
+	// 新建
+	entLock, err := r.client.ArticleLock.Create().
+		SetArticleID(articleID).
+		SetHolder(holder).
+		SetAcquiredAt(now).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return r.current(ctx, articleID)
+		}
+		return nil, err
+	}
+
+	return r.entToDomain(entLock), nil
+}
+
+// current 返回他人持有的租约；外键约束失败说明文章不存在
+func (r *ArticleLockRepository) current(ctx context.Context, articleID int) (*domain.ArticleLock, error) {
+	lock, err := r.Get(ctx, articleID)
+	if err != nil {
+		return nil, err
+	}
+	return lock, domain.ErrLocked
+}
+
+// Delete 删除编辑租约
+func (r *ArticleLockRepository) Delete(ctx context.Context, articleID int) error {
+	_, err := r.client.ArticleLock.Delete().
+		Where(articlelock.ArticleID(articleID)).
+		Exec(ctx)
+	return err
+}
+
+// entToDomain 将ent实体转换为领域模型
+func (r *ArticleLockRepository) entToDomain(entLock *ent.ArticleLock) *domain.ArticleLock {
+	return &domain.ArticleLock{
+		ArticleID:  entLock.ArticleID,
+		Holder:     entLock.Holder,
+		AcquiredAt: entLock.AcquiredAt,
+		ExpiresAt:  entLock.ExpiresAt,
+	}
+}
@@ -0,0 +1,394 @@
+package repository
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"goblog/ent"
+	"goblog/ent/article"
+	"goblog/ent/category"
+	"goblog/ent/comment"
+	"goblog/ent/predicate"
+	"goblog/ent/tag"
+	"goblog/internal/domain"
+	"slices"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// defaultCursorLimit 游标分页未指定每页数量时的默认值
+const defaultCursorLimit = 10
+
+// filterArticles 将列表查询参数转换为ent查询条件
+func filterArticles(query *ent.ArticleQuery, params domain.QueryParams) *ent.ArticleQuery {
+	query = filterByStatus(query, params)
+
+	query = query.Where(timeRange(article.FieldCreatedAt, params.CreatedFrom, params.CreatedTo)...)
+	query = query.Where(timeRange(article.FieldUpdatedAt, params.UpdatedFrom, params.UpdatedTo)...)
+	query = query.Where(timeRange(article.FieldPublishedAt, params.PublishedFrom, params.PublishedTo)...)
+
+	if len(params.CategoryIDs) > 0 {
+		query = query.Where(article.HasCategoryWith(category.IDIn(params.CategoryIDs...)))
+	}
+
+	if len(params.TagIDs) > 0 {
+		switch params.TagMatch {
+		case domain.TagMatchAll:
+			for _, id := range params.TagIDs {
+				query = query.Where(article.HasTagsWith(tag.ID(id)))
+			}
+		case domain.TagMatchNone:
+			query = query.Where(article.Not(article.HasTagsWith(tag.IDIn(params.TagIDs...))))
+		default:
+			query = query.Where(article.HasTagsWith(tag.IDIn(params.TagIDs...)))
+		}
+	}
+
+	if params.Author != "" {
+		query = query.Where(article.Author(params.Author))
+	}
+
+	return query
+}
+
+// searchArticles 按关键词匹配标题或正文
+func searchArticles(query *ent.ArticleQuery, params domain.QueryParams) *ent.ArticleQuery {
+	if params.Search == "" {
+		return query
+	}
+	return query.Where(article.Or(
+		article.TitleContains(params.Search),
+		article.ContentContains(params.Search),
+	))
+}
+
+// filterByStatus 按状态过滤，published=true 等价于 status=published，published=false 包含其余全部状态
+func filterByStatus(query *ent.ArticleQuery, params domain.QueryParams) *ent.ArticleQuery {
+	if params.Published != nil {
+		if *params.Published {
+			query = query.Where(article.StatusEQ(article.StatusPublished))
+		} else {
+			query = query.Where(article.StatusNEQ(article.StatusPublished))
+		}
+	}
+	if params.Status != "" {
+		query = query.Where(article.StatusEQ(article.Status(params.Status)))
+	}
+	return query
+}
+
+// timeRange 时间范围条件，from包含，to不包含
+func timeRange(field string, from, to *time.Time) []predicate.Article {
+	var predicates []predicate.Article
+	if from != nil {
+		predicates = append(predicates, sql.FieldGTE(field, *from))
+	}
+	if to != nil {
+		predicates = append(predicates, sql.FieldLT(field, *to))
+	}
+	return predicates
+}
+
+// facets 按分面分组统计过滤结果中的文章数，每个分面一次分组查询，结果按数量降序
+func (r *ArticleRepository) facets(ctx context.Context, query *ent.ArticleQuery, names []string) (map[string][]domain.FacetBucket, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	facets := make(map[string][]domain.FacetBucket, len(names))
+	for _, name := range names {
+		var rows []struct {
+			Value *int `json:"value"`
+			Count int  `json:"count"`
+		}
+		err := query.Clone().
+			Aggregate(facetGroup(name), ent.As(ent.Count(), "count")).
+			Scan(ctx, &rows)
+		if err != nil {
+			return nil, err
+		}
+
+		buckets := make([]domain.FacetBucket, 0, len(rows))
+		for _, row := range rows {
+			// 未分类的文章不计入分类分面
+			if row.Value == nil {
+				continue
+			}
+			buckets = append(buckets, domain.FacetBucket{Value: *row.Value, Count: row.Count})
+		}
+		if err := r.nameBuckets(ctx, name, buckets); err != nil {
+			return nil, err
+		}
+
+		slices.SortFunc(buckets, func(a, b domain.FacetBucket) int {
+			if a.Count != b.Count {
+				return b.Count - a.Count
+			}
+			return a.Value - b.Value
+		})
+		facets[name] = buckets
+	}
+
+	return facets, nil
+}
+
+// facetGroup 返回按分面分组的聚合函数，选出分组值并添加GROUP BY
+func facetGroup(name string) ent.AggregateFunc {
+	return func(s *sql.Selector) string {
+		var value string
+		switch name {
+		case domain.FacetCategory:
+			value = s.C(article.CategoryColumn)
+		case domain.FacetTag:
+			t := sql.Table(article.TagsTable)
+			s.Join(t).On(s.C(article.FieldID), t.C(article.TagsPrimaryKey[1]))
+			value = t.C(article.TagsPrimaryKey[0])
+		default:
+			value = "CAST(EXTRACT(YEAR FROM COALESCE(" + s.C(article.FieldPublishedAt) + ", " + s.C(article.FieldCreatedAt) + ")) AS INTEGER)"
+		}
+		s.GroupBy(value)
+		return sql.As(value, "value")
+	}
+}
+
+// countArticles 按分类或标签分组统计文章总数和已发布数，只执行一次分组查询，不加载文章
+func countArticles(ctx context.Context, client *ent.Client, facet string) (map[int]domain.ArticleCounts, error) {
+	var rows []struct {
+		Value     *int `json:"value"`
+		Total     int  `json:"total"`
+		Published int  `json:"published"`
+	}
+	err := client.Article.Query().
+		Aggregate(facetGroup(facet), ent.As(ent.Count(), "total"), countPublished).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[int]domain.ArticleCounts, len(rows))
+	for _, row := range rows {
+		if row.Value == nil {
+			continue
+		}
+		counts[*row.Value] = domain.ArticleCounts{Total: row.Total, Published: row.Published}
+	}
+	return counts, nil
+}
+
+// countPublished 统计分组中已发布的文章数，聚合表达式中的参数不会被绑定，状态值直接写入语句
+func countPublished(s *sql.Selector) string {
+	return sql.As("COUNT(CASE WHEN "+s.C(article.FieldStatus)+" = '"+string(article.StatusPublished)+"' THEN 1 END)", "published")
+}
+
+// nameBuckets 为分类和标签分面填充名称
+func (r *ArticleRepository) nameBuckets(ctx context.Context, name string, buckets []domain.FacetBucket) error {
+	if len(buckets) == 0 || name == domain.FacetYear {
+		return nil
+	}
+
+	ids := make([]int, len(buckets))
+	for i, b := range buckets {
+		ids[i] = b.Value
+	}
+
+	names := make(map[int]string, len(ids))
+	if name == domain.FacetCategory {
+		categories, err := r.db(ctx).Category.Query().Where(category.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, c := range categories {
+			names[c.ID] = c.Name
+		}
+	} else {
+		tags, err := r.db(ctx).Tag.Query().Where(tag.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, t := range tags {
+			names[t.ID] = t.Name
+		}
+	}
+
+	for i := range buckets {
+		buckets[i].Name = names[buckets[i].Value]
+	}
+	return nil
+}
+
+// articleProjection 文章列表投影，只查询请求的列，只预加载请求的关联
+type articleProjection struct {
+	columns  []string // 为空时查询全部列
+	category bool
+	tags     bool
+}
+
+// articleProjectionOf 返回查询参数请求的投影，未指定字段时返回完整文章。
+// 更新时间总是被查询，用于生成ETag；排序字段总是被查询，用于生成游标
+func articleProjectionOf(params domain.QueryParams, sort articleSort) articleProjection {
+	if len(params.Fields) == 0 {
+		return articleProjection{category: true, tags: true}
+	}
+
+	p := articleProjection{columns: []string{article.FieldID, article.FieldUpdatedAt}}
+	for _, field := range params.Fields {
+		switch field {
+		case domain.ArticleFieldCategory, domain.ArticleFieldBreadcrumbs:
+			p.category = true
+		case domain.ArticleFieldTags:
+			p.tags = true
+		case domain.ArticleFieldExcerpt:
+			// 没有填写摘要时才使用生成的摘要
+			p.columns = appendOnce(p.columns, article.FieldSummary, article.FieldExcerpt)
+		default:
+			if article.ValidColumn(field) {
+				p.columns = appendOnce(p.columns, field)
+			}
+		}
+	}
+	if sort.seekable() {
+		p.columns = appendOnce(p.columns, sort.field)
+	}
+	return p
+}
+
+// appendOnce 追加尚未包含的列
+func appendOnce(columns []string, add ...string) []string {
+	for _, column := range add {
+		if !slices.Contains(columns, column) {
+			columns = append(columns, column)
+		}
+	}
+	return columns
+}
+
+// apply 为查询设置列和预加载。设置列后的查询不能再用于Count
+func (p articleProjection) apply(query *ent.ArticleQuery) *ent.ArticleQuery {
+	if p.category {
+		query = query.WithCategory()
+	}
+	if p.tags {
+		query = query.WithTags()
+	}
+	if len(p.columns) > 0 {
+		query = query.Select(p.columns...).ArticleQuery
+	}
+	return query
+}
+
+// articleSort 文章列表排序，排序值相同时按id排序，保证顺序稳定
+type articleSort struct {
+	field string
+	desc  bool
+}
+
+// articleSortOf 返回查询参数指定的排序，默认按创建时间倒序
+func articleSortOf(params domain.QueryParams) articleSort {
+	if params.Sort == "" {
+		return articleSort{field: domain.ArticleSortCreatedAt, desc: true}
+	}
+	return articleSort{field: params.Sort, desc: params.SortDesc}
+}
+
+// order 返回排序条件，reverse为true时反向排序，用于向前翻页
+func (s articleSort) order(reverse bool) []article.OrderOption {
+	desc := s.desc != reverse
+	term := sql.OrderAsc()
+	if desc {
+		term = sql.OrderDesc()
+	}
+
+	if s.field == domain.ArticleSortPopularity {
+		return []article.OrderOption{byPopularity(desc), article.ByID(term)}
+	}
+	return []article.OrderOption{sql.OrderByField(s.field, term).ToFunc(), article.ByID(term)}
+}
+
+// byPopularity 按已通过审核的评论数排序
+func byPopularity(desc bool) article.OrderOption {
+	return func(s *sql.Selector) {
+		s.OrderExprFunc(func(b *sql.Builder) {
+			t := sql.Table(comment.Table)
+			// 排序表达式中的参数不会被绑定，状态值直接写入语句
+			b.WriteString("(SELECT COUNT(*) FROM ").Ident(comment.Table).WriteString(" WHERE ").
+				Ident(t.C(comment.ArticleColumn)).WriteOp(sql.OpEQ).Ident(s.C(article.FieldID)).
+				WriteString(" AND ").Ident(t.C(comment.FieldStatus)).WriteOp(sql.OpEQ).
+				WriteString("'" + string(comment.StatusApproved) + "')")
+			if desc {
+				b.WriteString(" DESC")
+			}
+		})
+	}
+}
+
+// seekable 是否支持游标分页，按评论数排序时排序值随时变化，不支持游标
+func (s articleSort) seekable() bool {
+	return s.field != domain.ArticleSortPopularity
+}
+
+// articleCursor 文章列表游标，记录排序方式和文章在其中的位置，编码后对客户端不透明
+type articleCursor struct {
+	Sort  string `json:"s"`
+	Value string `json:"v"`
+	ID    int    `json:"id"`
+}
+
+// cursor 编码文章在当前排序中的位置
+func (s articleSort) cursor(a *ent.Article) string {
+	cursor := articleCursor{Sort: s.key(), ID: a.ID}
+	switch s.field {
+	case domain.ArticleSortTitle:
+		cursor.Value = a.Title
+	case domain.ArticleSortUpdatedAt:
+		cursor.Value = a.UpdatedAt.Format(time.RFC3339Nano)
+	default:
+		cursor.Value = a.CreatedAt.Format(time.RFC3339Nano)
+	}
+
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// seek 解码游标并返回排在其后（forward）或其前的文章条件。
+// 游标格式错误或与当前排序不一致时返回ErrInvalidInput
+func (s articleSort) seek(encoded string, forward bool) (predicate.Article, error) {
+	if !s.seekable() {
+		return nil, domain.ErrInvalidInput
+	}
+
+	var cursor articleCursor
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil || json.Unmarshal(data, &cursor) != nil || cursor.ID <= 0 || cursor.Sort != s.key() {
+		return nil, domain.ErrInvalidInput
+	}
+
+	var value interface{} = cursor.Value
+	if s.field != domain.ArticleSortTitle {
+		t, err := time.Parse(time.RFC3339Nano, cursor.Value)
+		if err != nil {
+			return nil, domain.ErrInvalidInput
+		}
+		value = t
+	}
+
+	// 倒序时向后翻页、正序时向前翻页，取排序值更小的文章
+	if forward == s.desc {
+		return article.Or(
+			sql.FieldLT(s.field, value),
+			article.And(sql.FieldEQ(s.field, value), article.IDLT(cursor.ID)),
+		), nil
+	}
+	return article.Or(
+		sql.FieldGT(s.field, value),
+		article.And(sql.FieldEQ(s.field, value), article.IDGT(cursor.ID)),
+	), nil
+}
+
+// key 排序方式的标识，游标只能用于生成它的排序方式
+func (s articleSort) key() string {
+	if s.desc {
+		return "-" + s.field
+	}
+	return s.field
+}
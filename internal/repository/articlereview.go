@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"goblog/ent"
+	"goblog/ent/article"
+	"goblog/ent/articlereview"
+	"goblog/internal/domain"
+)
+
+// ArticleReviewRepository 文章审阅记录仓储实现
+type ArticleReviewRepository struct {
+	client *ent.Client
+}
+
+// NewArticleReviewRepository 创建文章审阅记录仓储
+func NewArticleReviewRepository(client *ent.Client) domain.ArticleReviewRepository {
+	return &ArticleReviewRepository{client: client}
+}
+
+// db 返回当前上下文使用的客户端，服务层开启事务时加入该事务
+func (r *ArticleReviewRepository) db(ctx context.Context) *ent.Client {
+	return txClient(ctx, r.client)
+}
+
+// Create 创建审阅记录
+func (r *ArticleReviewRepository) Create(ctx context.Context, review *domain.ArticleReview) (*domain.ArticleReview, error) {
+	create := r.db(ctx).ArticleReview.Create().
+		SetArticleID(review.ArticleID).
+		SetReviewer(review.Reviewer).
+		SetFromStatus(review.FromStatus).
+		SetToStatus(review.ToStatus)
+
+	if review.Note != "" {
+		create = create.SetNote(review.Note)
+	}
+
+	entReview, err := create.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	created := r.entToDomain(entReview)
+	created.ArticleID = review.ArticleID
+	return created, nil
+}
+
+// ListByArticle 按时间顺序获取文章的审阅记录
+func (r *ArticleReviewRepository) ListByArticle(ctx context.Context, articleID int) ([]*domain.ArticleReview, error) {
+	entReviews, err := r.db(ctx).ArticleReview.Query().
+		Where(articlereview.HasArticleWith(article.ID(articleID))).
+		Order(ent.Asc(articlereview.FieldCreatedAt), ent.Asc(articlereview.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reviews := make([]*domain.ArticleReview, len(entReviews))
+	for i, entReview := range entReviews {
+		reviews[i] = r.entToDomain(entReview)
+		reviews[i].ArticleID = articleID
+	}
+
+	return reviews, nil
+}
+
+// entToDomain 将ent实体转换为领域模型
+func (r *ArticleReviewRepository) entToDomain(entReview *ent.ArticleReview) *domain.ArticleReview {
+	return &domain.ArticleReview{
+		ID:         entReview.ID,
+		Reviewer:   entReview.Reviewer,
+		FromStatus: entReview.FromStatus,
+		ToStatus:   entReview.ToStatus,
+		Note:       entReview.Note,
+		CreatedAt:  entReview.CreatedAt,
+	}
+}
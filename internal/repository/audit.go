@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"goblog/ent"
+	"goblog/ent/auditlog"
+	"goblog/ent/hook"
+	"goblog/ent/schema"
+	"goblog/internal/domain"
+)
+
+// AuditRepository 审计日志仓储实现
+type AuditRepository struct {
+	client *ent.Client
+}
+
+// NewAuditRepository 创建审计日志仓储，同时在客户端上禁止修改和删除审计日志
+func NewAuditRepository(client *ent.Client) domain.AuditRepository {
+	client.AuditLog.Use(hook.Reject(ent.OpUpdate | ent.OpUpdateOne | ent.OpDelete | ent.OpDeleteOne))
+	return &AuditRepository{client: client}
+}
+
+// Create 追加审计日志
+func (r *AuditRepository) Create(ctx context.Context, log *domain.AuditLog) (*domain.AuditLog, error) {
+	changes := make(map[string]schema.AuditChange, len(log.Changes))
+	for field, change := range log.Changes {
+		changes[field] = schema.AuditChange{Before: change.Before, After: change.After}
+	}
+
+	create := r.client.AuditLog.Create().
+		SetActor(log.Actor).
+		SetActorRole(log.ActorRole).
+		SetAPIKeyID(log.APIKeyID).
+		SetEntityType(log.EntityType).
+		SetEntityID(log.EntityID).
+		SetAction(auditlog.Action(log.Action)).
+		SetChanges(changes).
+		SetIP(log.IP).
+		SetRequestID(log.RequestID)
+
+	if log.EventID == "" {
+		entLog, err := create.Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return r.entToDomain(entLog), nil
+	}
+
+	// 同一事件重复投递时保留已有记录，返回先写入的那条
+	err := create.
+		SetEventID(log.EventID).
+		OnConflictColumns(auditlog.FieldEventID).
+		Ignore().
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entLog, err := r.client.AuditLog.Query().
+		Where(auditlog.EventID(log.EventID)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.entToDomain(entLog), nil
+}
+
+// List 获取审计日志列表，按时间倒序
+func (r *AuditRepository) List(ctx context.Context, params domain.AuditQueryParams) ([]*domain.AuditLog, int64, error) {
+	query := r.client.AuditLog.Query().
+		Order(ent.Desc(auditlog.FieldCreatedAt), ent.Desc(auditlog.FieldID))
+
+	// 添加过滤条件
+	if params.Actor != "" {
+		query = query.Where(auditlog.Actor(params.Actor))
+	}
+	if params.EntityType != "" {
+		query = query.Where(auditlog.EntityType(params.EntityType))
+	}
+	if params.EntityID > 0 {
+		query = query.Where(auditlog.EntityID(params.EntityID))
+	}
+	if params.Action != "" {
+		query = query.Where(auditlog.ActionEQ(auditlog.Action(params.Action)))
+	}
+	if params.RequestID != "" {
+		query = query.Where(auditlog.RequestID(params.RequestID))
+	}
+	if params.From != nil {
+		query = query.Where(auditlog.CreatedAtGTE(*params.From))
+	}
+	if params.To != nil {
+		query = query.Where(auditlog.CreatedAtLT(*params.To))
+	}
+
+	// 获取总数
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// 分页
+	if params.Page > 0 && params.Limit > 0 {
+		offset := (params.Page - 1) * params.Limit
+		query = query.Offset(offset).Limit(params.Limit)
+	}
+
+	entLogs, err := query.All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	logs := make([]*domain.AuditLog, len(entLogs))
+	for i, entLog := range entLogs {
+		logs[i] = r.entToDomain(entLog)
+	}
+
+	return logs, int64(total), nil
+}
+
+// entToDomain 将ent实体转换为领域模型
+func (r *AuditRepository) entToDomain(entLog *ent.AuditLog) *domain.AuditLog {
+	changes := make(map[string]domain.AuditChange, len(entLog.Changes))
+	for field, change := range entLog.Changes {
+		changes[field] = domain.AuditChange{Before: change.Before, After: change.After}
+	}
+
+	log := &domain.AuditLog{
+		ID:         entLog.ID,
+		Actor:      entLog.Actor,
+		ActorRole:  entLog.ActorRole,
+		APIKeyID:   entLog.APIKeyID,
+		EntityType: entLog.EntityType,
+		EntityID:   entLog.EntityID,
+		Action:     string(entLog.Action),
+		Changes:    changes,
+		IP:         entLog.IP,
+		RequestID:  entLog.RequestID,
+		CreatedAt:  entLog.CreatedAt,
+	}
+	if entLog.EventID != nil {
+		log.EventID = *entLog.EventID
+	}
+	return log
+}
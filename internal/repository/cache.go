@@ -0,0 +1,561 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"goblog/ent"
+	"goblog/internal/domain"
+	"goblog/internal/pkg/cache"
+)
+
+// 缓存世代：文章列表无法逐条失效，任何文章写操作都使列表进入新的世代；
+// 文章中内嵌了分类和标签，分类、标签的修改使全部文章条目进入新的世代
+const (
+	articlesGeneration = "articles"
+	taxonomyGeneration = "taxonomy"
+)
+
+// CachedArticleRepository 带读穿缓存的文章仓储
+type CachedArticleRepository struct {
+	next  domain.ArticleRepository
+	cache *cache.Cache
+}
+
+// NewCachedArticleRepository 为文章仓储增加缓存，事务中的读操作直接访问数据库，写操作在提交后失效相关条目
+func NewCachedArticleRepository(next domain.ArticleRepository, c *cache.Cache) domain.ArticleRepository {
+	return &CachedArticleRepository{next: next, cache: c}
+}
+
+// Create 创建文章
+func (r *CachedArticleRepository) Create(ctx context.Context, a *domain.Article) (*domain.Article, error) {
+	created, err := r.next.Create(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+
+	r.invalidate(ctx, 0)
+	return created, nil
+}
+
+// GetByID 根据ID获取文章
+func (r *CachedArticleRepository) GetByID(ctx context.Context, id int) (*domain.Article, error) {
+	if ent.TxFromContext(ctx) != nil {
+		return r.next.GetByID(ctx, id)
+	}
+
+	var a *domain.Article
+	err := r.cache.Load(ctx, "article", r.itemKey(ctx, id), &a, func(ctx context.Context) (interface{}, error) {
+		return r.next.GetByID(ctx, id)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Update 更新文章
+func (r *CachedArticleRepository) Update(ctx context.Context, id int, a *domain.Article) (*domain.Article, error) {
+	updated, err := r.next.Update(ctx, id, a)
+	if err != nil {
+		return nil, err
+	}
+
+	r.invalidate(ctx, id)
+	return updated, nil
+}
+
+// UpdateStatus 更新文章状态
+func (r *CachedArticleRepository) UpdateStatus(ctx context.Context, id int, status string) (*domain.Article, error) {
+	updated, err := r.next.UpdateStatus(ctx, id, status)
+	if err != nil {
+		return nil, err
+	}
+
+	r.invalidate(ctx, id)
+	return updated, nil
+}
+
+// Delete 删除文章
+func (r *CachedArticleRepository) Delete(ctx context.Context, id int) error {
+	if err := r.next.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	r.invalidate(ctx, id)
+	return nil
+}
+
+// List 获取文章列表
+func (r *CachedArticleRepository) List(ctx context.Context, params domain.QueryParams) (*domain.ArticlePage, error) {
+	return r.list(ctx, "all", params, func(ctx context.Context) (*domain.ArticlePage, error) {
+		return r.next.List(ctx, params)
+	})
+}
+
+// ListByCategory 根据分类获取文章列表
+func (r *CachedArticleRepository) ListByCategory(ctx context.Context, categoryID int, params domain.QueryParams) (*domain.ArticlePage, error) {
+	return r.list(ctx, fmt.Sprintf("category:%d", categoryID), params, func(ctx context.Context) (*domain.ArticlePage, error) {
+		return r.next.ListByCategory(ctx, categoryID, params)
+	})
+}
+
+// ListByTag 根据标签获取文章列表
+func (r *CachedArticleRepository) ListByTag(ctx context.Context, tagID int, params domain.QueryParams) (*domain.ArticlePage, error) {
+	return r.list(ctx, fmt.Sprintf("tag:%d", tagID), params, func(ctx context.Context) (*domain.ArticlePage, error) {
+		return r.next.ListByTag(ctx, tagID, params)
+	})
+}
+
+// Retag 批量修改文章标签，提交后使文章列表和全部文章条目进入新的世代
+func (r *CachedArticleRepository) Retag(ctx context.Context, params domain.QueryParams, add, remove []int) (*domain.ArticleRetag, error) {
+	result, err := r.next.Retag(ctx, params, add, remove)
+	if err != nil {
+		return nil, err
+	}
+
+	afterCommit(ctx, func(ctx context.Context) {
+		r.cache.Bump(ctx, articlesGeneration, taxonomyGeneration)
+	})
+	return result, nil
+}
+
+// list 按范围和查询参数缓存一页结果，列表、总数和游标一起缓存
+func (r *CachedArticleRepository) list(ctx context.Context, scope string, params domain.QueryParams, load func(ctx context.Context) (*domain.ArticlePage, error)) (*domain.ArticlePage, error) {
+	if ent.TxFromContext(ctx) != nil {
+		return load(ctx)
+	}
+
+	encoded, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	key := fmt.Sprintf("articles:%s:%s:%s:%s",
+		r.cache.Generation(ctx, articlesGeneration), r.cache.Generation(ctx, taxonomyGeneration), scope, encoded)
+
+	var page *domain.ArticlePage
+	err = r.cache.Load(ctx, "articles", key, &page, func(ctx context.Context) (interface{}, error) {
+		return load(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+func (r *CachedArticleRepository) itemKey(ctx context.Context, id int) string {
+	return fmt.Sprintf("article:%d:%s", id, r.cache.Generation(ctx, taxonomyGeneration))
+}
+
+// invalidate 提交后删除文章条目并使文章列表进入新的世代，id为0时只失效列表
+func (r *CachedArticleRepository) invalidate(ctx context.Context, id int) {
+	afterCommit(ctx, func(ctx context.Context) {
+		if id > 0 {
+			r.cache.Delete(ctx, r.itemKey(ctx, id))
+		}
+		r.cache.Bump(ctx, articlesGeneration)
+	})
+}
+
+// CachedCategoryRepository 带读穿缓存的分类仓储
+type CachedCategoryRepository struct {
+	next  domain.CategoryRepository
+	cache *cache.Cache
+}
+
+// NewCachedCategoryRepository 为分类仓储增加缓存
+func NewCachedCategoryRepository(next domain.CategoryRepository, c *cache.Cache) domain.CategoryRepository {
+	return &CachedCategoryRepository{next: next, cache: c}
+}
+
+// Create 创建分类
+func (r *CachedCategoryRepository) Create(ctx context.Context, cat *domain.Category) (*domain.Category, error) {
+	created, err := r.next.Create(ctx, cat)
+	if err != nil {
+		return nil, err
+	}
+
+	r.invalidate(ctx, 0)
+	return created, nil
+}
+
+// GetByID 根据ID获取分类
+func (r *CachedCategoryRepository) GetByID(ctx context.Context, id int) (*domain.Category, error) {
+	if ent.TxFromContext(ctx) != nil {
+		return r.next.GetByID(ctx, id)
+	}
+
+	var cat *domain.Category
+	err := r.cache.Load(ctx, "category", categoryKey(id), &cat, func(ctx context.Context) (interface{}, error) {
+		return r.next.GetByID(ctx, id)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cat, nil
+}
+
+// GetForUpdate 加锁读取必须访问数据库，不使用缓存
+func (r *CachedCategoryRepository) GetForUpdate(ctx context.Context, id int) (*domain.Category, error) {
+	return r.next.GetForUpdate(ctx, id)
+}
+
+// Update 更新分类
+func (r *CachedCategoryRepository) Update(ctx context.Context, id int, cat *domain.Category) (*domain.Category, error) {
+	updated, err := r.next.Update(ctx, id, cat)
+	if err != nil {
+		return nil, err
+	}
+
+	r.invalidate(ctx, id)
+	return updated, nil
+}
+
+// Delete 删除分类
+func (r *CachedCategoryRepository) Delete(ctx context.Context, id int) error {
+	if err := r.next.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	r.invalidate(ctx, id)
+	return nil
+}
+
+// List 获取所有分类
+func (r *CachedCategoryRepository) List(ctx context.Context) ([]*domain.Category, error) {
+	if ent.TxFromContext(ctx) != nil {
+		return r.next.List(ctx)
+	}
+
+	var categories []*domain.Category
+	err := r.cache.Load(ctx, "categories", "categories", &categories, func(ctx context.Context) (interface{}, error) {
+		return r.next.List(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return categories, nil
+}
+
+// GetByName 根据名称获取分类，用于唯一性检查，不经过缓存
+func (r *CachedCategoryRepository) GetByName(ctx context.Context, name string) (*domain.Category, error) {
+	return r.next.GetByName(ctx, name)
+}
+
+// CountArticles 统计分类下的文章数，用于删除前检查，不经过缓存
+func (r *CachedCategoryRepository) CountArticles(ctx context.Context, id int) (int, error) {
+	return r.next.CountArticles(ctx, id)
+}
+
+// CountChildren 统计直接子分类数，不经过缓存
+func (r *CachedCategoryRepository) CountChildren(ctx context.Context, id int) (int, error) {
+	return r.next.CountChildren(ctx, id)
+}
+
+// ReassignArticles 移动分类下的文章，文章中内嵌的分类改变，提交后使全部文章条目进入新的世代
+func (r *CachedCategoryRepository) ReassignArticles(ctx context.Context, from, to int) (int, error) {
+	moved, err := r.next.ReassignArticles(ctx, from, to)
+	if err != nil {
+		return 0, err
+	}
+
+	afterCommit(ctx, func(ctx context.Context) {
+		r.cache.Bump(ctx, articlesGeneration, taxonomyGeneration)
+	})
+	return moved, nil
+}
+
+// ReparentChildren 移动子分类，提交后删除被移动的分类条目和分类列表，分类路径改变，使文章条目进入新的世代
+func (r *CachedCategoryRepository) ReparentChildren(ctx context.Context, from int, to *int) ([]int, error) {
+	ids, err := r.next.ReparentChildren(ctx, from, to)
+	if err != nil || len(ids) == 0 {
+		return ids, err
+	}
+
+	afterCommit(ctx, func(ctx context.Context) {
+		keys := []string{"categories"}
+		for _, id := range ids {
+			keys = append(keys, categoryKey(id))
+		}
+		r.cache.Delete(ctx, keys...)
+		r.cache.Bump(ctx, taxonomyGeneration)
+	})
+	return ids, nil
+}
+
+// ArticleCounts 按分类统计文章数，与文章列表使用相同的世代
+func (r *CachedCategoryRepository) ArticleCounts(ctx context.Context) (map[int]domain.ArticleCounts, error) {
+	return loadArticleCounts(ctx, r.cache, "category-counts", r.next.ArticleCounts)
+}
+
+// invalidate 提交后删除分类条目和分类列表，id为0时只失效列表。
+// 修改或删除分类会改变文章中内嵌的分类，同时使文章条目进入新的世代
+func (r *CachedCategoryRepository) invalidate(ctx context.Context, id int) {
+	afterCommit(ctx, func(ctx context.Context) {
+		if id == 0 {
+			r.cache.Delete(ctx, "categories")
+			return
+		}
+		r.cache.Delete(ctx, categoryKey(id), "categories")
+		r.cache.Bump(ctx, taxonomyGeneration)
+	})
+}
+
+func categoryKey(id int) string {
+	return fmt.Sprintf("category:%d", id)
+}
+
+// CachedTagRepository 带读穿缓存的标签仓储
+type CachedTagRepository struct {
+	next  domain.TagRepository
+	cache *cache.Cache
+}
+
+// NewCachedTagRepository 为标签仓储增加缓存
+func NewCachedTagRepository(next domain.TagRepository, c *cache.Cache) domain.TagRepository {
+	return &CachedTagRepository{next: next, cache: c}
+}
+
+// Create 创建标签
+func (r *CachedTagRepository) Create(ctx context.Context, t *domain.Tag) (*domain.Tag, error) {
+	created, err := r.next.Create(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+
+	r.invalidate(ctx, 0)
+	return created, nil
+}
+
+// GetByID 根据ID获取标签
+func (r *CachedTagRepository) GetByID(ctx context.Context, id int) (*domain.Tag, error) {
+	if ent.TxFromContext(ctx) != nil {
+		return r.next.GetByID(ctx, id)
+	}
+
+	var t *domain.Tag
+	err := r.cache.Load(ctx, "tag", tagKey(id), &t, func(ctx context.Context) (interface{}, error) {
+		return r.next.GetByID(ctx, id)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Update 更新标签
+func (r *CachedTagRepository) Update(ctx context.Context, id int, t *domain.Tag) (*domain.Tag, error) {
+	updated, err := r.next.Update(ctx, id, t)
+	if err != nil {
+		return nil, err
+	}
+
+	r.invalidate(ctx, id)
+	return updated, nil
+}
+
+// Delete 删除标签
+func (r *CachedTagRepository) Delete(ctx context.Context, id int) error {
+	if err := r.next.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	r.invalidate(ctx, id)
+	return nil
+}
+
+// List 获取所有标签
+func (r *CachedTagRepository) List(ctx context.Context) ([]*domain.Tag, error) {
+	if ent.TxFromContext(ctx) != nil {
+		return r.next.List(ctx)
+	}
+
+	var tags []*domain.Tag
+	err := r.cache.Load(ctx, "tags", "tags", &tags, func(ctx context.Context) (interface{}, error) {
+		return r.next.List(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// GetByName 根据名称获取标签，用于唯一性检查，不经过缓存
+func (r *CachedTagRepository) GetByName(ctx context.Context, name string) (*domain.Tag, error) {
+	return r.next.GetByName(ctx, name)
+}
+
+// GetByIDs 根据ID列表获取标签，用于写文章时校验标签，不经过缓存
+func (r *CachedTagRepository) GetByIDs(ctx context.Context, ids []int) ([]*domain.Tag, error) {
+	return r.next.GetByIDs(ctx, ids)
+}
+
+// GetAliasByName 根据旧名称获取别名，不经过缓存
+func (r *CachedTagRepository) GetAliasByName(ctx context.Context, name string) (*domain.TagAlias, error) {
+	return r.next.GetAliasByName(ctx, name)
+}
+
+// GetAliasByFormerID 根据旧ID获取别名，只在标签不存在时用于重定向，不经过缓存
+func (r *CachedTagRepository) GetAliasByFormerID(ctx context.Context, formerID int) (*domain.TagAlias, error) {
+	return r.next.GetAliasByFormerID(ctx, formerID)
+}
+
+// CreateAlias 创建别名，标签中内嵌了别名，提交后失效该标签
+func (r *CachedTagRepository) CreateAlias(ctx context.Context, alias *domain.TagAlias) error {
+	if err := r.next.CreateAlias(ctx, alias); err != nil {
+		return err
+	}
+
+	afterCommit(ctx, func(ctx context.Context) {
+		r.cache.Delete(ctx, tagKey(alias.TagID), "tags")
+	})
+	return nil
+}
+
+// DeleteAlias 删除别名，提交后失效标签列表和全部标签条目
+func (r *CachedTagRepository) DeleteAlias(ctx context.Context, name string) error {
+	alias, err := r.next.GetAliasByName(ctx, name)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := r.next.DeleteAlias(ctx, name); err != nil {
+		return err
+	}
+
+	afterCommit(ctx, func(ctx context.Context) {
+		r.cache.Delete(ctx, tagKey(alias.TagID), "tags")
+	})
+	return nil
+}
+
+// Merge 合并标签，提交后删除目标和来源标签条目，文章的标签改变，使文章列表和条目进入新的世代
+func (r *CachedTagRepository) Merge(ctx context.Context, targetID int, sourceIDs []int) (int, error) {
+	moved, err := r.next.Merge(ctx, targetID, sourceIDs)
+	if err != nil {
+		return 0, err
+	}
+
+	afterCommit(ctx, func(ctx context.Context) {
+		keys := []string{tagKey(targetID), "tags"}
+		for _, id := range sourceIDs {
+			keys = append(keys, tagKey(id))
+		}
+		r.cache.Delete(ctx, keys...)
+		r.cache.Bump(ctx, articlesGeneration, taxonomyGeneration)
+	})
+	return moved, nil
+}
+
+// ArticleCounts 按标签统计文章数，与文章列表使用相同的世代
+func (r *CachedTagRepository) ArticleCounts(ctx context.Context) (map[int]domain.ArticleCounts, error) {
+	return loadArticleCounts(ctx, r.cache, "tag-counts", r.next.ArticleCounts)
+}
+
+// invalidate 提交后删除标签条目和标签列表，id为0时只失效列表。
+// 修改或删除标签会改变文章中内嵌的标签，同时使文章条目进入新的世代
+func (r *CachedTagRepository) invalidate(ctx context.Context, id int) {
+	afterCommit(ctx, func(ctx context.Context) {
+		if id == 0 {
+			r.cache.Delete(ctx, "tags")
+			return
+		}
+		r.cache.Delete(ctx, tagKey(id), "tags")
+		r.cache.Bump(ctx, taxonomyGeneration)
+	})
+}
+
+func tagKey(id int) string {
+	return fmt.Sprintf("tag:%d", id)
+}
+
+// CachedCommentRepository 评论本身不缓存，已通过审核的评论数决定文章列表按热度的排序，
+// 已通过审核的评论增减时使文章列表进入新的世代
+type CachedCommentRepository struct {
+	next  domain.CommentRepository
+	cache *cache.Cache
+}
+
+// NewCachedCommentRepository 为评论仓储增加文章列表的失效
+func NewCachedCommentRepository(next domain.CommentRepository, c *cache.Cache) domain.CommentRepository {
+	return &CachedCommentRepository{next: next, cache: c}
+}
+
+// Create 创建评论，直接通过审核的评论改变文章的热度
+func (r *CachedCommentRepository) Create(ctx context.Context, c *domain.Comment) (*domain.Comment, error) {
+	created, err := r.next.Create(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	if created.Status == domain.CommentStatusApproved {
+		r.invalidate(ctx)
+	}
+	return created, nil
+}
+
+// UpdateStatus 更新审核状态，通过审核或撤销通过时改变文章的热度
+func (r *CachedCommentRepository) UpdateStatus(ctx context.Context, before *domain.Comment, status string) (*domain.Comment, error) {
+	updated, err := r.next.UpdateStatus(ctx, before, status)
+	if err != nil {
+		return nil, err
+	}
+
+	if before.Status == domain.CommentStatusApproved || status == domain.CommentStatusApproved {
+		r.invalidate(ctx)
+	}
+	return updated, nil
+}
+
+// Delete 删除评论
+func (r *CachedCommentRepository) Delete(ctx context.Context, id int) error {
+	if err := r.next.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	r.invalidate(ctx)
+	return nil
+}
+
+// GetByID 根据ID获取评论
+func (r *CachedCommentRepository) GetByID(ctx context.Context, id int) (*domain.Comment, error) {
+	return r.next.GetByID(ctx, id)
+}
+
+// List 获取评论列表（审核队列）
+func (r *CachedCommentRepository) List(ctx context.Context, params domain.CommentQueryParams) ([]*domain.Comment, int64, error) {
+	return r.next.List(ctx, params)
+}
+
+// ListByArticle 获取文章下指定状态的评论
+func (r *CachedCommentRepository) ListByArticle(ctx context.Context, articleID int, status string) ([]*domain.Comment, error) {
+	return r.next.ListByArticle(ctx, articleID, status)
+}
+
+// invalidate 提交后使文章列表进入新的世代
+func (r *CachedCommentRepository) invalidate(ctx context.Context) {
+	afterCommit(ctx, func(ctx context.Context) {
+		r.cache.Bump(ctx, articlesGeneration)
+	})
+}
+
+// loadArticleCounts 缓存文章数统计。文章的增删和状态变化、分类和标签的修改都会改变统计，
+// key带有文章和分类标签两个世代
+func loadArticleCounts(ctx context.Context, c *cache.Cache, namespace string, load func(ctx context.Context) (map[int]domain.ArticleCounts, error)) (map[int]domain.ArticleCounts, error) {
+	if ent.TxFromContext(ctx) != nil {
+		return load(ctx)
+	}
+
+	key := fmt.Sprintf("%s:%s:%s", namespace, c.Generation(ctx, articlesGeneration), c.Generation(ctx, taxonomyGeneration))
+	var counts map[int]domain.ArticleCounts
+	err := c.Load(ctx, namespace, key, &counts, func(ctx context.Context) (interface{}, error) {
+		return load(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"goblog/ent"
+	"goblog/ent/article"
 	"goblog/ent/category"
 	"goblog/internal/domain"
 )
@@ -17,15 +18,24 @@ func NewCategoryRepository(client *ent.Client) domain.CategoryRepository {
 	return &CategoryRepository{client: client}
 }
 
+// db 返回当前上下文使用的客户端，服务层开启事务时加入该事务
+func (r *CategoryRepository) db(ctx context.Context) *ent.Client {
+	return txClient(ctx, r.client)
+}
+
 // Create 创建分类
 func (r *CategoryRepository) Create(ctx context.Context, cat *domain.Category) (*domain.Category, error) {
-	create := r.client.Category.Create().
+	create := r.db(ctx).Category.Create().
 		SetName(cat.Name)
 
 	if cat.Description != "" {
 		create = create.SetDescription(cat.Description)
 	}
 
+	if cat.ParentID != nil {
+		create = create.SetParentID(*cat.ParentID)
+	}
+
 	entCategory, err := create.Save(ctx)
 	if err != nil {
 		return nil, err
@@ -36,7 +46,7 @@ func (r *CategoryRepository) Create(ctx context.Context, cat *domain.Category) (
 
 // GetByID 根据ID获取分类
 func (r *CategoryRepository) GetByID(ctx context.Context, id int) (*domain.Category, error) {
-	entCategory, err := r.client.Category.Query().
+	entCategory, err := r.db(ctx).Category.Query().
 		Where(category.ID(id)).
 		Only(ctx)
 	if err != nil {
@@ -49,15 +59,37 @@ func (r *CategoryRepository) GetByID(ctx context.Context, id int) (*domain.Categ
 	return r.entToDomain(entCategory), nil
 }
 
+// GetForUpdate 读取分类并加行锁，需在事务中调用
+func (r *CategoryRepository) GetForUpdate(ctx context.Context, id int) (*domain.Category, error) {
+	entCategory, err := r.db(ctx).Category.Query().
+		Where(category.ID(id)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return r.entToDomain(entCategory), nil
+}
+
 // Update 更新分类
 func (r *CategoryRepository) Update(ctx context.Context, id int, cat *domain.Category) (*domain.Category, error) {
-	update := r.client.Category.UpdateOneID(id).
+	update := r.db(ctx).Category.UpdateOneID(id).
 		SetName(cat.Name)
 
 	if cat.Description != "" {
 		update = update.SetDescription(cat.Description)
 	}
 
+	if cat.ParentID != nil {
+		update = update.SetParentID(*cat.ParentID)
+	} else {
+		update = update.ClearParent()
+	}
+
 	entCategory, err := update.Save(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -71,7 +103,7 @@ func (r *CategoryRepository) Update(ctx context.Context, id int, cat *domain.Cat
 
 // Delete 删除分类
 func (r *CategoryRepository) Delete(ctx context.Context, id int) error {
-	err := r.client.Category.DeleteOneID(id).Exec(ctx)
+	err := r.db(ctx).Category.DeleteOneID(id).Exec(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return domain.ErrNotFound
@@ -83,7 +115,7 @@ func (r *CategoryRepository) Delete(ctx context.Context, id int) error {
 
 // List 获取分类列表
 func (r *CategoryRepository) List(ctx context.Context) ([]*domain.Category, error) {
-	entCategories, err := r.client.Category.Query().
+	entCategories, err := r.db(ctx).Category.Query().
 		Order(ent.Desc(category.FieldCreatedAt)).
 		All(ctx)
 	if err != nil {
@@ -100,7 +132,7 @@ func (r *CategoryRepository) List(ctx context.Context) ([]*domain.Category, erro
 
 // GetByName 根据名称获取分类
 func (r *CategoryRepository) GetByName(ctx context.Context, name string) (*domain.Category, error) {
-	entCategory, err := r.client.Category.Query().
+	entCategory, err := r.db(ctx).Category.Query().
 		Where(category.Name(name)).
 		Only(ctx)
 	if err != nil {
@@ -113,12 +145,63 @@ func (r *CategoryRepository) GetByName(ctx context.Context, name string) (*domai
 	return r.entToDomain(entCategory), nil
 }
 
+// CountArticles 统计分类下的文章数，不含子分类
+func (r *CategoryRepository) CountArticles(ctx context.Context, id int) (int, error) {
+	return r.db(ctx).Article.Query().
+		Where(article.HasCategoryWith(category.ID(id))).
+		Count(ctx)
+}
+
+// CountChildren 统计直接子分类数
+func (r *CategoryRepository) CountChildren(ctx context.Context, id int) (int, error) {
+	return r.db(ctx).Category.Query().
+		Where(category.ParentID(id)).
+		Count(ctx)
+}
+
+// ReassignArticles 把分类下的文章移到另一个分类，文章版本号加一，返回移动的文章数
+func (r *CategoryRepository) ReassignArticles(ctx context.Context, from, to int) (int, error) {
+	return r.db(ctx).Article.Update().
+		Where(article.HasCategoryWith(category.ID(from))).
+		SetCategoryID(to).
+		AddVersion(1).
+		Save(ctx)
+}
+
+// ReparentChildren 把直接子分类移到另一个上级分类下，to为nil时移为顶级分类，返回被移动的分类ID
+func (r *CategoryRepository) ReparentChildren(ctx context.Context, from int, to *int) ([]int, error) {
+	ids, err := r.db(ctx).Category.Query().
+		Where(category.ParentID(from)).
+		IDs(ctx)
+	if err != nil || len(ids) == 0 {
+		return ids, err
+	}
+
+	update := r.db(ctx).Category.Update().
+		Where(category.IDIn(ids...))
+	if to != nil {
+		update = update.SetParentID(*to)
+	} else {
+		update = update.ClearParentID()
+	}
+	if err := update.Exec(ctx); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// ArticleCounts 按分类统计文章总数和已发布数
+func (r *CategoryRepository) ArticleCounts(ctx context.Context) (map[int]domain.ArticleCounts, error) {
+	return countArticles(ctx, r.db(ctx), domain.FacetCategory)
+}
+
 // entToDomain 将ent实体转换为领域模型
 func (r *CategoryRepository) entToDomain(entCategory *ent.Category) *domain.Category {
 	return &domain.Category{
 		ID:          entCategory.ID,
 		Name:        entCategory.Name,
 		Description: entCategory.Description,
+		ParentID:    entCategory.ParentID,
 		CreatedAt:   entCategory.CreatedAt,
 		UpdatedAt:   entCategory.UpdatedAt,
 	}
@@ -0,0 +1,190 @@
+package repository
+
+import (
+	"context"
+	"goblog/ent"
+	"goblog/ent/article"
+	"goblog/ent/comment"
+	"goblog/internal/domain"
+)
+
+// CommentRepository 评论仓储实现
+type CommentRepository struct {
+	client *ent.Client
+}
+
+// NewCommentRepository 创建评论仓储
+func NewCommentRepository(client *ent.Client) domain.CommentRepository {
+	return &CommentRepository{client: client}
+}
+
+// db 返回当前上下文使用的客户端，服务层开启事务时加入该事务
+func (r *CommentRepository) db(ctx context.Context) *ent.Client {
+	return txClient(ctx, r.client)
+}
+
+// Create 创建评论
+func (r *CommentRepository) Create(ctx context.Context, c *domain.Comment) (*domain.Comment, error) {
+	create := r.db(ctx).Comment.Create().
+		SetArticleID(c.ArticleID).
+		SetAuthorName(c.AuthorName).
+		SetContent(c.Content).
+		SetStatus(comment.Status(c.Status)).
+		SetSpamScore(c.SpamScore).
+		SetSpamReasons(c.SpamReasons)
+
+	if c.AuthorEmail != "" {
+		create = create.SetAuthorEmail(c.AuthorEmail)
+	}
+	if c.IP != "" {
+		create = create.SetIP(c.IP)
+	}
+	if c.UserAgent != "" {
+		create = create.SetUserAgent(c.UserAgent)
+	}
+
+	entComment, err := create.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetByID(ctx, entComment.ID)
+}
+
+// GetByID 根据ID获取评论
+func (r *CommentRepository) GetByID(ctx context.Context, id int) (*domain.Comment, error) {
+	entComment, err := r.db(ctx).Comment.Query().
+		Where(comment.ID(id)).
+		WithArticle().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return r.entToDomain(entComment), nil
+}
+
+// UpdateStatus 更新评论审核状态，仅在评论的状态和审核标记仍与before一致时更新，
+// 否则返回ErrVersionConflict，避免并发审核重复训练分类器
+func (r *CommentRepository) UpdateStatus(ctx context.Context, before *domain.Comment, status string) (*domain.Comment, error) {
+	n, err := r.db(ctx).Comment.Update().
+		Where(
+			comment.ID(before.ID),
+			comment.StatusEQ(comment.Status(before.Status)),
+			comment.Moderated(before.Moderated),
+		).
+		SetStatus(comment.Status(status)).
+		SetModerated(true).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		exists, err := r.db(ctx).Comment.Query().Where(comment.ID(before.ID)).Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, domain.ErrNotFound
+		}
+		return nil, domain.ErrVersionConflict
+	}
+
+	return r.GetByID(ctx, before.ID)
+}
+
+// Delete 删除评论
+func (r *CommentRepository) Delete(ctx context.Context, id int) error {
+	err := r.db(ctx).Comment.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// List 获取评论列表（审核队列）
+func (r *CommentRepository) List(ctx context.Context, params domain.CommentQueryParams) ([]*domain.Comment, int64, error) {
+	query := r.db(ctx).Comment.Query().
+		WithArticle().
+		Order(ent.Desc(comment.FieldCreatedAt))
+
+	if params.Status != "" {
+		query = query.Where(comment.StatusEQ(comment.Status(params.Status)))
+	}
+
+	// 获取总数
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// 分页
+	if params.Page > 0 && params.Limit > 0 {
+		offset := (params.Page - 1) * params.Limit
+		query = query.Offset(offset).Limit(params.Limit)
+	}
+
+	entComments, err := query.All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	comments := make([]*domain.Comment, len(entComments))
+	for i, entComment := range entComments {
+		comments[i] = r.entToDomain(entComment)
+	}
+
+	return comments, int64(total), nil
+}
+
+// ListByArticle 获取文章下指定状态的评论
+func (r *CommentRepository) ListByArticle(ctx context.Context, articleID int, status string) ([]*domain.Comment, error) {
+	entComments, err := r.db(ctx).Comment.Query().
+		Where(
+			comment.HasArticleWith(article.ID(articleID)),
+			comment.StatusEQ(comment.Status(status)),
+		).
+		WithArticle().
+		Order(ent.Asc(comment.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	comments := make([]*domain.Comment, len(entComments))
+	for i, entComment := range entComments {
+		comments[i] = r.entToDomain(entComment)
+	}
+
+	return comments, nil
+}
+
+// entToDomain 将ent实体转换为领域模型
+func (r *CommentRepository) entToDomain(entComment *ent.Comment) *domain.Comment {
+	c := &domain.Comment{
+		ID:          entComment.ID,
+		AuthorName:  entComment.AuthorName,
+		AuthorEmail: entComment.AuthorEmail,
+		Content:     entComment.Content,
+		Status:      string(entComment.Status),
+		Moderated:   entComment.Moderated,
+		SpamScore:   entComment.SpamScore,
+		SpamReasons: entComment.SpamReasons,
+		IP:          entComment.IP,
+		UserAgent:   entComment.UserAgent,
+		CreatedAt:   entComment.CreatedAt,
+		UpdatedAt:   entComment.UpdatedAt,
+	}
+
+	if a := entComment.Edges.Article; a != nil {
+		c.ArticleID = a.ID
+	}
+
+	return c
+}
@@ -0,0 +1,204 @@
+package repository
+
+import (
+	"context"
+	"goblog/ent"
+	"goblog/ent/loginattempt"
+	"goblog/ent/loginthrottle"
+	"goblog/internal/domain"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// LoginAttemptRepository 登录尝试记录仓储实现
+type LoginAttemptRepository struct {
+	client *ent.Client
+}
+
+// NewLoginAttemptRepository 创建登录尝试记录仓储
+func NewLoginAttemptRepository(client *ent.Client) domain.LoginAttemptRepository {
+	return &LoginAttemptRepository{client: client}
+}
+
+// Create 记录一次登录尝试
+func (r *LoginAttemptRepository) Create(ctx context.Context, attempt *domain.LoginAttempt) error {
+	create := r.client.LoginAttempt.Create().
+		SetUsername(attempt.Username).
+		SetSuccess(attempt.Success)
+
+	if attempt.IP != "" {
+		create = create.SetIP(attempt.IP)
+	}
+	if attempt.UserAgent != "" {
+		create = create.SetUserAgent(attempt.UserAgent)
+	}
+	if attempt.Reason != "" {
+		create = create.SetReason(attempt.Reason)
+	}
+
+	return create.Exec(ctx)
+}
+
+// List 查询登录尝试记录
+func (r *LoginAttemptRepository) List(ctx context.Context, params domain.LoginAttemptQueryParams) ([]*domain.LoginAttempt, int64, error) {
+	query := r.client.LoginAttempt.Query().
+		Order(ent.Desc(loginattempt.FieldCreatedAt), ent.Desc(loginattempt.FieldID))
+
+	// 添加过滤条件
+	if params.Username != "" {
+		query = query.Where(loginattempt.Username(params.Username))
+	}
+	if params.IP != "" {
+		query = query.Where(loginattempt.IP(params.IP))
+	}
+	if params.Success != nil {
+		query = query.Where(loginattempt.Success(*params.Success))
+	}
+
+	// 获取总数
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// 分页
+	if params.Page > 0 && params.Limit > 0 {
+		offset := (params.Page - 1) * params.Limit
+		query = query.Offset(offset).Limit(params.Limit)
+	}
+
+	entAttempts, err := query.All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	attempts := make([]*domain.LoginAttempt, len(entAttempts))
+	for i, entAttempt := range entAttempts {
+		attempts[i] = &domain.LoginAttempt{
+			ID:        entAttempt.ID,
+			Username:  entAttempt.Username,
+			IP:        entAttempt.IP,
+			UserAgent: entAttempt.UserAgent,
+			Success:   entAttempt.Success,
+			Reason:    entAttempt.Reason,
+			CreatedAt: entAttempt.CreatedAt,
+		}
+	}
+
+	return attempts, int64(total), nil
+}
+
+// LoginThrottleRepository 登录失败限制仓储实现
+type LoginThrottleRepository struct {
+	client *ent.Client
+}
+
+// NewLoginThrottleRepository 创建登录失败限制仓储
+func NewLoginThrottleRepository(client *ent.Client) domain.LoginThrottleRepository {
+	return &LoginThrottleRepository{client: client}
+}
+
+// Get 获取限制状态
+func (r *LoginThrottleRepository) Get(ctx context.Context, key string) (*domain.LoginThrottle, error) {
+	entThrottle, err := r.client.LoginThrottle.Query().
+		Where(loginthrottle.Key(key)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return r.entToDomain(entThrottle), nil
+}
+
+// RecordFailure 累加失败次数，上次失败超出统计窗口或锁定已过期时重新计数。
+// 插入和累加在同一条 INSERT ... ON CONFLICT 语句中完成，并发失败不会互相覆盖
+func (r *LoginThrottleRepository) RecordFailure(ctx context.Context, key string, window time.Duration) (*domain.LoginThrottle, error) {
+	now := time.Now()
+	id, err := r.client.LoginThrottle.Create().
+		SetKey(key).
+		SetFailures(1).
+		SetLastFailureAt(now).
+		OnConflict(
+			sql.ConflictColumns(loginthrottle.FieldKey),
+			sql.ResolveWith(func(u *sql.UpdateSet) {
+				t := u.Table()
+				// 重新计数的条件基于冲突行的旧值判断
+				expired := func(b *sql.Builder) {
+					b.WriteString(t.C(loginthrottle.FieldLastFailureAt)).WriteString(" < ").Arg(now.Add(-window)).
+						WriteString(" OR ").
+						WriteString(t.C(loginthrottle.FieldLockedUntil)).WriteString(" <= ").Arg(now)
+				}
+				u.Set(loginthrottle.FieldFailures, sql.ExprFunc(func(b *sql.Builder) {
+					b.WriteString("CASE WHEN ")
+					expired(b)
+					b.WriteString(" THEN 1 ELSE ").WriteString(t.C(loginthrottle.FieldFailures)).WriteString(" + 1 END")
+				}))
+				u.Set(loginthrottle.FieldLockedUntil, sql.ExprFunc(func(b *sql.Builder) {
+					b.WriteString("CASE WHEN ")
+					expired(b)
+					b.WriteString(" THEN NULL ELSE ").WriteString(t.C(loginthrottle.FieldLockedUntil)).WriteString(" END")
+				}))
+				u.SetExcluded(loginthrottle.FieldLastFailureAt)
+			}),
+		).
+		ID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entThrottle, err := r.client.LoginThrottle.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.entToDomain(entThrottle), nil
+}
+
+// Lock 锁定到指定时间
+func (r *LoginThrottleRepository) Lock(ctx context.Context, key string, until time.Time) error {
+	_, err := r.client.LoginThrottle.Update().
+		Where(loginthrottle.Key(key)).
+		SetLockedUntil(until).
+		Save(ctx)
+	return err
+}
+
+// Reset 清除限制状态
+func (r *LoginThrottleRepository) Reset(ctx context.Context, key string) error {
+	_, err := r.client.LoginThrottle.Delete().
+		Where(loginthrottle.Key(key)).
+		Exec(ctx)
+	return err
+}
+
+// ListLocked 获取仍处于锁定状态的记录
+func (r *LoginThrottleRepository) ListLocked(ctx context.Context, now time.Time) ([]*domain.LoginThrottle, error) {
+	entThrottles, err := r.client.LoginThrottle.Query().
+		Where(loginthrottle.LockedUntilGT(now)).
+		Order(ent.Desc(loginthrottle.FieldLockedUntil)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	throttles := make([]*domain.LoginThrottle, len(entThrottles))
+	for i, entThrottle := range entThrottles {
+		throttles[i] = r.entToDomain(entThrottle)
+	}
+
+	return throttles, nil
+}
+
+// entToDomain 将ent实体转换为领域模型
+func (r *LoginThrottleRepository) entToDomain(entThrottle *ent.LoginThrottle) *domain.LoginThrottle {
+	return &domain.LoginThrottle{
+		Key:           entThrottle.Key,
+		Failures:      entThrottle.Failures,
+		LastFailureAt: entThrottle.LastFailureAt,
+		LockedUntil:   entThrottle.LockedUntil,
+	}
+}
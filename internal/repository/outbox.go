@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"goblog/ent"
+	"goblog/ent/outboxevent"
+	"goblog/internal/domain"
+	"time"
+)
+
+// OutboxRepository 事件发件箱仓储实现
+type OutboxRepository struct {
+	client *ent.Client
+}
+
+// NewOutboxRepository 创建事件发件箱仓储
+func NewOutboxRepository(client *ent.Client) domain.OutboxRepository {
+	return &OutboxRepository{client: client}
+}
+
+// Add 写入发件箱，在服务层开启的事务中调用时与数据变更一起提交
+func (r *OutboxRepository) Add(ctx context.Context, events []*domain.OutboxEvent) error {
+	client := txClient(ctx, r.client)
+
+	builders := make([]*ent.OutboxEventCreate, len(events))
+	for i, e := range events {
+		create := client.OutboxEvent.Create().
+			SetEventID(e.EventID).
+			SetEvent(e.Event).
+			SetSubscriber(e.Subscriber).
+			SetPayload(e.Payload)
+		if e.Actor != nil {
+			create = create.
+				SetActor(e.Actor.Username).
+				SetActorRole(e.Actor.Role).
+				SetAPIKeyID(e.Actor.APIKeyID).
+				SetIP(e.Actor.IP).
+				SetRequestID(e.Actor.RequestID)
+		}
+		builders[i] = create
+	}
+
+	return client.OutboxEvent.CreateBulk(builders...).Exec(ctx)
+}
+
+// ClaimDue 领取到期的待分发记录，只有成功推迟下次尝试时间的记录才算领取成功
+func (r *OutboxRepository) ClaimDue(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]*domain.OutboxEvent, error) {
+	entEvents, err := r.client.OutboxEvent.Query().
+		Where(
+			outboxevent.StatusEQ(outboxevent.StatusPending),
+			outboxevent.NextAttemptAtLTE(now),
+		).
+		Order(ent.Asc(outboxevent.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	leaseUntil := now.Add(lease)
+	claimed := make([]*domain.OutboxEvent, 0, len(entEvents))
+	for _, entEvent := range entEvents {
+		n, err := r.client.OutboxEvent.Update().
+			Where(
+				outboxevent.ID(entEvent.ID),
+				outboxevent.StatusEQ(outboxevent.StatusPending),
+				outboxevent.NextAttemptAt(entEvent.NextAttemptAt),
+			).
+			SetNextAttemptAt(leaseUntil).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			// 已被其他实例领取
+			continue
+		}
+
+		event := r.entToDomain(entEvent)
+		event.NextAttemptAt = leaseUntil
+		claimed = append(claimed, event)
+	}
+
+	return claimed, nil
+}
+
+// SaveResult 保存分发结果
+func (r *OutboxRepository) SaveResult(ctx context.Context, e *domain.OutboxEvent) error {
+	err := r.client.OutboxEvent.UpdateOneID(e.ID).
+		SetStatus(outboxevent.Status(e.Status)).
+		SetAttempts(e.Attempts).
+		SetNextAttemptAt(e.NextAttemptAt).
+		SetLastError(e.LastError).
+		SetNillableDispatchedAt(e.DispatchedAt).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// entToDomain 将ent实体转换为领域模型
+func (r *OutboxRepository) entToDomain(entEvent *ent.OutboxEvent) *domain.OutboxEvent {
+	event := &domain.OutboxEvent{
+		ID:            entEvent.ID,
+		EventID:       entEvent.EventID,
+		Event:         entEvent.Event,
+		Subscriber:    entEvent.Subscriber,
+		Payload:       entEvent.Payload,
+		Status:        string(entEvent.Status),
+		Attempts:      entEvent.Attempts,
+		NextAttemptAt: entEvent.NextAttemptAt,
+		LastError:     entEvent.LastError,
+		DispatchedAt:  entEvent.DispatchedAt,
+		CreatedAt:     entEvent.CreatedAt,
+	}
+	if entEvent.Actor != "" {
+		event.Actor = &domain.Actor{
+			Username:  entEvent.Actor,
+			Role:      entEvent.ActorRole,
+			APIKeyID:  entEvent.APIKeyID,
+			IP:        entEvent.IP,
+			RequestID: entEvent.RequestID,
+		}
+	}
+	return event
+}
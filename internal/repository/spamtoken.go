@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"goblog/ent"
+	"goblog/ent/spamtoken"
+	"goblog/internal/domain"
+)
+
+// SpamTokenRepository 垃圾评论词元统计仓储实现
+type SpamTokenRepository struct {
+	client *ent.Client
+}
+
+// NewSpamTokenRepository 创建垃圾评论词元统计仓储
+func NewSpamTokenRepository(client *ent.Client) domain.SpamTokenRepository {
+	return &SpamTokenRepository{client: client}
+}
+
+// GetCounts 批量获取词元统计，未出现过的词元不会出现在结果中
+func (r *SpamTokenRepository) GetCounts(ctx context.Context, tokens []string) (map[string]domain.SpamTokenCount, error) {
+	counts := make(map[string]domain.SpamTokenCount, len(tokens))
+	if len(tokens) == 0 {
+		return counts, nil
+	}
+
+	entTokens, err := r.client.SpamToken.Query().
+		Where(spamtoken.TokenIn(tokens...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entToken := range entTokens {
+		counts[entToken.Token] = domain.SpamTokenCount{
+			Spam: entToken.SpamCount,
+			Ham:  entToken.HamCount,
+		}
+	}
+
+	return counts, nil
+}
+
+// Add 在一个事务中为每个词元累加计数，不存在的词元会被创建。服务层已开启事务时加入该事务，
+// 训练结果与审核状态一起提交或回滚
+func (r *SpamTokenRepository) Add(ctx context.Context, tokens []string, delta domain.SpamTokenCount) error {
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	return NewTransactor(r.client).WithinTx(ctx, func(ctx context.Context) error {
+		client := txClient(ctx, r.client)
+
+		existing, err := client.SpamToken.Query().
+			Where(spamtoken.TokenIn(tokens...)).
+			ForUpdate().
+			All(ctx)
+		if err != nil {
+			return err
+		}
+
+		known := make(map[string]bool, len(existing))
+		for _, entToken := range existing {
+			known[entToken.Token] = true
+			err := client.SpamToken.UpdateOneID(entToken.ID).
+				SetSpamCount(max(entToken.SpamCount+delta.Spam, 0)).
+				SetHamCount(max(entToken.HamCount+delta.Ham, 0)).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		var builders []*ent.SpamTokenCreate
+		for _, token := range tokens {
+			if known[token] {
+				continue
+			}
+			known[token] = true
+			builders = append(builders, client.SpamToken.Create().
+				SetToken(token).
+				SetSpamCount(max(delta.Spam, 0)).
+				SetHamCount(max(delta.Ham, 0)))
+		}
+		if len(builders) > 0 {
+			return client.SpamToken.CreateBulk(builders...).Exec(ctx)
+		}
+		return nil
+	})
+}
+
+// rollback 回滚事务并返回原始错误
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return fmt.Errorf("%w: rollback failed: %v", err, rerr)
+	}
+	return err
+}
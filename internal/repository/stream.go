@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"goblog/internal/domain"
+	"goblog/internal/pkg/logger"
+	"goblog/internal/pkg/sse"
+)
+
+// StreamNotifier 事务提交后推送实时事件流，回滚的变更不会推送
+type StreamNotifier struct {
+	publisher sse.Publisher
+}
+
+// NewStreamNotifier 创建实时事件流推送器
+func NewStreamNotifier(publisher sse.Publisher) domain.StreamNotifier {
+	return &StreamNotifier{publisher: publisher}
+}
+
+// Notify 不在事务中时立即推送。事件流不保证送达，推送失败只记录日志
+func (n *StreamNotifier) Notify(ctx context.Context, name string, data []byte) {
+	afterCommit(ctx, func(ctx context.Context) {
+		if err := n.publisher.Publish(ctx, name, data); err != nil {
+			logger.Warn("推送实时事件失败", "event", name, "error", err)
+		}
+	})
+}
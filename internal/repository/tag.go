@@ -3,7 +3,9 @@ package repository
 import (
 	"context"
 	"goblog/ent"
+	"goblog/ent/article"
 	"goblog/ent/tag"
+	"goblog/ent/tagalias"
 	"goblog/internal/domain"
 )
 
@@ -17,9 +19,14 @@ func NewTagRepository(client *ent.Client) domain.TagRepository {
 	return &TagRepository{client: client}
 }
 
+// db 返回当前上下文使用的客户端，服务层开启事务时加入该事务
+func (r *TagRepository) db(ctx context.Context) *ent.Client {
+	return txClient(ctx, r.client)
+}
+
 // Create 创建标签
 func (r *TagRepository) Create(ctx context.Context, t *domain.Tag) (*domain.Tag, error) {
-	create := r.client.Tag.Create().
+	create := r.db(ctx).Tag.Create().
 		SetName(t.Name)
 
 	if t.Color != "" {
@@ -34,10 +41,11 @@ func (r *TagRepository) Create(ctx context.Context, t *domain.Tag) (*domain.Tag,
 	return r.entToDomain(entTag), nil
 }
 
-// GetByID 根据ID获取标签
+// GetByID 根据ID获取标签，包含别名
 func (r *TagRepository) GetByID(ctx context.Context, id int) (*domain.Tag, error) {
-	entTag, err := r.client.Tag.Query().
+	entTag, err := r.db(ctx).Tag.Query().
 		Where(tag.ID(id)).
+		WithAliases(withAliasOrder).
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -51,7 +59,7 @@ func (r *TagRepository) GetByID(ctx context.Context, id int) (*domain.Tag, error
 
 // Update 更新标签
 func (r *TagRepository) Update(ctx context.Context, id int, t *domain.Tag) (*domain.Tag, error) {
-	update := r.client.Tag.UpdateOneID(id).
+	update := r.db(ctx).Tag.UpdateOneID(id).
 		SetName(t.Name)
 
 	if t.Color != "" {
@@ -71,7 +79,7 @@ func (r *TagRepository) Update(ctx context.Context, id int, t *domain.Tag) (*dom
 
 // Delete 删除标签
 func (r *TagRepository) Delete(ctx context.Context, id int) error {
-	err := r.client.Tag.DeleteOneID(id).Exec(ctx)
+	err := r.db(ctx).Tag.DeleteOneID(id).Exec(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return domain.ErrNotFound
@@ -81,9 +89,10 @@ func (r *TagRepository) Delete(ctx context.Context, id int) error {
 	return nil
 }
 
-// List 获取标签列表
+// List 获取标签列表，包含别名
 func (r *TagRepository) List(ctx context.Context) ([]*domain.Tag, error) {
-	entTags, err := r.client.Tag.Query().
+	entTags, err := r.db(ctx).Tag.Query().
+		WithAliases(withAliasOrder).
 		Order(ent.Desc(tag.FieldCreatedAt)).
 		All(ctx)
 	if err != nil {
@@ -100,7 +109,7 @@ func (r *TagRepository) List(ctx context.Context) ([]*domain.Tag, error) {
 
 // GetByName 根据名称获取标签
 func (r *TagRepository) GetByName(ctx context.Context, name string) (*domain.Tag, error) {
-	entTag, err := r.client.Tag.Query().
+	entTag, err := r.db(ctx).Tag.Query().
 		Where(tag.Name(name)).
 		Only(ctx)
 	if err != nil {
@@ -115,7 +124,7 @@ func (r *TagRepository) GetByName(ctx context.Context, name string) (*domain.Tag
 
 // GetByIDs 根据ID列表获取标签
 func (r *TagRepository) GetByIDs(ctx context.Context, ids []int) ([]*domain.Tag, error) {
-	entTags, err := r.client.Tag.Query().
+	entTags, err := r.db(ctx).Tag.Query().
 		Where(tag.IDIn(ids...)).
 		All(ctx)
 	if err != nil {
@@ -130,13 +139,155 @@ func (r *TagRepository) GetByIDs(ctx context.Context, ids []int) ([]*domain.Tag,
 	return tags, nil
 }
 
-// entToDomain 将ent实体转换为领域模型
+// GetAliasByName 根据旧名称获取别名
+func (r *TagRepository) GetAliasByName(ctx context.Context, name string) (*domain.TagAlias, error) {
+	entAlias, err := r.db(ctx).TagAlias.Query().
+		Where(tagalias.Name(name)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return aliasToDomain(entAlias), nil
+}
+
+// GetAliasByFormerID 根据被合并标签原来的ID获取别名
+func (r *TagRepository) GetAliasByFormerID(ctx context.Context, formerID int) (*domain.TagAlias, error) {
+	entAlias, err := r.db(ctx).TagAlias.Query().
+		Where(tagalias.FormerID(formerID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return aliasToDomain(entAlias), nil
+}
+
+// CreateAlias 创建别名
+func (r *TagRepository) CreateAlias(ctx context.Context, alias *domain.TagAlias) error {
+	return r.db(ctx).TagAlias.Create().
+		SetName(alias.Name).
+		SetNillableFormerID(alias.FormerID).
+		SetTagID(alias.TagID).
+		Exec(ctx)
+}
+
+// DeleteAlias 根据名称删除别名
+func (r *TagRepository) DeleteAlias(ctx context.Context, name string) error {
+	_, err := r.db(ctx).TagAlias.Delete().
+		Where(tagalias.Name(name)).
+		Exec(ctx)
+	return err
+}
+
+// Merge 将来源标签合并到目标标签：文章关联移到目标标签，来源标签及其别名成为目标标签的别名，
+// 然后删除来源标签。受影响文章的版本号加一，返回新增目标标签的文章数。应在事务中调用
+func (r *TagRepository) Merge(ctx context.Context, targetID int, sourceIDs []int) (int, error) {
+	client := r.db(ctx)
+
+	sources, err := client.Tag.Query().
+		Where(tag.IDIn(sourceIDs...)).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// 已有目标标签的文章只删除来源标签的关联，其余文章新增目标标签
+	ids, err := client.Article.Query().
+		Where(
+			article.HasTagsWith(tag.IDIn(sourceIDs...)),
+			article.Not(article.HasTagsWith(tag.ID(targetID))),
+		).
+		IDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	err = client.Article.Update().
+		Where(article.HasTagsWith(tag.IDIn(sourceIDs...))).
+		AddVersion(1).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// 更新目标标签使其更新时间改变，别名和文章数变化后旧的ETag失效
+	err = client.Tag.UpdateOneID(targetID).
+		AddArticleIDs(ids...).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, domain.ErrNotFound
+		}
+		return 0, err
+	}
+
+	// 来源标签已有的别名改为指向目标标签，链式合并后旧链接仍能找到最终的标签
+	err = client.TagAlias.Update().
+		Where(tagalias.TagIDIn(sourceIDs...)).
+		SetTagID(targetID).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	aliases := make([]*ent.TagAliasCreate, len(sources))
+	for i, source := range sources {
+		aliases[i] = client.TagAlias.Create().
+			SetName(source.Name).
+			SetFormerID(source.ID).
+			SetTagID(targetID)
+	}
+	if err := client.TagAlias.CreateBulk(aliases...).Exec(ctx); err != nil {
+		return 0, err
+	}
+
+	_, err = client.Tag.Delete().
+		Where(tag.IDIn(sourceIDs...)).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(ids), nil
+}
+
+// ArticleCounts 按标签统计文章总数和已发布数
+func (r *TagRepository) ArticleCounts(ctx context.Context) (map[int]domain.ArticleCounts, error) {
+	return countArticles(ctx, r.db(ctx), domain.FacetTag)
+}
+
+// withAliasOrder 别名按名称排序
+func withAliasOrder(q *ent.TagAliasQuery) {
+	q.Order(ent.Asc(tagalias.FieldName))
+}
+
+// entToDomain 将ent实体转换为领域模型，预加载了别名时包含别名
 func (r *TagRepository) entToDomain(entTag *ent.Tag) *domain.Tag {
-	return &domain.Tag{
+	t := &domain.Tag{
 		ID:        entTag.ID,
 		Name:      entTag.Name,
 		Color:     entTag.Color,
 		CreatedAt: entTag.CreatedAt,
 		UpdatedAt: entTag.UpdatedAt,
 	}
+	for _, alias := range entTag.Edges.Aliases {
+		t.Aliases = append(t.Aliases, alias.Name)
+	}
+	return t
+}
+
+// aliasToDomain 将ent别名转换为领域模型
+func aliasToDomain(entAlias *ent.TagAlias) *domain.TagAlias {
+	return &domain.TagAlias{
+		Name:     entAlias.Name,
+		FormerID: entAlias.FormerID,
+		TagID:    entAlias.TagID,
+	}
 }
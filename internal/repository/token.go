@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+	"goblog/ent"
+	"goblog/ent/refreshtoken"
+	"goblog/ent/revokedtoken"
+	"goblog/internal/domain"
+	"time"
+)
+
+// RefreshTokenRepository 刷新令牌仓储实现
+type RefreshTokenRepository struct {
+	client *ent.Client
+}
+
+// NewRefreshTokenRepository 创建刷新令牌仓储
+func NewRefreshTokenRepository(client *ent.Client) domain.RefreshTokenRepository {
+	return &RefreshTokenRepository{client: client}
+}
+
+// Create 保存刷新令牌
+func (r *RefreshTokenRepository) Create(ctx context.Context, token *domain.RefreshToken) error {
+	create := r.client.RefreshToken.Create().
+		SetTokenHash(token.TokenHash).
+		SetFamilyID(token.FamilyID).
+		SetUsername(token.Username).
+		SetExpiresAt(token.ExpiresAt)
+
+	if token.IP != "" {
+		create = create.SetIP(token.IP)
+	}
+	if token.UserAgent != "" {
+		create = create.SetUserAgent(token.UserAgent)
+	}
+
+	return create.Exec(ctx)
+}
+
+// GetByHash 根据摘要获取刷新令牌
+func (r *RefreshTokenRepository) GetByHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	entToken, err := r.client.RefreshToken.Query().
+		Where(refreshtoken.TokenHash(tokenHash)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &domain.RefreshToken{
+		ID:        entToken.ID,
+		TokenHash: entToken.TokenHash,
+		FamilyID:  entToken.FamilyID,
+		Username:  entToken.Username,
+		IP:        entToken.IP,
+		UserAgent: entToken.UserAgent,
+		ExpiresAt: entToken.ExpiresAt,
+		RevokedAt: entToken.RevokedAt,
+		CreatedAt: entToken.CreatedAt,
+	}, nil
+}
+
+// Revoke 以条件更新吊销令牌，保证并发刷新时只有一个请求能成功轮换
+func (r *RefreshTokenRepository) Revoke(ctx context.Context, tokenHash string) (bool, error) {
+	n, err := r.client.RefreshToken.Update().
+		Where(
+			refreshtoken.TokenHash(tokenHash),
+			refreshtoken.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// RevokeFamily 吊销整个令牌家族
+func (r *RefreshTokenRepository) RevokeFamily(ctx context.Context, familyID string) error {
+	_, err := r.client.RefreshToken.Update().
+		Where(
+			refreshtoken.FamilyID(familyID),
+			refreshtoken.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	return err
+}
+
+// TokenDenylistRepository 访问令牌黑名单仓储实现
+type TokenDenylistRepository struct {
+	client *ent.Client
+}
+
+// NewTokenDenylistRepository 创建访问令牌黑名单仓储
+func NewTokenDenylistRepository(client *ent.Client) domain.TokenDenylistRepository {
+	return &TokenDenylistRepository{client: client}
+}
+
+// Add 将令牌ID加入黑名单，并顺带清理已经过期的记录
+func (r *TokenDenylistRepository) Add(ctx context.Context, jti string, expiresAt time.Time) error {
+	if _, err := r.client.RevokedToken.Delete().
+		Where(revokedtoken.ExpiresAtLT(time.Now())).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	exists, err := r.client.RevokedToken.Query().
+		Where(revokedtoken.Jti(jti)).
+		Exist(ctx)
+	if err != nil || exists {
+		return err
+	}
+
+	return r.client.RevokedToken.Create().
+		SetJti(jti).
+		SetExpiresAt(expiresAt).
+		Exec(ctx)
+}
+
+// Contains 检查令牌ID是否在黑名单中
+func (r *TokenDenylistRepository) Contains(ctx context.Context, jti string) (bool, error) {
+	return r.client.RevokedToken.Query().
+		Where(revokedtoken.Jti(jti)).
+		Exist(ctx)
+}
@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+	"goblog/ent"
+	"goblog/ent/recoverycode"
+	"goblog/ent/twofactor"
+	"goblog/internal/domain"
+	"time"
+)
+
+// TwoFactorRepository 两步验证仓储实现
+type TwoFactorRepository struct {
+	client *ent.Client
+}
+
+// NewTwoFactorRepository 创建两步验证仓储
+func NewTwoFactorRepository(client *ent.Client) domain.TwoFactorRepository {
+	return &TwoFactorRepository{client: client}
+}
+
+// GetByUsername 获取用户的两步验证配置
+func (r *TwoFactorRepository) GetByUsername(ctx context.Context, username string) (*domain.TwoFactor, error) {
+	entTwoFactor, err := r.client.TwoFactor.Query().
+		Where(twofactor.Username(username)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &domain.TwoFactor{
+		Username:     entTwoFactor.Username,
+		Secret:       entTwoFactor.Secret,
+		Enabled:      entTwoFactor.Enabled,
+		LastUsedStep: entTwoFactor.LastUsedStep,
+	}, nil
+}
+
+// Save 创建或覆盖用户的两步验证配置
+func (r *TwoFactorRepository) Save(ctx context.Context, tf *domain.TwoFactor) error {
+	n, err := r.client.TwoFactor.Update().
+		Where(twofactor.Username(tf.Username)).
+		SetSecret(tf.Secret).
+		SetEnabled(tf.Enabled).
+		SetLastUsedStep(tf.LastUsedStep).
+		Save(ctx)
+	if err != nil || n > 0 {
+		return err
+	}
+
+	return r.client.TwoFactor.Create().
+		SetUsername(tf.Username).
+		SetSecret(tf.Secret).
+		SetEnabled(tf.Enabled).
+		SetLastUsedStep(tf.LastUsedStep).
+		Exec(ctx)
+}
+
+// Delete 删除用户的两步验证配置及恢复码
+func (r *TwoFactorRepository) Delete(ctx context.Context, username string) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.RecoveryCode.Delete().
+		Where(recoverycode.Username(username)).
+		Exec(ctx); err != nil {
+		return rollback(tx, err)
+	}
+
+	if _, err := tx.TwoFactor.Delete().
+		Where(twofactor.Username(username)).
+		Exec(ctx); err != nil {
+		return rollback(tx, err)
+	}
+
+	return tx.Commit()
+}
+
+// UseStep 以条件更新记录时间步，保证同一个验证码只能使用一次
+func (r *TwoFactorRepository) UseStep(ctx context.Context, username string, step int64) (bool, error) {
+	n, err := r.client.TwoFactor.Update().
+		Where(
+			twofactor.Username(username),
+			twofactor.LastUsedStepLT(step),
+		).
+		SetLastUsedStep(step).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// ReplaceRecoveryCodes 在事务中替换用户的全部恢复码
+func (r *TwoFactorRepository) ReplaceRecoveryCodes(ctx context.Context, username string, codeHashes []string) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.RecoveryCode.Delete().
+		Where(recoverycode.Username(username)).
+		Exec(ctx); err != nil {
+		return rollback(tx, err)
+	}
+
+	builders := make([]*ent.RecoveryCodeCreate, len(codeHashes))
+	for i, codeHash := range codeHashes {
+		builders[i] = tx.RecoveryCode.Create().
+			SetUsername(username).
+			SetCodeHash(codeHash)
+	}
+	if err := tx.RecoveryCode.CreateBulk(builders...).Exec(ctx); err != nil {
+		return rollback(tx, err)
+	}
+
+	return tx.Commit()
+}
+
+// ConsumeRecoveryCode 以条件更新标记恢复码已使用，并发使用同一个恢复码时只有一个请求成功
+func (r *TwoFactorRepository) ConsumeRecoveryCode(ctx context.Context, username, codeHash string) (bool, error) {
+	n, err := r.client.RecoveryCode.Update().
+		Where(
+			recoverycode.Username(username),
+			recoverycode.CodeHash(codeHash),
+			recoverycode.UsedAtIsNil(),
+		).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
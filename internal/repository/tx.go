@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"goblog/ent"
+	"goblog/internal/domain"
+)
+
+// Transactor 基于ent的事务管理实现
+type Transactor struct {
+	client *ent.Client
+}
+
+// NewTransactor 创建事务管理器
+func NewTransactor(client *ent.Client) domain.Transactor {
+	return &Transactor{client: client}
+}
+
+// WithinTx 在事务中执行fn，fn返回错误时回滚，已在事务中时直接复用外层事务
+func (t *Transactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if ent.TxFromContext(ctx) != nil {
+		return fn(ctx)
+	}
+
+	tx, err := t.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(ent.NewTxContext(ctx, tx)); err != nil {
+		return rollback(tx, err)
+	}
+
+	return tx.Commit()
+}
+
+// txClient 上下文中存在事务时返回事务客户端，使仓储操作加入服务层开启的事务
+func txClient(ctx context.Context, client *ent.Client) *ent.Client {
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		return tx.Client()
+	}
+	return client
+}
+
+// afterCommit 在上下文中的事务提交成功后执行fn，回滚时不执行；不在事务中时立即执行
+func afterCommit(ctx context.Context, fn func(ctx context.Context)) {
+	tx := ent.TxFromContext(ctx)
+	if tx == nil {
+		fn(context.WithoutCancel(ctx))
+		return
+	}
+
+	tx.OnCommit(func(next ent.Committer) ent.Committer {
+		return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+			if err := next.Commit(ctx, tx); err != nil {
+				return err
+			}
+			fn(context.WithoutCancel(ctx))
+			return nil
+		})
+	})
+}
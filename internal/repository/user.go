@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"goblog/ent"
+	"goblog/ent/user"
+	"goblog/internal/domain"
+)
+
+// UserRepository 用户仓储实现
+type UserRepository struct {
+	client *ent.Client
+}
+
+// NewUserRepository 创建用户仓储
+func NewUserRepository(client *ent.Client) domain.UserRepository {
+	return &UserRepository{client: client}
+}
+
+// GetByUsername 根据用户名获取用户
+func (r *UserRepository) GetByUsername(ctx context.Context, username string) (*domain.User, error) {
+	entUser, err := r.client.User.Query().
+		Where(user.Username(username)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return r.entToDomain(entUser), nil
+}
+
+// GetBySubject 根据外部身份提供方和用户标识获取用户
+func (r *UserRepository) GetBySubject(ctx context.Context, issuer, subject string) (*domain.User, error) {
+	entUser, err := r.client.User.Query().
+		Where(user.Issuer(issuer), user.Subject(subject)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return r.entToDomain(entUser), nil
+}
+
+// Create 创建用户
+func (r *UserRepository) Create(ctx context.Context, u *domain.User) (*domain.User, error) {
+	create := r.client.User.Create().
+		SetUsername(u.Username).
+		SetEmail(u.Email).
+		SetDisplayName(u.DisplayName).
+		SetRole(user.Role(u.Role)).
+		SetNillableLastLoginAt(u.LastLoginAt)
+
+	if u.Issuer != "" {
+		create.SetIssuer(u.Issuer).SetSubject(u.Subject)
+	}
+
+	entUser, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, domain.ErrDuplicateResource
+		}
+		return nil, err
+	}
+
+	return r.entToDomain(entUser), nil
+}
+
+// Update 更新用户资料和角色
+func (r *UserRepository) Update(ctx context.Context, id int, u *domain.User) (*domain.User, error) {
+	entUser, err := r.client.User.UpdateOneID(id).
+		SetEmail(u.Email).
+		SetDisplayName(u.DisplayName).
+		SetRole(user.Role(u.Role)).
+		SetNillableLastLoginAt(u.LastLoginAt).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return r.entToDomain(entUser), nil
+}
+
+// entToDomain 将ent实体转换为领域模型
+func (r *UserRepository) entToDomain(entUser *ent.User) *domain.User {
+	u := &domain.User{
+		ID:          entUser.ID,
+		Username:    entUser.Username,
+		Email:       entUser.Email,
+		DisplayName: entUser.DisplayName,
+		Role:        string(entUser.Role),
+		LastLoginAt: entUser.LastLoginAt,
+		CreatedAt:   entUser.CreatedAt,
+		UpdatedAt:   entUser.UpdatedAt,
+	}
+	if entUser.Issuer != nil {
+		u.Issuer = *entUser.Issuer
+	}
+	if entUser.Subject != nil {
+		u.Subject = *entUser.Subject
+	}
+	return u
+}
@@ -0,0 +1,323 @@
+package repository
+
+import (
+	"context"
+	"goblog/ent"
+	"goblog/ent/webhook"
+	"goblog/ent/webhookdelivery"
+	"goblog/internal/domain"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// WebhookRepository Webhook订阅仓储实现
+type WebhookRepository struct {
+	client *ent.Client
+}
+
+// NewWebhookRepository 创建Webhook订阅仓储
+func NewWebhookRepository(client *ent.Client) domain.WebhookRepository {
+	return &WebhookRepository{client: client}
+}
+
+// Create 创建订阅
+func (r *WebhookRepository) Create(ctx context.Context, w *domain.Webhook) (*domain.Webhook, error) {
+	entWebhook, err := r.client.Webhook.Create().
+		SetURL(w.URL).
+		SetSecret(w.Secret).
+		SetEvents(w.Events).
+		SetDescription(w.Description).
+		SetActive(w.Active).
+		SetCreatedBy(w.CreatedBy).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.entToDomain(entWebhook), nil
+}
+
+// GetByID 根据ID获取订阅
+func (r *WebhookRepository) GetByID(ctx context.Context, id int) (*domain.Webhook, error) {
+	entWebhook, err := r.client.Webhook.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return r.entToDomain(entWebhook), nil
+}
+
+// Update 更新订阅
+func (r *WebhookRepository) Update(ctx context.Context, id int, w *domain.Webhook) (*domain.Webhook, error) {
+	entWebhook, err := r.client.Webhook.UpdateOneID(id).
+		SetURL(w.URL).
+		SetSecret(w.Secret).
+		SetEvents(w.Events).
+		SetDescription(w.Description).
+		SetActive(w.Active).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return r.entToDomain(entWebhook), nil
+}
+
+// Delete 删除订阅，投递记录作为日志保留
+func (r *WebhookRepository) Delete(ctx context.Context, id int) error {
+	err := r.client.Webhook.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// List 获取全部订阅
+func (r *WebhookRepository) List(ctx context.Context) ([]*domain.Webhook, error) {
+	entWebhooks, err := r.client.Webhook.Query().
+		Order(ent.Asc(webhook.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.entsToDomain(entWebhooks), nil
+}
+
+// ListActive 获取已启用的订阅
+func (r *WebhookRepository) ListActive(ctx context.Context) ([]*domain.Webhook, error) {
+	entWebhooks, err := r.client.Webhook.Query().
+		Where(webhook.Active(true)).
+		Order(ent.Asc(webhook.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.entsToDomain(entWebhooks), nil
+}
+
+// entsToDomain 批量转换ent实体
+func (r *WebhookRepository) entsToDomain(entWebhooks []*ent.Webhook) []*domain.Webhook {
+	webhooks := make([]*domain.Webhook, len(entWebhooks))
+	for i, entWebhook := range entWebhooks {
+		webhooks[i] = r.entToDomain(entWebhook)
+	}
+	return webhooks
+}
+
+// entToDomain 将ent实体转换为领域模型
+func (r *WebhookRepository) entToDomain(entWebhook *ent.Webhook) *domain.Webhook {
+	return &domain.Webhook{
+		ID:          entWebhook.ID,
+		URL:         entWebhook.URL,
+		Secret:      entWebhook.Secret,
+		Events:      entWebhook.Events,
+		Description: entWebhook.Description,
+		Active:      entWebhook.Active,
+		CreatedBy:   entWebhook.CreatedBy,
+		CreatedAt:   entWebhook.CreatedAt,
+		UpdatedAt:   entWebhook.UpdatedAt,
+	}
+}
+
+// WebhookDeliveryRepository Webhook投递仓储实现
+type WebhookDeliveryRepository struct {
+	client *ent.Client
+}
+
+// NewWebhookDeliveryRepository 创建Webhook投递仓储
+func NewWebhookDeliveryRepository(client *ent.Client) domain.WebhookDeliveryRepository {
+	return &WebhookDeliveryRepository{client: client}
+}
+
+// CreateBulk 批量创建待投递记录
+func (r *WebhookDeliveryRepository) CreateBulk(ctx context.Context, deliveries []*domain.WebhookDelivery) error {
+	builders := make([]*ent.WebhookDeliveryCreate, len(deliveries))
+	for i, d := range deliveries {
+		builders[i] = r.client.WebhookDelivery.Create().
+			SetWebhookID(d.WebhookID).
+			SetEventID(d.EventID).
+			SetEvent(d.Event).
+			SetPayload(d.Payload).
+			SetReplayOf(d.ReplayOf)
+	}
+
+	created, err := r.client.WebhookDelivery.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i, entDelivery := range created {
+		*deliveries[i] = *r.entToDomain(entDelivery)
+	}
+	return nil
+}
+
+// CreateForEvent 批量创建一个事件的投递记录，与 (webhook_id, event_id) 唯一索引冲突的记录跳过
+func (r *WebhookDeliveryRepository) CreateForEvent(ctx context.Context, deliveries []*domain.WebhookDelivery) error {
+	builders := make([]*ent.WebhookDeliveryCreate, len(deliveries))
+	for i, d := range deliveries {
+		builders[i] = r.client.WebhookDelivery.Create().
+			SetWebhookID(d.WebhookID).
+			SetEventID(d.EventID).
+			SetEvent(d.Event).
+			SetPayload(d.Payload).
+			SetReplayOf(0)
+	}
+
+	// 部分唯一索引的条件必须以字面量写出，数据库才能匹配到该索引
+	return r.client.WebhookDelivery.CreateBulk(builders...).
+		OnConflict(
+			sql.ConflictColumns(webhookdelivery.FieldWebhookID, webhookdelivery.FieldEventID),
+			sql.ConflictWhere(sql.ExprP("replay_of = 0")),
+			sql.DoNothing(),
+		).
+		Exec(ctx)
+}
+
+// GetByID 根据ID获取投递记录
+func (r *WebhookDeliveryRepository) GetByID(ctx context.Context, id int) (*domain.WebhookDelivery, error) {
+	entDelivery, err := r.client.WebhookDelivery.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return r.entToDomain(entDelivery), nil
+}
+
+// List 获取订阅的投递记录，按时间倒序
+func (r *WebhookDeliveryRepository) List(ctx context.Context, webhookID int, params domain.WebhookDeliveryQueryParams) ([]*domain.WebhookDelivery, int64, error) {
+	query := r.client.WebhookDelivery.Query().
+		Where(webhookdelivery.WebhookID(webhookID)).
+		Order(ent.Desc(webhookdelivery.FieldCreatedAt), ent.Desc(webhookdelivery.FieldID))
+
+	// 添加过滤条件
+	if params.Status != "" {
+		query = query.Where(webhookdelivery.StatusEQ(webhookdelivery.Status(params.Status)))
+	}
+	if params.Event != "" {
+		query = query.Where(webhookdelivery.Event(params.Event))
+	}
+
+	// 获取总数
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// 分页
+	if params.Page > 0 && params.Limit > 0 {
+		offset := (params.Page - 1) * params.Limit
+		query = query.Offset(offset).Limit(params.Limit)
+	}
+
+	entDeliveries, err := query.All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	deliveries := make([]*domain.WebhookDelivery, len(entDeliveries))
+	for i, entDelivery := range entDeliveries {
+		deliveries[i] = r.entToDomain(entDelivery)
+	}
+
+	return deliveries, int64(total), nil
+}
+
+// ClaimDue 领取到期的待投递记录，只有成功推迟下次尝试时间的记录才算领取成功
+func (r *WebhookDeliveryRepository) ClaimDue(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]*domain.WebhookDelivery, error) {
+	entDeliveries, err := r.client.WebhookDelivery.Query().
+		Where(
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+			webhookdelivery.NextAttemptAtLTE(now),
+		).
+		Order(ent.Asc(webhookdelivery.FieldNextAttemptAt), ent.Asc(webhookdelivery.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	leaseUntil := now.Add(lease)
+	claimed := make([]*domain.WebhookDelivery, 0, len(entDeliveries))
+	for _, entDelivery := range entDeliveries {
+		n, err := r.client.WebhookDelivery.Update().
+			Where(
+				webhookdelivery.ID(entDelivery.ID),
+				webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+				webhookdelivery.NextAttemptAt(entDelivery.NextAttemptAt),
+			).
+			SetNextAttemptAt(leaseUntil).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			// 已被其他实例领取
+			continue
+		}
+
+		delivery := r.entToDomain(entDelivery)
+		delivery.NextAttemptAt = leaseUntil
+		claimed = append(claimed, delivery)
+	}
+
+	return claimed, nil
+}
+
+// SaveResult 保存投递结果
+func (r *WebhookDeliveryRepository) SaveResult(ctx context.Context, d *domain.WebhookDelivery) error {
+	err := r.client.WebhookDelivery.UpdateOneID(d.ID).
+		SetStatus(webhookdelivery.Status(d.Status)).
+		SetAttempts(d.Attempts).
+		SetNextAttemptAt(d.NextAttemptAt).
+		SetResponseStatus(d.ResponseStatus).
+		SetResponseBody(d.ResponseBody).
+		SetLastError(d.LastError).
+		SetDurationMs(d.DurationMS).
+		SetNillableDeliveredAt(d.DeliveredAt).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// entToDomain 将ent实体转换为领域模型
+func (r *WebhookDeliveryRepository) entToDomain(entDelivery *ent.WebhookDelivery) *domain.WebhookDelivery {
+	return &domain.WebhookDelivery{
+		ID:             entDelivery.ID,
+		WebhookID:      entDelivery.WebhookID,
+		EventID:        entDelivery.EventID,
+		Event:          entDelivery.Event,
+		Payload:        entDelivery.Payload,
+		Status:         string(entDelivery.Status),
+		Attempts:       entDelivery.Attempts,
+		NextAttemptAt:  entDelivery.NextAttemptAt,
+		ResponseStatus: entDelivery.ResponseStatus,
+		ResponseBody:   entDelivery.ResponseBody,
+		LastError:      entDelivery.LastError,
+		DurationMS:     entDelivery.DurationMs,
+		ReplayOf:       entDelivery.ReplayOf,
+		DeliveredAt:    entDelivery.DeliveredAt,
+		CreatedAt:      entDelivery.CreatedAt,
+		UpdatedAt:      entDelivery.UpdatedAt,
+	}
+}
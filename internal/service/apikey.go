@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"goblog/internal/config"
+	"goblog/internal/domain"
+)
+
+const (
+	// apiKeyPrefix API密钥的固定前缀，便于密钥扫描工具识别泄露的密钥
+	apiKeyPrefix = "gb_"
+	// apiKeyDisplayLength 列表中展示的密钥前缀长度
+	apiKeyDisplayLength = 11
+	// apiKeyTouchInterval 最近使用时间的更新间隔，避免每个请求都写数据库
+	apiKeyTouchInterval = time.Minute
+)
+
+// APIKeyService API密钥服务实现
+type APIKeyService struct {
+	apiKeyRepo domain.APIKeyRepository
+	userRepo   domain.UserRepository
+	config     *config.Config
+}
+
+// NewAPIKeyService 创建API密钥服务
+func NewAPIKeyService(apiKeyRepo domain.APIKeyRepository, userRepo domain.UserRepository, cfg *config.Config) domain.APIKeyService {
+	return &APIKeyService{
+		apiKeyRepo: apiKeyRepo,
+		userRepo:   userRepo,
+		config:     cfg,
+	}
+}
+
+// Create 创建API密钥，返回的明文密钥只出现这一次
+func (s *APIKeyService) Create(ctx context.Context, username string, req *domain.APIKeyCreateRequest) (*domain.APIKeyCreated, error) {
+	scopes := make([]string, 0, len(req.Scopes))
+	for _, scope := range req.Scopes {
+		if !slices.Contains(domain.APIKeyScopes, scope) {
+			return nil, domain.ErrInvalidInput
+		}
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+	if len(scopes) == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return nil, domain.ErrInvalidInput
+	}
+
+	secret, err := randomToken(32)
+	if err != nil {
+		return nil, err
+	}
+	key := apiKeyPrefix + secret
+
+	created, err := s.apiKeyRepo.Create(ctx, &domain.APIKey{
+		Name:      req.Name,
+		Username:  username,
+		Prefix:    key[:apiKeyDisplayLength],
+		KeyHash:   hashToken(key),
+		Scopes:    scopes,
+		ExpiresAt: req.ExpiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.APIKeyCreated{APIKey: created, Key: key}, nil
+}
+
+// List 获取用户自己的API密钥
+func (s *APIKeyService) List(ctx context.Context, username string) ([]*domain.APIKey, error) {
+	return s.apiKeyRepo.ListByUsername(ctx, username)
+}
+
+// Revoke 吊销用户自己的API密钥
+func (s *APIKeyService) Revoke(ctx context.Context, username string, id int) error {
+	return s.apiKeyRepo.Revoke(ctx, id, username)
+}
+
+// Authenticate 验证API密钥，返回密钥所属用户及其权限范围
+func (s *APIKeyService) Authenticate(ctx context.Context, key string) (*domain.Principal, error) {
+	apiKey, err := s.apiKeyRepo.GetByHash(ctx, hashToken(key))
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, domain.ErrUnauthorized
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if apiKey.RevokedAt != nil || (apiKey.ExpiresAt != nil && now.After(*apiKey.ExpiresAt)) {
+		return nil, domain.ErrUnauthorized
+	}
+
+	// 角色跟随密钥所属用户，用户被删除后密钥随之失效
+	role, err := userRole(ctx, s.config, s.userRepo, apiKey.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	if apiKey.LastUsedAt == nil || now.Sub(*apiKey.LastUsedAt) >= apiKeyTouchInterval {
+		if err := s.apiKeyRepo.Touch(ctx, apiKey.ID, now); err != nil {
+			return nil, err
+		}
+	}
+
+	return &domain.Principal{
+		Username: apiKey.Username,
+		Role:     role,
+		APIKeyID: apiKey.ID,
+		Scopes:   apiKey.Scopes,
+	}, nil
+}
@@ -5,17 +5,50 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"goblog/internal/domain"
 )
 
+// articleTransitions 文章状态允许的流转及可以执行该流转的角色。
+// 作者提交审核或撤回，编辑和管理员审核、发布和归档；编辑可以跳过审核直接发布
+var articleTransitions = map[string]map[string][]string{
+	domain.ArticleStatusDraft: {
+		domain.ArticleStatusInReview:  {domain.RoleAuthor, domain.RoleEditor, domain.RoleAdmin},
+		domain.ArticleStatusPublished: {domain.RoleEditor, domain.RoleAdmin},
+	},
+	domain.ArticleStatusInReview: {
+		domain.ArticleStatusApproved: {domain.RoleEditor, domain.RoleAdmin},
+		domain.ArticleStatusDraft:    {domain.RoleAuthor, domain.RoleEditor, domain.RoleAdmin},
+	},
+	domain.ArticleStatusApproved: {
+		domain.ArticleStatusPublished: {domain.RoleEditor, domain.RoleAdmin},
+		domain.ArticleStatusDraft:     {domain.RoleEditor, domain.RoleAdmin},
+	},
+	domain.ArticleStatusPublished: {
+		domain.ArticleStatusArchived: {domain.RoleEditor, domain.RoleAdmin},
+		domain.ArticleStatusDraft:    {domain.RoleEditor, domain.RoleAdmin},
+	},
+	domain.ArticleStatusArchived: {
+		domain.ArticleStatusPublished: {domain.RoleEditor, domain.RoleAdmin},
+		domain.ArticleStatusDraft:     {domain.RoleEditor, domain.RoleAdmin},
+	},
+}
+
+// reviewerRoles 可以添加审阅备注、修改和删除任意文章，以及管理分类、标签和评论的角色
+var reviewerRoles = []string{domain.RoleEditor, domain.RoleAdmin}
+
 // ArticleService 文章服务实现
 type ArticleService struct {
 	articleRepo  domain.ArticleRepository
 	categoryRepo domain.CategoryRepository
 	tagRepo      domain.TagRepository
+	reviewRepo   domain.ArticleReviewRepository
+	tx           domain.Transactor
+	events       domain.EventPublisher
 }
 
 // NewArticleService 创建文章服务
@@ -23,21 +56,37 @@ func NewArticleService(
 	articleRepo domain.ArticleRepository,
 	categoryRepo domain.CategoryRepository,
 	tagRepo domain.TagRepository,
+	reviewRepo domain.ArticleReviewRepository,
+	tx domain.Transactor,
+	events domain.EventPublisher,
 ) domain.ArticleService {
 	return &ArticleService{
 		articleRepo:  articleRepo,
 		categoryRepo: categoryRepo,
 		tagRepo:      tagRepo,
+		reviewRepo:   reviewRepo,
+		tx:           tx,
+		events:       events,
 	}
 }
 
-// Create 创建文章
+// Create 创建文章，新文章为草稿；请求中published为true时直接发布，需要有发布权限
 func (s *ArticleService) Create(ctx context.Context, req *domain.ArticleCreateRequest) (*domain.Article, error) {
 	article := &domain.Article{
-		Title:     req.Title,
-		Content:   req.Content,
-		Summary:   req.Summary,
-		Published: req.Published,
+		Title:   req.Title,
+		Content: req.Content,
+		Summary: req.Summary,
+		Status:  domain.ArticleStatusDraft,
+	}
+	if actor := domain.ActorFromContext(ctx); actor != nil {
+		article.Author = actor.Username
+	}
+	if req.Published {
+		if err := checkArticleTransition(ctx, domain.ArticleStatusDraft, domain.ArticleStatusPublished); err != nil {
+			return nil, err
+		}
+		article.Status = domain.ArticleStatusPublished
+		article.Published = true
 	}
 
 	// 验证分类是否存在
@@ -64,7 +113,26 @@ func (s *ArticleService) Create(ctx context.Context, req *domain.ArticleCreateRe
 		}
 	}
 
-	return s.articleRepo.Create(ctx, article)
+	// 文章和事件在同一事务中写入
+	var created *domain.Article
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		created, err = s.articleRepo.Create(ctx, article)
+		if err != nil {
+			return err
+		}
+
+		events := []domain.Event{domain.ArticleCreated{Article: created}}
+		if created.Published {
+			events = append(events, domain.ArticlePublished{Article: created})
+		}
+		return s.events.Publish(ctx, events...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
 }
 
 // GetByID 根据ID获取文章
@@ -72,19 +140,45 @@ func (s *ArticleService) GetByID(ctx context.Context, id int) (*domain.Article,
 	return s.articleRepo.GetByID(ctx, id)
 }
 
-// Update 更新文章
+// Update 更新文章内容，作者只能修改自己的草稿，请求中的版本号必须与当前版本一致。请求中的published字段兼容旧客户端：true发布文章，
+// false将已发布的文章撤回为草稿，同样需要满足状态流转和角色要求
 func (s *ArticleService) Update(ctx context.Context, id int, req *domain.ArticleUpdateRequest) (*domain.Article, error) {
 	// 检查文章是否存在
-	_, err := s.articleRepo.GetByID(ctx, id)
+	before, err := s.articleRepo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
+	if err := checkArticleOwner(ctx, before); err != nil {
+		return nil, err
+	}
+
+	// 客户端基于旧版本修改时拒绝更新，返回服务端当前内容供客户端合并
+	if req.Version != before.Version {
+		return nil, &domain.ConflictError{Err: domain.ErrVersionConflict, Current: before}
+	}
+
+	status := before.Status
+	if req.Published != nil {
+		if *req.Published {
+			status = domain.ArticleStatusPublished
+		} else if before.Status == domain.ArticleStatusPublished {
+			status = domain.ArticleStatusDraft
+		}
+	}
+	if status != before.Status {
+		if err := checkArticleTransition(ctx, before.Status, status); err != nil {
+			return nil, err
+		}
+	}
 
 	article := &domain.Article{
-		Title:     req.Title,
-		Content:   req.Content,
-		Summary:   req.Summary,
-		Published: req.Published,
+		Title:   req.Title,
+		Content: req.Content,
+		Summary: req.Summary,
+		Version: req.Version,
+	}
+	if status != before.Status {
+		article.Status = status
 	}
 
 	// 验证分类是否存在
@@ -111,53 +205,332 @@ func (s *ArticleService) Update(ctx context.Context, id int, req *domain.Article
 		}
 	}
 
-	return s.articleRepo.Update(ctx, id, article)
+	var updated *domain.Article
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		updated, err = s.articleRepo.Update(ctx, id, article)
+		if err != nil {
+			return err
+		}
+		if status != before.Status {
+			if err := s.recordTransition(ctx, before, status, ""); err != nil {
+				return err
+			}
+		}
+
+		events := []domain.Event{domain.ArticleUpdated{Before: before, Article: updated}}
+		// 草稿首次发布
+		if updated.Published && !before.Published {
+			events = append(events, domain.ArticlePublished{Article: updated})
+		}
+		return s.events.Publish(ctx, events...)
+	})
+	if errors.Is(err, domain.ErrVersionConflict) {
+		// 读取之后被他人抢先更新
+		current, getErr := s.articleRepo.GetByID(ctx, id)
+		if getErr != nil {
+			return nil, getErr
+		}
+		return nil, &domain.ConflictError{Err: domain.ErrVersionConflict, Current: current}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
 }
 
-// Delete 删除文章
+// Delete 删除文章，作者只能删除自己的草稿
 func (s *ArticleService) Delete(ctx context.Context, id int) error {
-	return s.articleRepo.Delete(ctx, id)
+	before, err := s.articleRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := checkArticleOwner(ctx, before); err != nil {
+		return err
+	}
+
+	return s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.articleRepo.Delete(ctx, id); err != nil {
+			return err
+		}
+		return s.events.Publish(ctx, domain.ArticleDeleted{Article: before})
+	})
+}
+
+// Transition 按编辑流程变更文章状态，可同时附带审阅备注
+func (s *ArticleService) Transition(ctx context.Context, id int, req *domain.ArticleTransitionRequest) (*domain.Article, error) {
+	if !slices.Contains(domain.ArticleStatuses, req.Status) {
+		return nil, domain.ErrInvalidInput
+	}
+
+	before, err := s.articleRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkArticleTransition(ctx, before.Status, req.Status); err != nil {
+		return nil, err
+	}
+
+	var updated *domain.Article
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		updated, err = s.changeStatus(ctx, before, req.Status, req.Note)
+		if err != nil {
+			return err
+		}
+
+		events := []domain.Event{domain.ArticleUpdated{Before: before, Article: updated}}
+		if updated.Published && !before.Published {
+			events = append(events, domain.ArticlePublished{Article: updated})
+		}
+		return s.events.Publish(ctx, events...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// AddReviewNote 审阅者为文章添加备注，不改变文章状态
+func (s *ArticleService) AddReviewNote(ctx context.Context, id int, req *domain.ArticleReviewNoteRequest) (*domain.ArticleReview, error) {
+	if actor := domain.ActorFromContext(ctx); actor != nil && !slices.Contains(reviewerRoles, actor.Role) {
+		return nil, domain.ErrForbidden
+	}
+
+	article, err := s.articleRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.reviewRepo.Create(ctx, &domain.ArticleReview{
+		ArticleID:  id,
+		Reviewer:   reviewerName(ctx),
+		FromStatus: article.Status,
+		ToStatus:   article.Status,
+		Note:       req.Note,
+	})
+}
+
+// ListReviews 获取文章的状态流转和审阅备注记录
+func (s *ArticleService) ListReviews(ctx context.Context, id int) ([]*domain.ArticleReview, error) {
+	if _, err := s.articleRepo.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.reviewRepo.ListByArticle(ctx, id)
+}
+
+// changeStatus 更新文章状态并记录流转，需在事务中调用
+func (s *ArticleService) changeStatus(ctx context.Context, before *domain.Article, status, note string) (*domain.Article, error) {
+	updated, err := s.articleRepo.UpdateStatus(ctx, before.ID, status)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.recordTransition(ctx, before, status, note); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// recordTransition 记录状态流转的审阅历史
+func (s *ArticleService) recordTransition(ctx context.Context, before *domain.Article, status, note string) error {
+	_, err := s.reviewRepo.Create(ctx, &domain.ArticleReview{
+		ArticleID:  before.ID,
+		Reviewer:   reviewerName(ctx),
+		FromStatus: before.Status,
+		ToStatus:   status,
+		Note:       note,
+	})
+	return err
+}
+
+// checkArticleTransition 校验状态流转是否允许以及当前用户的角色能否执行；没有操作者的内部调用只校验流转
+func checkArticleTransition(ctx context.Context, from, to string) error {
+	roles, ok := articleTransitions[from][to]
+	if !ok {
+		return domain.ErrInvalidTransition
+	}
+	if actor := domain.ActorFromContext(ctx); actor != nil && !slices.Contains(roles, actor.Role) {
+		return domain.ErrForbidden
+	}
+	return nil
+}
+
+// checkReviewer 只有编辑和管理员可以执行；没有操作者的内部调用不校验
+func checkReviewer(ctx context.Context) error {
+	if actor := domain.ActorFromContext(ctx); actor != nil && !slices.Contains(reviewerRoles, actor.Role) {
+		return domain.ErrForbidden
+	}
+	return nil
+}
+
+// checkArticleOwner 作者只能修改和删除自己的草稿，其他人的文章或已进入审核流程的文章需要编辑或管理员；没有操作者的内部调用不校验
+func checkArticleOwner(ctx context.Context, article *domain.Article) error {
+	actor := domain.ActorFromContext(ctx)
+	if actor == nil || slices.Contains(reviewerRoles, actor.Role) {
+		return nil
+	}
+	if article.Author != actor.Username || article.Status != domain.ArticleStatusDraft {
+		return domain.ErrForbidden
+	}
+	return nil
+}
+
+// validateQueryParams 校验文章列表的过滤条件、排序和分页游标
+func validateQueryParams(params domain.QueryParams) error {
+	if params.Status != "" && !slices.Contains(domain.ArticleStatuses, params.Status) {
+		return domain.ErrInvalidInput
+	}
+	if params.Sort != "" && !slices.Contains(domain.ArticleSortFields, params.Sort) {
+		return domain.ErrInvalidInput
+	}
+	switch params.TagMatch {
+	case "", domain.TagMatchAny, domain.TagMatchAll, domain.TagMatchNone:
+	default:
+		return domain.ErrInvalidInput
+	}
+	if params.After != "" && params.Before != "" {
+		return domain.ErrInvalidInput
+	}
+	for _, facet := range params.Facets {
+		if !slices.Contains(domain.ArticleFacets, facet) {
+			return domain.ErrInvalidInput
+		}
+	}
+	for _, field := range params.Fields {
+		if !slices.Contains(domain.ArticleFields, field) {
+			return domain.ErrInvalidInput
+		}
+	}
+	// 按评论数排序时排序值随时变化，只支持页码分页
+	if params.Cursored() && params.Sort == domain.ArticleSortPopularity {
+		return domain.ErrInvalidInput
+	}
+	for _, r := range [][2]*time.Time{
+		{params.CreatedFrom, params.CreatedTo},
+		{params.UpdatedFrom, params.UpdatedTo},
+		{params.PublishedFrom, params.PublishedTo},
+	} {
+		if r[0] != nil && r[1] != nil && !r[0].Before(*r[1]) {
+			return domain.ErrInvalidInput
+		}
+	}
+	return nil
+}
+
+// reviewerName 返回记录在审阅记录中的操作者
+func reviewerName(ctx context.Context) string {
+	if actor := domain.ActorFromContext(ctx); actor != nil && actor.Username != "" {
+		return actor.Username
+	}
+	return auditSystemActor
 }
 
 // List 获取文章列表
-func (s *ArticleService) List(ctx context.Context, params domain.QueryParams) ([]*domain.Article, int64, error) {
+func (s *ArticleService) List(ctx context.Context, params domain.QueryParams) (*domain.ArticlePage, error) {
+	if err := validateQueryParams(params); err != nil {
+		return nil, err
+	}
 	return s.articleRepo.List(ctx, params)
 }
 
 // ListByCategory 按分类获取文章
-func (s *ArticleService) ListByCategory(ctx context.Context, categoryID int, params domain.QueryParams) ([]*domain.Article, int64, error) {
+func (s *ArticleService) ListByCategory(ctx context.Context, categoryID int, params domain.QueryParams) (*domain.ArticlePage, error) {
+	if err := validateQueryParams(params); err != nil {
+		return nil, err
+	}
+
 	// 验证分类是否存在
 	_, err := s.categoryRepo.GetByID(ctx, categoryID)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 
 	return s.articleRepo.ListByCategory(ctx, categoryID, params)
 }
 
 // ListByTag 按标签获取文章
-func (s *ArticleService) ListByTag(ctx context.Context, tagID int, params domain.QueryParams) ([]*domain.Article, int64, error) {
-	// 验证标签是否存在
-	_, err := s.tagRepo.GetByID(ctx, tagID)
+func (s *ArticleService) ListByTag(ctx context.Context, tagID int, params domain.QueryParams) (*domain.ArticlePage, error) {
+	if err := validateQueryParams(params); err != nil {
+		return nil, err
+	}
+
+	// 验证标签是否存在，已被合并的标签返回MovedError
+	_, err := getTag(ctx, s.tagRepo, tagID)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 
 	return s.articleRepo.ListByTag(ctx, tagID, params)
 }
 
-// BackupAll 备份所有文章为ZIP压缩包
+// Retag 为过滤条件选出的文章批量添加和移除标签，仅编辑和管理员可操作。
+// 至少需要一个过滤条件，避免误改全部文章；同一标签不能同时添加和移除
+func (s *ArticleService) Retag(ctx context.Context, params domain.QueryParams, req *domain.ArticleRetagRequest) (*domain.ArticleRetag, error) {
+	if actor := domain.ActorFromContext(ctx); actor != nil && !slices.Contains(reviewerRoles, actor.Role) {
+		return nil, domain.ErrForbidden
+	}
+	if err := validateQueryParams(params); err != nil {
+		return nil, err
+	}
+	if !params.Filtered() || len(req.AddTagIDs)+len(req.RemoveTagIDs) == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	add := uniqueIDs(req.AddTagIDs)
+	remove := uniqueIDs(req.RemoveTagIDs)
+	for _, id := range add {
+		if slices.Contains(remove, id) {
+			return nil, domain.ErrInvalidInput
+		}
+	}
+
+	var result *domain.ArticleRetag
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		tagIDs := append(slices.Clone(add), remove...)
+		tags, err := s.tagRepo.GetByIDs(ctx, tagIDs)
+		if err != nil {
+			return err
+		}
+		if len(tags) != len(tagIDs) {
+			return domain.ErrInvalidInput
+		}
+
+		result, err = s.articleRepo.Retag(ctx, params, add, remove)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// uniqueIDs 返回去重排序后的ID
+func uniqueIDs(ids []int) []int {
+	ids = slices.Clone(ids)
+	slices.Sort(ids)
+	return slices.Compact(ids)
+}
+
+// BackupAll 备份所有文章为ZIP压缩包，包含草稿等未发布文章，只有编辑和管理员可以备份
 func (s *ArticleService) BackupAll(ctx context.Context) ([]byte, error) {
+	if err := checkReviewer(ctx); err != nil {
+		return nil, err
+	}
+
 	// 获取所有文章（不分页）
 	params := domain.QueryParams{
 		Page:  1,
 		Limit: 10000, // 设置一个足够大的值来获取所有文章
 	}
 
-	articles, _, err := s.articleRepo.List(ctx, params)
+	page, err := s.articleRepo.List(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("获取文章列表失败: %w", err)
 	}
+	articles := page.Items
 
 	// 创建ZIP缓冲区
 	var buf bytes.Buffer
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"goblog/internal/config"
+	"goblog/internal/domain"
+)
+
+// lockTakeoverRoles 可以接管他人编辑租约的角色
+var lockTakeoverRoles = []string{domain.RoleEditor, domain.RoleAdmin}
+
+// ArticleLockService 文章编辑租约服务实现
+type ArticleLockService struct {
+	lockRepo    domain.ArticleLockRepository
+	articleRepo domain.ArticleRepository
+	config      config.ArticleConfig
+}
+
+// NewArticleLockService 创建文章编辑租约服务
+func NewArticleLockService(lockRepo domain.ArticleLockRepository, articleRepo domain.ArticleRepository, cfg config.ArticleConfig) domain.ArticleLockService {
+	return &ArticleLockService{
+		lockRepo:    lockRepo,
+		articleRepo: articleRepo,
+		config:      cfg,
+	}
+}
+
+// Get 获取文章当前有效的编辑租约，没有或已过期时返回ErrNotFound
+func (s *ArticleLockService) Get(ctx context.Context, articleID int) (*domain.ArticleLock, error) {
+	if _, err := s.articleRepo.GetByID(ctx, articleID); err != nil {
+		return nil, err
+	}
+
+	lock, err := s.lockRepo.Get(ctx, articleID)
+	if err != nil {
+		return nil, err
+	}
+	if !lock.ExpiresAt.After(time.Now()) {
+		return nil, domain.ErrNotFound
+	}
+	return lock, nil
+}
+
+// Acquire 获取或续期编辑租约。租约被他人持有时返回冲突错误，编辑和管理员可以强制接管
+func (s *ArticleLockService) Acquire(ctx context.Context, articleID int, req *domain.ArticleLockRequest) (*domain.ArticleLock, error) {
+	actor := domain.ActorFromContext(ctx)
+	if actor == nil || actor.Username == "" {
+		return nil, domain.ErrUnauthorized
+	}
+	if req.Force && !slices.Contains(lockTakeoverRoles, actor.Role) {
+		return nil, domain.ErrForbidden
+	}
+
+	if _, err := s.articleRepo.GetByID(ctx, articleID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	lock, err := s.lockRepo.Acquire(ctx, articleID, actor.Username, now.Add(s.config.LockTTL), now, req.Force)
+	if errors.Is(err, domain.ErrLocked) {
+		return nil, &domain.ConflictError{Err: domain.ErrLocked, Current: lock}
+	}
+	return lock, err
+}
+
+// Release 释放编辑租约，只有持有者、编辑和管理员可以释放
+func (s *ArticleLockService) Release(ctx context.Context, articleID int) error {
+	actor := domain.ActorFromContext(ctx)
+	if actor == nil || actor.Username == "" {
+		return domain.ErrUnauthorized
+	}
+
+	lock, err := s.lockRepo.Get(ctx, articleID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	active := lock.ExpiresAt.After(time.Now())
+	if active && lock.Holder != actor.Username && !slices.Contains(lockTakeoverRoles, actor.Role) {
+		return domain.ErrForbidden
+	}
+
+	return s.lockRepo.Delete(ctx, articleID)
+}
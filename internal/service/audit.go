@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+
+	"goblog/internal/domain"
+)
+
+const (
+	// auditSubscriber 审计订阅者名称
+	auditSubscriber = "audit"
+	// auditSystemActor 上下文中没有操作者时记录的名称，例如命令行任务
+	auditSystemActor = "system"
+)
+
+// auditIgnoredFields 不参与差异比较的字段，ID已单独记录，时间戳每次写入都会变化
+var auditIgnoredFields = map[string]bool{
+	"id":         true,
+	"created_at": true,
+	"updated_at": true,
+}
+
+// AuditService 审计服务实现
+type AuditService struct {
+	auditRepo domain.AuditRepository
+}
+
+// NewAuditService 创建审计服务
+func NewAuditService(auditRepo domain.AuditRepository) domain.AuditService {
+	return &AuditService{auditRepo: auditRepo}
+}
+
+// Record 记录一次写操作及字段变更，发件箱重复投递同一事件时只记录一次
+func (s *AuditService) Record(ctx context.Context, entityType string, entityID int, action string, before, after interface{}) error {
+	changes, err := auditDiff(before, after)
+	if err != nil {
+		return err
+	}
+
+	log := &domain.AuditLog{
+		Actor:      auditSystemActor,
+		EntityType: entityType,
+		EntityID:   entityID,
+		Action:     action,
+		Changes:    changes,
+		EventID:    domain.EventIDFromContext(ctx),
+	}
+	if actor := domain.ActorFromContext(ctx); actor != nil {
+		log.Actor = actor.Username
+		log.ActorRole = actor.Role
+		log.APIKeyID = actor.APIKeyID
+		log.IP = actor.IP
+		log.RequestID = actor.RequestID
+	}
+
+	_, err = s.auditRepo.Create(ctx, log)
+	return err
+}
+
+// List 获取审计日志列表
+func (s *AuditService) List(ctx context.Context, params domain.AuditQueryParams) ([]*domain.AuditLog, int64, error) {
+	return s.auditRepo.List(ctx, params)
+}
+
+// SubscribeAudit 注册审计订阅者，记录文章、分类和标签的写操作
+func SubscribeAudit(bus *domain.EventBus, auditService domain.AuditService) {
+	domain.On(bus, auditSubscriber, func(ctx context.Context, e domain.ArticleCreated) error {
+		return auditService.Record(ctx, domain.AuditEntityArticle, e.Article.ID, domain.AuditActionCreate, nil, e.Article)
+	})
+	domain.On(bus, auditSubscriber, func(ctx context.Context, e domain.ArticleUpdated) error {
+		return auditService.Record(ctx, domain.AuditEntityArticle, e.Article.ID, domain.AuditActionUpdate, e.Before, e.Article)
+	})
+	domain.On(bus, auditSubscriber, func(ctx context.Context, e domain.ArticleDeleted) error {
+		return auditService.Record(ctx, domain.AuditEntityArticle, e.Article.ID, domain.AuditActionDelete, e.Article, nil)
+	})
+	domain.On(bus, auditSubscriber, func(ctx context.Context, e domain.CategoryCreated) error {
+		return auditService.Record(ctx, domain.AuditEntityCategory, e.Category.ID, domain.AuditActionCreate, nil, e.Category)
+	})
+	domain.On(bus, auditSubscriber, func(ctx context.Context, e domain.CategoryUpdated) error {
+		return auditService.Record(ctx, domain.AuditEntityCategory, e.Category.ID, domain.AuditActionUpdate, e.Before, e.Category)
+	})
+	domain.On(bus, auditSubscriber, func(ctx context.Context, e domain.CategoryDeleted) error {
+		return auditService.Record(ctx, domain.AuditEntityCategory, e.Category.ID, domain.AuditActionDelete, e.Category, nil)
+	})
+	domain.On(bus, auditSubscriber, func(ctx context.Context, e domain.TagCreated) error {
+		return auditService.Record(ctx, domain.AuditEntityTag, e.Tag.ID, domain.AuditActionCreate, nil, e.Tag)
+	})
+	domain.On(bus, auditSubscriber, func(ctx context.Context, e domain.TagUpdated) error {
+		return auditService.Record(ctx, domain.AuditEntityTag, e.Tag.ID, domain.AuditActionUpdate, e.Before, e.Tag)
+	})
+	domain.On(bus, auditSubscriber, func(ctx context.Context, e domain.TagDeleted) error {
+		return auditService.Record(ctx, domain.AuditEntityTag, e.Tag.ID, domain.AuditActionDelete, e.Tag, nil)
+	})
+}
+
+// auditDiff 按JSON字段比较操作前后的对象，只返回发生变化的字段
+func auditDiff(before, after interface{}) (map[string]domain.AuditChange, error) {
+	beforeFields, err := auditFields(before)
+	if err != nil {
+		return nil, err
+	}
+	afterFields, err := auditFields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make(map[string]domain.AuditChange)
+	for field, value := range beforeFields {
+		if auditIgnoredFields[field] {
+			continue
+		}
+		if newValue, ok := afterFields[field]; !ok || !reflect.DeepEqual(value, newValue) {
+			changes[field] = domain.AuditChange{Before: value, After: afterFields[field]}
+		}
+	}
+	for field, value := range afterFields {
+		if auditIgnoredFields[field] {
+			continue
+		}
+		if _, ok := beforeFields[field]; !ok {
+			changes[field] = domain.AuditChange{After: value}
+		}
+	}
+
+	return changes, nil
+}
+
+// auditFields 将领域对象转换为字段映射，nil返回空映射
+func auditFields(v interface{}) (map[string]interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
@@ -2,89 +2,582 @@ package service
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
 	"strings"
 	"time"
 
 	"goblog/internal/config"
 	"goblog/internal/domain"
+	"goblog/internal/pkg/jwtkeys"
 
 	"github.com/golang-jwt/jwt/v5"
 	"golang.org/x/crypto/bcrypt"
 )
 
+// 登录尝试结果原因
+const (
+	loginReasonInvalidCredentials = "invalid_credentials"
+	loginReasonThrottled          = "throttled"
+	loginReasonInvalidTwoFactor   = "invalid_two_factor_code"
+)
+
+// 令牌用途，访问令牌的用途为空
+const (
+	tokenPurposeTwoFactor      = "2fa"       // 密码验证通过，等待提交两步验证码
+	tokenPurposeTwoFactorSetup = "2fa_setup" // 密码验证通过，必须先绑定两步验证
+)
+
 // AuthService 认证服务实现
 type AuthService struct {
-	config *config.Config
+	config       *config.Config
+	keys         *jwtkeys.KeySet
+	attemptRepo  domain.LoginAttemptRepository
+	throttleRepo domain.LoginThrottleRepository
+	refreshRepo  domain.RefreshTokenRepository
+	denylistRepo domain.TokenDenylistRepository
+	twoFactor    domain.TwoFactorService
+	userRepo     domain.UserRepository
 }
 
 // Claims JWT载荷
 type Claims struct {
 	Username string `json:"username"`
+	Role     string `json:"role,omitempty"`
+	Purpose  string `json:"purpose,omitempty"`
 	jwt.RegisteredClaims
 }
 
 // NewAuthService 创建认证服务
-func NewAuthService(cfg *config.Config) domain.AuthService {
-	return &AuthService{config: cfg}
+func NewAuthService(
+	cfg *config.Config,
+	keys *jwtkeys.KeySet,
+	attemptRepo domain.LoginAttemptRepository,
+	throttleRepo domain.LoginThrottleRepository,
+	refreshRepo domain.RefreshTokenRepository,
+	denylistRepo domain.TokenDenylistRepository,
+	twoFactor domain.TwoFactorService,
+	userRepo domain.UserRepository,
+) domain.AuthService {
+	return &AuthService{
+		config:       cfg,
+		keys:         keys,
+		attemptRepo:  attemptRepo,
+		throttleRepo: throttleRepo,
+		refreshRepo:  refreshRepo,
+		denylistRepo: denylistRepo,
+		twoFactor:    twoFactor,
+		userRepo:     userRepo,
+	}
 }
 
-// Login 用户登录
+// Login 用户登录，连续失败会触发指数退避和临时锁定，每次尝试都会被记录
 func (s *AuthService) Login(ctx context.Context, req *domain.LoginRequest) (*domain.LoginResponse, error) {
-	// 验证用户名
-	if req.Username != s.config.Admin.Username {
+	keys := s.throttleKeys(req)
+
+	// 检查是否处于锁定或退避期
+	wait, err := s.throttleWait(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	if wait > 0 {
+		if err := s.recordAttempt(ctx, req, false, loginReasonThrottled); err != nil {
+			return nil, err
+		}
+		return nil, &domain.RetryAfterError{Err: domain.ErrTooManyAttempts, RetryAfter: wait}
+	}
+
+	if !s.verifyCredentials(req) {
+		if err := s.recordFailure(ctx, keys); err != nil {
+			return nil, err
+		}
+		if err := s.recordAttempt(ctx, req, false, loginReasonInvalidCredentials); err != nil {
+			return nil, err
+		}
 		return nil, domain.ErrUnauthorized
 	}
 
-	// 验证密码 - 在实际项目中应该从数据库获取用户信息
-	expectedPasswordHash := "$2a$10$gYH0.79TG8qC6hC4zVamLOuJt/77ZiHEqoeinlos3.jz6u2DDqg42"
-	err := bcrypt.CompareHashAndPassword([]byte(expectedPasswordHash), []byte(req.Password))
+	challenge, err := s.secondFactor(ctx, req.Username)
+	if err != nil || challenge != nil {
+		return challenge, err
+	}
+
+	return s.completeLogin(ctx, req, keys)
+}
+
+// VerifyTwoFactor 校验挑战令牌和两步验证码，通过后签发令牌
+func (s *AuthService) VerifyTwoFactor(ctx context.Context, req *domain.TwoFactorLoginRequest) (*domain.LoginResponse, error) {
+	claims, err := s.parseToken(req.ChallengeToken)
+	if err != nil || claims.Purpose != tokenPurposeTwoFactor {
+		return nil, domain.ErrUnauthorized
+	}
+
+	loginReq := &domain.LoginRequest{
+		Username:  claims.Username,
+		IP:        req.IP,
+		UserAgent: req.UserAgent,
+	}
+	keys := s.throttleKeys(loginReq)
+
+	// 验证码错误同样计入失败次数，防止暴力猜测
+	wait, err := s.throttleWait(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	if wait > 0 {
+		if err := s.recordAttempt(ctx, loginReq, false, loginReasonThrottled); err != nil {
+			return nil, err
+		}
+		return nil, &domain.RetryAfterError{Err: domain.ErrTooManyAttempts, RetryAfter: wait}
+	}
+
+	revoked, err := s.denylistRepo.Contains(ctx, claims.ID)
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
+		return nil, domain.ErrUnauthorized
+	}
+
+	if err := s.twoFactor.Verify(ctx, claims.Username, req.Code); err != nil {
+		if !errors.Is(err, domain.ErrUnauthorized) {
+			return nil, err
+		}
+		if err := s.recordFailure(ctx, keys); err != nil {
+			return nil, err
+		}
+		if err := s.recordAttempt(ctx, loginReq, false, loginReasonInvalidTwoFactor); err != nil {
+			return nil, err
+		}
+		return nil, domain.ErrUnauthorized
+	}
+
+	// 挑战令牌只能使用一次
+	if err := s.denylistRepo.Add(ctx, claims.ID, claims.ExpiresAt.Time); err != nil {
+		return nil, err
+	}
+
+	return s.completeLogin(ctx, loginReq, keys)
+}
+
+// ValidateChallenge 验证绑定两步验证用的挑战令牌，返回用户名
+func (s *AuthService) ValidateChallenge(ctx context.Context, tokenString string) (string, error) {
+	claims, err := s.parseToken(tokenString)
+	if err != nil || claims.Purpose != tokenPurposeTwoFactorSetup {
+		return "", domain.ErrUnauthorized
+	}
+	return claims.Username, nil
+}
+
+// IssueSession 为已由外部身份提供方完成认证的用户签发令牌，
+// 不信任身份提供方的多因素认证，两步验证要求与密码登录相同
+func (s *AuthService) IssueSession(ctx context.Context, req *domain.LoginRequest) (*domain.LoginResponse, error) {
+	challenge, err := s.secondFactor(ctx, req.Username)
+	if err != nil || challenge != nil {
+		return challenge, err
+	}
+
+	return s.completeLogin(ctx, req, s.throttleKeys(req))
+}
+
+// secondFactor 返回第一步认证通过后需要的两步验证挑战，不需要时返回nil
+func (s *AuthService) secondFactor(ctx context.Context, username string) (*domain.LoginResponse, error) {
+	// 已开启两步验证时先返回挑战令牌，提交验证码后才签发访问令牌
+	enabled, err := s.twoFactor.IsEnabled(ctx, username)
 	if err != nil {
+		return nil, err
+	}
+	if enabled {
+		challenge, err := s.generateChallenge(username, tokenPurposeTwoFactor)
+		if err != nil {
+			return nil, err
+		}
+		return &domain.LoginResponse{
+			Username:          username,
+			TwoFactorRequired: true,
+			ChallengeToken:    challenge,
+		}, nil
+	}
+
+	// 强制管理员开启两步验证时，挑战令牌只能用于完成绑定；按角色判断，不只限于配置中的管理员
+	if s.config.TwoFactor.RequireForAdmin {
+		role, err := userRole(ctx, s.config, s.userRepo, username)
+		if err != nil {
+			return nil, err
+		}
+		if role == domain.RoleAdmin {
+			challenge, err := s.generateChallenge(username, tokenPurposeTwoFactorSetup)
+			if err != nil {
+				return nil, err
+			}
+			return &domain.LoginResponse{
+				Username:               username,
+				TwoFactorSetupRequired: true,
+				ChallengeToken:         challenge,
+			}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// completeLogin 清除失败计数、记录成功的登录并签发令牌
+func (s *AuthService) completeLogin(ctx context.Context, req *domain.LoginRequest, keys map[string]int) (*domain.LoginResponse, error) {
+	for key := range keys {
+		if err := s.throttleRepo.Reset(ctx, key); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.recordAttempt(ctx, req, true, ""); err != nil {
+		return nil, err
+	}
+
+	// 每次登录开启一个新的刷新令牌家族
+	familyID, err := randomToken(16)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.issueTokens(ctx, req.Username, familyID, req.IP, req.UserAgent)
+}
+
+// Refresh 轮换刷新令牌并签发新的访问令牌，已被轮换的令牌再次出现时吊销整个家族
+func (s *AuthService) Refresh(ctx context.Context, req *domain.RefreshRequest) (*domain.LoginResponse, error) {
+	tokenHash := hashToken(req.RefreshToken)
+
+	stored, err := s.refreshRepo.GetByHash(ctx, tokenHash)
+	if errors.Is(err, domain.ErrNotFound) {
 		return nil, domain.ErrUnauthorized
 	}
+	if err != nil {
+		return nil, err
+	}
 
-	// 生成JWT token
-	token, err := s.GenerateToken(ctx, req.Username)
+	if time.Now().After(stored.ExpiresAt) {
+		return nil, domain.ErrUnauthorized
+	}
+
+	// 只有一个请求能把令牌从有效状态吊销，失败说明令牌被重放
+	revoked, err := s.refreshRepo.Revoke(ctx, tokenHash)
 	if err != nil {
 		return nil, err
 	}
+	if !revoked {
+		if err := s.refreshRepo.RevokeFamily(ctx, stored.FamilyID); err != nil {
+			return nil, err
+		}
+		return nil, domain.ErrUnauthorized
+	}
 
-	return &domain.LoginResponse{
-		Token:    token,
-		Username: req.Username,
-	}, nil
+	return s.issueTokens(ctx, stored.Username, stored.FamilyID, req.IP, req.UserAgent)
 }
 
-// ValidateToken 验证token
-func (s *AuthService) ValidateToken(ctx context.Context, tokenString string) (string, error) {
-	// 移除Bearer前缀
-	tokenString = strings.TrimPrefix(tokenString, "Bearer ")
+// Logout 吊销当前访问令牌和刷新令牌所在的家族
+func (s *AuthService) Logout(ctx context.Context, req *domain.LogoutRequest) error {
+	loggedOut := false
 
-	// 解析token
-	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		return []byte(s.config.JWT.Secret), nil
-	})
+	if req.AccessToken != "" {
+		if claims, err := s.parseToken(req.AccessToken); err == nil && claims.ID != "" {
+			if err := s.denylistRepo.Add(ctx, claims.ID, claims.ExpiresAt.Time); err != nil {
+				return err
+			}
+			loggedOut = true
+		}
+	}
+
+	if req.RefreshToken != "" {
+		stored, err := s.refreshRepo.GetByHash(ctx, hashToken(req.RefreshToken))
+		if err != nil && !errors.Is(err, domain.ErrNotFound) {
+			return err
+		}
+		if err == nil {
+			if err := s.refreshRepo.RevokeFamily(ctx, stored.FamilyID); err != nil {
+				return err
+			}
+			loggedOut = true
+		}
+	}
+
+	if !loggedOut {
+		return domain.ErrUnauthorized
+	}
+	return nil
+}
 
+// ValidateToken 验证token
+func (s *AuthService) ValidateToken(ctx context.Context, tokenString string) (string, error) {
+	principal, err := s.Authenticate(ctx, tokenString)
 	if err != nil {
-		return "", domain.ErrUnauthorized
+		return "", err
+	}
+	return principal.Username, nil
+}
+
+// Authenticate 验证访问令牌，返回调用方的用户名和角色
+func (s *AuthService) Authenticate(ctx context.Context, tokenString string) (*domain.Principal, error) {
+	claims, err := s.parseToken(tokenString)
+	if err != nil || claims.Purpose != "" {
+		return nil, domain.ErrUnauthorized
 	}
 
-	if claims, ok := token.Claims.(*Claims); ok && token.Valid {
-		return claims.Username, nil
+	// 检查令牌是否已被吊销
+	if claims.ID != "" {
+		revoked, err := s.denylistRepo.Contains(ctx, claims.ID)
+		if err != nil {
+			return nil, err
+		}
+		if revoked {
+			return nil, domain.ErrUnauthorized
+		}
 	}
 
-	return "", domain.ErrUnauthorized
+	return &domain.Principal{Username: claims.Username, Role: claims.Role}, nil
 }
 
 // GenerateToken 生成JWT token
 func (s *AuthService) GenerateToken(ctx context.Context, username string) (string, error) {
+	role, err := userRole(ctx, s.config, s.userRepo, username)
+	if err != nil {
+		return "", err
+	}
+
+	jti, err := randomToken(16)
+	if err != nil {
+		return "", err
+	}
+
 	claims := &Claims{
 		Username: username,
+		Role:     role,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        jti,
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(s.config.JWT.Expiration)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(s.config.JWT.Secret))
+	return s.keys.Sign(claims)
+}
+
+// generateChallenge 生成短期有效的挑战令牌，不能当作访问令牌使用
+func (s *AuthService) generateChallenge(username, purpose string) (string, error) {
+	jti, err := randomToken(16)
+	if err != nil {
+		return "", err
+	}
+
+	claims := &Claims{
+		Username: username,
+		Purpose:  purpose,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        jti,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(s.config.TwoFactor.ChallengeExpiration)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	}
+
+	return s.keys.Sign(claims)
+}
+
+// parseToken 解析并校验访问令牌签名和有效期
+func (s *AuthService) parseToken(tokenString string) (*Claims, error) {
+	// 移除Bearer前缀
+	tokenString = strings.TrimPrefix(tokenString, "Bearer ")
+
+	// 按kid选择密钥验证签名，轮换后旧密钥签发的令牌在过期前仍然有效
+	claims := &Claims{}
+	if err := s.keys.Parse(tokenString, claims); err != nil {
+		return nil, domain.ErrUnauthorized
+	}
+
+	return claims, nil
+}
+
+// issueTokens 签发访问令牌和属于指定家族的新刷新令牌
+func (s *AuthService) issueTokens(ctx context.Context, username, familyID, ip, userAgent string) (*domain.LoginResponse, error) {
+	accessToken, err := s.GenerateToken(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := randomToken(32)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.refreshRepo.Create(ctx, &domain.RefreshToken{
+		TokenHash: hashToken(refreshToken),
+		FamilyID:  familyID,
+		Username:  username,
+		IP:        ip,
+		UserAgent: userAgent,
+		ExpiresAt: time.Now().Add(s.config.JWT.RefreshExpiration),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.LoginResponse{
+		Token:        accessToken,
+		RefreshToken: refreshToken,
+		ExpiresIn:    int64(s.config.JWT.Expiration.Seconds()),
+		Username:     username,
+	}, nil
+}
+
+// ListLoginAttempts 查询登录尝试记录
+func (s *AuthService) ListLoginAttempts(ctx context.Context, params domain.LoginAttemptQueryParams) ([]*domain.LoginAttempt, int64, error) {
+	return s.attemptRepo.List(ctx, params)
+}
+
+// ListLockouts 获取当前被锁定的用户名和IP
+func (s *AuthService) ListLockouts(ctx context.Context) ([]*domain.LoginThrottle, error) {
+	return s.throttleRepo.ListLocked(ctx, time.Now())
+}
+
+// ClearLockout 解除用户名或IP的锁定
+func (s *AuthService) ClearLockout(ctx context.Context, username, ip string) error {
+	if username == "" && ip == "" {
+		return domain.ErrInvalidInput
+	}
+
+	if username != "" {
+		if err := s.throttleRepo.Reset(ctx, "user:"+username); err != nil {
+			return err
+		}
+	}
+	if ip != "" {
+		if err := s.throttleRepo.Reset(ctx, "ip:"+ip); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// userRole 获取用户角色，配置中的管理员不在用户表中；刷新令牌时重新读取，角色变更在下次刷新后生效
+func userRole(ctx context.Context, cfg *config.Config, userRepo domain.UserRepository, username string) (string, error) {
+	if username == cfg.Admin.Username {
+		return domain.RoleAdmin, nil
+	}
+
+	user, err := userRepo.GetByUsername(ctx, username)
+	if errors.Is(err, domain.ErrNotFound) {
+		return "", domain.ErrUnauthorized
+	}
+	if err != nil {
+		return "", err
+	}
+	return user.Role, nil
+}
+
+// verifyCredentials 校验用户名和密码
+func (s *AuthService) verifyCredentials(req *domain.LoginRequest) bool {
+	// 验证用户名
+	if req.Username != s.config.Admin.Username {
+		return false
+	}
+
+	// 验证密码 - 在实际项目中应该从数据库获取用户信息
+	expectedPasswordHash := "$2a$10$gYH0.79TG8qC6hC4zVamLOuJt/77ZiHEqoeinlos3.jz6u2DDqg42"
+	err := bcrypt.CompareHashAndPassword([]byte(expectedPasswordHash), []byte(req.Password))
+	return err == nil
+}
+
+// throttleKeys 返回本次登录涉及的限制对象及其锁定阈值
+func (s *AuthService) throttleKeys(req *domain.LoginRequest) map[string]int {
+	keys := map[string]int{
+		"user:" + req.Username: s.config.Login.MaxFailures,
+	}
+	if req.IP != "" {
+		keys["ip:"+req.IP] = s.config.Login.MaxFailuresPerIP
+	}
+	return keys
+}
+
+// throttleWait 计算还需等待多久才能再次尝试登录
+func (s *AuthService) throttleWait(ctx context.Context, keys map[string]int) (time.Duration, error) {
+	now := time.Now()
+	var wait time.Duration
+
+	for key := range keys {
+		throttle, err := s.throttleRepo.Get(ctx, key)
+		if errors.Is(err, domain.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		// 处于锁定期
+		if throttle.LockedUntil != nil && now.Before(*throttle.LockedUntil) {
+			wait = max(wait, throttle.LockedUntil.Sub(now))
+			continue
+		}
+
+		// 处于退避期，超出统计窗口的失败不再计算
+		if throttle.Failures > 0 && now.Sub(throttle.LastFailureAt) <= s.config.Login.FailureWindow {
+			next := throttle.LastFailureAt.Add(s.backoff(throttle.Failures))
+			if now.Before(next) {
+				wait = max(wait, next.Sub(now))
+			}
+		}
+	}
+
+	return wait, nil
+}
+
+// backoff 计算第n次失败后的退避时间
+func (s *AuthService) backoff(failures int) time.Duration {
+	delay := s.config.Login.BaseBackoff
+	for i := 1; i < failures && delay < s.config.Login.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, s.config.Login.MaxBackoff)
+}
+
+// recordFailure 累加失败次数，达到阈值时锁定
+func (s *AuthService) recordFailure(ctx context.Context, keys map[string]int) error {
+	for key, maxFailures := range keys {
+		throttle, err := s.throttleRepo.RecordFailure(ctx, key, s.config.Login.FailureWindow)
+		if err != nil {
+			return err
+		}
+
+		if maxFailures > 0 && throttle.Failures >= maxFailures {
+			if err := s.throttleRepo.Lock(ctx, key, time.Now().Add(s.config.Login.LockoutDuration)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// recordAttempt 记录登录尝试
+func (s *AuthService) recordAttempt(ctx context.Context, req *domain.LoginRequest, success bool, reason string) error {
+	return s.attemptRepo.Create(ctx, &domain.LoginAttempt{
+		Username:  req.Username,
+		IP:        req.IP,
+		UserAgent: req.UserAgent,
+		Success:   success,
+		Reason:    reason,
+	})
+}
+
+// randomToken 生成URL安全的随机令牌
+func randomToken(size int) (string, error) {
+	buf := make([]byte, size)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// hashToken 计算令牌摘要，数据库中只保存摘要
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
 }
@@ -2,21 +2,32 @@ package service
 
 import (
 	"context"
+	"errors"
 	"goblog/internal/domain"
 )
 
 // CategoryService 分类服务实现
 type CategoryService struct {
 	categoryRepo domain.CategoryRepository
+	tx           domain.Transactor
+	events       domain.EventPublisher
 }
 
 // NewCategoryService 创建分类服务
-func NewCategoryService(categoryRepo domain.CategoryRepository) domain.CategoryService {
-	return &CategoryService{categoryRepo: categoryRepo}
+func NewCategoryService(categoryRepo domain.CategoryRepository, tx domain.Transactor, events domain.EventPublisher) domain.CategoryService {
+	return &CategoryService{
+		categoryRepo: categoryRepo,
+		tx:           tx,
+		events:       events,
+	}
 }
 
 // Create 创建分类
 func (s *CategoryService) Create(ctx context.Context, req *domain.CategoryCreateRequest) (*domain.Category, error) {
+	if err := checkReviewer(ctx); err != nil {
+		return nil, err
+	}
+
 	// 检查分类名称是否已存在
 	_, err := s.categoryRepo.GetByName(ctx, req.Name)
 	if err == nil {
@@ -29,9 +40,27 @@ func (s *CategoryService) Create(ctx context.Context, req *domain.CategoryCreate
 	category := &domain.Category{
 		Name:        req.Name,
 		Description: req.Description,
+		ParentID:    req.ParentID,
 	}
 
-	return s.categoryRepo.Create(ctx, category)
+	var created *domain.Category
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.checkParent(ctx, 0, req.ParentID); err != nil {
+			return err
+		}
+
+		var err error
+		created, err = s.categoryRepo.Create(ctx, category)
+		if err != nil {
+			return err
+		}
+		return s.events.Publish(ctx, domain.CategoryCreated{Category: created})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
 }
 
 // GetByID 根据ID获取分类
@@ -41,8 +70,12 @@ func (s *CategoryService) GetByID(ctx context.Context, id int) (*domain.Category
 
 // Update 更新分类
 func (s *CategoryService) Update(ctx context.Context, id int, req *domain.CategoryUpdateRequest) (*domain.Category, error) {
+	if err := checkReviewer(ctx); err != nil {
+		return nil, err
+	}
+
 	// 检查分类是否存在
-	_, err := s.categoryRepo.GetByID(ctx, id)
+	before, err := s.categoryRepo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -59,17 +92,186 @@ func (s *CategoryService) Update(ctx context.Context, id int, req *domain.Catego
 	category := &domain.Category{
 		Name:        req.Name,
 		Description: req.Description,
+		ParentID:    req.ParentID,
+	}
+
+	var updated *domain.Category
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		// 不能移动到自身或自己的子孙分类下
+		if err := s.checkParent(ctx, id, req.ParentID); err != nil {
+			return err
+		}
+
+		var err error
+		updated, err = s.categoryRepo.Update(ctx, id, category)
+		if err != nil {
+			return err
+		}
+		return s.events.Publish(ctx, domain.CategoryUpdated{Before: before, Category: updated})
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return s.categoryRepo.Update(ctx, id, category)
+	return updated, nil
 }
 
-// Delete 删除分类
-func (s *CategoryService) Delete(ctx context.Context, id int) error {
-	return s.categoryRepo.Delete(ctx, id)
+// Delete 删除分类。分类下有文章时必须指定 ReassignTo，否则返回带影响预览的冲突错误；
+// 文章移到目标分类、子分类移到被删除分类的上级分类下，与删除在同一事务中完成。DryRun 只检查并返回影响
+func (s *CategoryService) Delete(ctx context.Context, id int, opts domain.CategoryDeleteOptions) (*domain.CategoryDeletion, error) {
+	if err := checkReviewer(ctx); err != nil {
+		return nil, err
+	}
+
+	before, err := s.categoryRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.ReassignTo != nil {
+		if *opts.ReassignTo == id {
+			return nil, domain.ErrInvalidInput
+		}
+		if _, err := s.categoryRepo.GetByID(ctx, *opts.ReassignTo); err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				return nil, domain.ErrInvalidInput
+			}
+			return nil, err
+		}
+	}
+
+	var deletion *domain.CategoryDeletion
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		articles, err := s.categoryRepo.CountArticles(ctx, id)
+		if err != nil {
+			return err
+		}
+		children, err := s.categoryRepo.CountChildren(ctx, id)
+		if err != nil {
+			return err
+		}
+		deletion = &domain.CategoryDeletion{
+			CategoryID: id,
+			Articles:   articles,
+			Children:   children,
+			ReassignTo: opts.ReassignTo,
+			DryRun:     opts.DryRun,
+		}
+
+		if articles > 0 && opts.ReassignTo == nil {
+			return &domain.ConflictError{Err: domain.ErrCategoryInUse, Current: deletion}
+		}
+		if opts.DryRun {
+			return nil
+		}
+
+		if articles > 0 {
+			if _, err := s.categoryRepo.ReassignArticles(ctx, id, *opts.ReassignTo); err != nil {
+				return err
+			}
+		}
+		if children > 0 {
+			if _, err := s.categoryRepo.ReparentChildren(ctx, id, before.ParentID); err != nil {
+				return err
+			}
+		}
+		if err := s.categoryRepo.Delete(ctx, id); err != nil {
+			return err
+		}
+		return s.events.Publish(ctx, domain.CategoryDeleted{Category: before, Deletion: deletion})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deletion, nil
 }
 
-// List 获取分类列表
+// List 获取分类列表，包含每个分类直接包含的文章数
 func (s *CategoryService) List(ctx context.Context) ([]*domain.Category, error) {
-	return s.categoryRepo.List(ctx)
+	categories, err := s.categoryRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := s.categoryRepo.ArticleCounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, category := range categories {
+		c := counts[category.ID]
+		category.Articles = &c
+	}
+
+	return categories, nil
+}
+
+// Tree 获取分类树，上级分类不存在的分类作为顶级分类，同级分类保持列表中的顺序
+func (s *CategoryService) Tree(ctx context.Context) ([]*domain.Category, error) {
+	categories, err := s.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[int]bool, len(categories))
+	for _, category := range categories {
+		ids[category.ID] = true
+	}
+
+	var roots []*domain.Category
+	children := make(map[int][]*domain.Category)
+	for _, category := range categories {
+		if category.ParentID != nil && ids[*category.ParentID] {
+			children[*category.ParentID] = append(children[*category.ParentID], category)
+		} else {
+			roots = append(roots, category)
+		}
+	}
+
+	// 从顶级分类向下挂载，异常数据中成环的分类不可达，不会出现在树中
+	var attach func(nodes []*domain.Category, depth int)
+	attach = func(nodes []*domain.Category, depth int) {
+		if depth >= domain.CategoryMaxDepth {
+			return
+		}
+		for _, node := range nodes {
+			node.Children = children[node.ID]
+			attach(node.Children, depth+1)
+		}
+	}
+	attach(roots, 1)
+
+	if roots == nil {
+		roots = []*domain.Category{}
+	}
+	return roots, nil
+}
+
+// checkParent 校验上级分类存在，并且不是分类自身或其子孙分类。id为0表示新建的分类。
+// 需在事务中调用：沿途的分类加行锁直到提交，并发移动不会在检查之后形成环
+func (s *CategoryService) checkParent(ctx context.Context, id int, parentID *int) error {
+	if parentID == nil {
+		return nil
+	}
+
+	// 沿上级分类向上查找，遇到分类自身说明会形成环
+	current := *parentID
+	for depth := 0; depth < domain.CategoryMaxDepth; depth++ {
+		if current == id {
+			return domain.ErrCategoryCycle
+		}
+
+		category, err := s.categoryRepo.GetForUpdate(ctx, current)
+		if err != nil {
+			if errors.Is(err, domain.ErrNotFound) && current == *parentID {
+				return domain.ErrInvalidInput
+			}
+			return err
+		}
+		if category.ParentID == nil {
+			return nil
+		}
+		current = *category.ParentID
+	}
+	return domain.ErrInvalidInput
 }
@@ -0,0 +1,208 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"goblog/internal/config"
+	"goblog/internal/domain"
+)
+
+// CommentService 评论服务实现
+type CommentService struct {
+	commentRepo domain.CommentRepository
+	articleRepo domain.ArticleRepository
+	classifier  *SpamClassifier
+	tx          domain.Transactor
+	events      domain.EventPublisher
+	config      config.CommentConfig
+}
+
+// NewCommentService 创建评论服务
+func NewCommentService(
+	commentRepo domain.CommentRepository,
+	articleRepo domain.ArticleRepository,
+	classifier *SpamClassifier,
+	tx domain.Transactor,
+	events domain.EventPublisher,
+	cfg config.CommentConfig,
+) domain.CommentService {
+	return &CommentService{
+		commentRepo: commentRepo,
+		articleRepo: articleRepo,
+		classifier:  classifier,
+		tx:          tx,
+		events:      events,
+		config:      cfg,
+	}
+}
+
+// Create 发表评论，评分超过阈值的评论直接进入垃圾箱，其余进入审核队列
+func (s *CommentService) Create(ctx context.Context, articleID int, req *domain.CommentCreateRequest) (*domain.Comment, error) {
+	// 只能评论已发布的文章
+	article, err := s.articleRepo.GetByID(ctx, articleID)
+	if err != nil {
+		return nil, err
+	}
+	if !article.Published {
+		return nil, domain.ErrNotFound
+	}
+
+	result, err := s.classifier.Classify(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	status := domain.CommentStatusPending
+	if result.Score >= s.config.SpamThreshold {
+		status = domain.CommentStatusSpam
+	}
+
+	comment := &domain.Comment{
+		ArticleID:   articleID,
+		AuthorName:  strings.TrimSpace(req.AuthorName),
+		AuthorEmail: strings.TrimSpace(req.AuthorEmail),
+		Content:     req.Content,
+		Status:      status,
+		SpamScore:   result.Score,
+		SpamReasons: result.Reasons,
+		IP:          req.IP,
+		UserAgent:   req.UserAgent,
+	}
+
+	var created *domain.Comment
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		created, err = s.commentRepo.Create(ctx, comment)
+		if err != nil {
+			return err
+		}
+		return s.events.Publish(ctx, domain.CommentCreated{Comment: created})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ListByArticle 获取文章下已通过审核的评论，隐藏评论者的隐私信息
+func (s *CommentService) ListByArticle(ctx context.Context, articleID int) ([]*domain.Comment, error) {
+	comments, err := s.commentRepo.ListByArticle(ctx, articleID, domain.CommentStatusApproved)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, comment := range comments {
+		comments[i] = publicComment(comment)
+	}
+
+	return comments, nil
+}
+
+// publicComment 返回去除评论者隐私信息和反垃圾判定细节的副本
+func publicComment(comment *domain.Comment) *domain.Comment {
+	if comment == nil {
+		return nil
+	}
+	return &domain.Comment{
+		ID:         comment.ID,
+		ArticleID:  comment.ArticleID,
+		AuthorName: comment.AuthorName,
+		Content:    comment.Content,
+		Status:     comment.Status,
+		Moderated:  comment.Moderated,
+		CreatedAt:  comment.CreatedAt,
+		UpdatedAt:  comment.UpdatedAt,
+	}
+}
+
+// List 获取审核队列
+func (s *CommentService) List(ctx context.Context, params domain.CommentQueryParams) ([]*domain.Comment, int64, error) {
+	if err := checkReviewer(ctx); err != nil {
+		return nil, 0, err
+	}
+
+	switch params.Status {
+	case "", domain.CommentStatusPending, domain.CommentStatusApproved, domain.CommentStatusSpam:
+	default:
+		return nil, 0, domain.ErrInvalidInput
+	}
+
+	return s.commentRepo.List(ctx, params)
+}
+
+// Approve 审核通过评论，并作为正常样本训练分类器
+func (s *CommentService) Approve(ctx context.Context, id int) (*domain.Comment, error) {
+	return s.moderate(ctx, id, domain.CommentStatusApproved)
+}
+
+// MarkSpam 将评论标记为垃圾评论，并作为垃圾样本训练分类器
+func (s *CommentService) MarkSpam(ctx context.Context, id int) (*domain.Comment, error) {
+	return s.moderate(ctx, id, domain.CommentStatusSpam)
+}
+
+// Delete 删除评论
+func (s *CommentService) Delete(ctx context.Context, id int) error {
+	if err := checkReviewer(ctx); err != nil {
+		return err
+	}
+
+	before, err := s.commentRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.commentRepo.Delete(ctx, id); err != nil {
+			return err
+		}
+		return s.events.Publish(ctx, domain.CommentDeleted{Comment: before})
+	})
+}
+
+// moderate 更新评论状态并根据审核决定训练分类器
+func (s *CommentService) moderate(ctx context.Context, id int, status string) (*domain.Comment, error) {
+	if err := checkReviewer(ctx); err != nil {
+		return nil, err
+	}
+
+	comment, err := s.commentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 自动判定为垃圾的评论尚未经过人工确认，不能当作已训练样本撤销
+	from := comment.Status
+	if from == domain.CommentStatusSpam && !comment.Moderated {
+		from = domain.CommentStatusPending
+	}
+
+	// 条件更新和训练在同一事务中：并发审核只有一个成功，更新失败时训练一起回滚
+	var updated *domain.Comment
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		updated, err = s.commentRepo.UpdateStatus(ctx, comment, status)
+		if err != nil {
+			return err
+		}
+		if err := s.classifier.Train(ctx, comment, from, status); err != nil {
+			return err
+		}
+		return s.events.Publish(ctx, domain.CommentModerated{Before: comment, Comment: updated})
+	})
+	if errors.Is(err, domain.ErrVersionConflict) {
+		// 读取之后被其他审核者抢先处理
+		current, getErr := s.commentRepo.GetByID(ctx, id)
+		if getErr != nil {
+			return nil, getErr
+		}
+		return nil, &domain.ConflictError{Err: domain.ErrVersionConflict, Current: current}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"goblog/internal/config"
+	"goblog/internal/domain"
+	"goblog/internal/pkg/jwtkeys"
+	"goblog/internal/pkg/oidc"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// tokenPurposeOIDCState 单点登录状态令牌的用途
+const tokenPurposeOIDCState = "oidc_state"
+
+// OIDCService 单点登录服务实现
+type OIDCService struct {
+	provider    *oidc.Provider
+	keys        *jwtkeys.KeySet
+	userRepo    domain.UserRepository
+	authService domain.AuthService
+	config      *config.Config
+}
+
+// oidcStateClaims 发起登录时签发的状态令牌，保存state、nonce和PKCE校验码，避免服务端存储
+type oidcStateClaims struct {
+	State    string `json:"state"`
+	Nonce    string `json:"nonce"`
+	Verifier string `json:"verifier"`
+	Purpose  string `json:"purpose"`
+	jwt.RegisteredClaims
+}
+
+// NewOIDCService 创建单点登录服务
+func NewOIDCService(provider *oidc.Provider, keys *jwtkeys.KeySet, userRepo domain.UserRepository, authService domain.AuthService, cfg *config.Config) domain.OIDCService {
+	return &OIDCService{
+		provider:    provider,
+		keys:        keys,
+		userRepo:    userRepo,
+		authService: authService,
+		config:      cfg,
+	}
+}
+
+// AuthURL 生成跳转到身份提供方的授权地址和对应的状态令牌
+func (s *OIDCService) AuthURL(ctx context.Context) (*domain.OIDCAuthorization, error) {
+	state, err := oidc.RandomString(16)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := oidc.RandomString(16)
+	if err != nil {
+		return nil, err
+	}
+	verifier, err := oidc.NewVerifier()
+	if err != nil {
+		return nil, err
+	}
+
+	authURL, err := s.provider.AuthCodeURL(ctx, state, nonce, verifier)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := time.Now().Add(s.config.OIDC.StateExpiration)
+	claims := &oidcStateClaims{
+		State:    state,
+		Nonce:    nonce,
+		Verifier: verifier,
+		Purpose:  tokenPurposeOIDCState,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	}
+	stateToken, err := s.keys.Sign(claims)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.OIDCAuthorization{
+		URL:       authURL,
+		State:     stateToken,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// Callback 校验回调参数，换取并验证ID令牌，同步本地用户后签发令牌
+func (s *OIDCService) Callback(ctx context.Context, req *domain.OIDCCallbackRequest) (*domain.LoginResponse, error) {
+	state, err := s.parseState(req.StateToken)
+	if err != nil {
+		return nil, err
+	}
+	if req.Code == "" || subtle.ConstantTimeCompare([]byte(state.State), []byte(req.State)) != 1 {
+		return nil, domain.ErrUnauthorized
+	}
+
+	token, err := s.provider.Exchange(ctx, req.Code, state.Verifier)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrUnauthorized, err)
+	}
+
+	claims, err := s.provider.VerifyIDToken(ctx, token.IDToken, state.Nonce)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrUnauthorized, err)
+	}
+
+	user, err := s.syncUser(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.authService.IssueSession(ctx, &domain.LoginRequest{
+		Username:  user.Username,
+		IP:        req.IP,
+		UserAgent: req.UserAgent,
+	})
+}
+
+// parseState 校验状态令牌
+func (s *OIDCService) parseState(tokenString string) (*oidcStateClaims, error) {
+	claims := &oidcStateClaims{}
+	if err := s.keys.Parse(tokenString, claims); err != nil || claims.Purpose != tokenPurposeOIDCState {
+		return nil, domain.ErrUnauthorized
+	}
+	return claims, nil
+}
+
+// syncUser 根据ID令牌声明创建或更新本地用户，身份提供方是角色的唯一来源
+func (s *OIDCService) syncUser(ctx context.Context, claims jwt.MapClaims) (*domain.User, error) {
+	issuer := claimString(claims, "iss")
+	subject := claimString(claims, "sub")
+	if subject == "" {
+		return nil, domain.ErrUnauthorized
+	}
+
+	now := time.Now()
+	profile := &domain.User{
+		Username:    s.usernameFrom(claims),
+		Email:       claimString(claims, "email"),
+		DisplayName: claimString(claims, "name"),
+		Role:        s.roleFrom(claims),
+		Issuer:      issuer,
+		Subject:     subject,
+		LastLoginAt: &now,
+	}
+
+	existing, err := s.userRepo.GetBySubject(ctx, issuer, subject)
+	if err == nil {
+		return s.userRepo.Update(ctx, existing.ID, profile)
+	}
+	if !errors.Is(err, domain.ErrNotFound) {
+		return nil, err
+	}
+
+	// 不允许外部账号占用配置中的管理员或其他已有用户名
+	if profile.Username == s.config.Admin.Username {
+		return nil, domain.ErrDuplicateResource
+	}
+	if _, err := s.userRepo.GetByUsername(ctx, profile.Username); err == nil {
+		return nil, domain.ErrDuplicateResource
+	} else if !errors.Is(err, domain.ErrNotFound) {
+		return nil, err
+	}
+
+	return s.userRepo.Create(ctx, profile)
+}
+
+// usernameFrom 按配置的声明、email、sub的顺序确定本地用户名
+func (s *OIDCService) usernameFrom(claims jwt.MapClaims) string {
+	for _, name := range []string{s.config.OIDC.UsernameClaim, "email", "sub"} {
+		if value := claimString(claims, name); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// roleFrom 将用户组映射为本地角色
+func (s *OIDCService) roleFrom(claims jwt.MapClaims) string {
+	groups := claimStrings(claims, s.config.OIDC.RoleClaim)
+
+	for _, group := range groups {
+		if slices.Contains(s.config.OIDC.AdminGroups, group) {
+			return domain.RoleAdmin
+		}
+	}
+	for _, group := range groups {
+		if slices.Contains(s.config.OIDC.EditorGroups, group) {
+			return domain.RoleEditor
+		}
+	}
+
+	switch s.config.OIDC.DefaultRole {
+	case domain.RoleAdmin, domain.RoleEditor:
+		return s.config.OIDC.DefaultRole
+	}
+	return domain.RoleAuthor
+}
+
+// claimString 读取字符串声明
+func claimString(claims jwt.MapClaims, name string) string {
+	value, _ := claims[name].(string)
+	return value
+}
+
+// claimStrings 读取字符串数组声明，也兼容以空格或逗号分隔的字符串
+func claimStrings(claims jwt.MapClaims, name string) []string {
+	switch value := claims[name].(type) {
+	case []interface{}:
+		items := make([]string, 0, len(value))
+		for _, item := range value {
+			if str, ok := item.(string); ok {
+				items = append(items, str)
+			}
+		}
+		return items
+	case string:
+		return strings.FieldsFunc(value, func(r rune) bool { return r == ' ' || r == ',' })
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"goblog/internal/config"
+	"goblog/internal/domain"
+)
+
+// outboxLease 领取发件箱记录后的租约，实例崩溃后租约到期即可被重新领取
+const outboxLease = time.Minute
+
+// OutboxPublisher 将事件写入发件箱的发布实现
+type OutboxPublisher struct {
+	outboxRepo domain.OutboxRepository
+	bus        *domain.EventBus
+}
+
+// NewOutboxPublisher 创建发件箱事件发布器
+func NewOutboxPublisher(outboxRepo domain.OutboxRepository, bus *domain.EventBus) domain.EventPublisher {
+	return &OutboxPublisher{
+		outboxRepo: outboxRepo,
+		bus:        bus,
+	}
+}
+
+// Publish 为每个订阅者写入一条发件箱记录，没有订阅者的事件不写入
+func (p *OutboxPublisher) Publish(ctx context.Context, events ...domain.Event) error {
+	actor := domain.ActorFromContext(ctx)
+
+	var records []*domain.OutboxEvent
+	for _, event := range events {
+		subscribers := p.bus.Subscribers(event.EventName())
+		if len(subscribers) == 0 {
+			continue
+		}
+
+		payload, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+
+		eventID, err := randomToken(16)
+		if err != nil {
+			return err
+		}
+
+		for _, subscriber := range subscribers {
+			records = append(records, &domain.OutboxEvent{
+				EventID:    eventID,
+				Event:      event.EventName(),
+				Subscriber: subscriber,
+				Payload:    string(payload),
+				Actor:      actor,
+			})
+		}
+	}
+	if len(records) == 0 {
+		return nil
+	}
+
+	return p.outboxRepo.Add(ctx, records)
+}
+
+// OutboxDispatcher 发件箱分发实现
+type OutboxDispatcher struct {
+	outboxRepo domain.OutboxRepository
+	bus        *domain.EventBus
+	config     config.OutboxConfig
+}
+
+// NewOutboxDispatcher 创建发件箱分发器
+func NewOutboxDispatcher(outboxRepo domain.OutboxRepository, bus *domain.EventBus, cfg config.OutboxConfig) domain.EventDispatcher {
+	return &OutboxDispatcher{
+		outboxRepo: outboxRepo,
+		bus:        bus,
+		config:     cfg,
+	}
+}
+
+// ProcessDue 将一批到期的事件交给对应的订阅者处理，失败时按指数退避重试
+func (d *OutboxDispatcher) ProcessDue(ctx context.Context) (int, error) {
+	records, err := d.outboxRepo.ClaimDue(ctx, time.Now(), d.config.BatchSize, outboxLease)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, record := range records {
+		d.dispatch(ctx, record)
+		if err := d.outboxRepo.SaveResult(ctx, record); err != nil {
+			return i, err
+		}
+	}
+
+	return len(records), nil
+}
+
+// dispatch 分发一条记录并更新状态，无法解析的事件或已移除的订阅者不再重试
+func (d *OutboxDispatcher) dispatch(ctx context.Context, record *domain.OutboxEvent) {
+	record.Attempts++
+
+	event, err := domain.DecodeEvent(record.Event, []byte(record.Payload))
+	if err != nil {
+		record.Status = domain.OutboxStatusDead
+		record.LastError = err.Error()
+		return
+	}
+
+	// 恢复产生事件的操作者，供审计等订阅者使用
+	if record.Actor != nil {
+		ctx = domain.WithActor(ctx, record.Actor)
+	}
+	// 发件箱至少投递一次，订阅者按事件ID去重
+	ctx = domain.WithEventID(ctx, record.EventID)
+
+	err = d.bus.Deliver(ctx, record.Subscriber, event)
+	now := time.Now()
+	switch {
+	case err == nil:
+		record.Status = domain.OutboxStatusDispatched
+		record.LastError = ""
+		record.DispatchedAt = &now
+	case errors.Is(err, domain.ErrNoSubscriber), record.Attempts >= d.config.MaxAttempts:
+		record.Status = domain.OutboxStatusDead
+		record.LastError = err.Error()
+	default:
+		record.Status = domain.OutboxStatusPending
+		record.LastError = err.Error()
+		record.NextAttemptAt = now.Add(exponentialBackoff(d.config.BackoffBase, d.config.BackoffMax, record.Attempts))
+	}
+}
@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"goblog/internal/config"
+	"goblog/internal/domain"
+)
+
+const (
+	// spamDocumentsToken 记录已训练评论数量的保留词元，分词结果中不会出现下划线
+	spamDocumentsToken = "__documents__"
+	// spamInterestingTokens 参与贝叶斯合并的最显著词元数量
+	spamInterestingTokens = 15
+	// spamMinTrainedDocs 启用贝叶斯评分所需的最少训练样本数（垃圾和正常评论各自）
+	spamMinTrainedDocs = 5
+)
+
+var linkPattern = regexp.MustCompile(`(?i)(https?://|www\.|<a\s|\[url)`)
+
+// SpamClassifier 本地朴素贝叶斯垃圾评论分类器
+type SpamClassifier struct {
+	tokenRepo domain.SpamTokenRepository
+	config    config.CommentConfig
+}
+
+// SpamResult 垃圾评论评分结果
+type SpamResult struct {
+	Score   float64
+	Reasons []string
+}
+
+// NewSpamClassifier 创建垃圾评论分类器
+func NewSpamClassifier(tokenRepo domain.SpamTokenRepository, cfg config.CommentConfig) *SpamClassifier {
+	return &SpamClassifier{tokenRepo: tokenRepo, config: cfg}
+}
+
+// Classify 为评论打分，分数越接近1越可能是垃圾评论
+func (c *SpamClassifier) Classify(ctx context.Context, req *domain.CommentCreateRequest) (*SpamResult, error) {
+	result := &SpamResult{}
+
+	// 蜜罐字段被填写，直接判定为机器人
+	if strings.TrimSpace(req.Homepage) != "" {
+		result.Score = 1
+		result.Reasons = append(result.Reasons, "蜜罐字段被填写")
+		return result, nil
+	}
+
+	// 链接密度启发式
+	links := linkPattern.FindAllStringIndex(req.Content, -1)
+	words := len(strings.Fields(req.Content))
+	if words > 0 && len(links) > 0 {
+		density := float64(len(links)) / float64(words)
+		switch {
+		case len(links) > c.config.MaxLinks:
+			result.Score = math.Max(result.Score, 0.9)
+			result.Reasons = append(result.Reasons, fmt.Sprintf("链接过多: %d 个链接", len(links)))
+		case density >= c.config.MaxLinkDensity:
+			result.Score = math.Max(result.Score, 0.7)
+			result.Reasons = append(result.Reasons, fmt.Sprintf("链接密度过高: %.2f", density))
+		}
+	}
+
+	// 贝叶斯评分
+	tokens := tokenize(req.AuthorName + " " + req.Content)
+	counts, err := c.tokenRepo.GetCounts(ctx, append(tokens, spamDocumentsToken))
+	if err != nil {
+		return nil, err
+	}
+
+	docs := counts[spamDocumentsToken]
+	if docs.Spam < spamMinTrainedDocs || docs.Ham < spamMinTrainedDocs {
+		return result, nil
+	}
+
+	score, evidence := bayesScore(tokens, counts, docs)
+	if score > result.Score {
+		result.Score = score
+	}
+	if len(evidence) > 0 {
+		result.Reasons = append(result.Reasons, fmt.Sprintf("贝叶斯评分 %.2f: %s", score, strings.Join(evidence, ", ")))
+	}
+
+	return result, nil
+}
+
+// Train 根据审核结果更新词元统计，from为评论之前的状态，to为审核后的状态
+func (c *SpamClassifier) Train(ctx context.Context, comment *domain.Comment, from, to string) error {
+	if from == to {
+		return nil
+	}
+
+	var delta domain.SpamTokenCount
+	switch from {
+	case domain.CommentStatusSpam:
+		delta.Spam--
+	case domain.CommentStatusApproved:
+		delta.Ham--
+	}
+	switch to {
+	case domain.CommentStatusSpam:
+		delta.Spam++
+	case domain.CommentStatusApproved:
+		delta.Ham++
+	}
+	if delta.Spam == 0 && delta.Ham == 0 {
+		return nil
+	}
+
+	tokens := tokenize(comment.AuthorName + " " + comment.Content)
+	return c.tokenRepo.Add(ctx, append(tokens, spamDocumentsToken), delta)
+}
+
+// bayesScore 采用Robinson平滑和朴素贝叶斯合并计算垃圾概率，并返回影响最大的词元
+func bayesScore(tokens []string, counts map[string]domain.SpamTokenCount, docs domain.SpamTokenCount) (float64, []string) {
+	type scored struct {
+		token string
+		prob  float64
+	}
+
+	var candidates []scored
+	for _, token := range tokens {
+		count := counts[token]
+		n := float64(count.Spam + count.Ham)
+		if n == 0 {
+			continue
+		}
+
+		spamRatio := float64(count.Spam) / float64(docs.Spam)
+		hamRatio := float64(count.Ham) / float64(docs.Ham)
+		p := spamRatio / (spamRatio + hamRatio)
+
+		// 出现次数少的词元向0.5收缩
+		prob := (0.5 + n*p) / (1 + n)
+		prob = math.Min(math.Max(prob, 0.01), 0.99)
+
+		if math.Abs(prob-0.5) < 0.1 {
+			continue
+		}
+		candidates = append(candidates, scored{token: token, prob: prob})
+	}
+
+	if len(candidates) == 0 {
+		return 0.5, nil
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return math.Abs(candidates[i].prob-0.5) > math.Abs(candidates[j].prob-0.5)
+	})
+	if len(candidates) > spamInterestingTokens {
+		candidates = candidates[:spamInterestingTokens]
+	}
+
+	var logSpam, logHam float64
+	var evidence []string
+	for _, cand := range candidates {
+		logSpam += math.Log(cand.prob)
+		logHam += math.Log(1 - cand.prob)
+		if cand.prob > 0.5 && len(evidence) < 5 {
+			evidence = append(evidence, cand.token)
+		}
+	}
+
+	return 1 / (1 + math.Exp(logHam-logSpam)), evidence
+}
+
+// tokenize 将文本切分为去重后的词元，中日韩文字按单字切分
+func tokenize(text string) []string {
+	seen := make(map[string]bool)
+	var tokens []string
+	add := func(token string) {
+		if token == "" || seen[token] {
+			return
+		}
+		seen[token] = true
+		tokens = append(tokens, token)
+	}
+
+	var word strings.Builder
+	flush := func() {
+		if w := word.String(); len([]rune(w)) >= 2 && len(w) <= 40 {
+			add(w)
+		}
+		word.Reset()
+	}
+
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case isCJK(r):
+			flush()
+			add(string(r))
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			word.WriteRune(r)
+		default:
+			flush()
+		}
+	}
+	flush()
+
+	return tokens
+}
+
+// isCJK 判断是否为中日韩文字
+func isCJK(r rune) bool {
+	return unicode.Is(unicode.Han, r) || unicode.Is(unicode.Hiragana, r) ||
+		unicode.Is(unicode.Katakana, r) || unicode.Is(unicode.Hangul, r)
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	"goblog/internal/domain"
+)
+
+// StreamPublisher 在写入发件箱的同时推送实时事件流
+type StreamPublisher struct {
+	next     domain.EventPublisher
+	notifier domain.StreamNotifier
+}
+
+// NewStreamPublisher 为事件发布器增加实时事件流推送。事件流不经过发件箱，
+// 事务提交后立即推送给所有实例的连接，评论事件不包含评论者的隐私信息
+func NewStreamPublisher(next domain.EventPublisher, notifier domain.StreamNotifier) domain.EventPublisher {
+	return &StreamPublisher{next: next, notifier: notifier}
+}
+
+// Publish 写入发件箱并登记提交后的推送
+func (p *StreamPublisher) Publish(ctx context.Context, events ...domain.Event) error {
+	if err := p.next.Publish(ctx, events...); err != nil {
+		return err
+	}
+
+	for _, event := range events {
+		data, err := json.Marshal(streamPayload(event))
+		if err != nil {
+			return err
+		}
+		p.notifier.Notify(ctx, event.EventName(), data)
+	}
+	return nil
+}
+
+// streamPayload 返回推送给事件流客户端的事件内容
+func streamPayload(event domain.Event) domain.Event {
+	switch e := event.(type) {
+	case domain.CommentCreated:
+		return domain.CommentCreated{Comment: publicComment(e.Comment)}
+	case domain.CommentModerated:
+		return domain.CommentModerated{Before: publicComment(e.Before), Comment: publicComment(e.Comment)}
+	case domain.CommentDeleted:
+		return domain.CommentDeleted{Comment: publicComment(e.Comment)}
+	}
+	return event
+}
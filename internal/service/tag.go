@@ -3,20 +3,33 @@ package service
 import (
 	"context"
 	"goblog/internal/domain"
+	"math"
+	"slices"
+	"strings"
 )
 
 // TagService 标签服务实现
 type TagService struct {
 	tagRepo domain.TagRepository
+	tx      domain.Transactor
+	events  domain.EventPublisher
 }
 
 // NewTagService 创建标签服务
-func NewTagService(tagRepo domain.TagRepository) domain.TagService {
-	return &TagService{tagRepo: tagRepo}
+func NewTagService(tagRepo domain.TagRepository, tx domain.Transactor, events domain.EventPublisher) domain.TagService {
+	return &TagService{
+		tagRepo: tagRepo,
+		tx:      tx,
+		events:  events,
+	}
 }
 
 // Create 创建标签
 func (s *TagService) Create(ctx context.Context, req *domain.TagCreateRequest) (*domain.Tag, error) {
+	if err := checkReviewer(ctx); err != nil {
+		return nil, err
+	}
+
 	// 检查标签名称是否已存在
 	_, err := s.tagRepo.GetByName(ctx, req.Name)
 	if err == nil {
@@ -26,6 +39,15 @@ func (s *TagService) Create(ctx context.Context, req *domain.TagCreateRequest) (
 		return nil, err
 	}
 
+	// 别名仍指向原来的标签，不能再用作新标签的名称
+	_, err = s.tagRepo.GetAliasByName(ctx, req.Name)
+	if err == nil {
+		return nil, domain.ErrDuplicateResource
+	}
+	if err != domain.ErrNotFound {
+		return nil, err
+	}
+
 	tag := &domain.Tag{
 		Name:  req.Name,
 		Color: req.Color,
@@ -36,18 +58,52 @@ func (s *TagService) Create(ctx context.Context, req *domain.TagCreateRequest) (
 		tag.Color = "#007bff"
 	}
 
-	return s.tagRepo.Create(ctx, tag)
+	var created *domain.Tag
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		created, err = s.tagRepo.Create(ctx, tag)
+		if err != nil {
+			return err
+		}
+		return s.events.Publish(ctx, domain.TagCreated{Tag: created})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
 }
 
-// GetByID 根据ID获取标签
+// GetByID 根据ID获取标签，标签已被合并时返回指向目标标签的MovedError
 func (s *TagService) GetByID(ctx context.Context, id int) (*domain.Tag, error) {
-	return s.tagRepo.GetByID(ctx, id)
+	return getTag(ctx, s.tagRepo, id)
+}
+
+// getTag 获取标签，标签不存在但曾被合并到其他标签时返回MovedError
+func getTag(ctx context.Context, tagRepo domain.TagRepository, id int) (*domain.Tag, error) {
+	tag, err := tagRepo.GetByID(ctx, id)
+	if err != domain.ErrNotFound {
+		return tag, err
+	}
+
+	alias, aliasErr := tagRepo.GetAliasByFormerID(ctx, id)
+	if aliasErr == domain.ErrNotFound {
+		return nil, err
+	}
+	if aliasErr != nil {
+		return nil, aliasErr
+	}
+	return nil, &domain.MovedError{Location: alias.TagID}
 }
 
 // Update 更新标签
 func (s *TagService) Update(ctx context.Context, id int, req *domain.TagUpdateRequest) (*domain.Tag, error) {
+	if err := checkReviewer(ctx); err != nil {
+		return nil, err
+	}
+
 	// 检查标签是否存在
-	_, err := s.tagRepo.GetByID(ctx, id)
+	before, err := s.tagRepo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -61,6 +117,16 @@ func (s *TagService) Update(ctx context.Context, id int, req *domain.TagUpdateRe
 		return nil, err
 	}
 
+	// 新名称是其他标签的别名时视为重复；是本标签的别名时改回该名称，别名不再需要
+	alias, err := s.tagRepo.GetAliasByName(ctx, req.Name)
+	if err == nil && alias.TagID != id {
+		return nil, domain.ErrDuplicateResource
+	}
+	if err != nil && err != domain.ErrNotFound {
+		return nil, err
+	}
+	renamed := req.Name != before.Name
+
 	tag := &domain.Tag{
 		Name:  req.Name,
 		Color: req.Color,
@@ -71,15 +137,194 @@ func (s *TagService) Update(ctx context.Context, id int, req *domain.TagUpdateRe
 		tag.Color = "#007bff"
 	}
 
-	return s.tagRepo.Update(ctx, id, tag)
+	var updated *domain.Tag
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if alias != nil {
+			if err := s.tagRepo.DeleteAlias(ctx, alias.Name); err != nil {
+				return err
+			}
+		}
+		if renamed && req.KeepAlias {
+			if err := s.tagRepo.CreateAlias(ctx, &domain.TagAlias{Name: before.Name, TagID: id}); err != nil {
+				return err
+			}
+		}
+
+		var err error
+		updated, err = s.tagRepo.Update(ctx, id, tag)
+		if err != nil {
+			return err
+		}
+		if renamed && req.KeepAlias || alias != nil {
+			// 别名改变时重新读取，返回的标签包含最新的别名
+			if updated, err = s.tagRepo.GetByID(ctx, id); err != nil {
+				return err
+			}
+		} else {
+			updated.Aliases = before.Aliases
+		}
+		return s.events.Publish(ctx, domain.TagUpdated{Before: before, Tag: updated})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
 }
 
 // Delete 删除标签
 func (s *TagService) Delete(ctx context.Context, id int) error {
-	return s.tagRepo.Delete(ctx, id)
+	if err := checkReviewer(ctx); err != nil {
+		return err
+	}
+
+	before, err := s.tagRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.tagRepo.Delete(ctx, id); err != nil {
+			return err
+		}
+		return s.events.Publish(ctx, domain.TagDeleted{Tag: before})
+	})
+}
+
+// Merge 将来源标签合并到目标标签，在一个事务中移动文章关联、保留别名并删除来源标签。
+// 合并会改动大量文章，与批量修改标签一样只有编辑和管理员可以操作；每个来源标签发布一个TagDeleted事件
+func (s *TagService) Merge(ctx context.Context, targetID int, req *domain.TagMergeRequest) (*domain.TagMerge, error) {
+	if err := checkReviewer(ctx); err != nil {
+		return nil, err
+	}
+
+	sourceIDs := uniqueIDs(req.SourceIDs)
+	if len(sourceIDs) == 0 || slices.Contains(sourceIDs, targetID) {
+		return nil, domain.ErrInvalidInput
+	}
+
+	var merge *domain.TagMerge
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := s.tagRepo.GetByID(ctx, targetID); err != nil {
+			return err
+		}
+
+		sources, err := s.tagRepo.GetByIDs(ctx, sourceIDs)
+		if err != nil {
+			return err
+		}
+		if len(sources) != len(sourceIDs) {
+			return domain.ErrInvalidInput
+		}
+
+		moved, err := s.tagRepo.Merge(ctx, targetID, sourceIDs)
+		if err != nil {
+			return err
+		}
+
+		target, err := s.tagRepo.GetByID(ctx, targetID)
+		if err != nil {
+			return err
+		}
+		for _, source := range sources {
+			if err := s.events.Publish(ctx, domain.TagDeleted{Tag: source, MergedInto: target}); err != nil {
+				return err
+			}
+		}
+
+		merge = &domain.TagMerge{Target: target, SourceIDs: sourceIDs, Articles: moved}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return merge, nil
 }
 
-// List 获取标签列表
+// List 获取标签列表，包含每个标签的文章数
 func (s *TagService) List(ctx context.Context) ([]*domain.Tag, error) {
-	return s.tagRepo.List(ctx)
+	tags, err := s.tagRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := s.tagRepo.ArticleCounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, tag := range tags {
+		c := counts[tag.ID]
+		tag.Articles = &c
+	}
+
+	return tags, nil
+}
+
+// Cloud 返回标签云，只统计已发布的文章，按名称排序
+func (s *TagService) Cloud(ctx context.Context, limit int) ([]*domain.TagCloudItem, error) {
+	if limit < 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	tags, err := s.tagRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := s.tagRepo.ArticleCounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*domain.TagCloudItem, 0, len(tags))
+	for _, tag := range tags {
+		if published := counts[tag.ID].Published; published > 0 {
+			items = append(items, &domain.TagCloudItem{
+				ID:        tag.ID,
+				Name:      tag.Name,
+				Color:     tag.Color,
+				Articles:  published,
+				UpdatedAt: tag.UpdatedAt,
+			})
+		}
+	}
+
+	if limit > 0 && len(items) > limit {
+		slices.SortStableFunc(items, func(a, b *domain.TagCloudItem) int {
+			return b.Articles - a.Articles
+		})
+		items = items[:limit]
+	}
+
+	weighTagCloud(items)
+	slices.SortFunc(items, func(a, b *domain.TagCloudItem) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return items, nil
+}
+
+// weighTagCloud 按文章数的对数在最少和最多之间线性分级，文章数差距很大时少数热门标签不会压扁其余标签。
+// 文章数都相同时取中间一级
+func weighTagCloud(items []*domain.TagCloudItem) {
+	if len(items) == 0 {
+		return
+	}
+
+	least, most := items[0].Articles, items[0].Articles
+	for _, item := range items {
+		least = min(least, item.Articles)
+		most = max(most, item.Articles)
+	}
+
+	spread := math.Log(float64(most)) - math.Log(float64(least))
+	for _, item := range items {
+		if spread == 0 {
+			item.Weight = (domain.TagCloudLevels + 1) / 2
+			continue
+		}
+		ratio := (math.Log(float64(item.Articles)) - math.Log(float64(least))) / spread
+		item.Weight = 1 + int(math.Round(ratio*(domain.TagCloudLevels-1)))
+	}
 }
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"strings"
+	"time"
+
+	"goblog/internal/config"
+	"goblog/internal/domain"
+	"goblog/internal/pkg/totp"
+)
+
+// recoveryCodeCount 每次生成的恢复码数量
+const recoveryCodeCount = 10
+
+// TwoFactorService 两步验证服务实现
+type TwoFactorService struct {
+	twoFactorRepo domain.TwoFactorRepository
+	config        config.TwoFactorConfig
+}
+
+// NewTwoFactorService 创建两步验证服务
+func NewTwoFactorService(twoFactorRepo domain.TwoFactorRepository, cfg config.TwoFactorConfig) domain.TwoFactorService {
+	return &TwoFactorService{
+		twoFactorRepo: twoFactorRepo,
+		config:        cfg,
+	}
+}
+
+// IsEnabled 用户是否已开启两步验证
+func (s *TwoFactorService) IsEnabled(ctx context.Context, username string) (bool, error) {
+	tf, err := s.twoFactorRepo.GetByUsername(ctx, username)
+	if errors.Is(err, domain.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return tf.Enabled, nil
+}
+
+// Verify 校验TOTP验证码或恢复码，两者都只能使用一次
+func (s *TwoFactorService) Verify(ctx context.Context, username, code string) error {
+	tf, err := s.twoFactorRepo.GetByUsername(ctx, username)
+	if errors.Is(err, domain.ErrNotFound) {
+		return domain.ErrUnauthorized
+	}
+	if err != nil {
+		return err
+	}
+	if !tf.Enabled {
+		return domain.ErrUnauthorized
+	}
+
+	// TOTP验证码
+	if step, ok := totp.Validate(tf.Secret, code, time.Now()); ok {
+		used, err := s.twoFactorRepo.UseStep(ctx, username, step)
+		if err != nil {
+			return err
+		}
+		if !used {
+			return domain.ErrUnauthorized
+		}
+		return nil
+	}
+
+	// 恢复码
+	consumed, err := s.twoFactorRepo.ConsumeRecoveryCode(ctx, username, hashToken(normalizeRecoveryCode(code)))
+	if err != nil {
+		return err
+	}
+	if !consumed {
+		return domain.ErrUnauthorized
+	}
+	return nil
+}
+
+// Setup 生成新的TOTP密钥，需要调用Enable确认后才会生效
+func (s *TwoFactorService) Setup(ctx context.Context, username string) (*domain.TwoFactorSetup, error) {
+	enabled, err := s.IsEnabled(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	if enabled {
+		return nil, domain.ErrDuplicateResource
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.twoFactorRepo.Save(ctx, &domain.TwoFactor{
+		Username: username,
+		Secret:   secret,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &domain.TwoFactorSetup{
+		Secret:          secret,
+		ProvisioningURI: totp.ProvisioningURI(s.config.Issuer, username, secret),
+	}, nil
+}
+
+// Enable 使用验证器应用生成的验证码确认绑定，返回一次性恢复码
+func (s *TwoFactorService) Enable(ctx context.Context, username, code string) ([]string, error) {
+	tf, err := s.twoFactorRepo.GetByUsername(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	if tf.Enabled {
+		return nil, domain.ErrDuplicateResource
+	}
+
+	step, ok := totp.Validate(tf.Secret, code, time.Now())
+	if !ok {
+		return nil, domain.ErrUnauthorized
+	}
+
+	tf.Enabled = true
+	tf.LastUsedStep = step
+	if err := s.twoFactorRepo.Save(ctx, tf); err != nil {
+		return nil, err
+	}
+
+	return s.generateRecoveryCodes(ctx, username)
+}
+
+// Disable 关闭两步验证
+func (s *TwoFactorService) Disable(ctx context.Context, username, code string) error {
+	if err := s.Verify(ctx, username, code); err != nil {
+		return err
+	}
+	return s.twoFactorRepo.Delete(ctx, username)
+}
+
+// RegenerateRecoveryCodes 重新生成恢复码，旧的恢复码全部失效
+func (s *TwoFactorService) RegenerateRecoveryCodes(ctx context.Context, username, code string) ([]string, error) {
+	if err := s.Verify(ctx, username, code); err != nil {
+		return nil, err
+	}
+	return s.generateRecoveryCodes(ctx, username)
+}
+
+// generateRecoveryCodes 生成恢复码，数据库中只保存摘要
+func (s *TwoFactorService) generateRecoveryCodes(ctx context.Context, username string) ([]string, error) {
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+
+	for i := range codes {
+		buf := make([]byte, 7)
+		if _, err := rand.Read(buf); err != nil {
+			return nil, err
+		}
+		raw := strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(buf))[:10]
+		codes[i] = raw[:5] + "-" + raw[5:]
+		hashes[i] = hashToken(raw)
+	}
+
+	if err := s.twoFactorRepo.ReplaceRecoveryCodes(ctx, username, hashes); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// normalizeRecoveryCode 去掉恢复码中的分隔符并统一大小写
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	code = strings.ReplaceAll(code, "-", "")
+	return strings.ReplaceAll(code, " ", "")
+}
@@ -0,0 +1,389 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"goblog/internal/config"
+	"goblog/internal/domain"
+	"goblog/internal/pkg/webhook"
+)
+
+const (
+	// webhookSubscriber Webhook订阅者名称
+	webhookSubscriber = "webhook"
+	// webhookSecretPrefix 自动生成的签名密钥前缀
+	webhookSecretPrefix = "whsec_"
+	// webhookResponseLimit 投递日志中保存的响应内容长度
+	webhookResponseLimit = 1024
+	// webhookUserAgent 投递请求的User-Agent
+	webhookUserAgent = "goblog-webhook/1.0"
+)
+
+// webhookPayload 投递的请求体
+type webhookPayload struct {
+	ID        string      `json:"id"`
+	Event     string      `json:"event"`
+	CreatedAt time.Time   `json:"created_at"`
+	Data      interface{} `json:"data"`
+}
+
+// WebhookService Webhook服务实现
+type WebhookService struct {
+	webhookRepo  domain.WebhookRepository
+	deliveryRepo domain.WebhookDeliveryRepository
+	httpClient   *http.Client
+	config       config.WebhookConfig
+}
+
+// NewWebhookService 创建Webhook服务
+func NewWebhookService(
+	webhookRepo domain.WebhookRepository,
+	deliveryRepo domain.WebhookDeliveryRepository,
+	httpClient *http.Client,
+	cfg config.WebhookConfig,
+) domain.WebhookService {
+	return &WebhookService{
+		webhookRepo:  webhookRepo,
+		deliveryRepo: deliveryRepo,
+		httpClient:   httpClient,
+		config:       cfg,
+	}
+}
+
+// Create 创建订阅，签名密钥只在响应中返回这一次
+func (s *WebhookService) Create(ctx context.Context, username string, req *domain.WebhookCreateRequest) (*domain.WebhookCreated, error) {
+	events, err := normalizeWebhook(req.URL, req.Events)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := req.Secret
+	if secret == "" {
+		token, err := randomToken(24)
+		if err != nil {
+			return nil, err
+		}
+		secret = webhookSecretPrefix + token
+	}
+
+	active := true
+	if req.Active != nil {
+		active = *req.Active
+	}
+
+	created, err := s.webhookRepo.Create(ctx, &domain.Webhook{
+		URL:         req.URL,
+		Secret:      secret,
+		Events:      events,
+		Description: req.Description,
+		Active:      active,
+		CreatedBy:   username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.WebhookCreated{Webhook: created, Secret: secret}, nil
+}
+
+// GetByID 根据ID获取订阅
+func (s *WebhookService) GetByID(ctx context.Context, id int) (*domain.Webhook, error) {
+	return s.webhookRepo.GetByID(ctx, id)
+}
+
+// Update 更新订阅，未提供签名密钥时保留原密钥
+func (s *WebhookService) Update(ctx context.Context, id int, req *domain.WebhookUpdateRequest) (*domain.Webhook, error) {
+	existing, err := s.webhookRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := normalizeWebhook(req.URL, req.Events)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &domain.Webhook{
+		URL:         req.URL,
+		Secret:      existing.Secret,
+		Events:      events,
+		Description: req.Description,
+		Active:      existing.Active,
+	}
+	if req.Secret != "" {
+		w.Secret = req.Secret
+	}
+	if req.Active != nil {
+		w.Active = *req.Active
+	}
+
+	return s.webhookRepo.Update(ctx, id, w)
+}
+
+// Delete 删除订阅
+func (s *WebhookService) Delete(ctx context.Context, id int) error {
+	return s.webhookRepo.Delete(ctx, id)
+}
+
+// List 获取全部订阅
+func (s *WebhookService) List(ctx context.Context) ([]*domain.Webhook, error) {
+	return s.webhookRepo.List(ctx)
+}
+
+// ListDeliveries 获取订阅的投递日志
+func (s *WebhookService) ListDeliveries(ctx context.Context, webhookID int, params domain.WebhookDeliveryQueryParams) ([]*domain.WebhookDelivery, int64, error) {
+	switch params.Status {
+	case "", domain.WebhookDeliveryPending, domain.WebhookDeliverySucceeded, domain.WebhookDeliveryFailed:
+	default:
+		return nil, 0, domain.ErrInvalidInput
+	}
+
+	if _, err := s.webhookRepo.GetByID(ctx, webhookID); err != nil {
+		return nil, 0, err
+	}
+
+	return s.deliveryRepo.List(ctx, webhookID, params)
+}
+
+// Replay 使用原始请求体重新投递，事件ID不变，接收方可据此去重
+func (s *WebhookService) Replay(ctx context.Context, webhookID, deliveryID int) (*domain.WebhookDelivery, error) {
+	original, err := s.deliveryRepo.GetByID(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	if original.WebhookID != webhookID {
+		return nil, domain.ErrNotFound
+	}
+
+	if _, err := s.webhookRepo.GetByID(ctx, webhookID); err != nil {
+		return nil, err
+	}
+
+	replay := &domain.WebhookDelivery{
+		WebhookID: original.WebhookID,
+		EventID:   original.EventID,
+		Event:     original.Event,
+		Payload:   original.Payload,
+		ReplayOf:  original.ID,
+	}
+	if err := s.deliveryRepo.CreateBulk(ctx, []*domain.WebhookDelivery{replay}); err != nil {
+		return nil, err
+	}
+
+	return replay, nil
+}
+
+// Trigger 为订阅了该事件的Webhook创建投递记录，每个订阅对同一事件只创建一次
+func (s *WebhookService) Trigger(ctx context.Context, event string, data interface{}) error {
+	webhooks, err := s.webhookRepo.ListActive(ctx)
+	if err != nil {
+		return err
+	}
+
+	var matched []*domain.Webhook
+	for _, w := range webhooks {
+		if w.Matches(event) {
+			matched = append(matched, w)
+		}
+	}
+	if len(matched) == 0 {
+		return nil
+	}
+
+	// 由发件箱投递时沿用发件箱的事件ID，重复投递不会产生新的通知；同步发布时没有事件ID
+	eventID := domain.EventIDFromContext(ctx)
+	if eventID == "" {
+		eventID, err = randomToken(16)
+		if err != nil {
+			return err
+		}
+	}
+
+	body, err := json.Marshal(webhookPayload{
+		ID:        eventID,
+		Event:     event,
+		CreatedAt: time.Now().UTC(),
+		Data:      data,
+	})
+	if err != nil {
+		return err
+	}
+
+	deliveries := make([]*domain.WebhookDelivery, len(matched))
+	for i, w := range matched {
+		deliveries[i] = &domain.WebhookDelivery{
+			WebhookID: w.ID,
+			EventID:   eventID,
+			Event:     event,
+			Payload:   string(body),
+		}
+	}
+
+	return s.deliveryRepo.CreateForEvent(ctx, deliveries)
+}
+
+// ProcessDue 投递一批到期的记录
+func (s *WebhookService) ProcessDue(ctx context.Context) (int, error) {
+	// 租约需要覆盖一次请求的超时时间，实例崩溃后租约到期即可被重新领取
+	lease := 2 * s.config.Timeout
+	if lease < time.Minute {
+		lease = time.Minute
+	}
+
+	deliveries, err := s.deliveryRepo.ClaimDue(ctx, time.Now(), s.config.BatchSize, lease)
+	if err != nil {
+		return 0, err
+	}
+
+	webhooks := make(map[int]*domain.Webhook)
+	for i, d := range deliveries {
+		w, ok := webhooks[d.WebhookID]
+		if !ok {
+			w, err = s.webhookRepo.GetByID(ctx, d.WebhookID)
+			if err != nil && !errors.Is(err, domain.ErrNotFound) {
+				return i, err
+			}
+			webhooks[d.WebhookID] = w
+		}
+
+		switch {
+		case w == nil:
+			d.Status = domain.WebhookDeliveryFailed
+			d.LastError = "订阅已删除"
+		case !w.Active:
+			d.Status = domain.WebhookDeliveryFailed
+			d.LastError = "订阅已停用"
+		default:
+			s.deliver(ctx, w, d)
+		}
+
+		if err := s.deliveryRepo.SaveResult(ctx, d); err != nil {
+			return i, err
+		}
+	}
+
+	return len(deliveries), nil
+}
+
+// deliver 发送一次投递请求，并根据结果更新投递状态和下次尝试时间
+func (s *WebhookService) deliver(ctx context.Context, w *domain.Webhook, d *domain.WebhookDelivery) {
+	d.Attempts++
+	d.ResponseStatus = 0
+	d.ResponseBody = ""
+	d.LastError = ""
+
+	status, body, duration, err := s.send(ctx, w, d)
+	d.DurationMS = int(duration.Milliseconds())
+	d.ResponseStatus = status
+	d.ResponseBody = body
+
+	now := time.Now()
+	if err == nil && status >= 200 && status < 300 {
+		d.Status = domain.WebhookDeliverySucceeded
+		d.DeliveredAt = &now
+		return
+	}
+
+	if err != nil {
+		d.LastError = err.Error()
+	} else {
+		d.LastError = fmt.Sprintf("unexpected status %d", status)
+	}
+
+	if d.Attempts >= s.config.MaxAttempts {
+		d.Status = domain.WebhookDeliveryFailed
+		return
+	}
+	d.Status = domain.WebhookDeliveryPending
+	d.NextAttemptAt = now.Add(exponentialBackoff(s.config.BackoffBase, s.config.BackoffMax, d.Attempts))
+}
+
+// send 发送签名后的请求，返回状态码和截断后的响应内容
+func (s *WebhookService) send(ctx context.Context, w *domain.Webhook, d *domain.WebhookDelivery) (int, string, time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.config.Timeout)
+	defer cancel()
+
+	payload := []byte(d.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(payload))
+	if err != nil {
+		return 0, "", 0, err
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", webhookUserAgent)
+	req.Header.Set(webhook.HeaderEvent, d.Event)
+	req.Header.Set(webhook.HeaderEventID, d.EventID)
+	req.Header.Set(webhook.HeaderDelivery, strconv.Itoa(d.ID))
+	req.Header.Set(webhook.HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(webhook.HeaderSignature, webhook.Sign(w.Secret, timestamp, payload))
+
+	start := time.Now()
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return 0, "", time.Since(start), err
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, webhookResponseLimit))
+	// 截断可能切断多字节字符，去掉不完整的部分
+	return resp.StatusCode, strings.ToValidUTF8(string(body), ""), time.Since(start), nil
+}
+
+// normalizeWebhook 校验回调地址并去重事件列表
+func normalizeWebhook(rawURL string, events []string) ([]string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, domain.ErrInvalidInput
+	}
+
+	normalized := make([]string, 0, len(events))
+	for _, event := range events {
+		if event != domain.WebhookEventAll && !slices.Contains(domain.WebhookEvents, event) {
+			return nil, domain.ErrInvalidInput
+		}
+		if !slices.Contains(normalized, event) {
+			normalized = append(normalized, event)
+		}
+	}
+	if len(normalized) == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	return normalized, nil
+}
+
+// SubscribeWebhooks 注册Webhook订阅者，将可订阅的事件转换为投递记录
+func SubscribeWebhooks(bus *domain.EventBus, webhookService domain.WebhookService) {
+	domain.On(bus, webhookSubscriber, func(ctx context.Context, e domain.ArticlePublished) error {
+		return webhookService.Trigger(ctx, domain.EventArticlePublished, e.Article)
+	})
+	domain.On(bus, webhookSubscriber, func(ctx context.Context, e domain.ArticleUpdated) error {
+		// 草稿首次发布已经触发发布事件
+		if e.Article.Published && !e.Before.Published {
+			return nil
+		}
+		return webhookService.Trigger(ctx, domain.EventArticleUpdated, e.Article)
+	})
+	domain.On(bus, webhookSubscriber, func(ctx context.Context, e domain.ArticleDeleted) error {
+		return webhookService.Trigger(ctx, domain.EventArticleDeleted, e.Article)
+	})
+	domain.On(bus, webhookSubscriber, func(ctx context.Context, e domain.CommentCreated) error {
+		// 垃圾评论不通知订阅方，推送内容不包含评论者的隐私信息
+		if e.Comment.Status == domain.CommentStatusSpam {
+			return nil
+		}
+		return webhookService.Trigger(ctx, domain.EventCommentCreated, publicComment(e.Comment))
+	})
+}
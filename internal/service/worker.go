@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"goblog/internal/pkg/logger"
+)
+
+// RunWorker 定期执行process直到ctx取消，process返回处理的数量，
+// 有积压时连续处理直到没有到期任务，避免每个周期只处理一批
+func RunWorker(ctx context.Context, name string, interval time.Duration, process func(ctx context.Context) (int, error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for {
+				n, err := process(ctx)
+				if err != nil {
+					if ctx.Err() == nil {
+						logger.Error("后台任务执行失败", "worker", name, "error", err)
+					}
+					break
+				}
+				if n == 0 {
+					break
+				}
+			}
+		}
+	}
+}
+
+// exponentialBackoff 计算第n次失败后的重试间隔 base*2^(n-1)，不超过max
+func exponentialBackoff(base, max time.Duration, attempts int) time.Duration {
+	delay := base
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= max {
+			return max
+		}
+	}
+	return delay
+}
@@ -0,0 +1,185 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"goblog/internal/domain"
+	"goblog/internal/middleware"
+	"goblog/internal/service"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockAPIKeyRepository API密钥仓储Mock
+type MockAPIKeyRepository struct {
+	mock.Mock
+}
+
+func (m *MockAPIKeyRepository) Create(ctx context.Context, key *domain.APIKey) (*domain.APIKey, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.APIKey), args.Error(1)
+}
+
+func (m *MockAPIKeyRepository) GetByHash(ctx context.Context, keyHash string) (*domain.APIKey, error) {
+	args := m.Called(ctx, keyHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.APIKey), args.Error(1)
+}
+
+func (m *MockAPIKeyRepository) ListByUsername(ctx context.Context, username string) ([]*domain.APIKey, error) {
+	args := m.Called(ctx, username)
+	return args.Get(0).([]*domain.APIKey), args.Error(1)
+}
+
+func (m *MockAPIKeyRepository) Revoke(ctx context.Context, id int, username string) error {
+	args := m.Called(ctx, id, username)
+	return args.Error(0)
+}
+
+func (m *MockAPIKeyRepository) Touch(ctx context.Context, id int, usedAt time.Time) error {
+	args := m.Called(ctx, id, usedAt)
+	return args.Error(0)
+}
+
+// newTestAPIKeyService 创建API密钥服务及其Mock依赖
+func newTestAPIKeyService() (domain.APIKeyService, *MockAPIKeyRepository, *MockUserRepository) {
+	mockAPIKeyRepo := new(MockAPIKeyRepository)
+	mockUserRepo := new(MockUserRepository)
+	return service.NewAPIKeyService(mockAPIKeyRepo, mockUserRepo, testAuthConfig()), mockAPIKeyRepo, mockUserRepo
+}
+
+// TestAPIKeyService_Create 测试创建密钥时只保存摘要
+func TestAPIKeyService_Create(t *testing.T) {
+	apiKeyService, mockAPIKeyRepo, _ := newTestAPIKeyService()
+
+	var saved *domain.APIKey
+	mockAPIKeyRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.APIKey")).
+		Run(func(args mock.Arguments) { saved = args.Get(1).(*domain.APIKey) }).
+		Return(&domain.APIKey{ID: 1, Name: "ci"}, nil)
+
+	created, err := apiKeyService.Create(context.Background(), "admin", &domain.APIKeyCreateRequest{
+		Name:   "ci",
+		Scopes: []string{domain.ScopeArticlesWrite, domain.ScopeArticlesWrite},
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(created.Key, "gb_"))
+	assert.Equal(t, created.Key[:11], saved.Prefix)
+	assert.Len(t, saved.KeyHash, 64)
+	assert.NotContains(t, saved.KeyHash, created.Key)
+	assert.Equal(t, []string{domain.ScopeArticlesWrite}, saved.Scopes)
+}
+
+// TestAPIKeyService_Create_InvalidInput 测试未知权限范围和已过去的过期时间
+func TestAPIKeyService_Create_InvalidInput(t *testing.T) {
+	apiKeyService, _, _ := newTestAPIKeyService()
+
+	_, err := apiKeyService.Create(context.Background(), "admin", &domain.APIKeyCreateRequest{
+		Name:   "ci",
+		Scopes: []string{"everything"},
+	})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	past := time.Now().Add(-time.Hour)
+	_, err = apiKeyService.Create(context.Background(), "admin", &domain.APIKeyCreateRequest{
+		Name:      "ci",
+		Scopes:    []string{domain.ScopeBackupRead},
+		ExpiresAt: &past,
+	})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+// TestAPIKeyService_Authenticate 测试验证密钥并记录使用时间
+func TestAPIKeyService_Authenticate(t *testing.T) {
+	apiKeyService, mockAPIKeyRepo, mockUserRepo := newTestAPIKeyService()
+
+	mockAPIKeyRepo.On("GetByHash", mock.Anything, mock.Anything).
+		Return(&domain.APIKey{ID: 7, Username: "alice", Scopes: []string{domain.ScopeArticlesWrite}}, nil)
+	mockUserRepo.On("GetByUsername", mock.Anything, "alice").Return(&domain.User{Username: "alice", Role: domain.RoleAuthor}, nil)
+	mockAPIKeyRepo.On("Touch", mock.Anything, 7, mock.AnythingOfType("time.Time")).Return(nil)
+
+	principal, err := apiKeyService.Authenticate(context.Background(), "gb_secret")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", principal.Username)
+	assert.Equal(t, domain.RoleAuthor, principal.Role)
+	assert.True(t, principal.HasScope(domain.ScopeArticlesWrite))
+	assert.False(t, principal.HasScope(domain.ScopeBackupRead))
+	mockAPIKeyRepo.AssertExpectations(t)
+}
+
+// TestAPIKeyService_Authenticate_RecentlyUsed 测试最近使用过的密钥不重复写入使用时间
+func TestAPIKeyService_Authenticate_RecentlyUsed(t *testing.T) {
+	apiKeyService, mockAPIKeyRepo, _ := newTestAPIKeyService()
+
+	lastUsed := time.Now().Add(-10 * time.Second)
+	mockAPIKeyRepo.On("GetByHash", mock.Anything, mock.Anything).
+		Return(&domain.APIKey{ID: 7, Username: "admin", LastUsedAt: &lastUsed}, nil)
+
+	_, err := apiKeyService.Authenticate(context.Background(), "gb_secret")
+
+	assert.NoError(t, err)
+	mockAPIKeyRepo.AssertNotCalled(t, "Touch", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestAPIKeyService_Authenticate_ExpiredOrRevoked 测试过期和已吊销的密钥
+func TestAPIKeyService_Authenticate_ExpiredOrRevoked(t *testing.T) {
+	past := time.Now().Add(-time.Minute)
+
+	for _, key := range []*domain.APIKey{
+		{ID: 1, Username: "admin", ExpiresAt: &past},
+		{ID: 2, Username: "admin", RevokedAt: &past},
+	} {
+		apiKeyService, mockAPIKeyRepo, _ := newTestAPIKeyService()
+		mockAPIKeyRepo.On("GetByHash", mock.Anything, mock.Anything).Return(key, nil)
+
+		_, err := apiKeyService.Authenticate(context.Background(), "gb_secret")
+
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+	}
+}
+
+// TestAuthMiddleware_APIKeyScope 测试API密钥只能访问权限范围内的路由
+func TestAuthMiddleware_APIKeyScope(t *testing.T) {
+	authService, _, _, _, _ := newTestAuthService()
+	apiKeyService, mockAPIKeyRepo, _ := newTestAPIKeyService()
+	authMiddleware := middleware.NewAuthMiddleware(authService, apiKeyService)
+
+	lastUsed := time.Now()
+	mockAPIKeyRepo.On("GetByHash", mock.Anything, mock.Anything).
+		Return(&domain.APIKey{ID: 1, Username: "admin", Scopes: []string{domain.ScopeArticlesWrite}, LastUsedAt: &lastUsed}, nil)
+
+	e := echo.New()
+	ok := func(c echo.Context) error { return c.String(http.StatusOK, "ok") }
+	e.POST("/articles", ok, authMiddleware.RequireAuth(), authMiddleware.RequireScope(domain.ScopeArticlesWrite))
+	e.GET("/articles/backup", ok, authMiddleware.RequireAuth(), authMiddleware.RequireScope(domain.ScopeBackupRead))
+	e.GET("/auth/api-keys", ok, authMiddleware.RequireAuth(), authMiddleware.RequireSession())
+	e.GET("/events/stream", ok, authMiddleware.RequireAuth(), authMiddleware.RequireScope(domain.ScopeEventsRead))
+
+	for path, expected := range map[string]int{
+		"POST /articles":       http.StatusOK,
+		"GET /articles/backup": http.StatusForbidden,
+		"GET /auth/api-keys":   http.StatusForbidden,
+		"GET /events/stream":   http.StatusForbidden,
+	} {
+		parts := strings.SplitN(path, " ", 2)
+		req := httptest.NewRequest(parts[0], parts[1], nil)
+		req.Header.Set("Authorization", "ApiKey gb_secret")
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, expected, rec.Code, path)
+	}
+}
@@ -0,0 +1,71 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"goblog/internal/domain"
+	"goblog/internal/handler"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func listArticles(articleHandler *handler.ArticleHandler, query string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/api/articles?"+query, nil)
+	rec := httptest.NewRecorder()
+	articleHandler.List(echo.New().NewContext(req, rec))
+	return rec
+}
+
+// TestArticleHandler_CursorPagination 测试游标分页返回游标元信息，不返回总数
+func TestArticleHandler_CursorPagination(t *testing.T) {
+	articleService, mockArticleRepo, _, _ := newTestWorkflowService()
+	articleHandler := handler.NewArticleHandler(articleService)
+
+	mockArticleRepo.On("List", mock.Anything, domain.QueryParams{Limit: 10, After: "abc", Fields: domain.ArticleSummaryFields}).
+		Return(&domain.ArticlePage{Items: []*domain.Article{{ID: 9}}, Total: -1, NextCursor: "next", PrevCursor: "prev"}, nil)
+
+	rec := listArticles(articleHandler, "after=abc")
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var body struct {
+		Meta map[string]interface{} `json:"meta"`
+	}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, map[string]interface{}{"limit": float64(10), "next_cursor": "next", "prev_cursor": "prev"}, body.Meta)
+}
+
+// TestArticleHandler_OffsetPaginationCursors 测试页码分页保持原有元信息，并附带可切换到游标分页的游标
+func TestArticleHandler_OffsetPaginationCursors(t *testing.T) {
+	articleService, mockArticleRepo, _, _ := newTestWorkflowService()
+	articleHandler := handler.NewArticleHandler(articleService)
+
+	mockArticleRepo.On("List", mock.Anything, domain.QueryParams{Page: 2, Limit: 5, Fields: domain.ArticleSummaryFields}).
+		Return(&domain.ArticlePage{Items: []*domain.Article{{ID: 6}}, Total: 11, NextCursor: "next", PrevCursor: "prev"}, nil)
+
+	rec := listArticles(articleHandler, "page=2&limit=5")
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var body struct {
+		Meta map[string]interface{} `json:"meta"`
+	}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, float64(2), body.Meta["page"])
+	assert.Equal(t, float64(11), body.Meta["total"])
+	assert.Equal(t, float64(3), body.Meta["total_page"])
+	assert.Equal(t, "next", body.Meta["next_cursor"])
+}
+
+// TestArticleService_ListRejectsBothCursors 测试同时指定after和before时拒绝请求
+func TestArticleService_ListRejectsBothCursors(t *testing.T) {
+	articleService, mockArticleRepo, _, _ := newTestWorkflowService()
+
+	_, err := articleService.List(context.Background(), domain.QueryParams{After: "a", Before: "b"})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	mockArticleRepo.AssertNotCalled(t, "List", mock.Anything, mock.Anything)
+}
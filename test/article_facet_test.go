@@ -0,0 +1,100 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"goblog/internal/domain"
+	"goblog/internal/handler"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// TestArticleHandler_Facets 测试请求分面时默认分页，并在元信息中返回分面统计
+func TestArticleHandler_Facets(t *testing.T) {
+	articleService, mockArticleRepo, _, _ := newTestWorkflowService()
+	articleHandler := handler.NewArticleHandler(articleService)
+
+	facets := map[string][]domain.FacetBucket{
+		domain.FacetCategory: {{Value: 1, Name: "Go", Count: 3}},
+		domain.FacetYear:     {{Value: 2026, Count: 2}, {Value: 2025, Count: 1}},
+	}
+	mockArticleRepo.On("List", mock.Anything, domain.QueryParams{
+		Page:   1,
+		Limit:  10,
+		Facets: []string{domain.FacetCategory, domain.FacetYear},
+		Fields: domain.ArticleSummaryFields,
+	}).Return(&domain.ArticlePage{Items: []*domain.Article{}, Total: 3, Facets: facets}, nil)
+
+	rec := listArticles(articleHandler, "facets=category,year,category")
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var body struct {
+		Meta struct {
+			Total  int                             `json:"total"`
+			Facets map[string][]domain.FacetBucket `json:"facets"`
+		} `json:"meta"`
+	}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, 3, body.Meta.Total)
+	assert.Equal(t, facets, body.Meta.Facets)
+}
+
+// TestArticleHandler_NoFacets 测试未请求分面时元信息中不出现facets
+func TestArticleHandler_NoFacets(t *testing.T) {
+	articleService, mockArticleRepo, _, _ := newTestWorkflowService()
+	articleHandler := handler.NewArticleHandler(articleService)
+
+	mockArticleRepo.On("List", mock.Anything, domain.QueryParams{Page: 1, Limit: 10, Fields: domain.ArticleSummaryFields}).
+		Return(&domain.ArticlePage{Items: []*domain.Article{}}, nil)
+
+	rec := listArticles(articleHandler, "page=1")
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var body struct {
+		Meta map[string]interface{} `json:"meta"`
+	}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.NotContains(t, body.Meta, "facets")
+}
+
+// TestArticleHandler_InvalidFacet 测试未知分面返回400
+func TestArticleHandler_InvalidFacet(t *testing.T) {
+	articleService, mockArticleRepo, _, _ := newTestWorkflowService()
+	articleHandler := handler.NewArticleHandler(articleService)
+
+	rec := listArticles(articleHandler, "facets=category,author")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	_, err := articleService.List(context.Background(), domain.QueryParams{Facets: []string{"author"}})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	mockArticleRepo.AssertNotCalled(t, "List", mock.Anything, mock.Anything)
+}
+
+// TestArticleHandler_ListETagCoversFacetsAndCursors 测试文章相同但分面统计或游标变化时ETag也变化
+func TestArticleHandler_ListETagCoversFacetsAndCursors(t *testing.T) {
+	articleService, mockArticleRepo, _, _ := newTestWorkflowService()
+	articleHandler := handler.NewArticleHandler(articleService)
+
+	items := []*domain.Article{{ID: 1, UpdatedAt: time.Now()}}
+	params := domain.QueryParams{Page: 1, Limit: 10, Facets: []string{domain.FacetCategory}, Fields: domain.ArticleSummaryFields}
+	pages := []*domain.ArticlePage{
+		{Items: items, Total: 3, NextCursor: "a", Facets: map[string][]domain.FacetBucket{domain.FacetCategory: {{Value: 1, Name: "Go", Count: 3}}}},
+		{Items: items, Total: 3, NextCursor: "a", Facets: map[string][]domain.FacetBucket{domain.FacetCategory: {{Value: 1, Name: "Go", Count: 2}, {Value: 2, Name: "Rust", Count: 1}}}},
+		{Items: items, Total: 3, NextCursor: "a", Facets: map[string][]domain.FacetBucket{domain.FacetCategory: {{Value: 1, Name: "Golang", Count: 2}, {Value: 2, Name: "Rust", Count: 1}}}},
+		{Items: items, Total: 3, NextCursor: "b", Facets: map[string][]domain.FacetBucket{domain.FacetCategory: {{Value: 1, Name: "Golang", Count: 2}, {Value: 2, Name: "Rust", Count: 1}}}},
+	}
+
+	etags := map[string]bool{}
+	for _, page := range pages {
+		mockArticleRepo.On("List", mock.Anything, params).Return(page, nil).Once()
+		rec := listArticles(articleHandler, "facets=category")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		etags[rec.Header().Get("ETag")] = true
+	}
+	assert.Len(t, etags, len(pages))
+}
@@ -0,0 +1,101 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"goblog/internal/domain"
+	"goblog/internal/handler"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func listedItems(t *testing.T, body []byte) []map[string]interface{} {
+	var resp struct {
+		Data []map[string]interface{} `json:"data"`
+	}
+	assert.NoError(t, json.Unmarshal(body, &resp))
+	return resp.Data
+}
+
+// TestArticleHandler_DefaultSummaryFields 测试列表默认返回不含正文的摘要投影
+func TestArticleHandler_DefaultSummaryFields(t *testing.T) {
+	articleService, mockArticleRepo, _, _ := newTestWorkflowService()
+	articleHandler := handler.NewArticleHandler(articleService)
+
+	mockArticleRepo.On("List", mock.Anything, domain.QueryParams{Fields: domain.ArticleSummaryFields}).
+		Return(&domain.ArticlePage{Items: []*domain.Article{{ID: 1, Title: "标题", Summary: "摘要"}}}, nil)
+
+	rec := listArticles(articleHandler, "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	items := listedItems(t, rec.Body.Bytes())
+	if assert.Len(t, items, 1) {
+		assert.Equal(t, "标题", items[0]["title"])
+		assert.Equal(t, "摘要", items[0]["summary"])
+		assert.NotContains(t, items[0], "content")
+	}
+}
+
+// TestArticleHandler_SparseFields 测试fields只返回请求的字段，id总是返回
+func TestArticleHandler_SparseFields(t *testing.T) {
+	articleService, mockArticleRepo, _, _ := newTestWorkflowService()
+	articleHandler := handler.NewArticleHandler(articleService)
+
+	mockArticleRepo.On("List", mock.Anything, domain.QueryParams{Page: 1, Limit: 10, Fields: []string{"title", "tags"}}).
+		Return(&domain.ArticlePage{Items: []*domain.Article{{
+			ID:      1,
+			Title:   "标题",
+			Content: "正文",
+			Tags:    []domain.Tag{{ID: 2, Name: "Go"}},
+		}}, Total: 1}, nil)
+
+	rec := listArticles(articleHandler, "page=1&fields=title,tags,title")
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	items := listedItems(t, rec.Body.Bytes())
+	if assert.Len(t, items, 1) {
+		assert.ElementsMatch(t, []string{"id", "title", "tags"}, mapKeys(items[0]))
+	}
+}
+
+// TestArticleHandler_AllFields 测试fields=all返回完整文章
+func TestArticleHandler_AllFields(t *testing.T) {
+	articleService, mockArticleRepo, _, _ := newTestWorkflowService()
+	articleHandler := handler.NewArticleHandler(articleService)
+
+	mockArticleRepo.On("List", mock.Anything, domain.QueryParams{}).
+		Return(&domain.ArticlePage{Items: []*domain.Article{{ID: 1, Title: "标题", Content: "正文"}}}, nil)
+
+	rec := listArticles(articleHandler, "fields=all")
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	items := listedItems(t, rec.Body.Bytes())
+	if assert.Len(t, items, 1) {
+		assert.Equal(t, "正文", items[0]["content"])
+	}
+}
+
+// TestArticleHandler_InvalidFields 测试未知字段返回400
+func TestArticleHandler_InvalidFields(t *testing.T) {
+	articleService, mockArticleRepo, _, _ := newTestWorkflowService()
+	articleHandler := handler.NewArticleHandler(articleService)
+
+	rec := listArticles(articleHandler, "fields=title,password")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	_, err := articleService.List(context.Background(), domain.QueryParams{Fields: []string{"lock"}})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	mockArticleRepo.AssertNotCalled(t, "List", mock.Anything, mock.Anything)
+}
+
+func mapKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
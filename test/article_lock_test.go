@@ -0,0 +1,134 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"goblog/internal/config"
+	"goblog/internal/domain"
+	"goblog/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockArticleLockRepository 文章编辑租约仓储Mock
+type MockArticleLockRepository struct {
+	mock.Mock
+}
+
+func (m *MockArticleLockRepository) Get(ctx context.Context, articleID int) (*domain.ArticleLock, error) {
+	args := m.Called(ctx, articleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ArticleLock), args.Error(1)
+}
+
+func (m *MockArticleLockRepository) Acquire(ctx context.Context, articleID int, holder string, expiresAt, now time.Time, force bool) (*domain.ArticleLock, error) {
+	args := m.Called(ctx, articleID, holder, expiresAt, now, force)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ArticleLock), args.Error(1)
+}
+
+func (m *MockArticleLockRepository) Delete(ctx context.Context, articleID int) error {
+	args := m.Called(ctx, articleID)
+	return args.Error(0)
+}
+
+func newTestArticleLockService() (domain.ArticleLockService, *MockArticleLockRepository, *MockArticleRepository) {
+	mockLockRepo := new(MockArticleLockRepository)
+	mockArticleRepo := new(MockArticleRepository)
+	mockArticleRepo.On("GetByID", mock.Anything, 1).Return(&domain.Article{ID: 1, Version: 3}, nil)
+	lockService := service.NewArticleLockService(mockLockRepo, mockArticleRepo, config.ArticleConfig{LockTTL: 5 * time.Minute})
+	return lockService, mockLockRepo, mockArticleRepo
+}
+
+// TestArticleService_UpdateVersionConflict 测试基于旧版本的更新返回当前文章
+func TestArticleService_UpdateVersionConflict(t *testing.T) {
+	articleService, mockArticleRepo, _, publisher := newTestWorkflowService()
+
+	current := &domain.Article{ID: 1, Title: "他人修改后的标题", Status: domain.ArticleStatusDraft, Version: 4}
+	mockArticleRepo.On("GetByID", mock.Anything, 1).Return(current, nil)
+
+	_, err := articleService.Update(context.Background(), 1, &domain.ArticleUpdateRequest{Version: 3, Title: "标题", Content: "内容"})
+
+	var conflict *domain.ConflictError
+	assert.ErrorAs(t, err, &conflict)
+	assert.ErrorIs(t, err, domain.ErrVersionConflict)
+	assert.Equal(t, current, conflict.Current)
+	assert.Empty(t, publisher.events)
+	mockArticleRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestArticleService_UpdateConcurrentWrite 测试读取之后被他人抢先更新
+func TestArticleService_UpdateConcurrentWrite(t *testing.T) {
+	articleService, mockArticleRepo, _, _ := newTestWorkflowService()
+
+	before := &domain.Article{ID: 1, Status: domain.ArticleStatusDraft, Version: 3}
+	current := &domain.Article{ID: 1, Status: domain.ArticleStatusDraft, Version: 4}
+	mockArticleRepo.On("GetByID", mock.Anything, 1).Return(before, nil).Once()
+	mockArticleRepo.On("GetByID", mock.Anything, 1).Return(current, nil).Once()
+	mockArticleRepo.On("Update", mock.Anything, 1, mock.MatchedBy(func(a *domain.Article) bool { return a.Version == 3 })).
+		Return(nil, domain.ErrVersionConflict)
+
+	_, err := articleService.Update(context.Background(), 1, &domain.ArticleUpdateRequest{Version: 3, Title: "标题", Content: "内容"})
+
+	var conflict *domain.ConflictError
+	assert.ErrorAs(t, err, &conflict)
+	assert.Equal(t, current, conflict.Current)
+}
+
+// TestArticleLockService_Acquire 测试获取租约、他人持有时冲突以及强制接管的角色要求
+func TestArticleLockService_Acquire(t *testing.T) {
+	lockService, mockLockRepo, _ := newTestArticleLockService()
+
+	alice := actorContext("alice", domain.RoleAuthor)
+	bob := actorContext("bob", domain.RoleEditor)
+
+	held := &domain.ArticleLock{ArticleID: 1, Holder: "alice", ExpiresAt: time.Now().Add(5 * time.Minute)}
+	mockLockRepo.On("Acquire", mock.Anything, 1, "alice", mock.Anything, mock.Anything, false).Return(held, nil)
+	mockLockRepo.On("Acquire", mock.Anything, 1, "bob", mock.Anything, mock.Anything, false).Return(held, domain.ErrLocked)
+	mockLockRepo.On("Acquire", mock.Anything, 1, "bob", mock.Anything, mock.Anything, true).
+		Return(&domain.ArticleLock{ArticleID: 1, Holder: "bob"}, nil)
+
+	lock, err := lockService.Acquire(alice, 1, &domain.ArticleLockRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", lock.Holder)
+
+	_, err = lockService.Acquire(bob, 1, &domain.ArticleLockRequest{})
+	var conflict *domain.ConflictError
+	assert.ErrorAs(t, err, &conflict)
+	assert.Equal(t, held, conflict.Current)
+
+	// 作者不能接管
+	_, err = lockService.Acquire(actorContext("carol", domain.RoleAuthor), 1, &domain.ArticleLockRequest{Force: true})
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+
+	lock, err = lockService.Acquire(bob, 1, &domain.ArticleLockRequest{Force: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "bob", lock.Holder)
+
+	// 租约有效期来自配置
+	mockLockRepo.AssertCalled(t, "Acquire", mock.Anything, 1, "alice", mock.MatchedBy(func(expiresAt time.Time) bool {
+		return time.Until(expiresAt) > 4*time.Minute
+	}), mock.Anything, false)
+}
+
+// TestArticleLockService_Release 测试只有持有者和编辑可以释放有效租约
+func TestArticleLockService_Release(t *testing.T) {
+	lockService, mockLockRepo, _ := newTestArticleLockService()
+
+	mockLockRepo.On("Get", mock.Anything, 1).Return(&domain.ArticleLock{ArticleID: 1, Holder: "alice", ExpiresAt: time.Now().Add(time.Minute)}, nil)
+	mockLockRepo.On("Delete", mock.Anything, 1).Return(nil)
+
+	err := lockService.Release(actorContext("carol", domain.RoleAuthor), 1)
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+
+	assert.NoError(t, lockService.Release(actorContext("alice", domain.RoleAuthor), 1))
+	assert.NoError(t, lockService.Release(actorContext("bob", domain.RoleEditor), 1))
+	mockLockRepo.AssertNumberOfCalls(t, "Delete", 2)
+}
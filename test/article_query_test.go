@@ -0,0 +1,95 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"goblog/internal/domain"
+	"goblog/internal/handler"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// TestArticleHandler_ParseFilters 测试文章列表的过滤和排序参数解析
+func TestArticleHandler_ParseFilters(t *testing.T) {
+	articleService, mockArticleRepo, _, _ := newTestWorkflowService()
+	articleHandler := handler.NewArticleHandler(articleService)
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	expected := domain.QueryParams{
+		Page:          1,
+		Limit:         10,
+		Status:        domain.ArticleStatusPublished,
+		Author:        "alice",
+		PublishedFrom: &from,
+		PublishedTo:   &to,
+		CategoryIDs:   []int{1, 2},
+		TagIDs:        []int{3, 4},
+		TagMatch:      domain.TagMatchAll,
+		Sort:          domain.ArticleSortTitle,
+		SortDesc:      true,
+		Fields:        domain.ArticleSummaryFields,
+	}
+	mockArticleRepo.On("List", mock.Anything, expected).Return(&domain.ArticlePage{Items: []*domain.Article{}}, nil)
+
+	rec := listArticles(articleHandler, "page=1&status=published&author=alice&published_from=2026-01-01T00:00:00Z&published_to=2026-02-01T00:00:00Z&category=1,2&tags=3,4&tag_match=all&sort=-title")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	mockArticleRepo.AssertExpectations(t)
+}
+
+// TestArticleHandler_InvalidFilters 测试无效的过滤和排序参数返回400
+func TestArticleHandler_InvalidFilters(t *testing.T) {
+	articleService, mockArticleRepo, _, _ := newTestWorkflowService()
+	articleHandler := handler.NewArticleHandler(articleService)
+
+	for _, query := range []string{
+		"sort=content",
+		"sort=-popularity&after=abc",
+		"tags=1,x",
+		"category=0",
+		"tag_match=some",
+		"created_from=2026-01-01",
+		"status=unknown",
+		"after=a&before=b",
+	} {
+		rec := listArticles(articleHandler, query)
+		assert.Equal(t, http.StatusBadRequest, rec.Code, query)
+	}
+	mockArticleRepo.AssertNotCalled(t, "List", mock.Anything, mock.Anything)
+}
+
+// TestArticleService_ValidateFilters 测试服务层拒绝无效的排序、匹配方式和时间范围
+func TestArticleService_ValidateFilters(t *testing.T) {
+	articleService, mockArticleRepo, _, _ := newTestWorkflowService()
+	ctx := context.Background()
+	from := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, -1, 0)
+
+	for _, params := range []domain.QueryParams{
+		{Sort: "content"},
+		{TagMatch: "some"},
+		{Sort: domain.ArticleSortPopularity, After: "abc"},
+		{CreatedFrom: &from, CreatedTo: &to},
+	} {
+		_, err := articleService.List(ctx, params)
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	}
+	mockArticleRepo.AssertNotCalled(t, "List", mock.Anything, mock.Anything)
+}
+
+// TestArticleService_CreateRecordsAuthor 测试创建文章时记录作者
+func TestArticleService_CreateRecordsAuthor(t *testing.T) {
+	articleService, mockArticleRepo, _, _ := newTestWorkflowService()
+
+	mockArticleRepo.On("Create", mock.Anything, mock.MatchedBy(func(a *domain.Article) bool {
+		return a.Author == "alice"
+	})).Return(&domain.Article{ID: 1, Author: "alice"}, nil)
+
+	article, err := articleService.Create(actorContext("alice", domain.RoleAuthor), &domain.ArticleCreateRequest{Title: "标题", Content: "内容"})
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", article.Author)
+}
@@ -0,0 +1,137 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"goblog/internal/domain"
+	"goblog/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestWorkflowService() (domain.ArticleService, *MockArticleRepository, *MockArticleReviewRepository, *recordingPublisher) {
+	mockArticleRepo := new(MockArticleRepository)
+	mockReviewRepo := new(MockArticleReviewRepository)
+	publisher := newRecordingPublisher()
+	articleService := service.NewArticleService(mockArticleRepo, new(MockCategoryRepository), new(MockTagRepository), mockReviewRepo, newStubTransactor(), publisher)
+	return articleService, mockArticleRepo, mockReviewRepo, publisher
+}
+
+func actorContext(username, role string) context.Context {
+	return domain.WithActor(context.Background(), &domain.Actor{Username: username, Role: role})
+}
+
+// TestArticleService_Transition 测试作者提交审核并记录流转
+func TestArticleService_Transition(t *testing.T) {
+	articleService, mockArticleRepo, mockReviewRepo, publisher := newTestWorkflowService()
+
+	draft := &domain.Article{ID: 1, Title: "草稿", Status: domain.ArticleStatusDraft}
+	inReview := &domain.Article{ID: 1, Title: "草稿", Status: domain.ArticleStatusInReview}
+	mockArticleRepo.On("GetByID", mock.Anything, 1).Return(draft, nil)
+	mockArticleRepo.On("UpdateStatus", mock.Anything, 1, domain.ArticleStatusInReview).Return(inReview, nil)
+
+	var review *domain.ArticleReview
+	mockReviewRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.ArticleReview")).
+		Run(func(args mock.Arguments) { review = args.Get(1).(*domain.ArticleReview) }).
+		Return(&domain.ArticleReview{ID: 1}, nil)
+
+	ctx := actorContext("alice", domain.RoleAuthor)
+	result, err := articleService.Transition(ctx, 1, &domain.ArticleTransitionRequest{Status: domain.ArticleStatusInReview, Note: "请审阅"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.ArticleStatusInReview, result.Status)
+	assert.Equal(t, &domain.ArticleReview{ArticleID: 1, Reviewer: "alice", FromStatus: domain.ArticleStatusDraft, ToStatus: domain.ArticleStatusInReview, Note: "请审阅"}, review)
+	assert.Equal(t, []domain.Event{domain.ArticleUpdated{Before: draft, Article: inReview}}, publisher.events)
+}
+
+// TestArticleService_TransitionRejected 测试不允许的流转和角色
+func TestArticleService_TransitionRejected(t *testing.T) {
+	articleService, mockArticleRepo, _, publisher := newTestWorkflowService()
+	mockArticleRepo.On("GetByID", mock.Anything, 1).Return(&domain.Article{ID: 1, Status: domain.ArticleStatusInReview}, nil)
+	mockArticleRepo.On("GetByID", mock.Anything, 2).Return(&domain.Article{ID: 2, Author: "alice", Status: domain.ArticleStatusDraft}, nil)
+
+	author := actorContext("alice", domain.RoleAuthor)
+	editor := actorContext("bob", domain.RoleEditor)
+
+	// 作者不能批准
+	_, err := articleService.Transition(author, 1, &domain.ArticleTransitionRequest{Status: domain.ArticleStatusApproved})
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+
+	// 草稿不能直接归档
+	_, err = articleService.Transition(editor, 2, &domain.ArticleTransitionRequest{Status: domain.ArticleStatusArchived})
+	assert.ErrorIs(t, err, domain.ErrInvalidTransition)
+
+	// 未知状态
+	_, err = articleService.Transition(editor, 2, &domain.ArticleTransitionRequest{Status: "deleted"})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	// 作者不能通过旧的published字段绕过审核
+	publish := true
+	_, err = articleService.Update(author, 2, &domain.ArticleUpdateRequest{Title: "标题", Content: "内容", Published: &publish})
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+
+	assert.Empty(t, publisher.events)
+	mockArticleRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestArticleService_UpdateDeleteOwner 测试作者只能修改和删除自己的草稿，编辑可以修改和删除任意文章
+func TestArticleService_UpdateDeleteOwner(t *testing.T) {
+	articleService, mockArticleRepo, _, publisher := newTestWorkflowService()
+	own := &domain.Article{ID: 1, Author: "alice", Status: domain.ArticleStatusDraft, Version: 1}
+	others := &domain.Article{ID: 2, Author: "carol", Status: domain.ArticleStatusDraft, Version: 1}
+	published := &domain.Article{ID: 3, Author: "alice", Status: domain.ArticleStatusPublished, Published: true, Version: 1}
+	approved := &domain.Article{ID: 4, Author: "alice", Status: domain.ArticleStatusApproved, Version: 1}
+	for _, article := range []*domain.Article{own, others, published, approved} {
+		mockArticleRepo.On("GetByID", mock.Anything, article.ID).Return(article, nil)
+	}
+	mockArticleRepo.On("Update", mock.Anything, mock.Anything, mock.AnythingOfType("*domain.Article")).Return(own, nil)
+	mockArticleRepo.On("Delete", mock.Anything, mock.Anything).Return(nil)
+
+	author := actorContext("alice", domain.RoleAuthor)
+	req := &domain.ArticleUpdateRequest{Title: "标题", Content: "内容", Version: 1}
+	for _, id := range []int{2, 3, 4} {
+		_, err := articleService.Update(author, id, req)
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+		assert.ErrorIs(t, articleService.Delete(author, id), domain.ErrForbidden)
+	}
+	mockArticleRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	mockArticleRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	assert.Empty(t, publisher.events)
+
+	_, err := articleService.Update(author, 1, req)
+	assert.NoError(t, err)
+	assert.NoError(t, articleService.Delete(author, 1))
+
+	editor := actorContext("bob", domain.RoleEditor)
+	_, err = articleService.Update(editor, 3, req)
+	assert.NoError(t, err)
+	assert.NoError(t, articleService.Delete(editor, 4))
+}
+
+// TestArticleService_AddReviewNote 测试只有审阅者可以添加备注，备注不改变状态
+func TestArticleService_AddReviewNote(t *testing.T) {
+	articleService, mockArticleRepo, mockReviewRepo, _ := newTestWorkflowService()
+	mockArticleRepo.On("GetByID", mock.Anything, 1).Return(&domain.Article{ID: 1, Status: domain.ArticleStatusInReview}, nil)
+
+	_, err := articleService.AddReviewNote(actorContext("alice", domain.RoleAuthor), 1, &domain.ArticleReviewNoteRequest{Note: "自己批准"})
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+
+	expected := &domain.ArticleReview{ArticleID: 1, Reviewer: "bob", FromStatus: domain.ArticleStatusInReview, ToStatus: domain.ArticleStatusInReview, Note: "第二段需要引用来源"}
+	mockReviewRepo.On("Create", mock.Anything, expected).Return(expected, nil)
+
+	review, err := articleService.AddReviewNote(actorContext("bob", domain.RoleEditor), 1, &domain.ArticleReviewNoteRequest{Note: "第二段需要引用来源"})
+	assert.NoError(t, err)
+	assert.Equal(t, expected, review)
+	mockReviewRepo.AssertExpectations(t)
+}
+
+// TestArticleService_ListInvalidStatus 测试按未知状态过滤
+func TestArticleService_ListInvalidStatus(t *testing.T) {
+	articleService, mockArticleRepo, _, _ := newTestWorkflowService()
+
+	_, err := articleService.List(context.Background(), domain.QueryParams{Status: "unknown"})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	mockArticleRepo.AssertNotCalled(t, "List", mock.Anything, mock.Anything)
+}
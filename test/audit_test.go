@@ -0,0 +1,162 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"goblog/internal/domain"
+	"goblog/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockAuditRepository 审计日志仓储Mock
+type MockAuditRepository struct {
+	mock.Mock
+}
+
+func (m *MockAuditRepository) Create(ctx context.Context, log *domain.AuditLog) (*domain.AuditLog, error) {
+	args := m.Called(ctx, log)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.AuditLog), args.Error(1)
+}
+
+func (m *MockAuditRepository) List(ctx context.Context, params domain.AuditQueryParams) ([]*domain.AuditLog, int64, error) {
+	args := m.Called(ctx, params)
+	return args.Get(0).([]*domain.AuditLog), args.Get(1).(int64), args.Error(2)
+}
+
+// MockAuditService 审计服务Mock
+type MockAuditService struct {
+	mock.Mock
+}
+
+func (m *MockAuditService) Record(ctx context.Context, entityType string, entityID int, action string, before, after interface{}) error {
+	args := m.Called(ctx, entityType, entityID, action, before, after)
+	return args.Error(0)
+}
+
+func (m *MockAuditService) List(ctx context.Context, params domain.AuditQueryParams) ([]*domain.AuditLog, int64, error) {
+	args := m.Called(ctx, params)
+	return args.Get(0).([]*domain.AuditLog), args.Get(1).(int64), args.Error(2)
+}
+
+// TestAuditService_RecordUpdate 测试更新只记录发生变化的字段，并从上下文获取操作者和发件箱事件ID
+func TestAuditService_RecordUpdate(t *testing.T) {
+	mockAuditRepo := new(MockAuditRepository)
+	auditService := service.NewAuditService(mockAuditRepo)
+
+	before := &domain.Tag{ID: 1, Name: "Go", Color: "#007bff"}
+	after := &domain.Tag{ID: 1, Name: "Golang", Color: "#007bff"}
+
+	var saved *domain.AuditLog
+	mockAuditRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.AuditLog")).
+		Run(func(args mock.Arguments) { saved = args.Get(1).(*domain.AuditLog) }).
+		Return(&domain.AuditLog{ID: 1}, nil)
+
+	ctx := domain.WithActor(context.Background(), &domain.Actor{
+		Username:  "admin",
+		Role:      domain.RoleAdmin,
+		IP:        "10.0.0.1",
+		RequestID: "req-1",
+	})
+	ctx = domain.WithEventID(ctx, "ev-1")
+	err := auditService.Record(ctx, domain.AuditEntityTag, 1, domain.AuditActionUpdate, before, after)
+
+	assert.NoError(t, err)
+	if assert.NotNil(t, saved) {
+		assert.Equal(t, "admin", saved.Actor)
+		assert.Equal(t, domain.RoleAdmin, saved.ActorRole)
+		assert.Equal(t, "10.0.0.1", saved.IP)
+		assert.Equal(t, "req-1", saved.RequestID)
+		assert.Equal(t, "ev-1", saved.EventID)
+		assert.Equal(t, map[string]domain.AuditChange{
+			"name": {Before: "Go", After: "Golang"},
+		}, saved.Changes)
+	}
+}
+
+// TestAuditService_RecordCreateAndDelete 测试创建和删除记录全部字段，没有操作者时记录为system
+func TestAuditService_RecordCreateAndDelete(t *testing.T) {
+	mockAuditRepo := new(MockAuditRepository)
+	auditService := service.NewAuditService(mockAuditRepo)
+
+	category := &domain.Category{ID: 3, Name: "技术", Description: "技术文章"}
+
+	var saved []*domain.AuditLog
+	mockAuditRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.AuditLog")).
+		Run(func(args mock.Arguments) { saved = append(saved, args.Get(1).(*domain.AuditLog)) }).
+		Return(&domain.AuditLog{ID: 1}, nil)
+
+	ctx := context.Background()
+	assert.NoError(t, auditService.Record(ctx, domain.AuditEntityCategory, 3, domain.AuditActionCreate, nil, category))
+	assert.NoError(t, auditService.Record(ctx, domain.AuditEntityCategory, 3, domain.AuditActionDelete, category, nil))
+
+	if assert.Len(t, saved, 2) {
+		assert.Equal(t, "system", saved[0].Actor)
+		assert.Equal(t, domain.AuditChange{Before: nil, After: "技术"}, saved[0].Changes["name"])
+		assert.NotContains(t, saved[0].Changes, "id")
+		assert.NotContains(t, saved[0].Changes, "created_at")
+		assert.Equal(t, domain.AuditChange{Before: "技术文章", After: nil}, saved[1].Changes["description"])
+	}
+}
+
+// TestCategoryService_UpdatePublishesEvent 测试分类更新在事务中发布包含变更前后数据的事件
+func TestCategoryService_UpdatePublishesEvent(t *testing.T) {
+	mockCategoryRepo := new(MockCategoryRepository)
+	publisher := newRecordingPublisher()
+	categoryService := service.NewCategoryService(mockCategoryRepo, newStubTransactor(), publisher)
+
+	before := &domain.Category{ID: 1, Name: "技术"}
+	updated := &domain.Category{ID: 1, Name: "技术分享"}
+
+	mockCategoryRepo.On("GetByID", mock.Anything, 1).Return(before, nil)
+	mockCategoryRepo.On("GetByName", mock.Anything, "技术分享").Return(nil, domain.ErrNotFound)
+	mockCategoryRepo.On("Update", mock.Anything, 1, mock.AnythingOfType("*domain.Category")).Return(updated, nil)
+
+	result, err := categoryService.Update(context.Background(), 1, &domain.CategoryUpdateRequest{Name: "技术分享"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, updated, result)
+	assert.Equal(t, []domain.Event{domain.CategoryUpdated{Before: before, Category: updated}}, publisher.events)
+}
+
+// TestTagService_DeleteFailurePublishesNothing 测试删除失败时不发布事件
+func TestTagService_DeleteFailurePublishesNothing(t *testing.T) {
+	mockTagRepo := new(MockTagRepository)
+	publisher := newRecordingPublisher()
+	tagService := service.NewTagService(mockTagRepo, newStubTransactor(), publisher)
+
+	mockTagRepo.On("GetByID", mock.Anything, 1).Return(&domain.Tag{ID: 1, Name: "Go"}, nil)
+	mockTagRepo.On("Delete", mock.Anything, 1).Return(domain.ErrInternalError)
+
+	err := tagService.Delete(context.Background(), 1)
+
+	assert.ErrorIs(t, err, domain.ErrInternalError)
+	assert.Empty(t, publisher.events)
+}
+
+// TestSubscribeAudit 测试审计订阅者将内容事件转换为审计记录
+func TestSubscribeAudit(t *testing.T) {
+	mockAuditService := new(MockAuditService)
+	bus := domain.NewEventBus()
+	service.SubscribeAudit(bus, mockAuditService)
+
+	before := &domain.Article{ID: 2, Title: "旧标题"}
+	after := &domain.Article{ID: 2, Title: "新标题"}
+	tag := &domain.Tag{ID: 5, Name: "Go"}
+
+	mockAuditService.On("Record", mock.Anything, domain.AuditEntityArticle, 2, domain.AuditActionUpdate, before, after).Return(nil)
+	mockAuditService.On("Record", mock.Anything, domain.AuditEntityTag, 5, domain.AuditActionDelete, tag, nil).Return(nil)
+
+	ctx := context.Background()
+	assert.NoError(t, bus.Publish(ctx, domain.ArticleUpdated{Before: before, Article: after}))
+	assert.NoError(t, bus.Publish(ctx, domain.TagDeleted{Tag: tag}))
+
+	// 审计订阅者不关心评论事件
+	assert.Empty(t, bus.Subscribers(domain.EventCommentCreated))
+	mockAuditService.AssertExpectations(t)
+}
@@ -0,0 +1,321 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"goblog/internal/config"
+	"goblog/internal/domain"
+	"goblog/internal/pkg/jwtkeys"
+	"goblog/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockLoginAttemptRepository 登录尝试记录仓储Mock
+type MockLoginAttemptRepository struct {
+	mock.Mock
+}
+
+func (m *MockLoginAttemptRepository) Create(ctx context.Context, attempt *domain.LoginAttempt) error {
+	args := m.Called(ctx, attempt)
+	return args.Error(0)
+}
+
+func (m *MockLoginAttemptRepository) List(ctx context.Context, params domain.LoginAttemptQueryParams) ([]*domain.LoginAttempt, int64, error) {
+	args := m.Called(ctx, params)
+	return args.Get(0).([]*domain.LoginAttempt), args.Get(1).(int64), args.Error(2)
+}
+
+// MockLoginThrottleRepository 登录失败限制仓储Mock
+type MockLoginThrottleRepository struct {
+	mock.Mock
+}
+
+func (m *MockLoginThrottleRepository) Get(ctx context.Context, key string) (*domain.LoginThrottle, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.LoginThrottle), args.Error(1)
+}
+
+func (m *MockLoginThrottleRepository) RecordFailure(ctx context.Context, key string, window time.Duration) (*domain.LoginThrottle, error) {
+	args := m.Called(ctx, key, window)
+	return args.Get(0).(*domain.LoginThrottle), args.Error(1)
+}
+
+func (m *MockLoginThrottleRepository) Lock(ctx context.Context, key string, until time.Time) error {
+	args := m.Called(ctx, key, until)
+	return args.Error(0)
+}
+
+func (m *MockLoginThrottleRepository) Reset(ctx context.Context, key string) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
+
+func (m *MockLoginThrottleRepository) ListLocked(ctx context.Context, now time.Time) ([]*domain.LoginThrottle, error) {
+	args := m.Called(ctx, now)
+	return args.Get(0).([]*domain.LoginThrottle), args.Error(1)
+}
+
+// testAuthConfig 测试用认证配置
+func testAuthConfig() *config.Config {
+	return &config.Config{
+		JWT: config.JWTConfig{
+			Secret:     "test-secret",
+			Expiration: time.Hour,
+		},
+		Admin: config.AdminConfig{
+			Username: "admin",
+		},
+		Login: config.LoginConfig{
+			MaxFailures:      3,
+			MaxFailuresPerIP: 10,
+			FailureWindow:    15 * time.Minute,
+			BaseBackoff:      time.Second,
+			MaxBackoff:       30 * time.Second,
+			LockoutDuration:  15 * time.Minute,
+		},
+		TwoFactor: config.TwoFactorConfig{
+			Issuer:              "goblog",
+			ChallengeExpiration: 5 * time.Minute,
+		},
+	}
+}
+
+// MockRefreshTokenRepository 刷新令牌仓储Mock
+type MockRefreshTokenRepository struct {
+	mock.Mock
+}
+
+func (m *MockRefreshTokenRepository) Create(ctx context.Context, token *domain.RefreshToken) error {
+	args := m.Called(ctx, token)
+	return args.Error(0)
+}
+
+func (m *MockRefreshTokenRepository) GetByHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	args := m.Called(ctx, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.RefreshToken), args.Error(1)
+}
+
+func (m *MockRefreshTokenRepository) Revoke(ctx context.Context, tokenHash string) (bool, error) {
+	args := m.Called(ctx, tokenHash)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRefreshTokenRepository) RevokeFamily(ctx context.Context, familyID string) error {
+	args := m.Called(ctx, familyID)
+	return args.Error(0)
+}
+
+// MockTokenDenylistRepository 访问令牌黑名单仓储Mock
+type MockTokenDenylistRepository struct {
+	mock.Mock
+}
+
+func (m *MockTokenDenylistRepository) Add(ctx context.Context, jti string, expiresAt time.Time) error {
+	args := m.Called(ctx, jti, expiresAt)
+	return args.Error(0)
+}
+
+func (m *MockTokenDenylistRepository) Contains(ctx context.Context, jti string) (bool, error) {
+	args := m.Called(ctx, jti)
+	return args.Bool(0), args.Error(1)
+}
+
+// newTestAuthService 创建认证服务及其Mock依赖
+func newTestAuthService() (domain.AuthService, *MockLoginAttemptRepository, *MockLoginThrottleRepository, *MockRefreshTokenRepository, *MockTokenDenylistRepository) {
+	mockAttemptRepo := new(MockLoginAttemptRepository)
+	mockThrottleRepo := new(MockLoginThrottleRepository)
+	mockRefreshRepo := new(MockRefreshTokenRepository)
+	mockDenylistRepo := new(MockTokenDenylistRepository)
+
+	// 默认未开启两步验证
+	mockTwoFactor := new(MockTwoFactorService)
+	mockTwoFactor.On("IsEnabled", mock.Anything, mock.Anything).Return(false, nil)
+
+	authService := service.NewAuthService(testAuthConfig(), jwtkeys.NewHMAC("test-secret"), mockAttemptRepo, mockThrottleRepo, mockRefreshRepo, mockDenylistRepo, mockTwoFactor, new(MockUserRepository))
+
+	return authService, mockAttemptRepo, mockThrottleRepo, mockRefreshRepo, mockDenylistRepo
+}
+
+// TestAuthService_Login_Success 测试登录成功时清除失败计数并记录
+func TestAuthService_Login_Success(t *testing.T) {
+	authService, mockAttemptRepo, mockThrottleRepo, mockRefreshRepo, _ := newTestAuthService()
+
+	mockThrottleRepo.On("Get", mock.Anything, mock.Anything).Return(nil, domain.ErrNotFound)
+	mockRefreshRepo.On("Create", mock.Anything, mock.MatchedBy(func(token *domain.RefreshToken) bool {
+		return token.Username == "admin" && len(token.TokenHash) == 64 && token.FamilyID != ""
+	})).Return(nil)
+	mockThrottleRepo.On("Reset", mock.Anything, "user:admin").Return(nil)
+	mockThrottleRepo.On("Reset", mock.Anything, "ip:10.0.0.1").Return(nil)
+	mockAttemptRepo.On("Create", mock.Anything, mock.MatchedBy(func(a *domain.LoginAttempt) bool {
+		return a.Success && a.Username == "admin" && a.IP == "10.0.0.1" && a.UserAgent == "curl"
+	})).Return(nil)
+
+	resp, err := authService.Login(context.Background(), &domain.LoginRequest{
+		Username:  "admin",
+		Password:  "admin123",
+		IP:        "10.0.0.1",
+		UserAgent: "curl",
+	})
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, resp.Token)
+	assert.NotEmpty(t, resp.RefreshToken)
+	assert.Equal(t, int64(3600), resp.ExpiresIn)
+	mockThrottleRepo.AssertExpectations(t)
+	mockAttemptRepo.AssertExpectations(t)
+	mockRefreshRepo.AssertExpectations(t)
+}
+
+// TestAuthService_Login_LocksAfterMaxFailures 测试失败次数达到阈值后锁定
+func TestAuthService_Login_LocksAfterMaxFailures(t *testing.T) {
+	authService, mockAttemptRepo, mockThrottleRepo, _, _ := newTestAuthService()
+
+	mockThrottleRepo.On("Get", mock.Anything, mock.Anything).Return(nil, domain.ErrNotFound)
+	mockThrottleRepo.On("RecordFailure", mock.Anything, "user:admin", 15*time.Minute).
+		Return(&domain.LoginThrottle{Key: "user:admin", Failures: 3, LastFailureAt: time.Now()}, nil)
+	mockThrottleRepo.On("RecordFailure", mock.Anything, "ip:10.0.0.1", 15*time.Minute).
+		Return(&domain.LoginThrottle{Key: "ip:10.0.0.1", Failures: 3, LastFailureAt: time.Now()}, nil)
+	mockThrottleRepo.On("Lock", mock.Anything, "user:admin", mock.AnythingOfType("time.Time")).Return(nil)
+	mockAttemptRepo.On("Create", mock.Anything, mock.MatchedBy(func(a *domain.LoginAttempt) bool {
+		return !a.Success && a.Reason == "invalid_credentials"
+	})).Return(nil)
+
+	_, err := authService.Login(context.Background(), &domain.LoginRequest{
+		Username: "admin",
+		Password: "wrong",
+		IP:       "10.0.0.1",
+	})
+
+	assert.ErrorIs(t, err, domain.ErrUnauthorized)
+	mockThrottleRepo.AssertExpectations(t)
+	// IP的阈值更高，不应被锁定
+	mockThrottleRepo.AssertNotCalled(t, "Lock", mock.Anything, "ip:10.0.0.1", mock.Anything)
+}
+
+// TestAuthService_Login_Locked 测试锁定期间拒绝登录且不校验密码
+func TestAuthService_Login_Locked(t *testing.T) {
+	authService, mockAttemptRepo, mockThrottleRepo, _, _ := newTestAuthService()
+
+	lockedUntil := time.Now().Add(10 * time.Minute)
+	mockThrottleRepo.On("Get", mock.Anything, "user:admin").
+		Return(&domain.LoginThrottle{Key: "user:admin", Failures: 3, LastFailureAt: time.Now(), LockedUntil: &lockedUntil}, nil)
+	mockAttemptRepo.On("Create", mock.Anything, mock.MatchedBy(func(a *domain.LoginAttempt) bool {
+		return !a.Success && a.Reason == "throttled"
+	})).Return(nil)
+
+	_, err := authService.Login(context.Background(), &domain.LoginRequest{
+		Username: "admin",
+		Password: "admin123",
+	})
+
+	var retryErr *domain.RetryAfterError
+	assert.True(t, errors.As(err, &retryErr))
+	assert.ErrorIs(t, err, domain.ErrTooManyAttempts)
+	assert.InDelta(t, (10 * time.Minute).Seconds(), retryErr.RetryAfter.Seconds(), 5)
+	mockThrottleRepo.AssertNotCalled(t, "RecordFailure", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestAuthService_Login_Backoff 测试失败后的指数退避
+func TestAuthService_Login_Backoff(t *testing.T) {
+	authService, mockAttemptRepo, mockThrottleRepo, _, _ := newTestAuthService()
+
+	// 第2次失败后需要等待2秒
+	mockThrottleRepo.On("Get", mock.Anything, "user:admin").
+		Return(&domain.LoginThrottle{Key: "user:admin", Failures: 2, LastFailureAt: time.Now()}, nil)
+	mockAttemptRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+	_, err := authService.Login(context.Background(), &domain.LoginRequest{
+		Username: "admin",
+		Password: "admin123",
+	})
+
+	var retryErr *domain.RetryAfterError
+	assert.True(t, errors.As(err, &retryErr))
+	assert.InDelta(t, 2, retryErr.RetryAfter.Seconds(), 0.5)
+}
+
+// TestAuthService_ClearLockout 测试解除锁定
+func TestAuthService_ClearLockout(t *testing.T) {
+	authService, _, mockThrottleRepo, _, _ := newTestAuthService()
+
+	mockThrottleRepo.On("Reset", mock.Anything, "user:admin").Return(nil)
+	mockThrottleRepo.On("Reset", mock.Anything, "ip:10.0.0.1").Return(nil)
+
+	assert.NoError(t, authService.ClearLockout(context.Background(), "admin", "10.0.0.1"))
+	assert.ErrorIs(t, authService.ClearLockout(context.Background(), "", ""), domain.ErrInvalidInput)
+	mockThrottleRepo.AssertExpectations(t)
+}
+
+// TestAuthService_Refresh_Rotates 测试刷新时轮换令牌并保持家族不变
+func TestAuthService_Refresh_Rotates(t *testing.T) {
+	authService, _, _, mockRefreshRepo, _ := newTestAuthService()
+
+	stored := &domain.RefreshToken{FamilyID: "family-1", Username: "admin", ExpiresAt: time.Now().Add(time.Hour)}
+	mockRefreshRepo.On("GetByHash", mock.Anything, mock.Anything).Return(stored, nil)
+	mockRefreshRepo.On("Revoke", mock.Anything, mock.Anything).Return(true, nil)
+	mockRefreshRepo.On("Create", mock.Anything, mock.MatchedBy(func(token *domain.RefreshToken) bool {
+		return token.FamilyID == "family-1"
+	})).Return(nil)
+
+	resp, err := authService.Refresh(context.Background(), &domain.RefreshRequest{RefreshToken: "old-token"})
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, resp.Token)
+	assert.NotEqual(t, "old-token", resp.RefreshToken)
+	mockRefreshRepo.AssertExpectations(t)
+	mockRefreshRepo.AssertNotCalled(t, "RevokeFamily", mock.Anything, mock.Anything)
+}
+
+// TestAuthService_Refresh_ReuseRevokesFamily 测试重放已轮换的令牌会吊销整个家族
+func TestAuthService_Refresh_ReuseRevokesFamily(t *testing.T) {
+	authService, _, _, mockRefreshRepo, _ := newTestAuthService()
+
+	revokedAt := time.Now().Add(-time.Minute)
+	stored := &domain.RefreshToken{FamilyID: "family-1", Username: "admin", ExpiresAt: time.Now().Add(time.Hour), RevokedAt: &revokedAt}
+	mockRefreshRepo.On("GetByHash", mock.Anything, mock.Anything).Return(stored, nil)
+	mockRefreshRepo.On("Revoke", mock.Anything, mock.Anything).Return(false, nil)
+	mockRefreshRepo.On("RevokeFamily", mock.Anything, "family-1").Return(nil)
+
+	_, err := authService.Refresh(context.Background(), &domain.RefreshRequest{RefreshToken: "stolen-token"})
+
+	assert.ErrorIs(t, err, domain.ErrUnauthorized)
+	mockRefreshRepo.AssertExpectations(t)
+	mockRefreshRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+// TestAuthService_Logout_DeniesAccessToken 测试登出后访问令牌失效
+func TestAuthService_Logout_DeniesAccessToken(t *testing.T) {
+	authService, _, _, mockRefreshRepo, mockDenylistRepo := newTestAuthService()
+	ctx := context.Background()
+
+	token, err := authService.GenerateToken(ctx, "admin")
+	assert.NoError(t, err)
+
+	var deniedJTI string
+	mockDenylistRepo.On("Add", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("time.Time")).
+		Run(func(args mock.Arguments) { deniedJTI = args.String(1) }).
+		Return(nil)
+	mockRefreshRepo.On("GetByHash", mock.Anything, mock.Anything).
+		Return(&domain.RefreshToken{FamilyID: "family-1", Username: "admin"}, nil)
+	mockRefreshRepo.On("RevokeFamily", mock.Anything, "family-1").Return(nil)
+
+	err = authService.Logout(ctx, &domain.LogoutRequest{AccessToken: "Bearer " + token, RefreshToken: "refresh"})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, deniedJTI)
+
+	mockDenylistRepo.On("Contains", mock.Anything, deniedJTI).Return(true, nil)
+	_, err = authService.ValidateToken(ctx, "Bearer "+token)
+	assert.ErrorIs(t, err, domain.ErrUnauthorized)
+	mockRefreshRepo.AssertExpectations(t)
+}
@@ -23,7 +23,7 @@ func TestArticleService_BackupAll(t *testing.T) {
 	mockTagRepo := new(MockTagRepository)
 
 	// 创建服务
-	articleService := service.NewArticleService(mockArticleRepo, mockCategoryRepo, mockTagRepo)
+	articleService := service.NewArticleService(mockArticleRepo, mockCategoryRepo, mockTagRepo, new(MockArticleReviewRepository), newStubTransactor(), newRecordingPublisher())
 
 	// 测试数据
 	testArticles := []*domain.Article{
@@ -64,7 +64,7 @@ func TestArticleService_BackupAll(t *testing.T) {
 
 	// 设置Mock期望
 	mockArticleRepo.On("List", mock.Anything, mock.AnythingOfType("domain.QueryParams")).
-		Return(testArticles, int64(len(testArticles)), nil)
+		Return(&domain.ArticlePage{Items: testArticles, Total: int64(len(testArticles))}, nil)
 
 	// 执行测试
 	ctx := context.Background()
@@ -157,11 +157,11 @@ func TestArticleService_BackupAll_EmptyArticles(t *testing.T) {
 	mockTagRepo := new(MockTagRepository)
 
 	// 创建服务
-	articleService := service.NewArticleService(mockArticleRepo, mockCategoryRepo, mockTagRepo)
+	articleService := service.NewArticleService(mockArticleRepo, mockCategoryRepo, mockTagRepo, new(MockArticleReviewRepository), newStubTransactor(), newRecordingPublisher())
 
 	// 设置Mock期望 - 返回空文章列表
 	mockArticleRepo.On("List", mock.Anything, mock.AnythingOfType("domain.QueryParams")).
-		Return([]*domain.Article{}, int64(0), nil)
+		Return(&domain.ArticlePage{Items: []*domain.Article{}}, nil)
 
 	// 执行测试
 	ctx := context.Background()
@@ -209,11 +209,11 @@ func TestArticleService_BackupAll_RepositoryError(t *testing.T) {
 	mockTagRepo := new(MockTagRepository)
 
 	// 创建服务
-	articleService := service.NewArticleService(mockArticleRepo, mockCategoryRepo, mockTagRepo)
+	articleService := service.NewArticleService(mockArticleRepo, mockCategoryRepo, mockTagRepo, new(MockArticleReviewRepository), newStubTransactor(), newRecordingPublisher())
 
 	// 设置Mock期望 - 返回错误
 	mockArticleRepo.On("List", mock.Anything, mock.AnythingOfType("domain.QueryParams")).
-		Return([]*domain.Article(nil), int64(0), domain.ErrNotFound)
+		Return(nil, domain.ErrNotFound)
 
 	// 执行测试
 	ctx := context.Background()
@@ -227,3 +227,15 @@ func TestArticleService_BackupAll_RepositoryError(t *testing.T) {
 	// 验证Mock调用
 	mockArticleRepo.AssertExpectations(t)
 }
+
+// TestArticleService_BackupAll_AuthorForbidden 测试作者不能备份文章
+func TestArticleService_BackupAll_AuthorForbidden(t *testing.T) {
+	mockArticleRepo := new(MockArticleRepository)
+	articleService := service.NewArticleService(mockArticleRepo, new(MockCategoryRepository), new(MockTagRepository), new(MockArticleReviewRepository), newStubTransactor(), newRecordingPublisher())
+
+	backupData, err := articleService.BackupAll(actorContext("alice", domain.RoleAuthor))
+
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	assert.Nil(t, backupData)
+	mockArticleRepo.AssertNotCalled(t, "List", mock.Anything, mock.Anything)
+}
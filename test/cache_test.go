@@ -0,0 +1,233 @@
+package test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"goblog/internal/domain"
+	"goblog/internal/pkg/cache"
+	"goblog/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestCache() *cache.Cache {
+	return cache.New(cache.NewMemory(100), nil, cache.Options{TTL: time.Minute})
+}
+
+// TestMemory_LRUAndTTL 测试容量写满时淘汰最久未访问的条目以及过期条目不再返回
+func TestMemory_LRUAndTTL(t *testing.T) {
+	ctx := context.Background()
+	m := cache.NewMemory(2)
+
+	m.Set(ctx, "a", []byte("1"), time.Minute)
+	m.Set(ctx, "b", []byte("2"), time.Minute)
+	_, ok, _ := m.Get(ctx, "a")
+	assert.True(t, ok)
+
+	// b最久未访问，被淘汰
+	m.Set(ctx, "c", []byte("3"), time.Minute)
+	_, ok, _ = m.Get(ctx, "b")
+	assert.False(t, ok)
+	_, ok, _ = m.Get(ctx, "a")
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), m.Evictions())
+
+	m.Set(ctx, "d", []byte("4"), 10*time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+	_, ok, _ = m.Get(ctx, "d")
+	assert.False(t, ok)
+}
+
+// TestNewRedis_InvalidURL 测试Redis连接地址无效时创建失败
+func TestNewRedis_InvalidURL(t *testing.T) {
+	_, err := cache.NewRedis(context.Background(), "localhost:6379")
+	assert.Error(t, err)
+}
+
+// TestCache_Singleflight 测试同一key的并发未命中只加载一次
+func TestCache_Singleflight(t *testing.T) {
+	c := newTestCache()
+	var loads int32
+	release := make(chan struct{})
+
+	var wg sync.WaitGroup
+	results := make([]string, 10)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			c.Load(context.Background(), "test", "key", &results[i], func(ctx context.Context) (interface{}, error) {
+				atomic.AddInt32(&loads, 1)
+				<-release
+				return "value", nil
+			})
+		}(i)
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&loads))
+	for _, result := range results {
+		assert.Equal(t, "value", result)
+	}
+
+	stats := c.Stats().Namespaces["test"]
+	assert.Equal(t, int64(10), stats.Hits+stats.Misses)
+	assert.Equal(t, stats.Misses-1, stats.Shared)
+}
+
+// TestCache_DeleteDuringLoad 测试加载期间被删除的key不会把旧数据写回缓存
+func TestCache_DeleteDuringLoad(t *testing.T) {
+	c := newTestCache()
+	ctx := context.Background()
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	done := make(chan struct{})
+	go func() {
+		var v string
+		c.Load(ctx, "test", "key", &v, func(ctx context.Context) (interface{}, error) {
+			close(started)
+			<-release
+			return "old", nil
+		})
+		close(done)
+	}()
+	<-started
+	c.Delete(ctx, "key")
+	close(release)
+	<-done
+
+	var v string
+	c.Load(ctx, "test", "key", &v, func(ctx context.Context) (interface{}, error) {
+		return "new", nil
+	})
+	assert.Equal(t, "new", v)
+}
+
+// TestCachedTagRepository 测试标签读取命中缓存，修改后失效
+func TestCachedTagRepository(t *testing.T) {
+	mockTagRepo := new(MockTagRepository)
+	c := newTestCache()
+	repo := repository.NewCachedTagRepository(mockTagRepo, c)
+	ctx := context.Background()
+
+	mockTagRepo.On("GetByID", mock.Anything, 1).Return(&domain.Tag{ID: 1, Name: "Go"}, nil).Once()
+	mockTagRepo.On("List", mock.Anything).Return([]*domain.Tag{{ID: 1, Name: "Go"}}, nil).Once()
+
+	for i := 0; i < 2; i++ {
+		tag, err := repo.GetByID(ctx, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, "Go", tag.Name)
+		tags, err := repo.List(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, tags, 1)
+	}
+	mockTagRepo.AssertNumberOfCalls(t, "GetByID", 1)
+	mockTagRepo.AssertNumberOfCalls(t, "List", 1)
+	assert.Equal(t, cache.Stats{Hits: 1, Misses: 1}, c.Stats().Namespaces["tag"])
+
+	mockTagRepo.On("Update", mock.Anything, 1, mock.Anything).Return(&domain.Tag{ID: 1, Name: "Golang"}, nil)
+	mockTagRepo.On("GetByID", mock.Anything, 1).Return(&domain.Tag{ID: 1, Name: "Golang"}, nil).Once()
+	mockTagRepo.On("List", mock.Anything).Return([]*domain.Tag{{ID: 1, Name: "Golang"}}, nil).Once()
+	_, err := repo.Update(ctx, 1, &domain.Tag{Name: "Golang"})
+	assert.NoError(t, err)
+
+	tag, err := repo.GetByID(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "Golang", tag.Name)
+	tags, _ := repo.List(ctx)
+	assert.Equal(t, "Golang", tags[0].Name)
+}
+
+// TestCachedArticleRepository 测试文章列表按查询参数缓存，文章写操作和标签修改使其失效
+func TestCachedArticleRepository(t *testing.T) {
+	mockArticleRepo := new(MockArticleRepository)
+	mockTagRepo := new(MockTagRepository)
+	c := newTestCache()
+	articleRepo := repository.NewCachedArticleRepository(mockArticleRepo, c)
+	tagRepo := repository.NewCachedTagRepository(mockTagRepo, c)
+	ctx := context.Background()
+
+	page1 := domain.QueryParams{Page: 1, Limit: 10}
+	page2 := domain.QueryParams{Page: 2, Limit: 10}
+	mockArticleRepo.On("List", mock.Anything, page1).Return(&domain.ArticlePage{Items: []*domain.Article{{ID: 1}}, Total: 11}, nil)
+	mockArticleRepo.On("List", mock.Anything, page2).Return(&domain.ArticlePage{Items: []*domain.Article{{ID: 11}}, Total: 11}, nil)
+	mockArticleRepo.On("GetByID", mock.Anything, 1).Return(&domain.Article{ID: 1, Tags: []domain.Tag{{ID: 1, Name: "Go"}}}, nil)
+
+	page, err := articleRepo.List(ctx, page1)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(11), page.Total)
+	assert.Equal(t, 1, page.Items[0].ID)
+	articleRepo.List(ctx, page1)
+	articleRepo.List(ctx, page2)
+	articleRepo.GetByID(ctx, 1)
+	articleRepo.GetByID(ctx, 1)
+	mockArticleRepo.AssertNumberOfCalls(t, "List", 2)
+	mockArticleRepo.AssertNumberOfCalls(t, "GetByID", 1)
+
+	// 文章写操作使列表失效
+	mockArticleRepo.On("Delete", mock.Anything, 11).Return(nil)
+	assert.NoError(t, articleRepo.Delete(ctx, 11))
+	articleRepo.List(ctx, page1)
+	mockArticleRepo.AssertNumberOfCalls(t, "List", 3)
+	articleRepo.GetByID(ctx, 1)
+	mockArticleRepo.AssertNumberOfCalls(t, "GetByID", 1)
+
+	// 标签修改使内嵌该标签的文章失效
+	mockTagRepo.On("Update", mock.Anything, 1, mock.Anything).Return(&domain.Tag{ID: 1, Name: "Golang"}, nil)
+	_, err = tagRepo.Update(ctx, 1, &domain.Tag{Name: "Golang"})
+	assert.NoError(t, err)
+	articleRepo.GetByID(ctx, 1)
+	mockArticleRepo.AssertNumberOfCalls(t, "GetByID", 2)
+
+	// 查询失败不缓存
+	mockArticleRepo.On("GetByID", mock.Anything, 2).Return(nil, domain.ErrNotFound)
+	_, err = articleRepo.GetByID(ctx, 2)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	_, err = articleRepo.GetByID(ctx, 2)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	mockArticleRepo.AssertNumberOfCalls(t, "GetByID", 4)
+}
+
+// TestCachedCommentRepository 测试已通过审核的评论增减时文章列表失效，热度排序不会读到旧结果
+func TestCachedCommentRepository(t *testing.T) {
+	mockArticleRepo := new(MockArticleRepository)
+	mockCommentRepo := new(MockCommentRepository)
+	c := newTestCache()
+	articleRepo := repository.NewCachedArticleRepository(mockArticleRepo, c)
+	commentRepo := repository.NewCachedCommentRepository(mockCommentRepo, c)
+	ctx := context.Background()
+
+	params := domain.QueryParams{Page: 1, Limit: 10, Sort: "popularity"}
+	mockArticleRepo.On("List", mock.Anything, params).Return(&domain.ArticlePage{Items: []*domain.Article{{ID: 1}}, Total: 1}, nil)
+	articleRepo.List(ctx, params)
+
+	// 待审核的评论不影响热度
+	pending := &domain.Comment{ID: 1, ArticleID: 1, Status: domain.CommentStatusPending}
+	mockCommentRepo.On("Create", mock.Anything, mock.Anything).Return(pending, nil)
+	_, err := commentRepo.Create(ctx, &domain.Comment{ArticleID: 1})
+	assert.NoError(t, err)
+	articleRepo.List(ctx, params)
+	mockArticleRepo.AssertNumberOfCalls(t, "List", 1)
+
+	// 审核通过
+	approved := &domain.Comment{ID: 1, ArticleID: 1, Status: domain.CommentStatusApproved}
+	mockCommentRepo.On("UpdateStatus", mock.Anything, pending, domain.CommentStatusApproved).Return(approved, nil)
+	_, err = commentRepo.UpdateStatus(ctx, pending, domain.CommentStatusApproved)
+	assert.NoError(t, err)
+	articleRepo.List(ctx, params)
+	mockArticleRepo.AssertNumberOfCalls(t, "List", 2)
+
+	// 删除
+	mockCommentRepo.On("Delete", mock.Anything, 1).Return(nil)
+	assert.NoError(t, commentRepo.Delete(ctx, 1))
+	articleRepo.List(ctx, params)
+	mockArticleRepo.AssertNumberOfCalls(t, "List", 3)
+}
@@ -0,0 +1,166 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"goblog/internal/domain"
+	"goblog/internal/handler"
+	"goblog/internal/service"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// newDeletableCategory 准备 工程(1) > 后端(2)，后端下有3篇文章和1个子分类，另有目标分类运维(5)
+func newDeletableCategory(repo *MockCategoryRepository) {
+	repo.On("GetByID", mock.Anything, 2).Return(&domain.Category{ID: 2, Name: "后端", ParentID: intPtr(1)}, nil)
+	repo.On("GetByID", mock.Anything, 5).Return(&domain.Category{ID: 5, Name: "运维"}, nil)
+	repo.On("GetByID", mock.Anything, 9).Return(nil, domain.ErrNotFound)
+	repo.On("CountArticles", mock.Anything, 2).Return(3, nil)
+	repo.On("CountChildren", mock.Anything, 2).Return(1, nil)
+}
+
+// TestCategoryService_DeleteRefusesWithArticles 测试分类下有文章且未指定目标分类时拒绝删除
+func TestCategoryService_DeleteRefusesWithArticles(t *testing.T) {
+	mockCategoryRepo := new(MockCategoryRepository)
+	publisher := newRecordingPublisher()
+	categoryService := service.NewCategoryService(mockCategoryRepo, newStubTransactor(), publisher)
+	newDeletableCategory(mockCategoryRepo)
+
+	_, err := categoryService.Delete(context.Background(), 2, domain.CategoryDeleteOptions{})
+	assert.ErrorIs(t, err, domain.ErrCategoryInUse)
+
+	var conflict *domain.ConflictError
+	if assert.ErrorAs(t, err, &conflict) {
+		assert.Equal(t, &domain.CategoryDeletion{CategoryID: 2, Articles: 3, Children: 1}, conflict.Current)
+	}
+	mockCategoryRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	assert.Empty(t, publisher.events)
+}
+
+// TestCategoryService_DeleteReassigns 测试文章移到目标分类、子分类移到上级分类后删除
+func TestCategoryService_DeleteReassigns(t *testing.T) {
+	mockCategoryRepo := new(MockCategoryRepository)
+	publisher := newRecordingPublisher()
+	categoryService := service.NewCategoryService(mockCategoryRepo, newStubTransactor(), publisher)
+	newDeletableCategory(mockCategoryRepo)
+	mockCategoryRepo.On("ReassignArticles", mock.Anything, 2, 5).Return(3, nil)
+	mockCategoryRepo.On("ReparentChildren", mock.Anything, 2, intPtr(1)).Return([]int{3}, nil)
+	mockCategoryRepo.On("Delete", mock.Anything, 2).Return(nil)
+
+	deletion, err := categoryService.Delete(context.Background(), 2, domain.CategoryDeleteOptions{ReassignTo: intPtr(5)})
+	assert.NoError(t, err)
+	assert.Equal(t, &domain.CategoryDeletion{CategoryID: 2, Articles: 3, Children: 1, ReassignTo: intPtr(5)}, deletion)
+	mockCategoryRepo.AssertCalled(t, "ReassignArticles", mock.Anything, 2, 5)
+	mockCategoryRepo.AssertCalled(t, "ReparentChildren", mock.Anything, 2, intPtr(1))
+	mockCategoryRepo.AssertCalled(t, "Delete", mock.Anything, 2)
+
+	if assert.Len(t, publisher.events, 1) {
+		event := publisher.events[0].(domain.CategoryDeleted)
+		assert.Equal(t, deletion, event.Deletion)
+	}
+}
+
+// TestCategoryService_DeleteDryRun 测试预览只返回影响，不修改数据
+func TestCategoryService_DeleteDryRun(t *testing.T) {
+	mockCategoryRepo := new(MockCategoryRepository)
+	publisher := newRecordingPublisher()
+	categoryService := service.NewCategoryService(mockCategoryRepo, newStubTransactor(), publisher)
+	newDeletableCategory(mockCategoryRepo)
+
+	deletion, err := categoryService.Delete(context.Background(), 2, domain.CategoryDeleteOptions{ReassignTo: intPtr(5), DryRun: true})
+	assert.NoError(t, err)
+	assert.Equal(t, &domain.CategoryDeletion{CategoryID: 2, Articles: 3, Children: 1, ReassignTo: intPtr(5), DryRun: true}, deletion)
+
+	mockCategoryRepo.AssertNotCalled(t, "ReassignArticles", mock.Anything, mock.Anything, mock.Anything)
+	mockCategoryRepo.AssertNotCalled(t, "ReparentChildren", mock.Anything, mock.Anything, mock.Anything)
+	mockCategoryRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	assert.Empty(t, publisher.events)
+}
+
+// TestCategoryService_DeleteInvalidTarget 测试目标分类为自身或不存在时返回无效输入
+func TestCategoryService_DeleteInvalidTarget(t *testing.T) {
+	mockCategoryRepo := new(MockCategoryRepository)
+	categoryService := service.NewCategoryService(mockCategoryRepo, newStubTransactor(), newRecordingPublisher())
+	newDeletableCategory(mockCategoryRepo)
+	ctx := context.Background()
+
+	_, err := categoryService.Delete(ctx, 2, domain.CategoryDeleteOptions{ReassignTo: intPtr(2)})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	_, err = categoryService.Delete(ctx, 2, domain.CategoryDeleteOptions{ReassignTo: intPtr(9)})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	mockCategoryRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
+
+func deleteCategory(categoryHandler *handler.CategoryHandler, id, query string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodDelete, "/api/categories/"+id+"?"+query, nil)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(id)
+	categoryHandler.Delete(c)
+	return rec
+}
+
+// TestCategoryHandler_DeleteConflict 测试分类下有文章时返回409和影响预览
+func TestCategoryHandler_DeleteConflict(t *testing.T) {
+	mockCategoryRepo := new(MockCategoryRepository)
+	categoryHandler := handler.NewCategoryHandler(service.NewCategoryService(mockCategoryRepo, newStubTransactor(), newRecordingPublisher()))
+	newDeletableCategory(mockCategoryRepo)
+
+	rec := deleteCategory(categoryHandler, "2", "")
+	assert.Equal(t, http.StatusConflict, rec.Code)
+
+	var body struct {
+		Data domain.CategoryDeletion `json:"data"`
+	}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, 3, body.Data.Articles)
+	assert.Equal(t, 1, body.Data.Children)
+
+	rec = deleteCategory(categoryHandler, "2", "reassign_to=abc")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+// TestCategoryService_AuthorForbidden 测试作者不能创建、修改和删除分类
+func TestCategoryService_AuthorForbidden(t *testing.T) {
+	mockCategoryRepo := new(MockCategoryRepository)
+	categoryService := service.NewCategoryService(mockCategoryRepo, newStubTransactor(), newRecordingPublisher())
+	author := actorContext("alice", domain.RoleAuthor)
+
+	_, err := categoryService.Create(author, &domain.CategoryCreateRequest{Name: "运维"})
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+
+	_, err = categoryService.Update(author, 2, &domain.CategoryUpdateRequest{Name: "服务端"})
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+
+	_, err = categoryService.Delete(author, 2, domain.CategoryDeleteOptions{ReassignTo: intPtr(5)})
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+
+	mockCategoryRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	mockCategoryRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	mockCategoryRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
+
+// TestCategoryHandler_DeleteForbidden 测试作者删除分类时返回403
+func TestCategoryHandler_DeleteForbidden(t *testing.T) {
+	mockCategoryRepo := new(MockCategoryRepository)
+	categoryHandler := handler.NewCategoryHandler(service.NewCategoryService(mockCategoryRepo, newStubTransactor(), newRecordingPublisher()))
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/categories/2", nil)
+	req = req.WithContext(actorContext("alice", domain.RoleAuthor))
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("2")
+	categoryHandler.Delete(c)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
@@ -18,7 +18,7 @@ func TestCategoryService_Create(t *testing.T) {
 	mockCategoryRepo := new(MockCategoryRepository)
 
 	// 创建服务
-	categoryService := service.NewCategoryService(mockCategoryRepo)
+	categoryService := service.NewCategoryService(mockCategoryRepo, newStubTransactor(), newRecordingPublisher())
 
 	// 测试数据
 	req := &domain.CategoryCreateRequest{
@@ -59,7 +59,7 @@ func TestCategoryService_Create_Duplicate(t *testing.T) {
 	mockCategoryRepo := new(MockCategoryRepository)
 
 	// 创建服务
-	categoryService := service.NewCategoryService(mockCategoryRepo)
+	categoryService := service.NewCategoryService(mockCategoryRepo, newStubTransactor(), newRecordingPublisher())
 
 	// 测试数据
 	req := &domain.CategoryCreateRequest{
@@ -97,7 +97,7 @@ func TestCategoryService_GetByID(t *testing.T) {
 	mockCategoryRepo := new(MockCategoryRepository)
 
 	// 创建服务
-	categoryService := service.NewCategoryService(mockCategoryRepo)
+	categoryService := service.NewCategoryService(mockCategoryRepo, newStubTransactor(), newRecordingPublisher())
 
 	// 测试数据
 	expectedCategory := &domain.Category{
@@ -131,7 +131,7 @@ func TestCategoryService_Update(t *testing.T) {
 	mockCategoryRepo := new(MockCategoryRepository)
 
 	// 创建服务
-	categoryService := service.NewCategoryService(mockCategoryRepo)
+	categoryService := service.NewCategoryService(mockCategoryRepo, newStubTransactor(), newRecordingPublisher())
 
 	// 测试数据
 	req := &domain.CategoryUpdateRequest{
@@ -180,17 +180,21 @@ func TestCategoryService_Delete(t *testing.T) {
 	mockCategoryRepo := new(MockCategoryRepository)
 
 	// 创建服务
-	categoryService := service.NewCategoryService(mockCategoryRepo)
+	categoryService := service.NewCategoryService(mockCategoryRepo, newStubTransactor(), newRecordingPublisher())
 
 	// 设置Mock期望
+	mockCategoryRepo.On("GetByID", mock.Anything, 1).Return(&domain.Category{ID: 1, Name: "技术"}, nil)
+	mockCategoryRepo.On("CountArticles", mock.Anything, 1).Return(0, nil)
+	mockCategoryRepo.On("CountChildren", mock.Anything, 1).Return(0, nil)
 	mockCategoryRepo.On("Delete", mock.Anything, 1).Return(nil)
 
 	// 执行测试
 	ctx := context.Background()
-	err := categoryService.Delete(ctx, 1)
+	deletion, err := categoryService.Delete(ctx, 1, domain.CategoryDeleteOptions{})
 
 	// 验证结果
 	assert.NoError(t, err)
+	assert.Equal(t, &domain.CategoryDeletion{CategoryID: 1}, deletion)
 
 	// 验证Mock调用
 	mockCategoryRepo.AssertExpectations(t)
@@ -202,7 +206,7 @@ func TestCategoryService_List(t *testing.T) {
 	mockCategoryRepo := new(MockCategoryRepository)
 
 	// 创建服务
-	categoryService := service.NewCategoryService(mockCategoryRepo)
+	categoryService := service.NewCategoryService(mockCategoryRepo, newStubTransactor(), newRecordingPublisher())
 
 	// 测试数据
 	expectedCategories := []*domain.Category{
@@ -224,6 +228,7 @@ func TestCategoryService_List(t *testing.T) {
 
 	// 设置Mock期望
 	mockCategoryRepo.On("List", mock.Anything).Return(expectedCategories, nil)
+	mockCategoryRepo.On("ArticleCounts", mock.Anything).Return(map[int]domain.ArticleCounts{1: {Total: 3, Published: 2}}, nil)
 
 	// 执行测试
 	ctx := context.Background()
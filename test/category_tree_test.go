@@ -0,0 +1,133 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"goblog/internal/domain"
+	"goblog/internal/handler"
+	"goblog/internal/service"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func intPtr(v int) *int {
+	return &v
+}
+
+// newCategoryChain 准备 工程(1) > 后端(2) > Go(3) 三级分类，加锁读取返回相同的数据
+func newCategoryChain(repo *MockCategoryRepository) {
+	for _, category := range []*domain.Category{
+		{ID: 1, Name: "工程"},
+		{ID: 2, Name: "后端", ParentID: intPtr(1)},
+		{ID: 3, Name: "Go", ParentID: intPtr(2)},
+	} {
+		repo.On("GetByID", mock.Anything, category.ID).Return(category, nil)
+		repo.On("GetForUpdate", mock.Anything, category.ID).Return(category, nil)
+	}
+	repo.On("GetByName", mock.Anything, mock.Anything).Return(nil, domain.ErrNotFound)
+}
+
+// TestCategoryService_UpdateRejectsCycle 测试不能把分类移动到自身或其子孙分类下
+func TestCategoryService_UpdateRejectsCycle(t *testing.T) {
+	mockCategoryRepo := new(MockCategoryRepository)
+	categoryService := service.NewCategoryService(mockCategoryRepo, newStubTransactor(), newRecordingPublisher())
+	newCategoryChain(mockCategoryRepo)
+	ctx := context.Background()
+
+	_, err := categoryService.Update(ctx, 1, &domain.CategoryUpdateRequest{Name: "工程", ParentID: intPtr(3)})
+	assert.ErrorIs(t, err, domain.ErrCategoryCycle)
+
+	_, err = categoryService.Update(ctx, 2, &domain.CategoryUpdateRequest{Name: "后端", ParentID: intPtr(2)})
+	assert.ErrorIs(t, err, domain.ErrCategoryCycle)
+
+	mockCategoryRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	// 上级链在事务中加锁读取，不使用缓存
+	mockCategoryRepo.AssertCalled(t, "GetForUpdate", mock.Anything, 2)
+}
+
+// TestCategoryService_UpdateMovesCategory 测试把分类移动到其他分支或移为顶级分类
+func TestCategoryService_UpdateMovesCategory(t *testing.T) {
+	mockCategoryRepo := new(MockCategoryRepository)
+	categoryService := service.NewCategoryService(mockCategoryRepo, newStubTransactor(), newRecordingPublisher())
+	newCategoryChain(mockCategoryRepo)
+	mockCategoryRepo.On("Update", mock.Anything, 3, &domain.Category{Name: "Go", ParentID: intPtr(1)}).
+		Return(&domain.Category{ID: 3, Name: "Go", ParentID: intPtr(1)}, nil)
+	mockCategoryRepo.On("Update", mock.Anything, 2, &domain.Category{Name: "后端"}).
+		Return(&domain.Category{ID: 2, Name: "后端"}, nil)
+	ctx := context.Background()
+
+	moved, err := categoryService.Update(ctx, 3, &domain.CategoryUpdateRequest{Name: "Go", ParentID: intPtr(1)})
+	assert.NoError(t, err)
+	assert.Equal(t, intPtr(1), moved.ParentID)
+
+	root, err := categoryService.Update(ctx, 2, &domain.CategoryUpdateRequest{Name: "后端"})
+	assert.NoError(t, err)
+	assert.Nil(t, root.ParentID)
+}
+
+// TestCategoryService_CreateWithMissingParent 测试上级分类不存在时拒绝创建
+func TestCategoryService_CreateWithMissingParent(t *testing.T) {
+	mockCategoryRepo := new(MockCategoryRepository)
+	categoryService := service.NewCategoryService(mockCategoryRepo, newStubTransactor(), newRecordingPublisher())
+	mockCategoryRepo.On("GetByName", mock.Anything, "Go").Return(nil, domain.ErrNotFound)
+	mockCategoryRepo.On("GetForUpdate", mock.Anything, 9).Return(nil, domain.ErrNotFound)
+
+	_, err := categoryService.Create(context.Background(), &domain.CategoryCreateRequest{Name: "Go", ParentID: intPtr(9)})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	mockCategoryRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+// TestCategoryService_Tree 测试按上级分类组装分类树，上级不存在的分类作为顶级分类
+func TestCategoryService_Tree(t *testing.T) {
+	mockCategoryRepo := new(MockCategoryRepository)
+	categoryService := service.NewCategoryService(mockCategoryRepo, newStubTransactor(), newRecordingPublisher())
+	mockCategoryRepo.On("List", mock.Anything).Return([]*domain.Category{
+		{ID: 3, Name: "Go", ParentID: intPtr(2)},
+		{ID: 4, Name: "Rust", ParentID: intPtr(2)},
+		{ID: 2, Name: "后端", ParentID: intPtr(1)},
+		{ID: 1, Name: "工程"},
+		{ID: 5, Name: "孤立", ParentID: intPtr(99)},
+	}, nil)
+	mockCategoryRepo.On("ArticleCounts", mock.Anything).Return(map[int]domain.ArticleCounts{}, nil)
+
+	roots, err := categoryService.Tree(context.Background())
+	assert.NoError(t, err)
+	if assert.Len(t, roots, 2) {
+		assert.Equal(t, "工程", roots[0].Name)
+		assert.Equal(t, "孤立", roots[1].Name)
+		if assert.Len(t, roots[0].Children, 1) {
+			backend := roots[0].Children[0]
+			assert.Equal(t, "后端", backend.Name)
+			assert.Equal(t, []string{"Go", "Rust"}, []string{backend.Children[0].Name, backend.Children[1].Name})
+		}
+	}
+}
+
+// TestArticleHandler_ListByCategoryIncludeDescendants 测试include_descendants参数传递到仓储
+func TestArticleHandler_ListByCategoryIncludeDescendants(t *testing.T) {
+	mockArticleRepo := new(MockArticleRepository)
+	mockCategoryRepo := new(MockCategoryRepository)
+	articleService := service.NewArticleService(mockArticleRepo, mockCategoryRepo, new(MockTagRepository), new(MockArticleReviewRepository), newStubTransactor(), newRecordingPublisher())
+	articleHandler := handler.NewArticleHandler(articleService)
+
+	mockCategoryRepo.On("GetByID", mock.Anything, 1).Return(&domain.Category{ID: 1, Name: "工程"}, nil)
+	mockArticleRepo.On("ListByCategory", mock.Anything, 1, domain.QueryParams{
+		Fields:             domain.ArticleSummaryFields,
+		IncludeDescendants: true,
+	}).Return(&domain.ArticlePage{Items: []*domain.Article{}}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/articles/category/1?include_descendants=true", nil)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("categoryId")
+	c.SetParamValues("1")
+
+	assert.NoError(t, articleHandler.ListByCategory(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	mockArticleRepo.AssertExpectations(t)
+}
@@ -0,0 +1,276 @@
+package test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"goblog/internal/config"
+	"goblog/internal/domain"
+	"goblog/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockCommentRepository 评论仓储Mock
+type MockCommentRepository struct {
+	mock.Mock
+}
+
+func (m *MockCommentRepository) Create(ctx context.Context, comment *domain.Comment) (*domain.Comment, error) {
+	args := m.Called(ctx, comment)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Comment), args.Error(1)
+}
+
+func (m *MockCommentRepository) GetByID(ctx context.Context, id int) (*domain.Comment, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Comment), args.Error(1)
+}
+
+func (m *MockCommentRepository) UpdateStatus(ctx context.Context, before *domain.Comment, status string) (*domain.Comment, error) {
+	args := m.Called(ctx, before, status)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Comment), args.Error(1)
+}
+
+func (m *MockCommentRepository) Delete(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockCommentRepository) List(ctx context.Context, params domain.CommentQueryParams) ([]*domain.Comment, int64, error) {
+	args := m.Called(ctx, params)
+	return args.Get(0).([]*domain.Comment), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockCommentRepository) ListByArticle(ctx context.Context, articleID int, status string) ([]*domain.Comment, error) {
+	args := m.Called(ctx, articleID, status)
+	return args.Get(0).([]*domain.Comment), args.Error(1)
+}
+
+// MockSpamTokenRepository 垃圾评论词元统计仓储Mock
+type MockSpamTokenRepository struct {
+	mock.Mock
+}
+
+func (m *MockSpamTokenRepository) GetCounts(ctx context.Context, tokens []string) (map[string]domain.SpamTokenCount, error) {
+	args := m.Called(ctx, tokens)
+	return args.Get(0).(map[string]domain.SpamTokenCount), args.Error(1)
+}
+
+func (m *MockSpamTokenRepository) Add(ctx context.Context, tokens []string, delta domain.SpamTokenCount) error {
+	args := m.Called(ctx, tokens, delta)
+	return args.Error(0)
+}
+
+// testCommentConfig 测试用评论配置
+func testCommentConfig() config.CommentConfig {
+	return config.CommentConfig{
+		SpamThreshold:  0.9,
+		MaxLinks:       2,
+		MaxLinkDensity: 0.2,
+	}
+}
+
+// newTestCommentService 创建评论服务及其Mock依赖
+func newTestCommentService() (domain.CommentService, *MockCommentRepository, *MockArticleRepository, *MockSpamTokenRepository) {
+	mockCommentRepo := new(MockCommentRepository)
+	mockArticleRepo := new(MockArticleRepository)
+	mockTokenRepo := new(MockSpamTokenRepository)
+
+	classifier := service.NewSpamClassifier(mockTokenRepo, testCommentConfig())
+	commentService := service.NewCommentService(mockCommentRepo, mockArticleRepo, classifier, newStubTransactor(), newRecordingPublisher(), testCommentConfig())
+
+	return commentService, mockCommentRepo, mockArticleRepo, mockTokenRepo
+}
+
+// TestCommentService_Create_Honeypot 测试蜜罐字段被填写时直接判定为垃圾评论
+func TestCommentService_Create_Honeypot(t *testing.T) {
+	commentService, mockCommentRepo, mockArticleRepo, _ := newTestCommentService()
+
+	mockArticleRepo.On("GetByID", mock.Anything, 1).Return(&domain.Article{ID: 1, Published: true}, nil)
+	mockCommentRepo.On("Create", mock.Anything, mock.MatchedBy(func(c *domain.Comment) bool {
+		return c.Status == domain.CommentStatusSpam && c.SpamScore == 1 && len(c.SpamReasons) == 1
+	})).Return(&domain.Comment{ID: 1, Status: domain.CommentStatusSpam}, nil)
+
+	req := &domain.CommentCreateRequest{
+		AuthorName: "bot",
+		Content:    "great post",
+		Homepage:   "http://spam.example.com",
+	}
+
+	result, err := commentService.Create(context.Background(), 1, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.CommentStatusSpam, result.Status)
+	mockCommentRepo.AssertExpectations(t)
+}
+
+// TestCommentService_Create_LinkDensity 测试链接过多的评论
+func TestCommentService_Create_LinkDensity(t *testing.T) {
+	commentService, mockCommentRepo, mockArticleRepo, mockTokenRepo := newTestCommentService()
+
+	mockArticleRepo.On("GetByID", mock.Anything, 1).Return(&domain.Article{ID: 1, Published: true}, nil)
+	mockTokenRepo.On("GetCounts", mock.Anything, mock.Anything).Return(map[string]domain.SpamTokenCount{}, nil)
+
+	var saved *domain.Comment
+	mockCommentRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.Comment")).
+		Run(func(args mock.Arguments) { saved = args.Get(1).(*domain.Comment) }).
+		Return(&domain.Comment{ID: 2}, nil)
+
+	req := &domain.CommentCreateRequest{
+		AuthorName: "seller",
+		Content:    "buy http://a.example http://b.example http://c.example now",
+	}
+
+	_, err := commentService.Create(context.Background(), 1, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.CommentStatusSpam, saved.Status)
+	assert.Contains(t, saved.SpamReasons[0], "链接过多")
+}
+
+// TestCommentService_Create_Bayes 测试已训练的贝叶斯分类器
+func TestCommentService_Create_Bayes(t *testing.T) {
+	commentService, mockCommentRepo, mockArticleRepo, mockTokenRepo := newTestCommentService()
+
+	mockArticleRepo.On("GetByID", mock.Anything, 1).Return(&domain.Article{ID: 1, Published: true}, nil)
+	mockTokenRepo.On("GetCounts", mock.Anything, mock.Anything).Return(map[string]domain.SpamTokenCount{
+		"__documents__": {Spam: 20, Ham: 20},
+		"casino":        {Spam: 18, Ham: 0},
+		"bonus":         {Spam: 15, Ham: 1},
+		"thanks":        {Spam: 1, Ham: 17},
+	}, nil)
+
+	var saved *domain.Comment
+	mockCommentRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.Comment")).
+		Run(func(args mock.Arguments) { saved = args.Get(1).(*domain.Comment) }).
+		Return(&domain.Comment{ID: 3}, nil)
+
+	req := &domain.CommentCreateRequest{
+		AuthorName: "visitor",
+		Content:    "casino bonus every day",
+	}
+
+	_, err := commentService.Create(context.Background(), 1, req)
+
+	assert.NoError(t, err)
+	assert.Greater(t, saved.SpamScore, 0.9)
+	assert.Equal(t, domain.CommentStatusSpam, saved.Status)
+	assert.True(t, strings.Contains(saved.SpamReasons[0], "casino"))
+}
+
+// TestCommentService_Create_UnpublishedArticle 测试不能评论未发布的文章
+func TestCommentService_Create_UnpublishedArticle(t *testing.T) {
+	commentService, _, mockArticleRepo, _ := newTestCommentService()
+
+	mockArticleRepo.On("GetByID", mock.Anything, 1).Return(&domain.Article{ID: 1, Published: false}, nil)
+
+	_, err := commentService.Create(context.Background(), 1, &domain.CommentCreateRequest{
+		AuthorName: "visitor",
+		Content:    "hello",
+	})
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+// TestCommentService_Approve 测试审核通过时作为正常样本训练
+func TestCommentService_Approve(t *testing.T) {
+	commentService, mockCommentRepo, _, mockTokenRepo := newTestCommentService()
+
+	pending := &domain.Comment{ID: 1, AuthorName: "visitor", Content: "thanks for sharing", Status: domain.CommentStatusPending}
+	mockCommentRepo.On("GetByID", mock.Anything, 1).Return(pending, nil)
+	mockTokenRepo.On("Add", mock.Anything, []string{"visitor", "thanks", "for", "sharing", "__documents__"}, domain.SpamTokenCount{Ham: 1}).Return(nil)
+	mockCommentRepo.On("UpdateStatus", mock.Anything, pending, domain.CommentStatusApproved).
+		Return(&domain.Comment{ID: 1, Status: domain.CommentStatusApproved, Moderated: true}, nil)
+
+	result, err := commentService.Approve(context.Background(), 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.CommentStatusApproved, result.Status)
+	mockTokenRepo.AssertExpectations(t)
+	mockCommentRepo.AssertExpectations(t)
+}
+
+// TestCommentService_MarkSpam_Retrain 测试改判已审核评论时撤销之前的训练
+func TestCommentService_MarkSpam_Retrain(t *testing.T) {
+	commentService, mockCommentRepo, _, mockTokenRepo := newTestCommentService()
+
+	approved := &domain.Comment{ID: 1, AuthorName: "visitor", Content: "cheap pills", Status: domain.CommentStatusApproved, Moderated: true}
+	mockCommentRepo.On("GetByID", mock.Anything, 1).Return(approved, nil)
+	mockTokenRepo.On("Add", mock.Anything, mock.Anything, domain.SpamTokenCount{Spam: 1, Ham: -1}).Return(nil)
+	mockCommentRepo.On("UpdateStatus", mock.Anything, approved, domain.CommentStatusSpam).
+		Return(&domain.Comment{ID: 1, Status: domain.CommentStatusSpam, Moderated: true}, nil)
+
+	_, err := commentService.MarkSpam(context.Background(), 1)
+
+	assert.NoError(t, err)
+	mockTokenRepo.AssertExpectations(t)
+}
+
+// TestCommentService_ModerateConflict 测试评论已被其他审核者处理时返回冲突，不训练分类器
+func TestCommentService_ModerateConflict(t *testing.T) {
+	commentService, mockCommentRepo, _, mockTokenRepo := newTestCommentService()
+
+	pending := &domain.Comment{ID: 1, AuthorName: "visitor", Content: "cheap pills", Status: domain.CommentStatusPending}
+	current := &domain.Comment{ID: 1, AuthorName: "visitor", Content: "cheap pills", Status: domain.CommentStatusSpam, Moderated: true}
+	mockCommentRepo.On("GetByID", mock.Anything, 1).Return(pending, nil).Once()
+	mockCommentRepo.On("UpdateStatus", mock.Anything, pending, domain.CommentStatusSpam).Return(nil, domain.ErrVersionConflict)
+	mockCommentRepo.On("GetByID", mock.Anything, 1).Return(current, nil).Once()
+
+	_, err := commentService.MarkSpam(context.Background(), 1)
+
+	assert.ErrorIs(t, err, domain.ErrVersionConflict)
+	var conflict *domain.ConflictError
+	if assert.ErrorAs(t, err, &conflict) {
+		assert.Equal(t, current, conflict.Current)
+	}
+	mockTokenRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestCommentService_ListByArticle_HidesPrivateFields 测试公开评论列表隐藏隐私信息
+func TestCommentService_ListByArticle_HidesPrivateFields(t *testing.T) {
+	commentService, mockCommentRepo, _, _ := newTestCommentService()
+
+	mockCommentRepo.On("ListByArticle", mock.Anything, 1, domain.CommentStatusApproved).Return([]*domain.Comment{
+		{ID: 1, AuthorName: "visitor", AuthorEmail: "v@example.com", IP: "10.0.0.1", SpamScore: 0.2},
+	}, nil)
+
+	comments, err := commentService.ListByArticle(context.Background(), 1)
+
+	assert.NoError(t, err)
+	assert.Len(t, comments, 1)
+	assert.Empty(t, comments[0].AuthorEmail)
+	assert.Empty(t, comments[0].IP)
+	assert.Zero(t, comments[0].SpamScore)
+}
+
+// TestCommentService_AuthorForbidden 测试作者不能查看审核列表、审核和删除评论
+func TestCommentService_AuthorForbidden(t *testing.T) {
+	commentService, mockCommentRepo, _, _ := newTestCommentService()
+	author := actorContext("alice", domain.RoleAuthor)
+
+	_, _, err := commentService.List(author, domain.CommentQueryParams{Page: 1, Limit: 10})
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+
+	_, err = commentService.Approve(author, 1)
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+
+	_, err = commentService.MarkSpam(author, 1)
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+
+	err = commentService.Delete(author, 1)
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+
+	mockCommentRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything)
+	mockCommentRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
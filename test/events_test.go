@@ -0,0 +1,240 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"goblog/internal/config"
+	"goblog/internal/domain"
+	"goblog/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// stubTransactor 直接执行fn的事务管理器，用于服务层测试
+type stubTransactor struct{}
+
+func (stubTransactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// newStubTransactor 创建测试用事务管理器
+func newStubTransactor() domain.Transactor {
+	return stubTransactor{}
+}
+
+// recordingPublisher 记录已发布事件的发布器
+type recordingPublisher struct {
+	events []domain.Event
+	err    error
+}
+
+func (p *recordingPublisher) Publish(ctx context.Context, events ...domain.Event) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.events = append(p.events, events...)
+	return nil
+}
+
+// newRecordingPublisher 创建测试用事件发布器
+func newRecordingPublisher() *recordingPublisher {
+	return &recordingPublisher{}
+}
+
+// MockOutboxRepository 发件箱仓储Mock
+type MockOutboxRepository struct {
+	mock.Mock
+}
+
+func (m *MockOutboxRepository) Add(ctx context.Context, events []*domain.OutboxEvent) error {
+	args := m.Called(ctx, events)
+	return args.Error(0)
+}
+
+func (m *MockOutboxRepository) ClaimDue(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]*domain.OutboxEvent, error) {
+	args := m.Called(ctx, now, limit, lease)
+	return args.Get(0).([]*domain.OutboxEvent), args.Error(1)
+}
+
+func (m *MockOutboxRepository) SaveResult(ctx context.Context, event *domain.OutboxEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+// testOutboxConfig 测试用发件箱配置
+func testOutboxConfig() config.OutboxConfig {
+	return config.OutboxConfig{
+		MaxAttempts:  3,
+		BackoffBase:  time.Second,
+		BackoffMax:   time.Minute,
+		PollInterval: time.Second,
+		BatchSize:    10,
+	}
+}
+
+// TestEventBus_TypedSubscribers 测试按事件类型订阅、订阅全部事件以及未知订阅者
+func TestEventBus_TypedSubscribers(t *testing.T) {
+	bus := domain.NewEventBus()
+
+	var published []string
+	domain.On(bus, "search", func(ctx context.Context, e domain.ArticlePublished) error {
+		published = append(published, e.Article.Title)
+		return nil
+	})
+	var all []string
+	bus.SubscribeAll("stream", func(ctx context.Context, e domain.Event) error {
+		all = append(all, e.EventName())
+		return nil
+	})
+
+	assert.Equal(t, []string{"search", "stream"}, bus.Subscribers(domain.EventArticlePublished))
+	assert.Equal(t, []string{"stream"}, bus.Subscribers(domain.EventTagCreated))
+
+	ctx := context.Background()
+	assert.NoError(t, bus.Publish(ctx, domain.ArticlePublished{Article: &domain.Article{Title: "Hello"}}))
+	assert.NoError(t, bus.Publish(ctx, domain.TagCreated{Tag: &domain.Tag{Name: "Go"}}))
+
+	assert.Equal(t, []string{"Hello"}, published)
+	assert.Equal(t, []string{domain.EventArticlePublished, domain.EventTagCreated}, all)
+	assert.ErrorIs(t, bus.Deliver(ctx, "feed", domain.TagCreated{}), domain.ErrNoSubscriber)
+}
+
+// TestDecodeEvent 测试事件序列化后可以恢复为具体类型
+func TestDecodeEvent(t *testing.T) {
+	original := domain.ArticleUpdated{
+		Before:  &domain.Article{ID: 1, Title: "旧"},
+		Article: &domain.Article{ID: 1, Title: "新", Published: true},
+	}
+	data, err := json.Marshal(original)
+	assert.NoError(t, err)
+
+	event, err := domain.DecodeEvent(domain.EventArticleUpdated, data)
+
+	assert.NoError(t, err)
+	if assert.IsType(t, domain.ArticleUpdated{}, event) {
+		decoded := event.(domain.ArticleUpdated)
+		assert.Equal(t, "旧", decoded.Before.Title)
+		assert.True(t, decoded.Article.Published)
+	}
+
+	_, err = domain.DecodeEvent("article.viewed", data)
+	assert.Error(t, err)
+}
+
+// TestOutboxPublisher_Publish 测试每个订阅者写入一条记录并携带操作者
+func TestOutboxPublisher_Publish(t *testing.T) {
+	mockOutboxRepo := new(MockOutboxRepository)
+	bus := domain.NewEventBus()
+	domain.On(bus, "audit", func(ctx context.Context, e domain.TagCreated) error { return nil })
+	domain.On(bus, "webhook", func(ctx context.Context, e domain.TagCreated) error { return nil })
+	publisher := service.NewOutboxPublisher(mockOutboxRepo, bus)
+
+	var saved []*domain.OutboxEvent
+	mockOutboxRepo.On("Add", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { saved = args.Get(1).([]*domain.OutboxEvent) }).
+		Return(nil).Once()
+
+	actor := &domain.Actor{Username: "admin", RequestID: "req-1"}
+	ctx := domain.WithActor(context.Background(), actor)
+
+	// 没有订阅者的事件不写入
+	err := publisher.Publish(ctx, domain.TagCreated{Tag: &domain.Tag{ID: 1, Name: "Go"}}, domain.CategoryDeleted{Category: &domain.Category{ID: 2}})
+
+	assert.NoError(t, err)
+	if assert.Len(t, saved, 2) {
+		assert.Equal(t, "audit", saved[0].Subscriber)
+		assert.Equal(t, "webhook", saved[1].Subscriber)
+		assert.Equal(t, saved[0].EventID, saved[1].EventID)
+		assert.Equal(t, domain.EventTagCreated, saved[0].Event)
+		assert.JSONEq(t, saved[0].Payload, saved[1].Payload)
+		assert.Equal(t, actor, saved[0].Actor)
+	}
+
+	// 全部事件都没有订阅者时不访问仓储
+	assert.NoError(t, publisher.Publish(ctx, domain.CategoryDeleted{Category: &domain.Category{ID: 2}}))
+	mockOutboxRepo.AssertExpectations(t)
+}
+
+// TestOutboxDispatcher_ProcessDue 测试分发成功、失败重试、超过次数和订阅者不存在的处理，订阅者可以从上下文获取事件ID
+func TestOutboxDispatcher_ProcessDue(t *testing.T) {
+	mockOutboxRepo := new(MockOutboxRepository)
+	bus := domain.NewEventBus()
+
+	var actors, eventIDs []string
+	domain.On(bus, "audit", func(ctx context.Context, e domain.TagCreated) error {
+		actors = append(actors, domain.ActorFromContext(ctx).Username)
+		eventIDs = append(eventIDs, domain.EventIDFromContext(ctx))
+		return nil
+	})
+	domain.On(bus, "webhook", func(ctx context.Context, e domain.TagCreated) error {
+		return errors.New("webhook store unavailable")
+	})
+	dispatcher := service.NewOutboxDispatcher(mockOutboxRepo, bus, testOutboxConfig())
+
+	payload := `{"tag":{"id":1,"name":"Go"}}`
+	ok := &domain.OutboxEvent{ID: 1, EventID: "ev-1", Event: domain.EventTagCreated, Subscriber: "audit", Payload: payload, Actor: &domain.Actor{Username: "admin"}}
+	retry := &domain.OutboxEvent{ID: 2, Event: domain.EventTagCreated, Subscriber: "webhook", Payload: payload}
+	exhausted := &domain.OutboxEvent{ID: 3, Event: domain.EventTagCreated, Subscriber: "webhook", Payload: payload, Attempts: 2}
+	removed := &domain.OutboxEvent{ID: 4, Event: domain.EventTagCreated, Subscriber: "search", Payload: payload}
+
+	mockOutboxRepo.On("ClaimDue", mock.Anything, mock.Anything, 10, mock.Anything).
+		Return([]*domain.OutboxEvent{ok, retry, exhausted, removed}, nil)
+	mockOutboxRepo.On("SaveResult", mock.Anything, mock.Anything).Return(nil)
+
+	before := time.Now()
+	n, err := dispatcher.ProcessDue(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 4, n)
+	assert.Equal(t, []string{"admin"}, actors)
+	assert.Equal(t, []string{"ev-1"}, eventIDs)
+
+	assert.Equal(t, domain.OutboxStatusDispatched, ok.Status)
+	assert.NotNil(t, ok.DispatchedAt)
+
+	assert.Equal(t, domain.OutboxStatusPending, retry.Status)
+	assert.Equal(t, 1, retry.Attempts)
+	assert.Equal(t, "webhook store unavailable", retry.LastError)
+	assert.WithinDuration(t, before.Add(time.Second), retry.NextAttemptAt, time.Second)
+
+	assert.Equal(t, domain.OutboxStatusDead, exhausted.Status)
+	assert.Equal(t, domain.OutboxStatusDead, removed.Status)
+	mockOutboxRepo.AssertNumberOfCalls(t, "SaveResult", 4)
+}
+
+// TestArticleService_PublishEvents 测试草稿发布时在事务中同时发布更新和发布事件，发布失败时返回错误
+func TestArticleService_PublishEvents(t *testing.T) {
+	mockArticleRepo := new(MockArticleRepository)
+	mockReviewRepo := new(MockArticleReviewRepository)
+	publisher := newRecordingPublisher()
+	articleService := service.NewArticleService(mockArticleRepo, new(MockCategoryRepository), new(MockTagRepository), mockReviewRepo, newStubTransactor(), publisher)
+
+	draft := &domain.Article{ID: 1, Title: "草稿", Content: "内容", Status: domain.ArticleStatusDraft}
+	published := &domain.Article{ID: 1, Title: "草稿", Content: "内容", Status: domain.ArticleStatusPublished, Published: true}
+
+	// 状态与内容在同一次更新中变更，版本只增加一次
+	mockArticleRepo.On("GetByID", mock.Anything, 1).Return(draft, nil)
+	mockArticleRepo.On("Update", mock.Anything, 1, mock.MatchedBy(func(a *domain.Article) bool { return a.Status == domain.ArticleStatusPublished })).Return(published, nil)
+	mockReviewRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.ArticleReview")).Return(&domain.ArticleReview{ID: 1}, nil)
+
+	publish := true
+	req := &domain.ArticleUpdateRequest{Title: "草稿", Content: "内容", Published: &publish}
+	_, err := articleService.Update(context.Background(), 1, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Event{
+		domain.ArticleUpdated{Before: draft, Article: published},
+		domain.ArticlePublished{Article: published},
+	}, publisher.events)
+	mockArticleRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything)
+
+	// 事件写入失败时整个事务失败
+	publisher.err = errors.New("outbox unavailable")
+	_, err = articleService.Update(context.Background(), 1, req)
+	assert.EqualError(t, err, "outbox unavailable")
+}
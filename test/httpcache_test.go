@@ -0,0 +1,111 @@
+package test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"goblog/internal/domain"
+	"goblog/internal/handler"
+	"goblog/internal/pkg/httpcache"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newCacheContext(method string, headers map[string]string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, "/", nil)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	rec := httptest.NewRecorder()
+	return echo.New().NewContext(req, rec), rec
+}
+
+func TestHTTPCache_ETags(t *testing.T) {
+	updated := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+	etag := httpcache.StrongETag("article", httpcache.Version{ID: 1, UpdatedAt: updated})
+
+	assert.Equal(t, etag, httpcache.StrongETag("article", httpcache.Version{ID: 1, UpdatedAt: updated}))
+	assert.NotEqual(t, etag, httpcache.StrongETag("article", httpcache.Version{ID: 1, UpdatedAt: updated.Add(time.Microsecond)}))
+	assert.NotEqual(t, etag, httpcache.StrongETag("article", httpcache.Version{ID: 2, UpdatedAt: updated}))
+	assert.True(t, strings.HasPrefix(httpcache.WeakETag("articles", 1, httpcache.Version{ID: 1, UpdatedAt: updated}), `W/"`))
+}
+
+func TestHTTPCache_NotModified(t *testing.T) {
+	updated := time.Date(2026, 5, 1, 10, 0, 0, 500, time.UTC)
+	etag := `"abc"`
+
+	c, rec := newCacheContext(http.MethodGet, map[string]string{"If-None-Match": `"other", W/"abc"`})
+	assert.True(t, httpcache.NotModified(c, etag, updated))
+	assert.Equal(t, etag, rec.Header().Get("ETag"))
+	assert.Equal(t, "Fri, 01 May 2026 10:00:00 GMT", rec.Header().Get("Last-Modified"))
+
+	// If-None-Match优先于If-Modified-Since
+	c, _ = newCacheContext(http.MethodGet, map[string]string{"If-None-Match": `"other"`, "If-Modified-Since": "Fri, 01 May 2026 10:00:00 GMT"})
+	assert.False(t, httpcache.NotModified(c, etag, updated))
+
+	c, _ = newCacheContext(http.MethodGet, map[string]string{"If-Modified-Since": "Fri, 01 May 2026 10:00:00 GMT"})
+	assert.True(t, httpcache.NotModified(c, etag, updated))
+
+	c, _ = newCacheContext(http.MethodGet, map[string]string{"If-Modified-Since": "Fri, 01 May 2026 09:59:59 GMT"})
+	assert.False(t, httpcache.NotModified(c, etag, updated))
+}
+
+func TestHTTPCache_IfMatch(t *testing.T) {
+	c, _ := newCacheContext(http.MethodPut, nil)
+	assert.True(t, httpcache.IfMatch(c, `"abc"`))
+
+	c, _ = newCacheContext(http.MethodPut, map[string]string{"If-Match": `"abc"`})
+	assert.True(t, httpcache.IfMatch(c, `"abc"`))
+
+	// 强比较，弱ETag不匹配
+	c, _ = newCacheContext(http.MethodPut, map[string]string{"If-Match": `W/"abc"`})
+	assert.False(t, httpcache.IfMatch(c, `"abc"`))
+
+	c, _ = newCacheContext(http.MethodPut, map[string]string{"If-Match": "*"})
+	assert.True(t, httpcache.IfMatch(c, `"abc"`))
+}
+
+// TestArticleHandler_ConditionalRequests 测试文章详情返回304以及If-Match不一致时拒绝删除
+func TestArticleHandler_ConditionalRequests(t *testing.T) {
+	articleService, mockArticleRepo, _, _ := newTestWorkflowService()
+	articleHandler := handler.NewArticleHandler(articleService)
+
+	article := &domain.Article{ID: 1, Title: "标题", UpdatedAt: time.Now(), Category: &domain.Category{ID: 2, UpdatedAt: time.Now()}}
+	mockArticleRepo.On("GetByID", mock.Anything, 1).Return(article, nil)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/articles/1", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+	assert.NoError(t, articleHandler.GetByID(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	etag := rec.Header().Get("ETag")
+	assert.NotEmpty(t, etag)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/articles/1", nil)
+	req.Header.Set("If-None-Match", etag)
+	rec = httptest.NewRecorder()
+	c = e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+	assert.NoError(t, articleHandler.GetByID(c))
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+	assert.Empty(t, rec.Body.String())
+
+	req = httptest.NewRequest(http.MethodDelete, "/api/articles/1", nil)
+	req.Header.Set("If-Match", `"stale"`)
+	rec = httptest.NewRecorder()
+	c = e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+	assert.NoError(t, articleHandler.Delete(c))
+	assert.Equal(t, http.StatusPreconditionFailed, rec.Code)
+	mockArticleRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,153 @@
+package test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"goblog/internal/config"
+	"goblog/internal/pkg/jwtkeys"
+	"goblog/internal/service"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// writePEMKey 将私钥以PKCS#8 PEM格式写入临时文件，返回 kid=path
+func writePEMKey(t *testing.T, kid string, key interface{}) string {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	assert.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), kid+".pem")
+	assert.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600))
+	return kid + "=" + path
+}
+
+// newTestRotationKeys 生成一个RSA密钥和一个Ed25519密钥
+func newTestRotationKeys(t *testing.T) (string, string) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	return writePEMKey(t, "2026-01", rsaKey), writePEMKey(t, "2026-07", edKey)
+}
+
+// TestKeySet_Rotation 测试轮换签名密钥后旧密钥签发的令牌仍然有效
+func TestKeySet_Rotation(t *testing.T) {
+	oldKey, newKey := newTestRotationKeys(t)
+
+	before, err := jwtkeys.LoadFiles([]string{oldKey}, "")
+	assert.NoError(t, err)
+	after, err := jwtkeys.LoadFiles([]string{oldKey, newKey}, "2026-07")
+	assert.NoError(t, err)
+
+	claims := jwt.RegisteredClaims{Subject: "admin", ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute))}
+	oldToken, err := before.Sign(claims)
+	assert.NoError(t, err)
+	newToken, err := after.Sign(claims)
+	assert.NoError(t, err)
+
+	assert.NoError(t, after.Parse(oldToken, &jwt.RegisteredClaims{}))
+	assert.NoError(t, after.Parse(newToken, &jwt.RegisteredClaims{}))
+
+	// 新密钥签发的令牌使用EdDSA和新的kid
+	parsed, _, err := jwt.NewParser().ParseUnverified(newToken, &jwt.RegisteredClaims{})
+	assert.NoError(t, err)
+	assert.Equal(t, "EdDSA", parsed.Method.Alg())
+	assert.Equal(t, "2026-07", parsed.Header["kid"])
+
+	// 只配置旧密钥的服务不认识新密钥
+	assert.Error(t, before.Parse(newToken, &jwt.RegisteredClaims{}))
+}
+
+// TestKeySet_JWKS 测试JWKS只包含公钥
+func TestKeySet_JWKS(t *testing.T) {
+	oldKey, newKey := newTestRotationKeys(t)
+	keys, err := jwtkeys.LoadFiles([]string{oldKey, newKey}, "")
+	assert.NoError(t, err)
+
+	set, err := keys.JWKS()
+	assert.NoError(t, err)
+	assert.Len(t, set.Keys, 2)
+	assert.Equal(t, "2026-01", set.Keys[0].Kid)
+	assert.Equal(t, "RS256", set.Keys[0].Alg)
+	assert.Equal(t, "OKP", set.Keys[1].Kty)
+
+	// JWKS中的公钥可以验证令牌
+	token, _ := keys.Sign(jwt.RegisteredClaims{Subject: "admin"})
+	publicKey, err := set.Keys[0].PublicKey()
+	assert.NoError(t, err)
+	_, err = jwt.Parse(token, func(*jwt.Token) (interface{}, error) { return publicKey, nil })
+	assert.NoError(t, err)
+
+	// 共享密钥不会被公开
+	hmacSet, _ := jwtkeys.NewHMAC("test-secret").JWKS()
+	assert.Empty(t, hmacSet.Keys)
+}
+
+// TestKeySet_RejectsAlgorithmConfusion 测试使用公钥作为HMAC密钥伪造的令牌被拒绝
+func TestKeySet_RejectsAlgorithmConfusion(t *testing.T) {
+	oldKey, _ := newTestRotationKeys(t)
+	keys, err := jwtkeys.LoadFiles([]string{oldKey}, "")
+	assert.NoError(t, err)
+
+	data, _ := os.ReadFile(strings.SplitN(oldKey, "=", 2)[1])
+	forged := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{Subject: "admin"})
+	forged.Header["kid"] = "2026-01"
+	tokenString, _ := forged.SignedString(data)
+
+	assert.Error(t, keys.Parse(tokenString, &jwt.RegisteredClaims{}))
+}
+
+// TestAuthService_AsymmetricToken 测试使用非对称密钥签发和验证访问令牌
+func TestAuthService_AsymmetricToken(t *testing.T) {
+	oldKey, _ := newTestRotationKeys(t)
+	keys, err := jwtkeys.LoadFiles([]string{oldKey}, "")
+	assert.NoError(t, err)
+
+	mockDenylistRepo := new(MockTokenDenylistRepository)
+	mockDenylistRepo.On("Contains", mock.Anything, mock.Anything).Return(false, nil)
+	authService := service.NewAuthService(testAuthConfig(), keys, new(MockLoginAttemptRepository), new(MockLoginThrottleRepository), new(MockRefreshTokenRepository), mockDenylistRepo, new(MockTwoFactorService), new(MockUserRepository))
+
+	token, err := authService.GenerateToken(context.Background(), "admin")
+	assert.NoError(t, err)
+
+	username, err := authService.ValidateToken(context.Background(), "Bearer "+token)
+	assert.NoError(t, err)
+	assert.Equal(t, "admin", username)
+
+	// 使用共享密钥签名的令牌不再被接受
+	legacy, _ := jwtkeys.NewHMAC("test-secret").Sign(jwt.RegisteredClaims{Subject: "admin"})
+	_, err = authService.ValidateToken(context.Background(), "Bearer "+legacy)
+	assert.Error(t, err)
+}
+
+// TestConfig_Validate 测试生产环境拒绝默认JWT密钥
+func TestConfig_Validate(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.JWT.Secret = "your-secret-key"
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Server.Environment = "production"
+	assert.Error(t, cfg.Validate())
+
+	cfg.JWT.Secret = "short"
+	assert.Error(t, cfg.Validate())
+
+	cfg.JWT.Secret = strings.Repeat("x", 32)
+	assert.NoError(t, cfg.Validate())
+
+	cfg.JWT.Secret = "your-secret-key"
+	cfg.JWT.Keys = []string{"2026-01=/etc/goblog/jwt.pem"}
+	assert.NoError(t, cfg.Validate())
+}
@@ -0,0 +1,326 @@
+package test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"goblog/internal/config"
+	"goblog/internal/domain"
+	"goblog/internal/pkg/jwk"
+	"goblog/internal/pkg/jwtkeys"
+	"goblog/internal/pkg/oidc"
+	"goblog/internal/service"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockUserRepository 用户仓储Mock
+type MockUserRepository struct {
+	mock.Mock
+}
+
+func (m *MockUserRepository) GetByUsername(ctx context.Context, username string) (*domain.User, error) {
+	args := m.Called(ctx, username)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.User), args.Error(1)
+}
+
+func (m *MockUserRepository) GetBySubject(ctx context.Context, issuer, subject string) (*domain.User, error) {
+	args := m.Called(ctx, issuer, subject)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.User), args.Error(1)
+}
+
+func (m *MockUserRepository) Create(ctx context.Context, user *domain.User) (*domain.User, error) {
+	args := m.Called(ctx, user)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.User), args.Error(1)
+}
+
+func (m *MockUserRepository) Update(ctx context.Context, id int, user *domain.User) (*domain.User, error) {
+	args := m.Called(ctx, id, user)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.User), args.Error(1)
+}
+
+// mockIssuer 本地模拟的OIDC身份提供方，提供发现文档、JWKS和令牌端点
+type mockIssuer struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+
+	mu      sync.Mutex
+	pending map[string]pendingAuthorization
+}
+
+// pendingAuthorization 已签发但尚未兑换的授权码
+type pendingAuthorization struct {
+	challenge string
+	claims    jwt.MapClaims
+}
+
+// newMockIssuer 启动模拟身份提供方
+func newMockIssuer(t *testing.T) *mockIssuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	issuer := &mockIssuer{key: key, pending: make(map[string]pendingAuthorization)}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 issuer.server.URL,
+			"authorization_endpoint": issuer.server.URL + "/authorize",
+			"token_endpoint":         issuer.server.URL + "/token",
+			"jwks_uri":               issuer.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		publicKey, _ := jwk.New("test-key", "RS256", &key.PublicKey)
+		json.NewEncoder(w).Encode(jwk.Set{Keys: []jwk.Key{publicKey}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		issuer.mu.Lock()
+		auth, ok := issuer.pending[r.FormValue("code")]
+		delete(issuer.pending, r.FormValue("code"))
+		issuer.mu.Unlock()
+
+		// 校验PKCE
+		if !ok || oidc.Challenge(r.FormValue("code_verifier")) != auth.challenge || r.FormValue("client_id") != "goblog" {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, auth.claims)
+		token.Header["kid"] = "test-key"
+		idToken, _ := token.SignedString(key)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": "opaque",
+			"token_type":   "Bearer",
+			"id_token":     idToken,
+			"expires_in":   300,
+		})
+	})
+
+	issuer.server = httptest.NewServer(mux)
+	t.Cleanup(issuer.server.Close)
+	return issuer
+}
+
+// authorize 模拟用户在身份提供方完成登录，返回授权码和回调中的state
+func (m *mockIssuer) authorize(t *testing.T, authURL string, claims jwt.MapClaims) (string, string) {
+	parsed, err := url.Parse(authURL)
+	assert.NoError(t, err)
+	query := parsed.Query()
+
+	assert.Equal(t, "S256", query.Get("code_challenge_method"))
+	assert.Equal(t, "code", query.Get("response_type"))
+
+	base := jwt.MapClaims{
+		"iss":   m.server.URL,
+		"aud":   "goblog",
+		"exp":   time.Now().Add(time.Minute).Unix(),
+		"iat":   time.Now().Unix(),
+		"nonce": query.Get("nonce"),
+	}
+	for k, v := range claims {
+		base[k] = v
+	}
+
+	m.mu.Lock()
+	m.pending["auth-code"] = pendingAuthorization{challenge: query.Get("code_challenge"), claims: base}
+	m.mu.Unlock()
+
+	return "auth-code", query.Get("state")
+}
+
+// testOIDCConfig 测试用单点登录配置
+func testOIDCConfig(issuer string) *config.Config {
+	cfg := testAuthConfig()
+	cfg.OIDC = config.OIDCConfig{
+		Enabled:         true,
+		Issuer:          issuer,
+		ClientID:        "goblog",
+		ClientSecret:    "secret",
+		RedirectURL:     "http://localhost:8080/auth/oidc/callback",
+		UsernameClaim:   "preferred_username",
+		RoleClaim:       "groups",
+		AdminGroups:     []string{"blog-admins"},
+		EditorGroups:    []string{"blog-editors"},
+		DefaultRole:     "author",
+		StateExpiration: 10 * time.Minute,
+	}
+	return cfg
+}
+
+// newTestOIDCService 创建连接模拟身份提供方的单点登录服务，令牌由真实的认证服务签发
+func newTestOIDCService(t *testing.T) (domain.OIDCService, domain.AuthService, *mockIssuer, *MockUserRepository, *MockLoginThrottleRepository, *MockLoginAttemptRepository, *MockRefreshTokenRepository) {
+	issuer := newMockIssuer(t)
+	cfg := testOIDCConfig(issuer.server.URL)
+
+	mockUserRepo := new(MockUserRepository)
+	mockThrottleRepo := new(MockLoginThrottleRepository)
+	mockAttemptRepo := new(MockLoginAttemptRepository)
+	mockRefreshRepo := new(MockRefreshTokenRepository)
+	mockDenylistRepo := new(MockTokenDenylistRepository)
+	mockDenylistRepo.On("Contains", mock.Anything, mock.Anything).Return(false, nil)
+
+	mockTwoFactor := new(MockTwoFactorService)
+	mockTwoFactor.On("IsEnabled", mock.Anything, mock.Anything).Return(false, nil)
+
+	authService := service.NewAuthService(cfg, jwtkeys.NewHMAC("test-secret"), mockAttemptRepo, mockThrottleRepo, mockRefreshRepo, mockDenylistRepo, mockTwoFactor, mockUserRepo)
+	provider := oidc.NewProvider(oidc.Config{
+		Issuer:       cfg.OIDC.Issuer,
+		ClientID:     cfg.OIDC.ClientID,
+		ClientSecret: cfg.OIDC.ClientSecret,
+		RedirectURL:  cfg.OIDC.RedirectURL,
+	}, issuer.server.Client())
+	oidcService := service.NewOIDCService(provider, jwtkeys.NewHMAC("test-secret"), mockUserRepo, authService, cfg)
+
+	return oidcService, authService, issuer, mockUserRepo, mockThrottleRepo, mockAttemptRepo, mockRefreshRepo
+}
+
+// TestOIDCService_Callback_CreatesUser 测试首次单点登录创建本地用户并按用户组映射角色
+func TestOIDCService_Callback_CreatesUser(t *testing.T) {
+	oidcService, authService, issuer, mockUserRepo, mockThrottleRepo, mockAttemptRepo, mockRefreshRepo := newTestOIDCService(t)
+	ctx := context.Background()
+
+	auth, err := oidcService.AuthURL(ctx)
+	assert.NoError(t, err)
+	code, state := issuer.authorize(t, auth.URL, jwt.MapClaims{
+		"sub":                "user-1",
+		"preferred_username": "alice",
+		"email":              "alice@example.com",
+		"groups":             []string{"staff", "blog-editors"},
+	})
+
+	alice := &domain.User{ID: 1, Username: "alice", Role: domain.RoleEditor}
+	mockUserRepo.On("GetBySubject", mock.Anything, issuer.server.URL, "user-1").Return(nil, domain.ErrNotFound)
+	mockUserRepo.On("GetByUsername", mock.Anything, "alice").Return(nil, domain.ErrNotFound).Once()
+	mockUserRepo.On("Create", mock.Anything, mock.MatchedBy(func(u *domain.User) bool {
+		return u.Username == "alice" && u.Role == domain.RoleEditor && u.Subject == "user-1" && u.Email == "alice@example.com"
+	})).Return(alice, nil)
+	mockUserRepo.On("GetByUsername", mock.Anything, "alice").Return(alice, nil)
+	mockThrottleRepo.On("Reset", mock.Anything, mock.Anything).Return(nil)
+	mockAttemptRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+	mockRefreshRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+	resp, err := oidcService.Callback(ctx, &domain.OIDCCallbackRequest{
+		Code:       code,
+		State:      state,
+		StateToken: auth.State,
+		IP:         "10.0.0.1",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", resp.Username)
+	assert.NotEmpty(t, resp.RefreshToken)
+
+	principal, err := authService.Authenticate(ctx, "Bearer "+resp.Token)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.RoleEditor, principal.Role)
+	mockUserRepo.AssertExpectations(t)
+}
+
+// TestOIDCService_Callback_UpdatesExistingUser 测试再次登录时同步角色
+func TestOIDCService_Callback_UpdatesExistingUser(t *testing.T) {
+	oidcService, _, issuer, mockUserRepo, mockThrottleRepo, mockAttemptRepo, mockRefreshRepo := newTestOIDCService(t)
+	ctx := context.Background()
+
+	auth, _ := oidcService.AuthURL(ctx)
+	code, state := issuer.authorize(t, auth.URL, jwt.MapClaims{
+		"sub":                "user-1",
+		"preferred_username": "alice",
+		"groups":             []string{"blog-admins"},
+	})
+
+	mockUserRepo.On("GetBySubject", mock.Anything, issuer.server.URL, "user-1").
+		Return(&domain.User{ID: 1, Username: "alice", Role: domain.RoleAuthor}, nil)
+	mockUserRepo.On("Update", mock.Anything, 1, mock.MatchedBy(func(u *domain.User) bool {
+		return u.Role == domain.RoleAdmin && u.LastLoginAt != nil
+	})).Return(&domain.User{ID: 1, Username: "alice", Role: domain.RoleAdmin}, nil)
+	mockUserRepo.On("GetByUsername", mock.Anything, "alice").Return(&domain.User{ID: 1, Username: "alice", Role: domain.RoleAdmin}, nil)
+	mockThrottleRepo.On("Reset", mock.Anything, mock.Anything).Return(nil)
+	mockAttemptRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+	mockRefreshRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+	_, err := oidcService.Callback(ctx, &domain.OIDCCallbackRequest{Code: code, State: state, StateToken: auth.State})
+
+	assert.NoError(t, err)
+	mockUserRepo.AssertExpectations(t)
+	mockUserRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+// TestOIDCService_Callback_StateMismatch 测试state不匹配时拒绝，不兑换授权码
+func TestOIDCService_Callback_StateMismatch(t *testing.T) {
+	oidcService, _, issuer, mockUserRepo, _, _, _ := newTestOIDCService(t)
+	ctx := context.Background()
+
+	auth, _ := oidcService.AuthURL(ctx)
+	code, _ := issuer.authorize(t, auth.URL, jwt.MapClaims{"sub": "user-1"})
+
+	_, err := oidcService.Callback(ctx, &domain.OIDCCallbackRequest{Code: code, State: "forged", StateToken: auth.State})
+
+	assert.ErrorIs(t, err, domain.ErrUnauthorized)
+	assert.Len(t, issuer.pending, 1)
+	mockUserRepo.AssertNotCalled(t, "GetBySubject", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestOIDCService_Callback_NonceMismatch 测试ID令牌的nonce与本次登录不一致时拒绝
+func TestOIDCService_Callback_NonceMismatch(t *testing.T) {
+	oidcService, _, issuer, mockUserRepo, _, _, _ := newTestOIDCService(t)
+	ctx := context.Background()
+
+	auth, _ := oidcService.AuthURL(ctx)
+	code, state := issuer.authorize(t, auth.URL, jwt.MapClaims{"sub": "user-1", "nonce": "replayed"})
+
+	_, err := oidcService.Callback(ctx, &domain.OIDCCallbackRequest{Code: code, State: state, StateToken: auth.State})
+
+	assert.ErrorIs(t, err, domain.ErrUnauthorized)
+	mockUserRepo.AssertNotCalled(t, "GetBySubject", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestOIDCService_Callback_WrongAudience 测试签发给其他客户端的ID令牌被拒绝
+func TestOIDCService_Callback_WrongAudience(t *testing.T) {
+	oidcService, _, issuer, _, _, _, _ := newTestOIDCService(t)
+	ctx := context.Background()
+
+	auth, _ := oidcService.AuthURL(ctx)
+	code, state := issuer.authorize(t, auth.URL, jwt.MapClaims{"sub": "user-1", "aud": "other-app"})
+
+	_, err := oidcService.Callback(ctx, &domain.OIDCCallbackRequest{Code: code, State: state, StateToken: auth.State})
+
+	assert.ErrorIs(t, err, domain.ErrUnauthorized)
+}
+
+// TestOIDCService_Callback_ReservedUsername 测试外部账号不能占用配置中的管理员用户名
+func TestOIDCService_Callback_ReservedUsername(t *testing.T) {
+	oidcService, _, issuer, mockUserRepo, _, _, _ := newTestOIDCService(t)
+	ctx := context.Background()
+
+	auth, _ := oidcService.AuthURL(ctx)
+	code, state := issuer.authorize(t, auth.URL, jwt.MapClaims{"sub": "user-2", "preferred_username": "admin"})
+	mockUserRepo.On("GetBySubject", mock.Anything, issuer.server.URL, "user-2").Return(nil, domain.ErrNotFound)
+
+	_, err := oidcService.Callback(ctx, &domain.OIDCCallbackRequest{Code: code, State: state, StateToken: auth.State})
+
+	assert.ErrorIs(t, err, domain.ErrDuplicateResource)
+}
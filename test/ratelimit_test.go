@@ -0,0 +1,144 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"goblog/internal/config"
+	"goblog/internal/middleware"
+	"goblog/internal/pkg/ratelimit"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestMemoryStore_Take 测试令牌桶耗尽后拒绝请求
+func TestMemoryStore_Take(t *testing.T) {
+	store := ratelimit.NewMemoryStore()
+	policy := ratelimit.Policy{Rate: 1, Burst: 2}
+	ctx := context.Background()
+
+	first, err := store.Take(ctx, "login:ip:10.0.0.1", policy)
+	assert.NoError(t, err)
+	assert.True(t, first.Allowed)
+	assert.Equal(t, 1, first.Remaining)
+
+	second, _ := store.Take(ctx, "login:ip:10.0.0.1", policy)
+	assert.True(t, second.Allowed)
+	assert.Equal(t, 0, second.Remaining)
+
+	third, _ := store.Take(ctx, "login:ip:10.0.0.1", policy)
+	assert.False(t, third.Allowed)
+	assert.Greater(t, third.RetryAfter, time.Duration(0))
+	assert.LessOrEqual(t, third.RetryAfter, time.Second)
+
+	// 不同的键使用独立的令牌桶
+	other, _ := store.Take(ctx, "login:ip:10.0.0.2", policy)
+	assert.True(t, other.Allowed)
+}
+
+// TestRateLimitMiddleware_Headers 测试限流响应头和429响应
+func TestRateLimitMiddleware_Headers(t *testing.T) {
+	e := echo.New()
+	rateLimit := middleware.NewRateLimitMiddleware(ratelimit.NewMemoryStore())
+	policy := config.RateLimitPolicy{Requests: 1, Period: time.Minute, Burst: 1}
+
+	e.GET("/ping", func(c echo.Context) error {
+		return c.String(http.StatusOK, "pong")
+	}, rateLimit.Limit("public", policy))
+
+	req := httptest.NewRequest(http.MethodGet, "/ping", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "1", rec.Header().Get("X-RateLimit-Limit"))
+	assert.Equal(t, "0", rec.Header().Get("X-RateLimit-Remaining"))
+	assert.Equal(t, "60", rec.Header().Get("X-RateLimit-Reset"))
+
+	req = httptest.NewRequest(http.MethodGet, "/ping", nil)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "60", rec.Header().Get("Retry-After"))
+}
+
+// TestRateLimitMiddleware_Disabled 测试未配置的策略不限流
+func TestRateLimitMiddleware_Disabled(t *testing.T) {
+	e := echo.New()
+	rateLimit := middleware.NewRateLimitMiddleware(ratelimit.NewMemoryStore())
+
+	e.GET("/ping", func(c echo.Context) error {
+		return c.String(http.StatusOK, "pong")
+	}, rateLimit.Limit("public", config.RateLimitPolicy{}))
+
+	for i := 0; i < 5; i++ {
+		req := httptest.NewRequest(http.MethodGet, "/ping", nil)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, rec.Header().Get("X-RateLimit-Limit"))
+	}
+}
+
+// newIPLimitedServer 创建按IP限流的服务，每分钟只允许一次请求
+func newIPLimitedServer(t *testing.T, trustedProxies []string) *echo.Echo {
+	e := echo.New()
+	extractor, err := middleware.IPExtractor(trustedProxies)
+	assert.NoError(t, err)
+	e.IPExtractor = extractor
+
+	rateLimit := middleware.NewRateLimitMiddleware(ratelimit.NewMemoryStore())
+	e.GET("/ping", func(c echo.Context) error {
+		return c.String(http.StatusOK, "pong")
+	}, rateLimit.Limit("public", config.RateLimitPolicy{Requests: 1, Period: time.Minute, Burst: 1}))
+	return e
+}
+
+// pingFrom 从remoteAddr发送请求，xff不为空时带上X-Forwarded-For
+func pingFrom(e *echo.Echo, remoteAddr, xff string) int {
+	req := httptest.NewRequest(http.MethodGet, "/ping", nil)
+	req.RemoteAddr = remoteAddr
+	if xff != "" {
+		req.Header.Set(echo.HeaderXForwardedFor, xff)
+		req.Header.Set(echo.HeaderXRealIP, xff)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec.Code
+}
+
+// TestRateLimitMiddleware_ForgedForwardedFor 测试客户端伪造X-Forwarded-For不能换到新的令牌桶
+func TestRateLimitMiddleware_ForgedForwardedFor(t *testing.T) {
+	e := newIPLimitedServer(t, nil)
+
+	assert.Equal(t, http.StatusOK, pingFrom(e, "203.0.113.7:5000", "198.51.100.1"))
+	assert.Equal(t, http.StatusTooManyRequests, pingFrom(e, "203.0.113.7:5000", "198.51.100.2"))
+	assert.Equal(t, http.StatusTooManyRequests, pingFrom(e, "203.0.113.7:5000", ""))
+
+	// 未配置受信任代理时内网地址转发的请求头同样不生效
+	assert.Equal(t, http.StatusOK, pingFrom(e, "10.0.0.5:5000", "198.51.100.3"))
+	assert.Equal(t, http.StatusTooManyRequests, pingFrom(e, "10.0.0.5:5000", "198.51.100.4"))
+}
+
+// TestRateLimitMiddleware_TrustedProxy 测试只信任配置的代理转发的X-Forwarded-For
+func TestRateLimitMiddleware_TrustedProxy(t *testing.T) {
+	e := newIPLimitedServer(t, []string{"10.0.0.0/24"})
+
+	// 经过受信任代理的不同客户端各自计数
+	assert.Equal(t, http.StatusOK, pingFrom(e, "10.0.0.5:5000", "198.51.100.1"))
+	assert.Equal(t, http.StatusOK, pingFrom(e, "10.0.0.5:5000", "198.51.100.2"))
+	assert.Equal(t, http.StatusTooManyRequests, pingFrom(e, "10.0.0.5:5000", "198.51.100.2"))
+
+	// 不受信任的地址伪造的请求头被忽略
+	assert.Equal(t, http.StatusOK, pingFrom(e, "203.0.113.7:5000", "198.51.100.9"))
+	assert.Equal(t, http.StatusTooManyRequests, pingFrom(e, "203.0.113.7:5000", "198.51.100.10"))
+
+	_, err := middleware.IPExtractor([]string{"not-a-cidr"})
+	assert.Error(t, err)
+}
@@ -32,6 +32,17 @@ func (m *MockArticleRepository) GetByID(ctx context.Context, id int) (*domain.Ar
 
 func (m *MockArticleRepository) Update(ctx context.Context, id int, article *domain.Article) (*domain.Article, error) {
 	args := m.Called(ctx, id, article)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Article), args.Error(1)
+}
+
+func (m *MockArticleRepository) UpdateStatus(ctx context.Context, id int, status string) (*domain.Article, error) {
+	args := m.Called(ctx, id, status)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
 	return args.Get(0).(*domain.Article), args.Error(1)
 }
 
@@ -40,19 +51,54 @@ func (m *MockArticleRepository) Delete(ctx context.Context, id int) error {
 	return args.Error(0)
 }
 
-func (m *MockArticleRepository) List(ctx context.Context, params domain.QueryParams) ([]*domain.Article, int64, error) {
+func (m *MockArticleRepository) List(ctx context.Context, params domain.QueryParams) (*domain.ArticlePage, error) {
 	args := m.Called(ctx, params)
-	return args.Get(0).([]*domain.Article), args.Get(1).(int64), args.Error(2)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ArticlePage), args.Error(1)
 }
 
-func (m *MockArticleRepository) ListByCategory(ctx context.Context, categoryID int, params domain.QueryParams) ([]*domain.Article, int64, error) {
+func (m *MockArticleRepository) ListByCategory(ctx context.Context, categoryID int, params domain.QueryParams) (*domain.ArticlePage, error) {
 	args := m.Called(ctx, categoryID, params)
-	return args.Get(0).([]*domain.Article), args.Get(1).(int64), args.Error(2)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ArticlePage), args.Error(1)
 }
 
-func (m *MockArticleRepository) ListByTag(ctx context.Context, tagID int, params domain.QueryParams) ([]*domain.Article, int64, error) {
+func (m *MockArticleRepository) ListByTag(ctx context.Context, tagID int, params domain.QueryParams) (*domain.ArticlePage, error) {
 	args := m.Called(ctx, tagID, params)
-	return args.Get(0).([]*domain.Article), args.Get(1).(int64), args.Error(2)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ArticlePage), args.Error(1)
+}
+
+func (m *MockArticleRepository) Retag(ctx context.Context, params domain.QueryParams, add, remove []int) (*domain.ArticleRetag, error) {
+	args := m.Called(ctx, params, add, remove)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ArticleRetag), args.Error(1)
+}
+
+// MockArticleReviewRepository 文章审阅记录仓储Mock
+type MockArticleReviewRepository struct {
+	mock.Mock
+}
+
+func (m *MockArticleReviewRepository) Create(ctx context.Context, review *domain.ArticleReview) (*domain.ArticleReview, error) {
+	args := m.Called(ctx, review)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ArticleReview), args.Error(1)
+}
+
+func (m *MockArticleReviewRepository) ListByArticle(ctx context.Context, articleID int) ([]*domain.ArticleReview, error) {
+	args := m.Called(ctx, articleID)
+	return args.Get(0).([]*domain.ArticleReview), args.Error(1)
 }
 
 // MockCategoryRepository 分类仓储Mock
@@ -73,6 +119,14 @@ func (m *MockCategoryRepository) GetByID(ctx context.Context, id int) (*domain.C
 	return args.Get(0).(*domain.Category), args.Error(1)
 }
 
+func (m *MockCategoryRepository) GetForUpdate(ctx context.Context, id int) (*domain.Category, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Category), args.Error(1)
+}
+
 func (m *MockCategoryRepository) Update(ctx context.Context, id int, category *domain.Category) (*domain.Category, error) {
 	args := m.Called(ctx, id, category)
 	return args.Get(0).(*domain.Category), args.Error(1)
@@ -96,6 +150,37 @@ func (m *MockCategoryRepository) GetByName(ctx context.Context, name string) (*d
 	return args.Get(0).(*domain.Category), args.Error(1)
 }
 
+func (m *MockCategoryRepository) CountArticles(ctx context.Context, id int) (int, error) {
+	args := m.Called(ctx, id)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockCategoryRepository) CountChildren(ctx context.Context, id int) (int, error) {
+	args := m.Called(ctx, id)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockCategoryRepository) ReassignArticles(ctx context.Context, from, to int) (int, error) {
+	args := m.Called(ctx, from, to)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockCategoryRepository) ReparentChildren(ctx context.Context, from int, to *int) ([]int, error) {
+	args := m.Called(ctx, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]int), args.Error(1)
+}
+
+func (m *MockCategoryRepository) ArticleCounts(ctx context.Context) (map[int]domain.ArticleCounts, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[int]domain.ArticleCounts), args.Error(1)
+}
+
 // MockTagRepository 标签仓储Mock
 type MockTagRepository struct {
 	mock.Mock
@@ -142,6 +227,45 @@ func (m *MockTagRepository) GetByIDs(ctx context.Context, ids []int) ([]*domain.
 	return args.Get(0).([]*domain.Tag), args.Error(1)
 }
 
+func (m *MockTagRepository) GetAliasByName(ctx context.Context, name string) (*domain.TagAlias, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TagAlias), args.Error(1)
+}
+
+func (m *MockTagRepository) GetAliasByFormerID(ctx context.Context, formerID int) (*domain.TagAlias, error) {
+	args := m.Called(ctx, formerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TagAlias), args.Error(1)
+}
+
+func (m *MockTagRepository) CreateAlias(ctx context.Context, alias *domain.TagAlias) error {
+	args := m.Called(ctx, alias)
+	return args.Error(0)
+}
+
+func (m *MockTagRepository) DeleteAlias(ctx context.Context, name string) error {
+	args := m.Called(ctx, name)
+	return args.Error(0)
+}
+
+func (m *MockTagRepository) ArticleCounts(ctx context.Context) (map[int]domain.ArticleCounts, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[int]domain.ArticleCounts), args.Error(1)
+}
+
+func (m *MockTagRepository) Merge(ctx context.Context, targetID int, sourceIDs []int) (int, error) {
+	args := m.Called(ctx, targetID, sourceIDs)
+	return args.Int(0), args.Error(1)
+}
+
 // TestArticleService_Create 测试创建文章
 func TestArticleService_Create(t *testing.T) {
 	// 准备Mock
@@ -150,7 +274,7 @@ func TestArticleService_Create(t *testing.T) {
 	mockTagRepo := new(MockTagRepository)
 
 	// 创建服务
-	articleService := service.NewArticleService(mockArticleRepo, mockCategoryRepo, mockTagRepo)
+	articleService := service.NewArticleService(mockArticleRepo, mockCategoryRepo, mockTagRepo, new(MockArticleReviewRepository), newStubTransactor(), newRecordingPublisher())
 
 	// 测试数据
 	req := &domain.ArticleCreateRequest{
@@ -230,7 +354,7 @@ func TestArticleService_GetByID(t *testing.T) {
 	mockTagRepo := new(MockTagRepository)
 
 	// 创建服务
-	articleService := service.NewArticleService(mockArticleRepo, mockCategoryRepo, mockTagRepo)
+	articleService := service.NewArticleService(mockArticleRepo, mockCategoryRepo, mockTagRepo, new(MockArticleReviewRepository), newStubTransactor(), newRecordingPublisher())
 
 	// 测试数据
 	expectedArticle := &domain.Article{
@@ -267,7 +391,7 @@ func TestArticleService_GetByID_NotFound(t *testing.T) {
 	mockTagRepo := new(MockTagRepository)
 
 	// 创建服务
-	articleService := service.NewArticleService(mockArticleRepo, mockCategoryRepo, mockTagRepo)
+	articleService := service.NewArticleService(mockArticleRepo, mockCategoryRepo, mockTagRepo, new(MockArticleReviewRepository), newStubTransactor(), newRecordingPublisher())
 
 	// 设置Mock期望
 	mockArticleRepo.On("GetByID", mock.Anything, 999).Return(nil, domain.ErrNotFound)
@@ -0,0 +1,152 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"goblog/internal/domain"
+	"goblog/internal/handler"
+	"goblog/internal/pkg/sse"
+	"goblog/internal/repository"
+	"goblog/internal/service"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBroker_Resume(t *testing.T) {
+	broker := sse.NewBroker(3)
+	first := broker.Publish("article.created", []byte(`{"id":1}`))
+	broker.Publish("article.updated", []byte(`{"id":2}`))
+	broker.Publish("article.updated", []byte(`{"id":3}`))
+
+	// 从缓冲区续传
+	sub, backlog, resumed := broker.Subscribe(first.ID)
+	assert.True(t, resumed)
+	assert.Len(t, backlog, 2)
+	assert.Equal(t, []byte(`{"id":2}`), backlog[0].Data)
+	sub.Close()
+
+	// 最早的事件被挤出缓冲区后无法续传
+	broker.Publish("article.deleted", []byte(`{"id":4}`))
+	broker.Publish("article.deleted", []byte(`{"id":5}`))
+	sub, backlog, resumed = broker.Subscribe(first.ID)
+	assert.False(t, resumed)
+	assert.Empty(t, backlog)
+	sub.Close()
+
+	// 其他进程签发的ID无法续传
+	_, _, resumed = broker.Subscribe("otherepoch-1")
+	assert.False(t, resumed)
+
+	// 新连接只接收之后的事件
+	sub, backlog, resumed = broker.Subscribe("")
+	assert.True(t, resumed)
+	assert.Empty(t, backlog)
+	event := broker.Publish("tag.created", []byte(`{}`))
+	assert.Equal(t, event, <-sub.Events())
+}
+
+func TestBroker_SlowSubscriberAndClose(t *testing.T) {
+	broker := sse.NewBroker(10)
+
+	slow, _, _ := broker.Subscribe("")
+	for i := 0; i < 100; i++ {
+		broker.Publish("tag.updated", []byte(`{}`))
+	}
+	received := 0
+	for range slow.Events() {
+		received++
+	}
+	assert.Less(t, received, 100)
+
+	active, _, _ := broker.Subscribe("")
+	broker.Close()
+	_, open := <-active.Events()
+	assert.False(t, open)
+
+	afterClose, _, _ := broker.Subscribe("")
+	_, open = <-afterClose.Events()
+	assert.False(t, open)
+}
+
+func TestSSE_Write(t *testing.T) {
+	var buf bytes.Buffer
+	err := sse.Write(&buf, sse.Event{ID: "a-1", Name: "article.created", Data: []byte("line1\nline2")})
+	assert.NoError(t, err)
+	assert.Equal(t, "id: a-1\nevent: article.created\ndata: line1\ndata: line2\n\n", buf.String())
+}
+
+func TestStreamPublisher(t *testing.T) {
+	broker := sse.NewBroker(10)
+	outbox := newRecordingPublisher()
+	publisher := service.NewStreamPublisher(outbox, repository.NewStreamNotifier(sse.NewLocalPublisher(broker)))
+	sub, _, _ := broker.Subscribe("")
+
+	comment := &domain.Comment{ID: 1, ArticleID: 2, AuthorName: "张三", AuthorEmail: "a@example.com", IP: "1.2.3.4", Content: "你好", Status: domain.CommentStatusPending}
+	err := publisher.Publish(context.Background(), domain.CommentCreated{Comment: comment})
+	assert.NoError(t, err)
+
+	// 发件箱收到完整事件，事件流不含评论者的隐私信息
+	assert.Equal(t, []domain.Event{domain.CommentCreated{Comment: comment}}, outbox.events)
+
+	event := <-sub.Events()
+	assert.Equal(t, domain.EventCommentCreated, event.Name)
+	assert.NotContains(t, string(event.Data), "a@example.com")
+	assert.NotContains(t, string(event.Data), "1.2.3.4")
+
+	var payload domain.CommentCreated
+	assert.NoError(t, json.Unmarshal(event.Data, &payload))
+	assert.Equal(t, "你好", payload.Comment.Content)
+
+	// 写入发件箱失败时不推送
+	outbox.err = errors.New("outbox unavailable")
+	err = publisher.Publish(context.Background(), domain.TagCreated{Tag: &domain.Tag{ID: 1}})
+	assert.Error(t, err)
+	select {
+	case event := <-sub.Events():
+		t.Fatalf("unexpected event %s", event.Name)
+	default:
+	}
+}
+
+func TestStreamHandler_Stream(t *testing.T) {
+	broker := sse.NewBroker(10)
+	first := broker.Publish("category.created", []byte(`{"id":1}`))
+	broker.Publish("category.updated", []byte(`{"id":2}`))
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/events/stream", nil)
+	req.Header.Set("Last-Event-ID", first.ID)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	done := make(chan error)
+	go func() {
+		done <- handler.NewStreamHandler(broker, time.Hour).Stream(c)
+	}()
+
+	// 关闭分发器后长连接立即结束
+	time.Sleep(50 * time.Millisecond)
+	broker.Close()
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("stream did not stop after broker closed")
+	}
+
+	body := rec.Body.String()
+	assert.Equal(t, "text/event-stream", rec.Header().Get(echo.HeaderContentType))
+	assert.True(t, strings.HasPrefix(body, "retry: 3000\n\n"))
+	assert.Contains(t, body, "event: category.updated\ndata: {\"id\":2}\n\n")
+	assert.NotContains(t, body, "category.created")
+	assert.NotContains(t, body, "stream.reset")
+}
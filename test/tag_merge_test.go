@@ -0,0 +1,189 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"goblog/internal/domain"
+	"goblog/internal/handler"
+	"goblog/internal/service"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// TestTagService_Merge 测试来源标签合并到目标标签，每个来源标签发布一个删除事件
+func TestTagService_Merge(t *testing.T) {
+	mockTagRepo := new(MockTagRepository)
+	publisher := newRecordingPublisher()
+	tagService := service.NewTagService(mockTagRepo, newStubTransactor(), publisher)
+
+	target := &domain.Tag{ID: 1, Name: "Go", Aliases: []string{"Golang", "golang"}}
+	sources := []*domain.Tag{{ID: 2, Name: "Golang"}, {ID: 3, Name: "golang"}}
+	mockTagRepo.On("GetByID", mock.Anything, 1).Return(target, nil)
+	mockTagRepo.On("GetByIDs", mock.Anything, []int{2, 3}).Return(sources, nil)
+	mockTagRepo.On("Merge", mock.Anything, 1, []int{2, 3}).Return(4, nil)
+
+	merge, err := tagService.Merge(actorContext("editor", domain.RoleEditor), 1, &domain.TagMergeRequest{SourceIDs: []int{3, 2, 3}})
+	assert.NoError(t, err)
+	assert.Equal(t, &domain.TagMerge{Target: target, SourceIDs: []int{2, 3}, Articles: 4}, merge)
+
+	if assert.Len(t, publisher.events, 2) {
+		event := publisher.events[0].(domain.TagDeleted)
+		assert.Equal(t, sources[0], event.Tag)
+		assert.Equal(t, target, event.MergedInto)
+	}
+}
+
+// TestTagService_MergeInvalid 测试作者无权合并，目标标签在来源中或来源标签不存在时拒绝合并
+func TestTagService_MergeInvalid(t *testing.T) {
+	mockTagRepo := new(MockTagRepository)
+	tagService := service.NewTagService(mockTagRepo, newStubTransactor(), newRecordingPublisher())
+
+	_, err := tagService.Merge(actorContext("alice", domain.RoleAuthor), 1, &domain.TagMergeRequest{SourceIDs: []int{2}})
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	mockTagRepo.On("GetByID", mock.Anything, 1).Return(&domain.Tag{ID: 1, Name: "Go"}, nil)
+	mockTagRepo.On("GetByIDs", mock.Anything, []int{2, 9}).Return([]*domain.Tag{{ID: 2, Name: "Golang"}}, nil)
+
+	_, err = tagService.Merge(context.Background(), 1, &domain.TagMergeRequest{SourceIDs: []int{1, 2}})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	_, err = tagService.Merge(context.Background(), 1, &domain.TagMergeRequest{SourceIDs: []int{2, 9}})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	mockTagRepo.AssertNotCalled(t, "Merge", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestTagService_GetByIDMerged 测试已被合并的标签返回指向目标标签的MovedError
+func TestTagService_GetByIDMerged(t *testing.T) {
+	mockTagRepo := new(MockTagRepository)
+	tagService := service.NewTagService(mockTagRepo, newStubTransactor(), newRecordingPublisher())
+	mockTagRepo.On("GetByID", mock.Anything, 2).Return(nil, domain.ErrNotFound)
+	mockTagRepo.On("GetAliasByFormerID", mock.Anything, 2).Return(&domain.TagAlias{Name: "Golang", FormerID: intPtr(2), TagID: 1}, nil)
+	mockTagRepo.On("GetByID", mock.Anything, 9).Return(nil, domain.ErrNotFound)
+	mockTagRepo.On("GetAliasByFormerID", mock.Anything, 9).Return(nil, domain.ErrNotFound)
+
+	_, err := tagService.GetByID(context.Background(), 2)
+	var moved *domain.MovedError
+	if assert.ErrorAs(t, err, &moved) {
+		assert.Equal(t, 1, moved.Location)
+	}
+
+	_, err = tagService.GetByID(context.Background(), 9)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+// TestTagHandler_GetByIDRedirects 测试读取已被合并的标签时永久重定向到目标标签
+func TestTagHandler_GetByIDRedirects(t *testing.T) {
+	mockTagRepo := new(MockTagRepository)
+	tagHandler := handler.NewTagHandler(service.NewTagService(mockTagRepo, newStubTransactor(), newRecordingPublisher()))
+	mockTagRepo.On("GetByID", mock.Anything, 2).Return(nil, domain.ErrNotFound)
+	mockTagRepo.On("GetAliasByFormerID", mock.Anything, 2).Return(&domain.TagAlias{Name: "Golang", FormerID: intPtr(2), TagID: 1}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tags/2", nil)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("2")
+	tagHandler.GetByID(c)
+
+	assert.Equal(t, http.StatusMovedPermanently, rec.Code)
+	assert.Equal(t, "/api/tags/1", rec.Header().Get(echo.HeaderLocation))
+}
+
+// TestArticleHandler_ListByTagRedirects 测试按已被合并的标签查询文章时重定向并保留查询参数
+func TestArticleHandler_ListByTagRedirects(t *testing.T) {
+	mockTagRepo := new(MockTagRepository)
+	articleService := service.NewArticleService(new(MockArticleRepository), new(MockCategoryRepository), mockTagRepo, new(MockArticleReviewRepository), newStubTransactor(), newRecordingPublisher())
+	mockTagRepo.On("GetByID", mock.Anything, 2).Return(nil, domain.ErrNotFound)
+	mockTagRepo.On("GetAliasByFormerID", mock.Anything, 2).Return(&domain.TagAlias{Name: "Golang", FormerID: intPtr(2), TagID: 1}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/articles/tag/2?page=2&limit=5", nil)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("tagId")
+	c.SetParamValues("2")
+	handler.NewArticleHandler(articleService).ListByTag(c)
+
+	assert.Equal(t, http.StatusMovedPermanently, rec.Code)
+	assert.Equal(t, "/api/articles/tag/1?page=2&limit=5", rec.Header().Get(echo.HeaderLocation))
+}
+
+// TestTagService_UpdateKeepAlias 测试改名时保留旧名称作为别名
+func TestTagService_UpdateKeepAlias(t *testing.T) {
+	mockTagRepo := new(MockTagRepository)
+	tagService := service.NewTagService(mockTagRepo, newStubTransactor(), newRecordingPublisher())
+
+	renamed := &domain.Tag{ID: 1, Name: "Go", Aliases: []string{"Golang"}}
+	mockTagRepo.On("GetByID", mock.Anything, 1).Return(&domain.Tag{ID: 1, Name: "Golang"}, nil).Once()
+	mockTagRepo.On("GetByName", mock.Anything, "Go").Return(nil, domain.ErrNotFound)
+	mockTagRepo.On("GetAliasByName", mock.Anything, "Go").Return(nil, domain.ErrNotFound)
+	mockTagRepo.On("CreateAlias", mock.Anything, &domain.TagAlias{Name: "Golang", TagID: 1}).Return(nil)
+	mockTagRepo.On("Update", mock.Anything, 1, mock.AnythingOfType("*domain.Tag")).Return(&domain.Tag{ID: 1, Name: "Go"}, nil)
+	mockTagRepo.On("GetByID", mock.Anything, 1).Return(renamed, nil).Once()
+
+	result, err := tagService.Update(context.Background(), 1, &domain.TagUpdateRequest{Name: "Go", KeepAlias: true})
+	assert.NoError(t, err)
+	assert.Equal(t, renamed, result)
+	mockTagRepo.AssertExpectations(t)
+}
+
+// TestTagService_AliasNameTaken 测试别名不能用作新标签或其他标签的名称
+func TestTagService_AliasNameTaken(t *testing.T) {
+	mockTagRepo := new(MockTagRepository)
+	tagService := service.NewTagService(mockTagRepo, newStubTransactor(), newRecordingPublisher())
+	mockTagRepo.On("GetByName", mock.Anything, "Golang").Return(nil, domain.ErrNotFound)
+	mockTagRepo.On("GetAliasByName", mock.Anything, "Golang").Return(&domain.TagAlias{Name: "Golang", TagID: 1}, nil)
+	mockTagRepo.On("GetByID", mock.Anything, 3).Return(&domain.Tag{ID: 3, Name: "Rust"}, nil)
+
+	_, err := tagService.Create(context.Background(), &domain.TagCreateRequest{Name: "Golang"})
+	assert.ErrorIs(t, err, domain.ErrDuplicateResource)
+
+	_, err = tagService.Update(context.Background(), 3, &domain.TagUpdateRequest{Name: "Golang"})
+	assert.ErrorIs(t, err, domain.ErrDuplicateResource)
+
+	mockTagRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	mockTagRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestArticleService_Retag 测试为过滤出的文章批量添加和移除标签
+func TestArticleService_Retag(t *testing.T) {
+	mockArticleRepo := new(MockArticleRepository)
+	mockTagRepo := new(MockTagRepository)
+	articleService := service.NewArticleService(mockArticleRepo, new(MockCategoryRepository), mockTagRepo, new(MockArticleReviewRepository), newStubTransactor(), newRecordingPublisher())
+
+	params := domain.QueryParams{TagIDs: []int{2}}
+	retag := &domain.ArticleRetag{Articles: 6, Added: 4, Removed: 6}
+	mockTagRepo.On("GetByIDs", mock.Anything, []int{1, 2}).Return([]*domain.Tag{{ID: 1}, {ID: 2}}, nil)
+	mockArticleRepo.On("Retag", mock.Anything, params, []int{1}, []int{2}).Return(retag, nil)
+
+	req := &domain.ArticleRetagRequest{AddTagIDs: []int{1, 1}, RemoveTagIDs: []int{2}}
+	result, err := articleService.Retag(actorContext("editor", domain.RoleEditor), params, req)
+	assert.NoError(t, err)
+	assert.Equal(t, retag, result)
+}
+
+// TestArticleService_RetagRejected 测试作者无权批量修改，缺少过滤条件或同一标签同时添加和移除时拒绝
+func TestArticleService_RetagRejected(t *testing.T) {
+	articleService, mockArticleRepo, _, _ := newTestWorkflowService()
+	filtered := domain.QueryParams{Author: "alice"}
+	req := &domain.ArticleRetagRequest{AddTagIDs: []int{1}}
+
+	_, err := articleService.Retag(actorContext("alice", domain.RoleAuthor), filtered, req)
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+
+	editor := actorContext("editor", domain.RoleEditor)
+	_, err = articleService.Retag(editor, domain.QueryParams{Page: 1, Limit: 10}, req)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	_, err = articleService.Retag(editor, filtered, &domain.ArticleRetagRequest{})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	_, err = articleService.Retag(editor, filtered, &domain.ArticleRetagRequest{AddTagIDs: []int{1}, RemoveTagIDs: []int{1}})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	mockArticleRepo.AssertNotCalled(t, "Retag", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -18,7 +18,7 @@ func TestTagService_Create(t *testing.T) {
 	mockTagRepo := new(MockTagRepository)
 
 	// 创建服务
-	tagService := service.NewTagService(mockTagRepo)
+	tagService := service.NewTagService(mockTagRepo, newStubTransactor(), newRecordingPublisher())
 
 	// 测试数据
 	req := &domain.TagCreateRequest{
@@ -34,8 +34,9 @@ func TestTagService_Create(t *testing.T) {
 		UpdatedAt: time.Now(),
 	}
 
-	// 设置Mock期望 - 检查名称不存在
+	// 设置Mock期望 - 检查名称不存在，也不是别名
 	mockTagRepo.On("GetByName", mock.Anything, req.Name).Return(nil, domain.ErrNotFound)
+	mockTagRepo.On("GetAliasByName", mock.Anything, req.Name).Return(nil, domain.ErrNotFound)
 	// 创建标签
 	mockTagRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.Tag")).Return(expectedTag, nil)
 
@@ -59,7 +60,7 @@ func TestTagService_Create_WithoutColor(t *testing.T) {
 	mockTagRepo := new(MockTagRepository)
 
 	// 创建服务
-	tagService := service.NewTagService(mockTagRepo)
+	tagService := service.NewTagService(mockTagRepo, newStubTransactor(), newRecordingPublisher())
 
 	// 测试数据
 	req := &domain.TagCreateRequest{
@@ -75,8 +76,9 @@ func TestTagService_Create_WithoutColor(t *testing.T) {
 		UpdatedAt: time.Now(),
 	}
 
-	// 设置Mock期望 - 检查名称不存在
+	// 设置Mock期望 - 检查名称不存在，也不是别名
 	mockTagRepo.On("GetByName", mock.Anything, req.Name).Return(nil, domain.ErrNotFound)
+	mockTagRepo.On("GetAliasByName", mock.Anything, req.Name).Return(nil, domain.ErrNotFound)
 	// 创建标签
 	mockTagRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.Tag")).Return(expectedTag, nil)
 
@@ -100,7 +102,7 @@ func TestTagService_Create_Duplicate(t *testing.T) {
 	mockTagRepo := new(MockTagRepository)
 
 	// 创建服务
-	tagService := service.NewTagService(mockTagRepo)
+	tagService := service.NewTagService(mockTagRepo, newStubTransactor(), newRecordingPublisher())
 
 	// 测试数据
 	req := &domain.TagCreateRequest{
@@ -138,7 +140,7 @@ func TestTagService_GetByID(t *testing.T) {
 	mockTagRepo := new(MockTagRepository)
 
 	// 创建服务
-	tagService := service.NewTagService(mockTagRepo)
+	tagService := service.NewTagService(mockTagRepo, newStubTransactor(), newRecordingPublisher())
 
 	// 测试数据
 	expectedTag := &domain.Tag{
@@ -173,7 +175,7 @@ func TestTagService_Update(t *testing.T) {
 	mockTagRepo := new(MockTagRepository)
 
 	// 创建服务
-	tagService := service.NewTagService(mockTagRepo)
+	tagService := service.NewTagService(mockTagRepo, newStubTransactor(), newRecordingPublisher())
 
 	// 测试数据
 	req := &domain.TagUpdateRequest{
@@ -200,6 +202,7 @@ func TestTagService_Update(t *testing.T) {
 	// 设置Mock期望
 	mockTagRepo.On("GetByID", mock.Anything, 1).Return(existingTag, nil)
 	mockTagRepo.On("GetByName", mock.Anything, req.Name).Return(nil, domain.ErrNotFound)
+	mockTagRepo.On("GetAliasByName", mock.Anything, req.Name).Return(nil, domain.ErrNotFound)
 	mockTagRepo.On("Update", mock.Anything, 1, mock.AnythingOfType("*domain.Tag")).Return(updatedTag, nil)
 
 	// 执行测试
@@ -222,9 +225,10 @@ func TestTagService_Delete(t *testing.T) {
 	mockTagRepo := new(MockTagRepository)
 
 	// 创建服务
-	tagService := service.NewTagService(mockTagRepo)
+	tagService := service.NewTagService(mockTagRepo, newStubTransactor(), newRecordingPublisher())
 
 	// 设置Mock期望
+	mockTagRepo.On("GetByID", mock.Anything, 1).Return(&domain.Tag{ID: 1, Name: "Go"}, nil)
 	mockTagRepo.On("Delete", mock.Anything, 1).Return(nil)
 
 	// 执行测试
@@ -244,7 +248,7 @@ func TestTagService_List(t *testing.T) {
 	mockTagRepo := new(MockTagRepository)
 
 	// 创建服务
-	tagService := service.NewTagService(mockTagRepo)
+	tagService := service.NewTagService(mockTagRepo, newStubTransactor(), newRecordingPublisher())
 
 	// 测试数据
 	expectedTags := []*domain.Tag{
@@ -266,6 +270,7 @@ func TestTagService_List(t *testing.T) {
 
 	// 设置Mock期望
 	mockTagRepo.On("List", mock.Anything).Return(expectedTags, nil)
+	mockTagRepo.On("ArticleCounts", mock.Anything).Return(map[int]domain.ArticleCounts{1: {Total: 3, Published: 2}}, nil)
 
 	// 执行测试
 	ctx := context.Background()
@@ -281,3 +286,23 @@ func TestTagService_List(t *testing.T) {
 	// 验证Mock调用
 	mockTagRepo.AssertExpectations(t)
 }
+
+// TestTagService_AuthorForbidden 测试作者不能创建、修改和删除标签
+func TestTagService_AuthorForbidden(t *testing.T) {
+	mockTagRepo := new(MockTagRepository)
+	tagService := service.NewTagService(mockTagRepo, newStubTransactor(), newRecordingPublisher())
+	author := actorContext("alice", domain.RoleAuthor)
+
+	_, err := tagService.Create(author, &domain.TagCreateRequest{Name: "Rust"})
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+
+	_, err = tagService.Update(author, 1, &domain.TagUpdateRequest{Name: "Golang"})
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+
+	err = tagService.Delete(author, 1)
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+
+	mockTagRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	mockTagRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	mockTagRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
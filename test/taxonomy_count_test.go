@@ -0,0 +1,153 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"goblog/internal/domain"
+	"goblog/internal/handler"
+	"goblog/internal/repository"
+	"goblog/internal/service"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// TestTagService_ListCounts 测试标签列表带有文章数，没有文章的标签计为0
+func TestTagService_ListCounts(t *testing.T) {
+	mockTagRepo := new(MockTagRepository)
+	tagService := service.NewTagService(mockTagRepo, newStubTransactor(), newRecordingPublisher())
+	mockTagRepo.On("List", mock.Anything).Return([]*domain.Tag{{ID: 1, Name: "Go"}, {ID: 2, Name: "Rust"}}, nil)
+	mockTagRepo.On("ArticleCounts", mock.Anything).Return(map[int]domain.ArticleCounts{1: {Total: 5, Published: 3}}, nil)
+
+	tags, err := tagService.List(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, &domain.ArticleCounts{Total: 5, Published: 3}, tags[0].Articles)
+	assert.Equal(t, &domain.ArticleCounts{}, tags[1].Articles)
+}
+
+// TestCategoryService_TreeCounts 测试分类树中每个分类带有直接包含的文章数
+func TestCategoryService_TreeCounts(t *testing.T) {
+	mockCategoryRepo := new(MockCategoryRepository)
+	categoryService := service.NewCategoryService(mockCategoryRepo, newStubTransactor(), newRecordingPublisher())
+	mockCategoryRepo.On("List", mock.Anything).Return([]*domain.Category{
+		{ID: 1, Name: "工程"},
+		{ID: 2, Name: "后端", ParentID: intPtr(1)},
+	}, nil)
+	mockCategoryRepo.On("ArticleCounts", mock.Anything).Return(map[int]domain.ArticleCounts{2: {Total: 4, Published: 1}}, nil)
+
+	roots, err := categoryService.Tree(context.Background())
+	assert.NoError(t, err)
+	if assert.Len(t, roots, 1) && assert.Len(t, roots[0].Children, 1) {
+		assert.Equal(t, &domain.ArticleCounts{}, roots[0].Articles)
+		assert.Equal(t, &domain.ArticleCounts{Total: 4, Published: 1}, roots[0].Children[0].Articles)
+	}
+}
+
+// newCloudTags 准备标签及已发布文章数：Go 100、Rust 10、Zig 1，Draft 只有草稿
+func newCloudTags(repo *MockTagRepository) {
+	repo.On("List", mock.Anything).Return([]*domain.Tag{
+		{ID: 1, Name: "Go"},
+		{ID: 2, Name: "Zig"},
+		{ID: 3, Name: "Rust"},
+		{ID: 4, Name: "Draft"},
+	}, nil)
+	repo.On("ArticleCounts", mock.Anything).Return(map[int]domain.ArticleCounts{
+		1: {Total: 120, Published: 100},
+		2: {Total: 1, Published: 1},
+		3: {Total: 10, Published: 10},
+		4: {Total: 2},
+	}, nil)
+}
+
+// TestTagService_Cloud 测试标签云按已发布文章数的对数分级，只包含有已发布文章的标签并按名称排序
+func TestTagService_Cloud(t *testing.T) {
+	mockTagRepo := new(MockTagRepository)
+	tagService := service.NewTagService(mockTagRepo, newStubTransactor(), newRecordingPublisher())
+	newCloudTags(mockTagRepo)
+
+	items, err := tagService.Cloud(context.Background(), 0)
+	assert.NoError(t, err)
+	if assert.Len(t, items, 3) {
+		assert.Equal(t, []string{"Go", "Rust", "Zig"}, []string{items[0].Name, items[1].Name, items[2].Name})
+		assert.Equal(t, []int{5, 3, 1}, []int{items[0].Weight, items[1].Weight, items[2].Weight})
+		assert.Equal(t, 100, items[0].Articles)
+	}
+
+	// 只保留文章最多的两个标签，权重在保留的标签中重新分级
+	items, err = tagService.Cloud(context.Background(), 2)
+	assert.NoError(t, err)
+	if assert.Len(t, items, 2) {
+		assert.Equal(t, []string{"Go", "Rust"}, []string{items[0].Name, items[1].Name})
+		assert.Equal(t, []int{5, 1}, []int{items[0].Weight, items[1].Weight})
+	}
+}
+
+// TestTagHandler_Cloud 测试标签云接口返回权重和ETag，limit无效时返回400
+func TestTagHandler_Cloud(t *testing.T) {
+	mockTagRepo := new(MockTagRepository)
+	tagHandler := handler.NewTagHandler(service.NewTagService(mockTagRepo, newStubTransactor(), newRecordingPublisher()))
+	newCloudTags(mockTagRepo)
+
+	get := func(query string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/tags/cloud?"+query, nil)
+		rec := httptest.NewRecorder()
+		tagHandler.Cloud(echo.New().NewContext(req, rec))
+		return rec
+	}
+
+	rec := get("limit=2")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.NotEmpty(t, rec.Header().Get("ETag"))
+	assert.Contains(t, rec.Body.String(), `"weight":5`)
+
+	assert.Equal(t, http.StatusBadRequest, get("limit=0").Code)
+	assert.Equal(t, http.StatusBadRequest, get("limit=x").Code)
+}
+
+// TestCachedTagRepository_ArticleCounts 测试文章数统计命中缓存，文章写操作后重新统计
+func TestCachedTagRepository_ArticleCounts(t *testing.T) {
+	mockTagRepo := new(MockTagRepository)
+	mockArticleRepo := new(MockArticleRepository)
+	c := newTestCache()
+	tagRepo := repository.NewCachedTagRepository(mockTagRepo, c)
+	articleRepo := repository.NewCachedArticleRepository(mockArticleRepo, c)
+	ctx := context.Background()
+
+	mockTagRepo.On("ArticleCounts", mock.Anything).Return(map[int]domain.ArticleCounts{1: {Total: 1}}, nil).Once()
+	for i := 0; i < 2; i++ {
+		counts, err := tagRepo.ArticleCounts(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, map[int]domain.ArticleCounts{1: {Total: 1}}, counts)
+	}
+	mockTagRepo.AssertNumberOfCalls(t, "ArticleCounts", 1)
+
+	mockArticleRepo.On("UpdateStatus", mock.Anything, 7, domain.ArticleStatusPublished).Return(&domain.Article{ID: 7}, nil)
+	mockTagRepo.On("ArticleCounts", mock.Anything).Return(map[int]domain.ArticleCounts{1: {Total: 1, Published: 1}}, nil).Once()
+	_, err := articleRepo.UpdateStatus(ctx, 7, domain.ArticleStatusPublished)
+	assert.NoError(t, err)
+
+	counts, err := tagRepo.ArticleCounts(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, map[int]domain.ArticleCounts{1: {Total: 1, Published: 1}}, counts)
+}
+
+// TestTagsETag_Counts 测试文章数变化时标签列表的ETag随之变化
+func TestTagsETag_Counts(t *testing.T) {
+	mockTagRepo := new(MockTagRepository)
+	tagHandler := handler.NewTagHandler(service.NewTagService(mockTagRepo, newStubTransactor(), newRecordingPublisher()))
+	mockTagRepo.On("List", mock.Anything).Return([]*domain.Tag{{ID: 1, Name: "Go"}}, nil)
+	mockTagRepo.On("ArticleCounts", mock.Anything).Return(map[int]domain.ArticleCounts{1: {Total: 1}}, nil).Once()
+	mockTagRepo.On("ArticleCounts", mock.Anything).Return(map[int]domain.ArticleCounts{1: {Total: 2}}, nil).Once()
+
+	var etags []string
+	for i := 0; i < 2; i++ {
+		rec := httptest.NewRecorder()
+		tagHandler.List(echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/api/tags", nil), rec))
+		etags = append(etags, rec.Header().Get("ETag"))
+	}
+	assert.NotEqual(t, etags[0], etags[1])
+}
@@ -0,0 +1,51 @@
+package test
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"goblog/internal/pkg/textstats"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestTextStats_PlainText 测试去掉Markdown格式，链接只保留文字，代码块不计入正文
+func TestTextStats_PlainText(t *testing.T) {
+	content := "# 标题\n\n这是**加粗**和[链接](https://example.com)。\n\n![图片](a.png)\n\n```go\nfunc main() {}\n```\n\n- 列表项 <b>html</b>"
+	assert.Equal(t, "标题 这是加粗和链接。 图片 列表项 html", textstats.PlainText(content))
+}
+
+// TestTextStats_Analyze 测试中文按字计数、英文按词计数，阅读时间向上取整
+func TestTextStats_Analyze(t *testing.T) {
+	stats := textstats.Analyze("Go语言的并发模型 is really nice, isn't it?")
+	assert.Equal(t, 1+7+5, stats.WordCount)
+	assert.Equal(t, 1, stats.ReadingMinutes)
+
+	stats = textstats.Analyze(strings.Repeat("字", 1000))
+	assert.Equal(t, 1000, stats.WordCount)
+	assert.Equal(t, 3, stats.ReadingMinutes)
+
+	stats = textstats.Analyze(strings.Repeat("word ", 450))
+	assert.Equal(t, 450, stats.WordCount)
+	assert.Equal(t, 3, stats.ReadingMinutes)
+
+	assert.Equal(t, textstats.Stats{}, textstats.Analyze("```\ncode\n```"))
+}
+
+// TestTextStats_Excerpt 测试短正文原样作为摘要，长正文在句末或词边界截断
+func TestTextStats_Excerpt(t *testing.T) {
+	assert.Equal(t, "简短的正文", textstats.Excerpt("## 简短的正文"))
+
+	sentence := strings.Repeat("这是一个句子", 10) + "。"
+	excerpt := textstats.Excerpt(strings.Repeat(sentence, 5))
+	assert.True(t, strings.HasSuffix(excerpt, "。"))
+	assert.LessOrEqual(t, utf8.RuneCountInString(excerpt), textstats.ExcerptLength)
+
+	excerpt = textstats.Excerpt(strings.Repeat("lorem ipsum ", 40))
+	assert.True(t, strings.HasSuffix(excerpt, "…"))
+	assert.NotContains(t, excerpt, " …")
+	for _, word := range strings.Fields(strings.TrimSuffix(excerpt, "…")) {
+		assert.Contains(t, []string{"lorem", "ipsum"}, word)
+	}
+}
@@ -0,0 +1,305 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"goblog/internal/domain"
+	"goblog/internal/pkg/jwtkeys"
+	"goblog/internal/pkg/totp"
+	"goblog/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockTwoFactorRepository 两步验证仓储Mock
+type MockTwoFactorRepository struct {
+	mock.Mock
+}
+
+func (m *MockTwoFactorRepository) GetByUsername(ctx context.Context, username string) (*domain.TwoFactor, error) {
+	args := m.Called(ctx, username)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TwoFactor), args.Error(1)
+}
+
+func (m *MockTwoFactorRepository) Save(ctx context.Context, twoFactor *domain.TwoFactor) error {
+	args := m.Called(ctx, twoFactor)
+	return args.Error(0)
+}
+
+func (m *MockTwoFactorRepository) Delete(ctx context.Context, username string) error {
+	args := m.Called(ctx, username)
+	return args.Error(0)
+}
+
+func (m *MockTwoFactorRepository) UseStep(ctx context.Context, username string, step int64) (bool, error) {
+	args := m.Called(ctx, username, step)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockTwoFactorRepository) ReplaceRecoveryCodes(ctx context.Context, username string, codeHashes []string) error {
+	args := m.Called(ctx, username, codeHashes)
+	return args.Error(0)
+}
+
+func (m *MockTwoFactorRepository) ConsumeRecoveryCode(ctx context.Context, username, codeHash string) (bool, error) {
+	args := m.Called(ctx, username, codeHash)
+	return args.Bool(0), args.Error(1)
+}
+
+// MockTwoFactorService 两步验证服务Mock
+type MockTwoFactorService struct {
+	mock.Mock
+}
+
+func (m *MockTwoFactorService) IsEnabled(ctx context.Context, username string) (bool, error) {
+	args := m.Called(ctx, username)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockTwoFactorService) Verify(ctx context.Context, username, code string) error {
+	args := m.Called(ctx, username, code)
+	return args.Error(0)
+}
+
+func (m *MockTwoFactorService) Setup(ctx context.Context, username string) (*domain.TwoFactorSetup, error) {
+	args := m.Called(ctx, username)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TwoFactorSetup), args.Error(1)
+}
+
+func (m *MockTwoFactorService) Enable(ctx context.Context, username, code string) ([]string, error) {
+	args := m.Called(ctx, username, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockTwoFactorService) Disable(ctx context.Context, username, code string) error {
+	args := m.Called(ctx, username, code)
+	return args.Error(0)
+}
+
+func (m *MockTwoFactorService) RegenerateRecoveryCodes(ctx context.Context, username, code string) ([]string, error) {
+	args := m.Called(ctx, username, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+// rfcSecret RFC 6238 测试向量中的密钥 "12345678901234567890"
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+// TestTOTP_Code 测试RFC 6238测试向量
+func TestTOTP_Code(t *testing.T) {
+	code, err := totp.Code(rfcSecret, totp.Step(time.Unix(59, 0)), 8)
+	assert.NoError(t, err)
+	assert.Equal(t, "94287082", code)
+
+	code, err = totp.Code(rfcSecret, totp.Step(time.Unix(1111111109, 0)), 8)
+	assert.NoError(t, err)
+	assert.Equal(t, "07081804", code)
+}
+
+// TestTOTP_Validate 测试验证码允许前后一个时间步的偏差
+func TestTOTP_Validate(t *testing.T) {
+	now := time.Unix(1111111109, 0)
+	previous, _ := totp.Code(rfcSecret, totp.Step(now)-1, totp.Digits)
+
+	step, ok := totp.Validate(rfcSecret, previous, now)
+	assert.True(t, ok)
+	assert.Equal(t, totp.Step(now)-1, step)
+
+	old, _ := totp.Code(rfcSecret, totp.Step(now)-2, totp.Digits)
+	_, ok = totp.Validate(rfcSecret, old, now)
+	assert.False(t, ok)
+
+	_, ok = totp.Validate(rfcSecret, "abc", now)
+	assert.False(t, ok)
+}
+
+// TestTwoFactorService_Verify_ReplayRejected 测试同一时间步的验证码不能重复使用
+func TestTwoFactorService_Verify_ReplayRejected(t *testing.T) {
+	mockRepo := new(MockTwoFactorRepository)
+	twoFactorService := service.NewTwoFactorService(mockRepo, testAuthConfig().TwoFactor)
+
+	step := totp.Step(time.Now())
+	code, _ := totp.Code(rfcSecret, step, totp.Digits)
+	mockRepo.On("GetByUsername", mock.Anything, "admin").Return(&domain.TwoFactor{Username: "admin", Secret: rfcSecret, Enabled: true}, nil)
+	mockRepo.On("UseStep", mock.Anything, "admin", step).Return(true, nil).Once()
+	mockRepo.On("UseStep", mock.Anything, "admin", step).Return(false, nil).Once()
+
+	assert.NoError(t, twoFactorService.Verify(context.Background(), "admin", code))
+	assert.ErrorIs(t, twoFactorService.Verify(context.Background(), "admin", code), domain.ErrUnauthorized)
+}
+
+// TestTwoFactorService_Verify_RecoveryCode 测试使用恢复码验证
+func TestTwoFactorService_Verify_RecoveryCode(t *testing.T) {
+	mockRepo := new(MockTwoFactorRepository)
+	twoFactorService := service.NewTwoFactorService(mockRepo, testAuthConfig().TwoFactor)
+
+	mockRepo.On("GetByUsername", mock.Anything, "admin").Return(&domain.TwoFactor{Username: "admin", Secret: rfcSecret, Enabled: true}, nil)
+	mockRepo.On("ConsumeRecoveryCode", mock.Anything, "admin", mock.MatchedBy(func(hash string) bool {
+		return len(hash) == 64
+	})).Return(true, nil)
+
+	err := twoFactorService.Verify(context.Background(), "admin", "ABCDE-FGHIJ")
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+// TestTwoFactorService_Enable 测试确认绑定后生成恢复码
+func TestTwoFactorService_Enable(t *testing.T) {
+	mockRepo := new(MockTwoFactorRepository)
+	twoFactorService := service.NewTwoFactorService(mockRepo, testAuthConfig().TwoFactor)
+
+	code, _ := totp.Code(rfcSecret, totp.Step(time.Now()), totp.Digits)
+	mockRepo.On("GetByUsername", mock.Anything, "admin").Return(&domain.TwoFactor{Username: "admin", Secret: rfcSecret}, nil)
+	mockRepo.On("Save", mock.Anything, mock.MatchedBy(func(tf *domain.TwoFactor) bool {
+		return tf.Enabled && tf.LastUsedStep > 0
+	})).Return(nil)
+	mockRepo.On("ReplaceRecoveryCodes", mock.Anything, "admin", mock.MatchedBy(func(hashes []string) bool {
+		return len(hashes) == 10
+	})).Return(nil)
+
+	codes, err := twoFactorService.Enable(context.Background(), "admin", code)
+
+	assert.NoError(t, err)
+	assert.Len(t, codes, 10)
+	assert.Len(t, codes[0], 11)
+	mockRepo.AssertExpectations(t)
+}
+
+// TestAuthService_Login_TwoFactorChallenge 测试开启两步验证后登录只返回挑战令牌
+func TestAuthService_Login_TwoFactorChallenge(t *testing.T) {
+	mockAttemptRepo := new(MockLoginAttemptRepository)
+	mockThrottleRepo := new(MockLoginThrottleRepository)
+	mockRefreshRepo := new(MockRefreshTokenRepository)
+	mockDenylistRepo := new(MockTokenDenylistRepository)
+	mockTwoFactor := new(MockTwoFactorService)
+	authService := service.NewAuthService(testAuthConfig(), jwtkeys.NewHMAC("test-secret"), mockAttemptRepo, mockThrottleRepo, mockRefreshRepo, mockDenylistRepo, mockTwoFactor, new(MockUserRepository))
+
+	mockThrottleRepo.On("Get", mock.Anything, mock.Anything).Return(nil, domain.ErrNotFound)
+	mockTwoFactor.On("IsEnabled", mock.Anything, "admin").Return(true, nil)
+
+	resp, err := authService.Login(context.Background(), &domain.LoginRequest{Username: "admin", Password: "admin123"})
+
+	assert.NoError(t, err)
+	assert.True(t, resp.TwoFactorRequired)
+	assert.Empty(t, resp.Token)
+	assert.NotEmpty(t, resp.ChallengeToken)
+
+	// 挑战令牌不能当作访问令牌使用
+	_, err = authService.ValidateToken(context.Background(), "Bearer "+resp.ChallengeToken)
+	assert.ErrorIs(t, err, domain.ErrUnauthorized)
+	mockRefreshRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+
+	// 提交正确的验证码后签发令牌，挑战令牌被吊销
+	mockDenylistRepo.On("Contains", mock.Anything, mock.Anything).Return(false, nil)
+	mockTwoFactor.On("Verify", mock.Anything, "admin", "123456").Return(nil)
+	mockDenylistRepo.On("Add", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	mockThrottleRepo.On("Reset", mock.Anything, "user:admin").Return(nil)
+	mockAttemptRepo.On("Create", mock.Anything, mock.MatchedBy(func(a *domain.LoginAttempt) bool {
+		return a.Success
+	})).Return(nil)
+	mockRefreshRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+	tokens, err := authService.VerifyTwoFactor(context.Background(), &domain.TwoFactorLoginRequest{
+		ChallengeToken: resp.ChallengeToken,
+		Code:           "123456",
+	})
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, tokens.Token)
+	mockDenylistRepo.AssertExpectations(t)
+}
+
+// TestAuthService_VerifyTwoFactor_WrongCode 测试验证码错误计入失败次数
+func TestAuthService_VerifyTwoFactor_WrongCode(t *testing.T) {
+	mockAttemptRepo := new(MockLoginAttemptRepository)
+	mockThrottleRepo := new(MockLoginThrottleRepository)
+	mockDenylistRepo := new(MockTokenDenylistRepository)
+	mockTwoFactor := new(MockTwoFactorService)
+	authService := service.NewAuthService(testAuthConfig(), jwtkeys.NewHMAC("test-secret"), mockAttemptRepo, mockThrottleRepo, new(MockRefreshTokenRepository), mockDenylistRepo, mockTwoFactor, new(MockUserRepository))
+
+	mockThrottleRepo.On("Get", mock.Anything, mock.Anything).Return(nil, domain.ErrNotFound)
+	mockTwoFactor.On("IsEnabled", mock.Anything, "admin").Return(true, nil)
+	resp, _ := authService.Login(context.Background(), &domain.LoginRequest{Username: "admin", Password: "admin123"})
+
+	mockDenylistRepo.On("Contains", mock.Anything, mock.Anything).Return(false, nil)
+	mockTwoFactor.On("Verify", mock.Anything, "admin", "000000").Return(domain.ErrUnauthorized)
+	mockThrottleRepo.On("RecordFailure", mock.Anything, "user:admin", 15*time.Minute).
+		Return(&domain.LoginThrottle{Key: "user:admin", Failures: 1, LastFailureAt: time.Now()}, nil)
+	mockAttemptRepo.On("Create", mock.Anything, mock.MatchedBy(func(a *domain.LoginAttempt) bool {
+		return !a.Success && a.Reason == "invalid_two_factor_code"
+	})).Return(nil)
+
+	_, err := authService.VerifyTwoFactor(context.Background(), &domain.TwoFactorLoginRequest{
+		ChallengeToken: resp.ChallengeToken,
+		Code:           "000000",
+	})
+
+	assert.ErrorIs(t, err, domain.ErrUnauthorized)
+	mockThrottleRepo.AssertExpectations(t)
+	mockAttemptRepo.AssertExpectations(t)
+}
+
+// TestAuthService_Login_TwoFactorSetupRequired 测试强制管理员开启两步验证时，未绑定的管理员只能拿到绑定用的挑战令牌
+func TestAuthService_Login_TwoFactorSetupRequired(t *testing.T) {
+	cfg := testAuthConfig()
+	cfg.TwoFactor.RequireForAdmin = true
+	mockThrottleRepo := new(MockLoginThrottleRepository)
+	mockTwoFactor := new(MockTwoFactorService)
+	mockRefreshRepo := new(MockRefreshTokenRepository)
+	authService := service.NewAuthService(cfg, jwtkeys.NewHMAC("test-secret"), new(MockLoginAttemptRepository), mockThrottleRepo, mockRefreshRepo, new(MockTokenDenylistRepository), mockTwoFactor, new(MockUserRepository))
+
+	mockThrottleRepo.On("Get", mock.Anything, mock.Anything).Return(nil, domain.ErrNotFound)
+	mockTwoFactor.On("IsEnabled", mock.Anything, "admin").Return(false, nil)
+
+	resp, err := authService.Login(context.Background(), &domain.LoginRequest{Username: "admin", Password: "admin123"})
+
+	assert.NoError(t, err)
+	assert.True(t, resp.TwoFactorSetupRequired)
+	assert.Empty(t, resp.Token)
+
+	username, err := authService.ValidateChallenge(context.Background(), resp.ChallengeToken)
+	assert.NoError(t, err)
+	assert.Equal(t, "admin", username)
+	mockRefreshRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+// TestAuthService_IssueSession_TwoFactor 测试单点登录同样需要两步验证：已绑定时返回验证挑战，映射为管理员且未绑定时返回绑定挑战
+func TestAuthService_IssueSession_TwoFactor(t *testing.T) {
+	cfg := testAuthConfig()
+	cfg.TwoFactor.RequireForAdmin = true
+	mockUserRepo := new(MockUserRepository)
+	mockTwoFactor := new(MockTwoFactorService)
+	mockRefreshRepo := new(MockRefreshTokenRepository)
+	authService := service.NewAuthService(cfg, jwtkeys.NewHMAC("test-secret"), new(MockLoginAttemptRepository), new(MockLoginThrottleRepository), mockRefreshRepo, new(MockTokenDenylistRepository), mockTwoFactor, mockUserRepo)
+
+	mockTwoFactor.On("IsEnabled", mock.Anything, "alice").Return(true, nil)
+	mockTwoFactor.On("IsEnabled", mock.Anything, "bob").Return(false, nil)
+	mockUserRepo.On("GetByUsername", mock.Anything, "bob").Return(&domain.User{ID: 2, Username: "bob", Role: domain.RoleAdmin}, nil)
+
+	resp, err := authService.IssueSession(context.Background(), &domain.LoginRequest{Username: "alice"})
+	assert.NoError(t, err)
+	assert.True(t, resp.TwoFactorRequired)
+	assert.Empty(t, resp.Token)
+
+	resp, err = authService.IssueSession(context.Background(), &domain.LoginRequest{Username: "bob"})
+	assert.NoError(t, err)
+	assert.True(t, resp.TwoFactorSetupRequired)
+	assert.Empty(t, resp.Token)
+
+	mockRefreshRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
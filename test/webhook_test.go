@@ -0,0 +1,423 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"goblog/internal/config"
+	"goblog/internal/domain"
+	"goblog/internal/pkg/webhook"
+	"goblog/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockWebhookRepository Webhook订阅仓储Mock
+type MockWebhookRepository struct {
+	mock.Mock
+}
+
+func (m *MockWebhookRepository) Create(ctx context.Context, w *domain.Webhook) (*domain.Webhook, error) {
+	args := m.Called(ctx, w)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Webhook), args.Error(1)
+}
+
+func (m *MockWebhookRepository) GetByID(ctx context.Context, id int) (*domain.Webhook, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Webhook), args.Error(1)
+}
+
+func (m *MockWebhookRepository) Update(ctx context.Context, id int, w *domain.Webhook) (*domain.Webhook, error) {
+	args := m.Called(ctx, id, w)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Webhook), args.Error(1)
+}
+
+func (m *MockWebhookRepository) Delete(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockWebhookRepository) List(ctx context.Context) ([]*domain.Webhook, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*domain.Webhook), args.Error(1)
+}
+
+func (m *MockWebhookRepository) ListActive(ctx context.Context) ([]*domain.Webhook, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*domain.Webhook), args.Error(1)
+}
+
+// MockWebhookDeliveryRepository Webhook投递仓储Mock
+type MockWebhookDeliveryRepository struct {
+	mock.Mock
+}
+
+func (m *MockWebhookDeliveryRepository) CreateBulk(ctx context.Context, deliveries []*domain.WebhookDelivery) error {
+	args := m.Called(ctx, deliveries)
+	return args.Error(0)
+}
+
+func (m *MockWebhookDeliveryRepository) CreateForEvent(ctx context.Context, deliveries []*domain.WebhookDelivery) error {
+	args := m.Called(ctx, deliveries)
+	return args.Error(0)
+}
+
+func (m *MockWebhookDeliveryRepository) GetByID(ctx context.Context, id int) (*domain.WebhookDelivery, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.WebhookDelivery), args.Error(1)
+}
+
+func (m *MockWebhookDeliveryRepository) List(ctx context.Context, webhookID int, params domain.WebhookDeliveryQueryParams) ([]*domain.WebhookDelivery, int64, error) {
+	args := m.Called(ctx, webhookID, params)
+	return args.Get(0).([]*domain.WebhookDelivery), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockWebhookDeliveryRepository) ClaimDue(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]*domain.WebhookDelivery, error) {
+	args := m.Called(ctx, now, limit, lease)
+	return args.Get(0).([]*domain.WebhookDelivery), args.Error(1)
+}
+
+func (m *MockWebhookDeliveryRepository) SaveResult(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	args := m.Called(ctx, delivery)
+	return args.Error(0)
+}
+
+// MockWebhookService Webhook服务Mock
+type MockWebhookService struct {
+	mock.Mock
+}
+
+func (m *MockWebhookService) Create(ctx context.Context, username string, req *domain.WebhookCreateRequest) (*domain.WebhookCreated, error) {
+	args := m.Called(ctx, username, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.WebhookCreated), args.Error(1)
+}
+
+func (m *MockWebhookService) GetByID(ctx context.Context, id int) (*domain.Webhook, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Webhook), args.Error(1)
+}
+
+func (m *MockWebhookService) Update(ctx context.Context, id int, req *domain.WebhookUpdateRequest) (*domain.Webhook, error) {
+	args := m.Called(ctx, id, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Webhook), args.Error(1)
+}
+
+func (m *MockWebhookService) Delete(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockWebhookService) List(ctx context.Context) ([]*domain.Webhook, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*domain.Webhook), args.Error(1)
+}
+
+func (m *MockWebhookService) ListDeliveries(ctx context.Context, webhookID int, params domain.WebhookDeliveryQueryParams) ([]*domain.WebhookDelivery, int64, error) {
+	args := m.Called(ctx, webhookID, params)
+	return args.Get(0).([]*domain.WebhookDelivery), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockWebhookService) Replay(ctx context.Context, webhookID, deliveryID int) (*domain.WebhookDelivery, error) {
+	args := m.Called(ctx, webhookID, deliveryID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.WebhookDelivery), args.Error(1)
+}
+
+func (m *MockWebhookService) Trigger(ctx context.Context, event string, data interface{}) error {
+	args := m.Called(ctx, event, data)
+	return args.Error(0)
+}
+
+func (m *MockWebhookService) ProcessDue(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
+
+// testWebhookConfig 测试用Webhook配置
+func testWebhookConfig() config.WebhookConfig {
+	return config.WebhookConfig{
+		MaxAttempts:  3,
+		BackoffBase:  time.Minute,
+		BackoffMax:   time.Hour,
+		Timeout:      5 * time.Second,
+		PollInterval: time.Second,
+		BatchSize:    10,
+	}
+}
+
+// newTestWebhookService 创建Webhook服务及其Mock依赖
+func newTestWebhookService() (domain.WebhookService, *MockWebhookRepository, *MockWebhookDeliveryRepository) {
+	mockWebhookRepo := new(MockWebhookRepository)
+	mockDeliveryRepo := new(MockWebhookDeliveryRepository)
+	webhookService := service.NewWebhookService(mockWebhookRepo, mockDeliveryRepo, &http.Client{}, testWebhookConfig())
+	return webhookService, mockWebhookRepo, mockDeliveryRepo
+}
+
+// TestWebhookSignature 测试签名校验，篡改请求体或时间戳过期都会失败
+func TestWebhookSignature(t *testing.T) {
+	body := []byte(`{"event":"article.published"}`)
+	now := time.Unix(1700000000, 0)
+	signature := webhook.Sign("secret", now.Unix(), body)
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+
+	assert.True(t, webhook.Verify("secret", signature, timestamp, body, 5*time.Minute, now))
+	assert.False(t, webhook.Verify("other", signature, timestamp, body, 5*time.Minute, now))
+	assert.False(t, webhook.Verify("secret", signature, timestamp, []byte(`{}`), 5*time.Minute, now))
+	assert.False(t, webhook.Verify("secret", signature, timestamp, body, 5*time.Minute, now.Add(10*time.Minute)))
+}
+
+// TestWebhookService_CreateValidation 测试回调地址和事件校验，并在未提供时生成签名密钥
+func TestWebhookService_CreateValidation(t *testing.T) {
+	webhookService, mockWebhookRepo, _ := newTestWebhookService()
+	ctx := context.Background()
+
+	_, err := webhookService.Create(ctx, "admin", &domain.WebhookCreateRequest{URL: "ftp://example.com", Events: []string{domain.EventArticlePublished}})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	_, err = webhookService.Create(ctx, "admin", &domain.WebhookCreateRequest{URL: "https://example.com/hook", Events: []string{"article.viewed"}})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	var saved *domain.Webhook
+	mockWebhookRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.Webhook")).
+		Run(func(args mock.Arguments) { saved = args.Get(1).(*domain.Webhook) }).
+		Return(&domain.Webhook{ID: 1, Events: []string{domain.EventArticlePublished}, Active: true, CreatedBy: "admin"}, nil)
+
+	created, err := webhookService.Create(ctx, "admin", &domain.WebhookCreateRequest{
+		URL:    "https://example.com/hook",
+		Events: []string{domain.EventArticlePublished, domain.EventArticlePublished},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{domain.EventArticlePublished}, saved.Events)
+	assert.True(t, saved.Active)
+	assert.Equal(t, "admin", saved.CreatedBy)
+	assert.Contains(t, created.Secret, "whsec_")
+	assert.Equal(t, saved.Secret, created.Secret)
+}
+
+// TestWebhookService_Trigger 测试只为订阅了该事件的Webhook创建投递，同一事件共享事件ID
+func TestWebhookService_Trigger(t *testing.T) {
+	webhookService, mockWebhookRepo, mockDeliveryRepo := newTestWebhookService()
+
+	mockWebhookRepo.On("ListActive", mock.Anything).Return([]*domain.Webhook{
+		{ID: 1, Events: []string{domain.EventArticlePublished}},
+		{ID: 2, Events: []string{domain.EventCommentCreated}},
+		{ID: 3, Events: []string{domain.WebhookEventAll}},
+	}, nil)
+
+	var saved []*domain.WebhookDelivery
+	mockDeliveryRepo.On("CreateForEvent", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { saved = args.Get(1).([]*domain.WebhookDelivery) }).
+		Return(nil)
+
+	err := webhookService.Trigger(context.Background(), domain.EventArticlePublished, &domain.Article{ID: 7, Title: "Hello"})
+
+	assert.NoError(t, err)
+	if assert.Len(t, saved, 2) {
+		assert.Equal(t, 1, saved[0].WebhookID)
+		assert.Equal(t, 3, saved[1].WebhookID)
+		assert.Equal(t, saved[0].EventID, saved[1].EventID)
+
+		var payload struct {
+			ID    string         `json:"id"`
+			Event string         `json:"event"`
+			Data  domain.Article `json:"data"`
+		}
+		assert.NoError(t, json.Unmarshal([]byte(saved[0].Payload), &payload))
+		assert.Equal(t, saved[0].EventID, payload.ID)
+		assert.Equal(t, domain.EventArticlePublished, payload.Event)
+		assert.Equal(t, 7, payload.Data.ID)
+	}
+}
+
+// TestWebhookService_TriggerOutboxEventID 测试由发件箱投递时使用发件箱的事件ID，重复投递生成相同的投递记录
+func TestWebhookService_TriggerOutboxEventID(t *testing.T) {
+	webhookService, mockWebhookRepo, mockDeliveryRepo := newTestWebhookService()
+
+	mockWebhookRepo.On("ListActive", mock.Anything).Return([]*domain.Webhook{
+		{ID: 1, Events: []string{domain.WebhookEventAll}},
+	}, nil)
+
+	var saved []*domain.WebhookDelivery
+	mockDeliveryRepo.On("CreateForEvent", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { saved = append(saved, args.Get(1).([]*domain.WebhookDelivery)...) }).
+		Return(nil)
+
+	ctx := domain.WithEventID(context.Background(), "evt-outbox")
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, webhookService.Trigger(ctx, domain.EventArticleDeleted, &domain.Article{ID: 7}))
+	}
+
+	if assert.Len(t, saved, 2) {
+		assert.Equal(t, "evt-outbox", saved[0].EventID)
+		assert.Equal(t, saved[0].EventID, saved[1].EventID)
+		assert.Contains(t, saved[0].Payload, `"id":"evt-outbox"`)
+	}
+}
+
+// TestWebhookService_ProcessDueSuccess 测试投递请求携带可校验的签名
+func TestWebhookService_ProcessDueSuccess(t *testing.T) {
+	payload := `{"id":"evt","event":"article.published","data":{}}`
+
+	var received http.Header
+	var receivedBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header
+		receivedBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	webhookService, mockWebhookRepo, mockDeliveryRepo := newTestWebhookService()
+
+	delivery := &domain.WebhookDelivery{ID: 5, WebhookID: 1, EventID: "evt", Event: domain.EventArticlePublished, Payload: payload, Status: domain.WebhookDeliveryPending}
+	mockDeliveryRepo.On("ClaimDue", mock.Anything, mock.Anything, 10, mock.Anything).Return([]*domain.WebhookDelivery{delivery}, nil)
+	mockWebhookRepo.On("GetByID", mock.Anything, 1).Return(&domain.Webhook{ID: 1, URL: server.URL, Secret: "topsecret", Active: true}, nil)
+	mockDeliveryRepo.On("SaveResult", mock.Anything, delivery).Return(nil)
+
+	n, err := webhookService.ProcessDue(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, domain.WebhookDeliverySucceeded, delivery.Status)
+	assert.Equal(t, 1, delivery.Attempts)
+	assert.Equal(t, http.StatusNoContent, delivery.ResponseStatus)
+	assert.NotNil(t, delivery.DeliveredAt)
+
+	assert.Equal(t, payload, string(receivedBody))
+	assert.Equal(t, domain.EventArticlePublished, received.Get(webhook.HeaderEvent))
+	assert.Equal(t, "5", received.Get(webhook.HeaderDelivery))
+	assert.True(t, webhook.Verify("topsecret", received.Get(webhook.HeaderSignature), received.Get(webhook.HeaderTimestamp), receivedBody, time.Minute, time.Now()))
+}
+
+// TestWebhookService_ProcessDueRetry 测试失败后按指数退避重试，达到最大次数后标记为失败
+func TestWebhookService_ProcessDueRetry(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		_, _ = w.Write([]byte("upstream down"))
+	}))
+	defer server.Close()
+
+	webhookService, mockWebhookRepo, mockDeliveryRepo := newTestWebhookService()
+
+	first := &domain.WebhookDelivery{ID: 1, WebhookID: 1, Payload: `{}`, Attempts: 1}
+	last := &domain.WebhookDelivery{ID: 2, WebhookID: 1, Payload: `{}`, Attempts: 2}
+	mockDeliveryRepo.On("ClaimDue", mock.Anything, mock.Anything, 10, mock.Anything).Return([]*domain.WebhookDelivery{first, last}, nil)
+	mockWebhookRepo.On("GetByID", mock.Anything, 1).Return(&domain.Webhook{ID: 1, URL: server.URL, Secret: "s", Active: true}, nil).Once()
+	mockDeliveryRepo.On("SaveResult", mock.Anything, mock.Anything).Return(nil)
+
+	before := time.Now()
+	n, err := webhookService.ProcessDue(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	// 第二次失败，等待 1分钟*2
+	assert.Equal(t, domain.WebhookDeliveryPending, first.Status)
+	assert.Equal(t, 2, first.Attempts)
+	assert.Equal(t, http.StatusBadGateway, first.ResponseStatus)
+	assert.Equal(t, "upstream down", first.ResponseBody)
+	assert.Equal(t, "unexpected status 502", first.LastError)
+	assert.WithinDuration(t, before.Add(2*time.Minute), first.NextAttemptAt, 5*time.Second)
+
+	assert.Equal(t, domain.WebhookDeliveryFailed, last.Status)
+	assert.Equal(t, 3, last.Attempts)
+	mockWebhookRepo.AssertExpectations(t)
+}
+
+// TestWebhookService_ProcessDueDeletedWebhook 测试订阅已删除的投递直接标记为失败
+func TestWebhookService_ProcessDueDeletedWebhook(t *testing.T) {
+	webhookService, mockWebhookRepo, mockDeliveryRepo := newTestWebhookService()
+
+	delivery := &domain.WebhookDelivery{ID: 1, WebhookID: 9, Payload: `{}`}
+	mockDeliveryRepo.On("ClaimDue", mock.Anything, mock.Anything, 10, mock.Anything).Return([]*domain.WebhookDelivery{delivery}, nil)
+	mockWebhookRepo.On("GetByID", mock.Anything, 9).Return(nil, domain.ErrNotFound)
+	mockDeliveryRepo.On("SaveResult", mock.Anything, delivery).Return(nil)
+
+	_, err := webhookService.ProcessDue(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.WebhookDeliveryFailed, delivery.Status)
+	assert.Equal(t, 0, delivery.Attempts)
+}
+
+// TestWebhookService_Replay 测试重放使用原始请求体生成新的投递记录
+func TestWebhookService_Replay(t *testing.T) {
+	webhookService, mockWebhookRepo, mockDeliveryRepo := newTestWebhookService()
+	ctx := context.Background()
+
+	original := &domain.WebhookDelivery{ID: 4, WebhookID: 1, EventID: "evt", Event: domain.EventArticleDeleted, Payload: `{"id":"evt"}`, Status: domain.WebhookDeliveryFailed}
+	mockDeliveryRepo.On("GetByID", mock.Anything, 4).Return(original, nil)
+	mockWebhookRepo.On("GetByID", mock.Anything, 1).Return(&domain.Webhook{ID: 1}, nil)
+	mockDeliveryRepo.On("CreateBulk", mock.Anything, mock.Anything).Return(nil)
+
+	// 投递记录不属于该订阅
+	_, err := webhookService.Replay(ctx, 2, 4)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	replay, err := webhookService.Replay(ctx, 1, 4)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "evt", replay.EventID)
+	assert.Equal(t, original.Payload, replay.Payload)
+	assert.Equal(t, 4, replay.ReplayOf)
+}
+
+// TestSubscribeWebhooks 测试Webhook订阅者只推送可订阅的事件，并隐藏评论者隐私信息
+func TestSubscribeWebhooks(t *testing.T) {
+	mockWebhookService := new(MockWebhookService)
+	bus := domain.NewEventBus()
+	service.SubscribeWebhooks(bus, mockWebhookService)
+
+	draft := &domain.Article{ID: 1, Title: "草稿"}
+	published := &domain.Article{ID: 1, Title: "草稿", Published: true}
+	edited := &domain.Article{ID: 1, Title: "修改后", Published: true}
+
+	mockWebhookService.On("Trigger", mock.Anything, domain.EventArticlePublished, published).Return(nil).Once()
+	mockWebhookService.On("Trigger", mock.Anything, domain.EventArticleUpdated, edited).Return(nil).Once()
+	mockWebhookService.On("Trigger", mock.Anything, domain.EventCommentCreated, &domain.Comment{ID: 3, ArticleID: 1, AuthorName: "visitor", Content: "nice", Status: domain.CommentStatusPending}).
+		Return(nil).Once()
+
+	ctx := context.Background()
+	// 草稿首次发布只推送发布事件
+	assert.NoError(t, bus.Publish(ctx, domain.ArticleUpdated{Before: draft, Article: published}))
+	assert.NoError(t, bus.Publish(ctx, domain.ArticlePublished{Article: published}))
+	assert.NoError(t, bus.Publish(ctx, domain.ArticleUpdated{Before: published, Article: edited}))
+	// 垃圾评论不推送
+	assert.NoError(t, bus.Publish(ctx, domain.CommentCreated{Comment: &domain.Comment{ID: 2, Status: domain.CommentStatusSpam}}))
+	assert.NoError(t, bus.Publish(ctx, domain.CommentCreated{Comment: &domain.Comment{
+		ID: 3, ArticleID: 1, AuthorName: "visitor", AuthorEmail: "v@example.com", IP: "10.0.0.1", Content: "nice", Status: domain.CommentStatusPending,
+	}}))
+
+	mockWebhookService.AssertExpectations(t)
+}